@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
@@ -16,13 +17,25 @@ func main() {
 	flag.StringVar(output, "o", "", "write facts JSON to file (shorthand)")
 	deltaFrom := flag.String("delta-from", "", "previous facts JSON to compute delta from")
 	deltaOut := flag.String("delta-out", "", "write delta JSON to file (requires --delta-from)")
+	minSchema := flag.String("min-schema", "", "fail unless the emitted schema_version is at least this version")
+	format := flag.String("format", "json", "output format: json (one indented object) or ndjson (one {\"table\",\"row\"} record per line)")
 	flag.Parse()
 
 	args := flag.Args()
 	if len(args) < 1 {
-		fmt.Fprintln(os.Stderr, "Usage: vhdl-facts [--output file] [--delta-from prev.json --delta-out delta.json] <path>")
+		fmt.Fprintln(os.Stderr, "Usage: vhdl-facts [--output file] [--format json|ndjson] [--delta-from prev.json --delta-out delta.json] [--min-schema version] <path>")
 		os.Exit(1)
 	}
+	if *format != "json" && *format != "ndjson" {
+		fmt.Fprintf(os.Stderr, "Error: unknown --format %q (want json or ndjson)\n", *format)
+		os.Exit(1)
+	}
+	if *minSchema != "" {
+		if err := facts.CheckMinSchema(*minSchema, facts.SchemaVersion); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
 
 	path := args[0]
 	cfg, err := config.Load(path)
@@ -62,14 +75,12 @@ func main() {
 	tables := facts.BuildTables(idx.Facts, idx.FileLibraries, idx.ThirdPartyFiles, symbolRows)
 
 	if *output != "" {
-		if err := writeJSON(*output, tables); err != nil {
+		if err := writeTablesFile(*output, tables, *format); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing facts: %v\n", err)
 			os.Exit(1)
 		}
 	} else {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		if err := enc.Encode(tables); err != nil {
+		if err := writeTables(os.Stdout, tables, *format); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding facts: %v\n", err)
 			os.Exit(1)
 		}
@@ -107,6 +118,29 @@ func readTables(path string) (facts.Tables, error) {
 	return tables, nil
 }
 
+// writeTables writes tables to w as one indented JSON object, or as
+// newline-delimited table records when format is "ndjson".
+func writeTables(w io.Writer, tables facts.Tables, format string) error {
+	if format == "ndjson" {
+		return facts.WriteNDJSON(w, tables)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(tables)
+}
+
+func writeTablesFile(path string, tables facts.Tables, format string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := writeTables(f, tables, format); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
 func writeJSON(path string, data interface{}) error {
 	f, err := os.Create(path)
 	if err != nil {
@@ -25,13 +25,170 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
 	"github.com/robert-at-pretension-io/vhdl-lint/internal/indexer"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
 )
 
+// exitThreshold is the exit code when violations reach the --fail-on
+// threshold, distinct from the exit code 1 used for internal errors.
+const exitThreshold = 2
+
+// failOn is the --fail-on severity threshold. "none" keeps exit code 0
+// whatever is reported.
+var failOn = "none"
+
+// baselinePath and writeBaselinePath are the --baseline and --write-baseline
+// files; like --fail-on they combine with any lint command.
+var baselinePath, writeBaselinePath string
+
+// sarifPath and junitPath are the --sarif and --junit report files, written
+// alongside any lint command's normal output.
+var sarifPath, junitPath string
+
+// csvPath is the --csv report file (violations after --baseline), written
+// alongside any lint command's normal output.
+var csvPath string
+
+// manifestPath is the --manifest file listing each analyzed file's content
+// hash and whether its facts came from the cache; it combines with any lint
+// command.
+var manifestPath string
+
+// outputDir is the --output-dir directory for per-file JSON reports, written
+// alongside any lint command's normal output.
+var outputDir string
+
+// diffSpec is the --diff unified diff file or git ref; only violations on
+// lines it adds are reported.
+var diffSpec string
+
+// onlyRules and skipRules are the --rules allowlist and --skip-rules
+// denylist (applied after --rules); they combine with any lint command.
+var onlyRules, skipRules []string
+
+// quiet is --quiet (alias --no-summary): print only violations, one
+// "file:line: [rule] severity: message" line each; it combines with any lint
+// command.
+var quiet bool
+
+// progressJSON is --progress-json: stream one NDJSON event per extracted
+// file to stderr; it combines with any lint command, including --json.
+var progressJSON bool
+
+// cacheDirPath is the --cache-dir directory for the facts and policy caches,
+// overriding analysis.cache.dir; it combines with any lint command and
+// --clear-policy-cache.
+var cacheDirPath string
+
+// maxParseErrors is --max-parse-errors: files in which ERROR and MISSING
+// nodes are more than this percentage of the parse tree are reported as parse
+// errors instead of being extracted (0, the flag's absence, = no limit; the
+// flag itself only accepts a percentage above 0); it combines with any lint
+// command.
+var maxParseErrors float64
+
+// lintTimeout is --timeout: stop extracting after this long and report the
+// partial result as truncated (0 = no limit); it combines with any lint
+// command.
+var lintTimeout time.Duration
+
+// fileListPath is the -f filelist. When set, the listed files replace
+// directory scanning and the filelist's directory is the lint path.
+var fileListPath string
+
+// stdinFilename is --filename with --stdin: the path the VHDL read from
+// stdin stands in for. The rest of the project is linted from the nearest
+// enclosing directory with a config, and only this file is reported.
+var stdinFilename string
+
+// stdinContent is the VHDL read from stdin for --stdin.
+var stdinContent []byte
+
 func main() {
+	args, level, err := parseFailOn(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	failOn = level
+	if args, baselinePath, err = parsePathFlag(args, "--baseline"); err == nil {
+		args, writeBaselinePath, err = parsePathFlag(args, "--write-baseline")
+	}
+	if err == nil {
+		args, sarifPath, err = parsePathFlag(args, "--sarif")
+	}
+	if err == nil {
+		args, junitPath, err = parsePathFlag(args, "--junit")
+	}
+	if err == nil {
+		args, csvPath, err = parsePathFlag(args, "--csv")
+	}
+	if err == nil {
+		args, diffSpec, err = parsePathFlag(args, "--diff")
+	}
+	if err == nil {
+		args, manifestPath, err = parsePathFlag(args, "--manifest")
+	}
+	if err == nil {
+		args, outputDir, err = parsePathFlag(args, "--output-dir")
+	}
+	if err == nil {
+		args, cacheDirPath, err = parsePathFlag(args, "--cache-dir")
+	}
+	if err == nil && cacheDirPath != "" {
+		cacheDirPath, err = filepath.Abs(cacheDirPath)
+	}
+	if err == nil {
+		args, maxParseErrors, err = parsePercentFlag(args, "--max-parse-errors")
+	}
+	if err == nil {
+		args, lintTimeout, err = parseDurationFlag(args, "--timeout")
+	}
+	if err == nil {
+		args, fileListPath, err = parsePathFlag(args, "-f")
+	}
+	if err == nil {
+		args, stdinFilename, err = parsePathFlag(args, "--filename")
+	}
+	if err == nil {
+		args, onlyRules, err = parseRulesFlag(args, "--rules")
+	}
+	if err == nil {
+		args, skipRules, err = parseRulesFlag(args, "--skip-rules")
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	var noSummary bool
+	args, quiet = parseBoolFlag(args, "--quiet")
+	args, noSummary = parseBoolFlag(args, "--no-summary")
+	quiet = quiet || noSummary
+	args, progressJSON = parseBoolFlag(args, "--progress-json")
+	if fileListPath != "" {
+		args = append(args, filepath.Dir(fileListPath))
+	}
+	var useStdin bool
+	if args, useStdin = parseBoolFlag(args, "--stdin"); useStdin || stdinFilename != "" {
+		root, err := readStdinFile(useStdin)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		args = append(args, root)
+	}
+	os.Args = append(os.Args[:1], args...)
+
 	if len(os.Args) < 2 {
 		printUsage()
 		os.Exit(1)
@@ -47,45 +204,174 @@ func main() {
 			printUsage()
 			os.Exit(1)
 		}
-		runLintWithFlags(os.Args[2], true, false, false, false, false)
+		runLintWithFlags(os.Args[2:], true, false, false, false, false)
 	case "-p", "--progress":
 		if len(os.Args) < 3 {
 			printUsage()
 			os.Exit(1)
 		}
-		runLintWithFlags(os.Args[2], false, false, true, false, false)
+		runLintWithFlags(os.Args[2:], false, false, true, false, false)
 	case "-t", "--trace":
 		if len(os.Args) < 3 {
 			printUsage()
 			os.Exit(1)
 		}
-		runLintWithFlags(os.Args[2], false, false, true, true, false)
+		runLintWithFlags(os.Args[2:], false, false, true, true, false)
 	case "--policy-trace":
 		if len(os.Args) < 3 {
 			printUsage()
 			os.Exit(1)
 		}
 		_ = os.Setenv("VHDL_POLICY_TRACE_TIMING", "1")
-		runLintWithFlags(os.Args[2], false, false, false, false, false)
+		runLintWithFlags(os.Args[2:], false, false, false, false, false)
 	case "--policy-stream":
 		if len(os.Args) < 3 {
 			printUsage()
 			os.Exit(1)
 		}
 		_ = os.Setenv("VHDL_POLICY_STREAM", "1")
-		runLintWithFlags(os.Args[2], false, false, false, false, false)
+		runLintWithFlags(os.Args[2:], false, false, false, false, false)
+	case "--stream-input":
+		if len(os.Args) < 3 {
+			printUsage()
+			os.Exit(1)
+		}
+		_ = os.Setenv("VHDL_POLICY_INPUT_NDJSON", "1")
+		runLintWithFlags(os.Args[2:], false, false, false, false, false)
 	case "-j", "--json":
 		if len(os.Args) < 3 {
 			printUsage()
 			os.Exit(1)
 		}
-		runLintWithFlags(os.Args[2], false, true, false, false, false)
+		runLintWithFlags(os.Args[2:], false, true, false, false, false)
 	case "--timing":
 		if len(os.Args) < 3 {
 			printUsage()
 			os.Exit(1)
 		}
-		runLintWithFlags(os.Args[2], false, false, false, false, true)
+		runLintWithFlags(os.Args[2:], false, false, false, false, true)
+	case "--list-files":
+		if len(os.Args) < 3 {
+			printUsage()
+			os.Exit(1)
+		}
+		if os.Args[2] == "-j" || os.Args[2] == "--json" {
+			if len(os.Args) < 4 {
+				printUsage()
+				os.Exit(1)
+			}
+			runListFiles(os.Args[3], true)
+			return
+		}
+		runListFiles(os.Args[2], false)
+	case "--hier-dot":
+		if len(os.Args) < 3 {
+			printUsage()
+			os.Exit(1)
+		}
+		runGraph(os.Args[2], "dot")
+	case "graph":
+		args := os.Args[2:]
+		format := "dot"
+		if len(args) >= 2 && args[0] == "--format" {
+			format = args[1]
+			args = args[2:]
+		}
+		if len(args) < 1 || (format != "dot" && format != "json") {
+			printUsage()
+			os.Exit(1)
+		}
+		runGraph(args[0], format)
+	case "compile-order":
+		args := os.Args[2:]
+		format := "list"
+		if len(args) >= 2 && args[0] == "--format" {
+			format = args[1]
+			args = args[2:]
+		}
+		if len(args) < 1 || (format != "list" && format != "ghdl") {
+			printUsage()
+			os.Exit(1)
+		}
+		runCompileOrder(args[0], format)
+	case "--coverage":
+		args := os.Args[2:]
+		threshold := indexer.DefaultCoverageThreshold
+		if len(args) >= 2 && args[0] == "--threshold" {
+			percent, err := strconv.ParseFloat(args[1], 64)
+			if err != nil || percent < 0 {
+				fmt.Fprintf(os.Stderr, "Error: invalid --threshold %q (expected a percentage)\n", args[1])
+				os.Exit(1)
+			}
+			threshold = percent / 100
+			args = args[2:]
+		}
+		if len(args) < 1 {
+			printUsage()
+			os.Exit(1)
+		}
+		runCoverage(args[0], threshold)
+	case "symbols":
+		args := os.Args[2:]
+		var kinds []string
+		if len(args) >= 2 && args[0] == "--kind" {
+			kinds = strings.Split(args[1], ",")
+			args = args[2:]
+		}
+		if len(args) < 1 {
+			printUsage()
+			os.Exit(1)
+		}
+		runSymbols(args[0], kinds)
+	case "explain":
+		if len(os.Args) > 3 {
+			printUsage()
+			os.Exit(1)
+		}
+		rule := ""
+		if len(os.Args) == 3 {
+			rule = os.Args[2]
+		}
+		runExplain(rule)
+	case "serve":
+		args := os.Args[2:]
+		if len(args) < 2 || args[0] != "--socket" {
+			printUsage()
+			os.Exit(1)
+		}
+		runServe(args[1])
+	case "lsp":
+		runLSP()
+	case "watch":
+		if len(os.Args) < 3 {
+			printUsage()
+			os.Exit(1)
+		}
+		runWatch(os.Args[2])
+	case "--config-validate":
+		args := os.Args[2:]
+		configPath := ""
+		if len(args) >= 2 && (args[0] == "-c" || args[0] == "--config") {
+			configPath = args[1]
+			args = args[2:]
+		}
+		rootPath := "."
+		if len(args) > 0 {
+			rootPath = args[0]
+		}
+		runConfigValidate(configPath, rootPath)
+	case "config-check", "--config-check":
+		args := os.Args[2:]
+		jsonOutput := false
+		if len(args) > 0 && (args[0] == "-j" || args[0] == "--json") {
+			jsonOutput = true
+			args = args[1:]
+		}
+		rootPath := "."
+		if len(args) > 0 {
+			rootPath = args[0]
+		}
+		runConfigCheck(rootPath, jsonOutput)
 	case "--clear-policy-cache":
 		if len(os.Args) < 3 {
 			printUsage()
@@ -101,7 +387,7 @@ func main() {
 		}
 		runLintWithConfig(os.Args[2], os.Args[3], false, false, false, false, false)
 	default:
-		runLintWithFlags(cmd, false, false, false, false, false)
+		runLintWithFlags(os.Args[1:], false, false, false, false, false)
 	}
 }
 
@@ -110,18 +396,108 @@ func printUsage() {
 
 Commands:
   init              Create a vhdl_lint.json configuration file
+  symbols           Print the cross-file symbol table as JSON, sorted by name, without
+                    running policy checks: vhdl-lint symbols [--kind entity,package] <path>
+  graph             Print the entity instantiation hierarchy (unresolved targets dashed red):
+                    vhdl-lint graph [--format dot|json] <path> | dot -Tsvg -o hier.svg
+  compile-order     Print the files in dependency order for analysis, or a ghdl -a script
+                    (a cycle is an error): vhdl-lint compile-order [--format list|ghdl] <path>
+  explain           Describe a rule (title, rationale, default severity, example), or list
+                    every rule id and title: vhdl-lint explain [<rule>]
+  serve             Keep the pipeline resident and answer lint requests on a Unix socket:
+                    vhdl-lint serve --socket /tmp/vhdl-lint.sock
+                    Requests are JSON lines: {"command":"lint","path":"<dir>"} returns the
+                    JSON lint result; {"command":"shutdown"} stops the server
+  lsp               Language server on stdio: publishes the workspace's violations as
+                    diagnostics on open and save, and after edits pause (vhdl-lint lsp)
+  watch             Re-lint on every .vhd/.vhdl change (debounced 200ms), re-checking the
+                    changed files and their dependents and printing new (+) and resolved
+                    (-) violations: vhdl-lint watch <path>
+  config-check      Print the effective config for a path: the config file found, library
+                    file counts, third-party files, configured and enabled rules, ignore
+                    patterns, and problems such as bad globs or libraries matching no
+                    files: vhdl-lint config-check [--json] [path]
   <path>            Lint VHDL files in the given path
+  <path> <path>...  Lint each root with its own discovered config and report one merged
+                    result (JSON adds a per-root "roots" breakdown)
 
 Options:
   -v, --verbose     Enable verbose output (extraction details)
   -p, --progress    Stream per-file progress and dependencies
   -t, --trace       Progress plus per-file fact summaries
+  --progress-json   Stream one NDJSON event per extracted file to stderr while results
+                    still go to stdout; combines with any lint command, e.g.
+                    vhdl-lint --json --progress-json <path>. Events look like
+                    {"event":"file","path":"a.vhd","status":"extracted"|"cache_hit",
+                    "duration_ms":3,"done":1,"total":40}
   --policy-trace    Stream Rust policy timing output (per-rule start/done)
   --policy-stream   Stream Rust policy stderr without enabling timing
+  --stream-input    Spool the policy input to NDJSON table by table (lower peak memory)
+  --stdin --filename <file>
+                    Lint VHDL read from stdin as if it were <file> (e.g. an unsaved editor
+                    buffer), with the rest of the project loaded from the nearest directory
+                    holding a config; only <file>'s violations are reported
+  --quiet, --no-summary
+                    Print only violations, one "file:line: [rule] severity: message" line
+                    each, with no banners, progress, or summaries; combines with any lint
+                    command. Banners and summaries always go to stderr, results to stdout
   -j, --json        Output results as JSON (for programmatic parsing)
-  --timing          Emit timing.jsonl with pipeline timing events
+  --sarif <file>    Also write results as SARIF 2.1.0 for code scanning; combines with
+                    any lint command: vhdl-lint --sarif results.sarif <path>
+  --junit <file>    Also write a JUnit XML report (one testcase per file); combines with
+                    any lint command: vhdl-lint --junit report.xml <path>
+  --timing          Emit timing.jsonl with pipeline timing events; with --policy-trace it
+                    also records per-rule {"event":"rule_timing"} lines
   --clear-policy-cache  Remove cached policy results for the given path
+  --cache-dir <dir> Keep the facts and policy caches in <dir> instead of
+                    analysis.cache.dir (e.g. a shared CI cache); combines with any lint
+                    command and --clear-policy-cache. An unwritable <dir> disables
+                    caching with a warning
+  --max-parse-errors <percent>
+                    Skip extraction for files in which more than <percent> of the parse
+                    tree nodes are ERROR or MISSING (e.g. non-VHDL files or unsupported
+                    dialects) and report them as parse errors with their error-node
+                    count; <percent> must be above 0; combines with any lint command
+  --timeout <duration>
+                    Stop starting extractions after <duration> (e.g. 90s, 5m) and cancel
+                    the parses in flight, then lint the files extracted so far; the result
+                    is marked truncated and lists the timed-out files. Combines with any
+                    lint command
+  --list-files      List files that would be analyzed, with library and third-party
+                    attribution, without extracting: vhdl-lint --list-files [--json] <path>
+  --hier-dot        Same as graph --format dot: vhdl-lint --hier-dot <path>
+  --coverage        List files the grammar parses poorly (ERROR-node byte ratio, default 1%):
+                    vhdl-lint --coverage [--threshold <percent>] <path>
+  --config-validate Check the config file (unknown keys, severities, standard, globs)
+                    without linting: vhdl-lint --config-validate [-c config.json] [path]
   -c, --config      Specify config file: vhdl-lint -c config.json <path>
+  -f <filelist>     Analyze exactly the files in a .f filelist (one path per line, "#"
+                    comments, nested "-f sub.f", "-work lib" library directives) instead
+                    of scanning a directory: vhdl-lint -f project.f
+  --fail-on <level> Exit 2 when violations at or above error|warning|info are
+                    reported (default none); combines with any lint command:
+                    vhdl-lint --fail-on error -j <path>
+  --baseline <file> Drop violations recorded in a baseline snapshot (matched by rule,
+                    file, and message, not line); combines with any lint command
+  --csv <file>      Also write violations as CSV (file,line,severity,rule,message), after
+                    --baseline; combines with any lint command: vhdl-lint --csv out.csv <path>
+  --manifest <file> Also write every analyzed file's sha256, cache status (cache_hit or
+                    extracted), and facts count as JSON; combines with any lint command:
+                    vhdl-lint --manifest manifest.json <path>
+  --output-dir <dir>
+                    Also write <dir>/<relpath>.json per analyzed file with only that file's
+                    violations and counts (clean files included); combines with any lint
+                    command: vhdl-lint --output-dir reports <path>
+  --diff <file|ref>  Report only violations on lines added by a unified diff file, or by
+                    "git diff <ref>" when not a file: vhdl-lint --diff origin/main <path>
+  --write-baseline <file>
+                    Snapshot the current violations as a baseline:
+                    vhdl-lint --write-baseline baseline.json <path>
+  --rules <a,b>     Run only the listed rules (optional rules included); combines with
+                    any lint command: vhdl-lint --rules latch_inference,cdc_unsync <path>
+  --skip-rules <a,b>
+                    Skip the listed rules; applied after --rules, so a rule named in
+                    both is skipped
   -h, --help        Show this help message
 
 Configuration:
@@ -160,24 +536,61 @@ func runInit() {
 	fmt.Println("  - Lint rule severities")
 }
 
-func runLintWithFlags(path string, verbose, jsonOutput, progress, trace, timing bool) {
-	// Load config from default locations
-	cfg, err := config.Load(path)
+func runLintWithFlags(paths []string, verbose, jsonOutput, progress, trace, timing bool) {
+	newIndexer := func(path string) (*indexer.Indexer, error) {
+		// Load config from default locations
+		cfg, err := config.Load(path)
+		if err != nil {
+			return nil, fmt.Errorf("loading config: %w", err)
+		}
+		applyFileList(cfg)
+		applyCacheDir(cfg)
+
+		idx := indexer.NewWithConfig(cfg)
+		idx.Verbose = verbose
+		idx.Progress = progress || trace
+		idx.Trace = trace
+		idx.JSONOutput = jsonOutput
+		idx.Timing = timing
+		idx.SARIFPath = sarifPath
+		idx.JUnitPath = junitPath
+		idx.CSVPath = csvPath
+		idx.ManifestPath = manifestPath
+		idx.OutputDir = outputDir
+		idx.DiffSpec = diffSpec
+		idx.BaselinePath = baselinePath
+		idx.WriteBaselinePath = writeBaselinePath
+		idx.OnlyRules = onlyRules
+		idx.SkipRules = skipRules
+		idx.Quiet = quiet
+		idx.ProgressJSON = progressJSON
+		idx.MaxParseErrors = maxParseErrors
+		idx.Timeout = lintTimeout
+		applyStdinFile(idx)
+		return idx, nil
+	}
+
+	// Several roots are linted with their own configs and merged
+	if len(paths) > 1 {
+		summary, err := indexer.RunRoots(paths, newIndexer)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		exitOnThreshold(summary)
+		return
+	}
+
+	idx, err := newIndexer(paths[0])
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error %v\n", err)
 		os.Exit(1)
 	}
-
-	idx := indexer.NewWithConfig(cfg)
-	idx.Verbose = verbose
-	idx.Progress = progress || trace
-	idx.Trace = trace
-	idx.JSONOutput = jsonOutput
-	idx.Timing = timing
-	if err := idx.Run(path); err != nil {
+	if err := idx.Run(paths[0]); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
+	exitOnThreshold(idx.Summary)
 }
 
 func runLintWithConfig(configPath, lintPath string, verbose, jsonOutput, progress, trace, timing bool) {
@@ -186,6 +599,8 @@ func runLintWithConfig(configPath, lintPath string, verbose, jsonOutput, progres
 		fmt.Fprintf(os.Stderr, "Error loading config %s: %v\n", configPath, err)
 		os.Exit(1)
 	}
+	applyFileList(cfg)
+	applyCacheDir(cfg)
 
 	idx := indexer.NewWithConfig(cfg)
 	idx.Verbose = verbose
@@ -193,10 +608,483 @@ func runLintWithConfig(configPath, lintPath string, verbose, jsonOutput, progres
 	idx.Trace = trace
 	idx.JSONOutput = jsonOutput
 	idx.Timing = timing
+	idx.SARIFPath = sarifPath
+	idx.JUnitPath = junitPath
+	idx.CSVPath = csvPath
+	idx.ManifestPath = manifestPath
+	idx.OutputDir = outputDir
+	idx.DiffSpec = diffSpec
+	idx.BaselinePath = baselinePath
+	idx.WriteBaselinePath = writeBaselinePath
+	idx.OnlyRules = onlyRules
+	idx.SkipRules = skipRules
+	idx.Quiet = quiet
+	idx.ProgressJSON = progressJSON
+	idx.MaxParseErrors = maxParseErrors
+	idx.Timeout = lintTimeout
+	applyStdinFile(idx)
 	if err := idx.Run(lintPath); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
+	exitOnThreshold(idx.Summary)
+}
+
+// applyFileList restricts cfg to the -f filelist's files, if one was given.
+func applyFileList(cfg *config.Config) {
+	if fileListPath == "" {
+		return
+	}
+	entries, err := config.LoadFileListEntries(fileListPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading filelist: %v\n", err)
+		os.Exit(1)
+	}
+	cfg.UseFileList(entries)
+}
+
+// applyCacheDir points cfg's caches at the --cache-dir directory, if one was
+// given. A directory that cannot be created or written to disables caching
+// with a warning rather than failing the run.
+func applyCacheDir(cfg *config.Config) {
+	if cacheDirPath == "" {
+		return
+	}
+	cfg.Analysis.Cache.Dir = cacheDirPath
+	if err := indexer.CheckCacheDir(cacheDirPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: caching disabled: %v\n", err)
+		enabled := false
+		cfg.Analysis.Cache.Enabled = &enabled
+	}
+}
+
+// readStdinFile reads the --stdin content for --filename and returns the
+// directory to lint: the nearest directory at or above the file holding a
+// vhdl_lint.json or .vhdl_lint.json, or else the file's own directory.
+func readStdinFile(useStdin bool) (string, error) {
+	if !useStdin {
+		return "", fmt.Errorf("--filename requires --stdin")
+	}
+	if stdinFilename == "" {
+		return "", fmt.Errorf("--stdin requires --filename <file>")
+	}
+	abs, err := filepath.Abs(stdinFilename)
+	if err != nil {
+		return "", err
+	}
+	stdinFilename = abs
+	if stdinContent, err = io.ReadAll(os.Stdin); err != nil {
+		return "", fmt.Errorf("reading stdin: %w", err)
+	}
+	dir := filepath.Dir(abs)
+	for d := dir; ; d = filepath.Dir(d) {
+		for _, name := range []string{"vhdl_lint.json", ".vhdl_lint.json"} {
+			if _, err := os.Stat(filepath.Join(d, name)); err == nil {
+				return d, nil
+			}
+		}
+		if filepath.Dir(d) == d {
+			return dir, nil
+		}
+	}
+}
+
+// applyStdinFile lints the --stdin content in place of --filename and
+// reports only that file, if --stdin was given.
+func applyStdinFile(idx *indexer.Indexer) {
+	if stdinFilename == "" {
+		return
+	}
+	idx.Overlays = map[string][]byte{stdinFilename: stdinContent}
+	idx.ReportFile = stdinFilename
+}
+
+// parseFailOn removes "--fail-on <severity>" from args, wherever it appears,
+// and returns the remaining arguments with the chosen threshold.
+func parseFailOn(args []string) ([]string, string, error) {
+	level := "none"
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		if args[i] != "--fail-on" {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) {
+			return nil, "", fmt.Errorf("--fail-on requires a severity (%s)", strings.Join(indexer.FailOnLevels, ", "))
+		}
+		i++
+		level = strings.ToLower(args[i])
+		if !containsLevel(indexer.FailOnLevels, level) {
+			return nil, "", fmt.Errorf("invalid --fail-on %q (expected %s)", args[i], strings.Join(indexer.FailOnLevels, ", "))
+		}
+	}
+	return rest, level, nil
+}
+
+// parsePathFlag removes "<flag> <file>" from args, wherever it appears, and
+// returns the remaining arguments with the file ("" if the flag is absent).
+func parsePathFlag(args []string, flag string) ([]string, string, error) {
+	path := ""
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		if args[i] != flag {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) {
+			return nil, "", fmt.Errorf("%s requires a file", flag)
+		}
+		i++
+		path = args[i]
+	}
+	return rest, path, nil
+}
+
+// parseBoolFlag removes every occurrence of flag from args and reports
+// whether it was present.
+func parseBoolFlag(args []string, flag string) ([]string, bool) {
+	found := false
+	rest := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == flag {
+			found = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return rest, found
+}
+
+// parsePercentFlag removes "<flag> <percent>" from args, wherever it
+// appears, and returns the remaining arguments with the percentage (0 if
+// the flag is absent). The percentage must be above 0 and at most 100.
+func parsePercentFlag(args []string, flag string) ([]string, float64, error) {
+	percent := 0.0
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		if args[i] != flag {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) {
+			return nil, 0, fmt.Errorf("%s requires a percentage", flag)
+		}
+		i++
+		var err error
+		if percent, err = strconv.ParseFloat(strings.TrimSuffix(args[i], "%"), 64); err != nil || percent <= 0 || percent > 100 {
+			return nil, 0, fmt.Errorf("invalid %s %q (expected a percentage above 0, up to 100)", flag, args[i])
+		}
+	}
+	return rest, percent, nil
+}
+
+// parseDurationFlag removes "<flag> <duration>" from args, wherever it
+// appears, and returns the remaining arguments with the duration (0 if the
+// flag is absent).
+func parseDurationFlag(args []string, flag string) ([]string, time.Duration, error) {
+	var d time.Duration
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		if args[i] != flag {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) {
+			return nil, 0, fmt.Errorf("%s requires a duration", flag)
+		}
+		i++
+		var err error
+		if d, err = time.ParseDuration(args[i]); err != nil || d <= 0 {
+			return nil, 0, fmt.Errorf("invalid %s %q (expected a positive duration such as 90s or 5m)", flag, args[i])
+		}
+	}
+	return rest, d, nil
+}
+
+// parseRulesFlag removes "<flag> <rule,rule>" from args, wherever it
+// appears, and returns the remaining arguments with the listed rules.
+func parseRulesFlag(args []string, flag string) ([]string, []string, error) {
+	var rules []string
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		if args[i] != flag {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) {
+			return nil, nil, fmt.Errorf("%s requires a comma-separated list of rules", flag)
+		}
+		i++
+		rules = append(rules, indexer.ParseRuleList(args[i])...)
+	}
+	return rest, rules, nil
+}
+
+func containsLevel(levels []string, level string) bool {
+	for _, l := range levels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+// exitOnThreshold exits with exitThreshold when summary reports
+// violations at or above the --fail-on severity. Output has already been
+// written, so JSON consumers still receive the full result.
+func exitOnThreshold(summary indexer.ResultSummary) {
+	if count := summary.CountAtOrAbove(failOn); count > 0 {
+		fmt.Fprintf(os.Stderr, "%d violation(s) at or above --fail-on %s\n", count, failOn)
+		os.Exit(exitThreshold)
+	}
+}
+
+func runListFiles(path string, jsonOutput bool) {
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	applyFileList(cfg)
+
+	idx := indexer.NewWithConfig(cfg)
+	files, err := idx.ListFiles(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := indexer.WriteFileList(os.Stdout, files, jsonOutput); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runGraph(path, format string) {
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	idx := indexer.NewWithConfig(cfg)
+	if err := idx.CollectFacts(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	hierarchy := indexer.BuildHierarchy(idx.Facts, idx.FileLibrary)
+	write := indexer.WriteHierarchyDOT
+	if format == "json" {
+		write = indexer.WriteHierarchyJSON
+	}
+	if err := write(os.Stdout, hierarchy); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runCompileOrder prints the files under path in dependency order, or a
+// ghdl analysis script. A dependency cycle is an error naming its files.
+func runCompileOrder(path, format string) {
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	idx := indexer.NewWithConfig(cfg)
+	if err := idx.CollectFacts(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	order, err := idx.CompileOrder()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := indexer.WriteCompileOrder(os.Stdout, order, format, cfg.Standard); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runSymbols(path string, kinds []string) {
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	idx := indexer.NewWithConfig(cfg)
+	if err := idx.CollectFacts(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := indexer.WriteSymbols(os.Stdout, indexer.ListSymbols(idx.Symbols.All(), kinds)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runExplain prints one rule's metadata, or every rule id with its title
+// when rule is empty.
+func runExplain(rule string) {
+	if rule == "" {
+		rules := policy.Rules()
+		width := 0
+		for _, r := range rules {
+			width = max(width, len(r.ID))
+		}
+		for _, r := range rules {
+			optional := ""
+			if r.Optional {
+				optional = " (optional)"
+			}
+			fmt.Printf("%-*s  %s%s\n", width, r.ID, r.Title, optional)
+		}
+		return
+	}
+
+	r, ok := policy.RuleInfo(rule)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: unknown rule %q (run 'vhdl-lint explain' to list rules)\n", rule)
+		os.Exit(1)
+	}
+	fmt.Printf("%s: %s\n\n", r.ID, r.Title)
+	fmt.Printf("  Category:  %s\n", r.Category)
+	fmt.Printf("  Severity:  %s (default)\n", r.Severity)
+	if r.Optional {
+		fmt.Printf("  Enabled:   no - enable it in vhdl_lint.json or name it in --rules\n")
+	} else {
+		fmt.Printf("  Enabled:   yes - disable it in vhdl_lint.json (\"%s\": \"off\") or with --skip-rules\n", r.ID)
+	}
+	fmt.Printf("\n  %s\n", r.Rationale)
+	if r.Example != "" {
+		fmt.Printf("\nExample:\n")
+		for _, line := range strings.Split(r.Example, "\n") {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+}
+
+func runServe(socketPath string) {
+	idx := indexer.New()
+	idx.BaselinePath = baselinePath
+	idx.OnlyRules = onlyRules
+	idx.SkipRules = skipRules
+	srv := indexer.NewServer(idx)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGTERM, os.Interrupt)
+	go func() {
+		<-sigs
+		srv.Shutdown()
+	}()
+
+	if err := srv.Serve(socketPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runLSP() {
+	idx := indexer.New()
+	idx.BaselinePath = baselinePath
+	idx.OnlyRules = onlyRules
+	idx.SkipRules = skipRules
+	idx.Quiet = true
+	srv := indexer.NewLSPServer(idx, os.Stdin, os.Stdout)
+	if err := srv.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runWatch(path string) {
+	idx := indexer.New()
+	idx.BaselinePath = baselinePath
+	idx.OnlyRules = onlyRules
+	idx.SkipRules = skipRules
+	watcher := indexer.NewWatcher(idx, path, os.Stdout)
+
+	stop := make(chan struct{})
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGTERM, os.Interrupt)
+	go func() {
+		<-sigs
+		close(stop)
+	}()
+
+	if err := watcher.Run(stop); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runCoverage(path string, threshold float64) {
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+
+	idx := indexer.NewWithConfig(cfg)
+	if err := idx.CollectFacts(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := indexer.WriteCoverageReport(os.Stdout, indexer.BuildCoverageReport(idx.Facts, threshold)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runConfigValidate(configPath, rootPath string) {
+	if configPath == "" {
+		configPath = config.FindFile(rootPath)
+		if configPath == "" {
+			fmt.Fprintln(os.Stderr, "Error: no configuration file found (run 'vhdl-lint init' to create one)")
+			os.Exit(1)
+		}
+	}
+
+	problems, err := config.ValidateFile(configPath, rootPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, p := range problems {
+		fmt.Printf("%s: %s\n", configPath, p)
+	}
+	if config.HasErrors(problems) {
+		os.Exit(1)
+	}
+	if len(problems) == 0 {
+		fmt.Printf("%s: ok\n", configPath)
+	}
+}
+
+func runConfigCheck(path string, jsonOutput bool) {
+	cfg, err := config.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		os.Exit(1)
+	}
+	applyFileList(cfg)
+
+	idx := indexer.NewWithConfig(cfg)
+	report, err := idx.CheckConfig(path, config.FindFile(path))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := indexer.WriteConfigReport(os.Stdout, report, jsonOutput); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if config.HasErrors(report.Problems) {
+		os.Exit(1)
+	}
 }
 
 func runClearPolicyCache(path string) {
@@ -205,6 +1093,9 @@ func runClearPolicyCache(path string) {
 		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
 		os.Exit(1)
 	}
+	if cacheDirPath != "" {
+		cfg.Analysis.Cache.Dir = cacheDirPath
+	}
 
 	cacheDir, err := indexer.ClearPolicyCache(path, cfg)
 	if err != nil {
@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 )
 
 // Config is the top-level configuration for vhdl-lint
@@ -35,6 +38,15 @@ type LibraryConfig struct {
 
 	// IsThirdParty marks the library as third-party (suppress certain warnings)
 	IsThirdParty bool `json:"isThirdParty,omitempty"`
+
+	// Rules overrides lint.rules for violations in this library's files:
+	// "off" drops a rule's violations, a severity reports them at that
+	// level. A rule lint.rules leaves off is not enabled by this map.
+	Rules map[string]string `json:"rules,omitempty"`
+
+	// SeverityOverrides remaps the severity of rules' violations in this
+	// library's files, taking precedence over Rules
+	SeverityOverrides map[string]string `json:"severityOverrides,omitempty"`
 }
 
 // FileEntry is an explicit file entry with optional library and language metadata
@@ -55,6 +67,25 @@ type LintConfig struct {
 
 	// IgnoreRegions enables -- vhdl_lint off/on comment support
 	IgnoreRegions bool `json:"ignoreRegions,omitempty"`
+
+	// MagicNumberThreshold is the largest integer literal not reported by
+	// magic_number (unset = default of 1; small powers of two are always
+	// allowed). A pointer so that 0 can be configured.
+	MagicNumberThreshold *int `json:"magicNumberThreshold,omitempty"`
+
+	// TrojanLiteralBits is the literal width, in bits, from which
+	// large_literal_comparison and inverted_trigger report a comparison
+	// (0 = default of 32)
+	TrojanLiteralBits int `json:"trojanLiteralBits,omitempty"`
+
+	// SeverityOverrides maps rule names to the severity their violations are
+	// reported at: "error", "warning", or "info"
+	SeverityOverrides map[string]string `json:"severityOverrides,omitempty"`
+
+	// ClockPatterns are regular expressions for signal names accepted as
+	// clocks even though they are not input ports (e.g. PLL outputs:
+	// "clk_pll_.*"). Each must match the whole name, case-insensitively.
+	ClockPatterns []string `json:"clockPatterns,omitempty"`
 }
 
 // AnalysisConfig contains analysis options
@@ -94,9 +125,11 @@ func DefaultConfig() *Config {
 			},
 		},
 		Lint: LintConfig{
-			Rules:          map[string]string{},
-			IgnorePatterns: []string{},
-			IgnoreRegions:  true,
+			Rules:                map[string]string{},
+			IgnorePatterns:       []string{},
+			IgnoreRegions:        true,
+			MagicNumberThreshold: intPtr(1),
+			TrojanLiteralBits:    32,
 		},
 		Analysis: AnalysisConfig{
 			MaxParallelFiles:      0, // auto
@@ -114,6 +147,10 @@ func boolPtr(v bool) *bool {
 	return &v
 }
 
+func intPtr(v int) *int {
+	return &v
+}
+
 // Load finds and loads the configuration file
 // Search order:
 //  1. ./vhdl_lint.json (current working directory)
@@ -123,6 +160,17 @@ func boolPtr(v bool) *bool {
 //
 // Returns DefaultConfig if no config file is found
 func Load(rootPath string) (*Config, error) {
+	if path := FindFile(rootPath); path != "" {
+		return LoadFile(path)
+	}
+
+	// No config found, return defaults
+	return DefaultConfig(), nil
+}
+
+// FindFile returns the configuration file Load would use for rootPath, or ""
+// if none exists. The search order is documented on Load.
+func FindFile(rootPath string) string {
 	// Get current working directory
 	cwd, _ := os.Getwd()
 
@@ -150,12 +198,10 @@ func Load(rootPath string) (*Config, error) {
 
 	for _, path := range searchPaths {
 		if _, err := os.Stat(path); err == nil {
-			return LoadFile(path)
+			return path
 		}
 	}
-
-	// No config found, return defaults
-	return DefaultConfig(), nil
+	return ""
 }
 
 // LoadFile loads configuration from a specific file
@@ -173,6 +219,10 @@ func LoadFile(path string) (*Config, error) {
 	// Apply defaults for missing fields
 	cfg.applyDefaults()
 
+	if err := cfg.checkSeverityOverrides(); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
 	return &cfg, nil
 }
 
@@ -197,6 +247,12 @@ func (c *Config) applyDefaults() {
 	if c.Lint.Rules == nil {
 		c.Lint.Rules = make(map[string]string)
 	}
+	if c.Lint.MagicNumberThreshold == nil {
+		c.Lint.MagicNumberThreshold = intPtr(1)
+	}
+	if c.Lint.TrojanLiteralBits <= 0 {
+		c.Lint.TrojanLiteralBits = 32
+	}
 
 	if c.Analysis.Cache.Dir == "" {
 		c.Analysis.Cache.Dir = ".vhdl_lint_cache"
@@ -206,6 +262,56 @@ func (c *Config) applyDefaults() {
 	}
 }
 
+// checkSeverityOverrides rejects overrides, global or per library, that are
+// not a reporting severity. Turning a rule off belongs in Rules.
+func (c *Config) checkSeverityOverrides() error {
+	if rules := invalidSeverityOverrides(c.Lint.SeverityOverrides); len(rules) > 0 {
+		rule := rules[0]
+		return fmt.Errorf("lint.severityOverrides.%s: invalid severity %q (expected one of %s)",
+			rule, c.Lint.SeverityOverrides[rule], strings.Join(overrideSeverities, ", "))
+	}
+	for _, name := range c.libraryNames() {
+		overrides := c.Libraries[name].SeverityOverrides
+		if rules := invalidSeverityOverrides(overrides); len(rules) > 0 {
+			rule := rules[0]
+			return fmt.Errorf("libraries.%s.severityOverrides.%s: invalid severity %q (expected one of %s)",
+				name, rule, overrides[rule], strings.Join(overrideSeverities, ", "))
+		}
+	}
+	return nil
+}
+
+// LibraryRuleOverrides returns the severity each rule is reported at in the
+// named library's files ("off" to drop it), merging the library's Rules with
+// its SeverityOverrides, or nil when the library overrides nothing. Library
+// names match case-insensitively.
+func (c *Config) LibraryRuleOverrides(library string) map[string]string {
+	for name, lib := range c.Libraries {
+		if !strings.EqualFold(name, library) || len(lib.Rules)+len(lib.SeverityOverrides) == 0 {
+			continue
+		}
+		overrides := make(map[string]string, len(lib.Rules)+len(lib.SeverityOverrides))
+		for rule, sev := range lib.Rules {
+			overrides[rule] = sev
+		}
+		for rule, sev := range lib.SeverityOverrides {
+			overrides[rule] = sev
+		}
+		return overrides
+	}
+	return nil
+}
+
+// libraryNames returns the configured library names, sorted.
+func (c *Config) libraryNames() []string {
+	names := make([]string, 0, len(c.Libraries))
+	for name := range c.Libraries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Save writes the configuration to a file
 func (c *Config) Save(path string) error {
 	data, err := json.MarshalIndent(c, "", "  ")
@@ -267,15 +373,66 @@ func (c *Config) IsThirdPartyFile(filePath string) bool {
 	return false
 }
 
-// ShouldIgnoreFile checks if a file should be skipped entirely
+// ShouldIgnoreFile checks if a file should be skipped entirely. Patterns are
+// evaluated in order like .gitignore: the last pattern matching the file
+// decides, and a leading "!" re-includes what an earlier pattern ignored, so
+// "vendor/**" followed by "!vendor/keep_me.vhd" keeps that one file. "**"
+// matches any number of directories, a trailing "/" ignores everything under
+// a directory, and a pattern may match any trailing part of the path.
 func (c *Config) ShouldIgnoreFile(filePath string) bool {
+	ignored := false
 	for _, pattern := range c.Lint.IgnorePatterns {
-		if matched, _ := filepath.Match(pattern, filePath); matched {
-			return true
+		negate := strings.HasPrefix(pattern, "!")
+		if matchIgnorePattern(strings.TrimPrefix(pattern, "!"), filePath) {
+			ignored = !negate
 		}
-		if matched, _ := filepath.Match(pattern, filepath.Base(filePath)); matched {
+	}
+	return ignored
+}
+
+// matchIgnorePattern reports whether an ignore pattern matches filePath or
+// any of its trailing sub-paths ("rtl/*.vhd" matches "/src/rtl/a.vhd"). A
+// leading "/" anchors the pattern to the start of the path.
+func matchIgnorePattern(pattern, filePath string) bool {
+	pattern = filepath.ToSlash(pattern)
+	if pattern == "" {
+		return false
+	}
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	patternParts := strings.Split(strings.TrimPrefix(pattern, "/"), "/")
+	pathParts := strings.Split(strings.TrimPrefix(filepath.ToSlash(filePath), "/"), "/")
+	if strings.HasPrefix(pattern, "/") {
+		return matchPathParts(patternParts, pathParts)
+	}
+	for i := range pathParts {
+		if matchPathParts(patternParts, pathParts[i:]) {
 			return true
 		}
 	}
 	return false
 }
+
+// matchPathParts matches slash-separated pattern segments against path
+// segments; a "**" segment matches zero or more path segments.
+func matchPathParts(pattern, parts []string) bool {
+	if len(pattern) == 0 {
+		return len(parts) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(parts); i++ {
+			if matchPathParts(pattern[1:], parts[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(parts) == 0 {
+		return false
+	}
+	if matched, _ := path.Match(pattern[0], parts[0]); !matched {
+		return false
+	}
+	return matchPathParts(pattern[1:], parts[1:])
+}
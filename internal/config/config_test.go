@@ -0,0 +1,48 @@
+package config
+
+import "testing"
+
+func TestShouldIgnoreFileNegationOrder(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Lint.IgnorePatterns = []string{"vendor/**", "!vendor/keep_me.vhd"}
+
+	cases := map[string]bool{
+		"/proj/vendor/ip.vhd":          true,
+		"/proj/vendor/sub/ip.vhd":      true,
+		"/proj/vendor/keep_me.vhd":     false,
+		"/proj/vendor/sub/keep_me.vhd": true,
+		"/proj/rtl/top.vhd":            false,
+	}
+	for file, want := range cases {
+		if got := cfg.ShouldIgnoreFile(file); got != want {
+			t.Errorf("ShouldIgnoreFile(%s) = %v, want %v", file, got, want)
+		}
+	}
+
+	// A later ignore overrides an earlier negation
+	cfg.Lint.IgnorePatterns = []string{"!vendor/keep_me.vhd", "vendor/**"}
+	if !cfg.ShouldIgnoreFile("/proj/vendor/keep_me.vhd") {
+		t.Fatalf("expected the later vendor/** to ignore keep_me.vhd")
+	}
+}
+
+func TestShouldIgnoreFileDoubleStar(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Lint.IgnorePatterns = []string{"sim/**/tb_*.vhd", "gen/", "/abs/only/*.vhd", "*_old.vhd"}
+
+	cases := map[string]bool{
+		"/proj/sim/tb_top.vhd":         true,
+		"/proj/sim/unit/deep/tb_a.vhd": true,
+		"/proj/sim/unit/model.vhd":     false,
+		"/proj/gen/a/b/c.vhd":          true,
+		"/proj/rtl/gen.vhd":            false,
+		"/abs/only/x.vhd":              true,
+		"/proj/abs/only/x.vhd":         false,
+		"/proj/rtl/fifo_old.vhd":       true,
+	}
+	for file, want := range cases {
+		if got := cfg.ShouldIgnoreFile(file); got != want {
+			t.Errorf("ShouldIgnoreFile(%s) = %v, want %v", file, got, want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LoadFileList reads a .f filelist and returns the listed files in order, with
+// "-f sub.f" includes expanded in place. See LoadFileListEntries.
+func LoadFileList(path string) ([]string, error) {
+	entries, err := LoadFileListEntries(path)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		files = append(files, entry.File)
+	}
+	return files, nil
+}
+
+// LoadFileListEntries reads a .f filelist: one path per line, "#" comments,
+// "-f sub.f" includes (expanded recursively, cycles are an error), and
+// "-work libname" directives assigning the files that follow to libname
+// (default "work"). Relative paths resolve against the directory of the
+// filelist naming them. Other "-" and "+" simulator options are ignored, and
+// a file listed twice keeps its first position.
+func LoadFileListEntries(path string) ([]FileEntry, error) {
+	var entries []FileEntry
+	seen := make(map[string]bool)
+	if err := readFileList(path, "work", nil, seen, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// readFileList appends the entries of one filelist. stack holds the filelists
+// currently being expanded, for cycle detection; a -work directive inside an
+// include applies until the end of that include.
+func readFileList(path, library string, stack []string, seen map[string]bool, entries *[]FileEntry) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("filelist %s: %w", path, err)
+	}
+	for _, open := range stack {
+		if open == abs {
+			return fmt.Errorf("filelist include cycle: %s -> %s", strings.Join(stack, " -> "), abs)
+		}
+	}
+	stack = append(stack, abs)
+
+	f, err := os.Open(abs)
+	if err != nil {
+		return fmt.Errorf("open filelist: %w", err)
+	}
+	defer f.Close()
+
+	dir := filepath.Dir(abs)
+	resolve := func(p string) string {
+		if filepath.IsAbs(p) {
+			return filepath.Clean(p)
+		}
+		return filepath.Join(dir, p)
+	}
+
+	scanner := bufio.NewScanner(f)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if hash := strings.Index(line, "#"); hash != -1 {
+			line = line[:hash]
+		}
+		fields := strings.Fields(line)
+		for i := 0; i < len(fields); i++ {
+			tok := fields[i]
+			switch {
+			case tok == "-f" || tok == "-work":
+				if i+1 >= len(fields) {
+					return fmt.Errorf("%s:%d: %s requires an argument", abs, lineNum, tok)
+				}
+				i++
+				if tok == "-work" {
+					library = fields[i]
+					continue
+				}
+				if err := readFileList(resolve(fields[i]), library, stack, seen, entries); err != nil {
+					return err
+				}
+			case strings.HasPrefix(tok, "-") || strings.HasPrefix(tok, "+"):
+				continue // Simulator option
+			default:
+				file := resolve(tok)
+				if seen[file] {
+					continue
+				}
+				seen[file] = true
+				*entries = append(*entries, FileEntry{File: file, Library: library})
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read filelist %s: %w", abs, err)
+	}
+	return nil
+}
+
+// UseFileList replaces the configured library globs and file entries with a
+// filelist's entries, so exactly the listed files are analyzed.
+func (c *Config) UseFileList(entries []FileEntry) {
+	c.Libraries = nil
+	c.Files = entries
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadFileListEntries(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "ip")
+	if err := os.MkdirAll(sub, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	write := func(path, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+	}
+	write(filepath.Join(root, "project.f"), strings.Join([]string{
+		"# project files",
+		"pkg.vhd",
+		"-work ip_lib",
+		"-f ip/ip.f  # vendor core",
+		"+incdir+include",
+		"top.vhd",
+		"pkg.vhd",
+	}, "\n"))
+	write(filepath.Join(sub, "ip.f"), "-work vendor\ncore.vhd\n")
+
+	entries, err := LoadFileListEntries(filepath.Join(root, "project.f"))
+	if err != nil {
+		t.Fatalf("LoadFileListEntries: %v", err)
+	}
+	want := []FileEntry{
+		{File: filepath.Join(root, "pkg.vhd"), Library: "work"},
+		{File: filepath.Join(sub, "core.vhd"), Library: "vendor"},
+		{File: filepath.Join(root, "top.vhd"), Library: "ip_lib"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d entries, got %+v", len(want), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Errorf("entry %d: expected %+v, got %+v", i, want[i], entries[i])
+		}
+	}
+
+	files, err := LoadFileList(filepath.Join(root, "project.f"))
+	if err != nil || len(files) != 3 || files[1] != filepath.Join(sub, "core.vhd") {
+		t.Fatalf("LoadFileList: %v %v", files, err)
+	}
+}
+
+func TestLoadFileListDetectsCycles(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "a.f"), []byte("-f b.f\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "b.f"), []byte("x.vhd\n-f a.f\n"), 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_, err := LoadFileList(filepath.Join(root, "a.f"))
+	if err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Fatalf("expected include cycle error, got %v", err)
+	}
+}
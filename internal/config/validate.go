@@ -0,0 +1,284 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Problem is a configuration issue found by validation
+type Problem struct {
+	Severity string `json:"severity"` // "error" or "warning"
+	Field    string `json:"field"`    // JSON path of the offending key (e.g., "lint.rules.foo")
+	Message  string `json:"message"`
+}
+
+func (p Problem) String() string {
+	if p.Field == "" {
+		return fmt.Sprintf("%s: %s", p.Severity, p.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", p.Severity, p.Field, p.Message)
+}
+
+// HasErrors reports whether any problem is an error
+func HasErrors(problems []Problem) bool {
+	for _, p := range problems {
+		if p.Severity == "error" {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	validStandards  = []string{"1993", "2002", "2008", "2019"}
+	validSeverities = []string{"off", "info", "warning", "error"}
+	// Overrides remap a reported violation, so "off" is not one
+	overrideSeverities = []string{"info", "warning", "error"}
+)
+
+// ValidateFile strictly decodes the config file at path and validates it.
+// Unlike LoadFile, unknown keys are reported instead of ignored, and every
+// problem is collected rather than stopping at the first. Library globs are
+// resolved against rootPath. The returned error is only for files that cannot
+// be read or are not valid JSON.
+func ValidateFile(path, rootPath string) ([]Problem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config file: %w", err)
+	}
+
+	var problems []Problem
+	for _, key := range unknownKeys(data, reflect.TypeOf(cfg), "") {
+		problems = append(problems, Problem{Severity: "error", Field: key, Message: "unknown key"})
+	}
+	problems = append(problems, cfg.Validate(rootPath)...)
+	return problems, nil
+}
+
+// Validate checks semantic constraints that decoding cannot: the standard,
+// rule severities, glob syntax, and whether library patterns and explicit
+// files resolve to anything under rootPath.
+func (c *Config) Validate(rootPath string) []Problem {
+	var problems []Problem
+	add := func(severity, field, format string, args ...interface{}) {
+		problems = append(problems, Problem{Severity: severity, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if c.Standard != "" && !containsString(validStandards, c.Standard) {
+		add("error", "standard", "unsupported standard %q (expected one of %s)", c.Standard, strings.Join(validStandards, ", "))
+	}
+
+	rules := make([]string, 0, len(c.Lint.Rules))
+	for rule := range c.Lint.Rules {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	for _, rule := range rules {
+		if sev := c.Lint.Rules[rule]; !containsString(validSeverities, sev) {
+			add("error", "lint.rules."+rule, "invalid severity %q (expected one of %s)", sev, strings.Join(validSeverities, ", "))
+		}
+	}
+	for _, rule := range invalidSeverityOverrides(c.Lint.SeverityOverrides) {
+		add("error", "lint.severityOverrides."+rule, "invalid severity %q (expected one of %s)", c.Lint.SeverityOverrides[rule], strings.Join(overrideSeverities, ", "))
+	}
+	if t := c.Lint.MagicNumberThreshold; t != nil && *t < 0 {
+		add("error", "lint.magicNumberThreshold", "must not be negative")
+	}
+	if c.Lint.TrojanLiteralBits < 0 {
+		add("error", "lint.trojanLiteralBits", "must not be negative")
+	}
+	for i, pattern := range c.Lint.ClockPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			add("error", fmt.Sprintf("lint.clockPatterns[%d]", i), "invalid regular expression %q: %v", pattern, err)
+		}
+	}
+	for i, pattern := range c.Lint.IgnorePatterns {
+		if _, err := filepath.Match(strings.TrimPrefix(pattern, "!"), ""); err != nil {
+			add("error", fmt.Sprintf("lint.ignorePatterns[%d]", i), "invalid glob %q: %v", pattern, err)
+		}
+	}
+
+	for _, name := range c.libraryNames() {
+		lib := c.Libraries[name]
+		field := "libraries." + name
+		if len(lib.Files) == 0 {
+			add("warning", field+".files", "library has no file patterns")
+		}
+		libRules := make([]string, 0, len(lib.Rules))
+		for rule := range lib.Rules {
+			libRules = append(libRules, rule)
+		}
+		sort.Strings(libRules)
+		for _, rule := range libRules {
+			if sev := lib.Rules[rule]; !containsString(validSeverities, sev) {
+				add("error", field+".rules."+rule, "invalid severity %q (expected one of %s)", sev, strings.Join(validSeverities, ", "))
+			}
+		}
+		for _, rule := range invalidSeverityOverrides(lib.SeverityOverrides) {
+			add("error", field+".severityOverrides."+rule, "invalid severity %q (expected one of %s)", lib.SeverityOverrides[rule], strings.Join(overrideSeverities, ", "))
+		}
+		matched := false
+		for i, pattern := range lib.Files {
+			if validatePattern(rootPath, pattern, fmt.Sprintf("%s.files[%d]", field, i), add) {
+				matched = true
+			}
+		}
+		for i, pattern := range lib.Exclude {
+			if _, err := filepath.Match(strings.ReplaceAll(pattern, "**", "*"), ""); err != nil {
+				add("error", fmt.Sprintf("%s.exclude[%d]", field, i), "invalid glob %q: %v", pattern, err)
+			}
+		}
+		if len(lib.Files) > 0 && !matched {
+			add("warning", field+".files", "patterns match no VHDL files under %s", rootPath)
+		}
+	}
+
+	for i, entry := range c.Files {
+		field := fmt.Sprintf("files[%d]", i)
+		if entry.File == "" {
+			add("error", field+".file", "missing file path")
+			continue
+		}
+		// Non-VHDL entries are skipped during resolution, so they need not exist
+		if !isVHDLFile(entry.File, entry.Language) {
+			continue
+		}
+		path := entry.File
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(rootPath, path)
+		}
+		if _, err := os.Stat(path); err != nil {
+			add("error", field+".file", "file %q does not exist", entry.File)
+		}
+	}
+
+	return problems
+}
+
+// invalidSeverityOverrides returns the rules, sorted, whose override is not
+// one of overrideSeverities.
+func invalidSeverityOverrides(overrides map[string]string) []string {
+	var rules []string
+	for rule, sev := range overrides {
+		if !containsString(overrideSeverities, sev) {
+			rules = append(rules, rule)
+		}
+	}
+	sort.Strings(rules)
+	return rules
+}
+
+// validatePattern checks glob syntax and reports whether the pattern matches
+// at least one VHDL file under rootPath.
+func validatePattern(rootPath, pattern, field string, add func(severity, field, format string, args ...interface{})) bool {
+	if _, err := filepath.Match(strings.ReplaceAll(pattern, "**", "*"), ""); err != nil {
+		add("error", field, "invalid glob %q: %v", pattern, err)
+		return false
+	}
+	full := pattern
+	if !filepath.IsAbs(full) {
+		full = filepath.Join(rootPath, full)
+	}
+	matches, err := expandGlob(full)
+	if err != nil {
+		add("error", field, "cannot expand %q: %v", pattern, err)
+		return false
+	}
+	for _, m := range matches {
+		if isVHDLFile(m, "") {
+			return true
+		}
+	}
+	return false
+}
+
+// unknownKeys returns the JSON paths of object keys in data that do not map
+// to a field of t. Key matching is case-insensitive, like encoding/json.
+func unknownKeys(data []byte, t reflect.Type, path string) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+
+	var unknown []string
+	switch t.Kind() {
+	case reflect.Struct:
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil
+		}
+		fields := make(map[string]reflect.Type)
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			fields[strings.ToLower(name)] = f.Type
+		}
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			ft, ok := fields[strings.ToLower(key)]
+			if !ok {
+				unknown = append(unknown, join(key))
+				continue
+			}
+			unknown = append(unknown, unknownKeys(obj[key], ft, join(key))...)
+		}
+	case reflect.Map:
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil
+		}
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			unknown = append(unknown, unknownKeys(obj[key], t.Elem(), join(key))...)
+		}
+	case reflect.Slice:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil
+		}
+		for i, item := range items {
+			unknown = append(unknown, unknownKeys(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+	return unknown
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateFileReportsAllProblems(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "rtl"), 0o755); err != nil {
+		t.Fatalf("mkdir rtl: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "rtl", "core.vhd"), []byte("-- core"), 0o644); err != nil {
+		t.Fatalf("write core: %v", err)
+	}
+
+	cfgPath := filepath.Join(root, "vhdl_lint.json")
+	data := `{
+  "standard": "2007",
+  "libraries": {
+    "work": {"files": ["rtl/*.vhd"], "exlude": ["x"], "rules": {"magic_number": "off", "latch_inferred": "loud"}, "severityOverrides": {"unused_signal": "off"}},
+    "ip": {"files": ["ip/**/*.vhd", "bad[.vhd"]}
+  },
+  "files": [{"file": "sim/missing.vhd"}, {"file": "sim/tb.sv", "language": "verilog"}],
+  "lint": {
+    "rules": {"magic_number": "warn", "latch_inferred": "error"},
+    "severityOverrides": {"latch_inferred": "warning", "unused_signal": "off"},
+    "ignorePatterns": ["*.tmp"],
+    "clockPatterns": ["clk_pll_.*", "clk_(div"],
+    "trojanLiteralBits": -4,
+    "maxWarnings": 3
+  }
+}`
+	if err := os.WriteFile(cfgPath, []byte(data), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	problems, err := ValidateFile(cfgPath, root)
+	if err != nil {
+		t.Fatalf("ValidateFile: %v", err)
+	}
+	if !HasErrors(problems) {
+		t.Fatalf("expected errors, got %v", problems)
+	}
+
+	want := map[string]string{
+		"libraries.work.exlude":                          "error",
+		"libraries.work.rules.latch_inferred":            "error",
+		"libraries.work.severityOverrides.unused_signal": "error",
+		"lint.maxWarnings":                               "error",
+		"lint.trojanLiteralBits":                         "error",
+		"lint.clockPatterns[1]":                          "error",
+		"standard":                                       "error",
+		"lint.rules.magic_number":                        "error",
+		"lint.severityOverrides.unused_signal":           "error",
+		"libraries.ip.files":                             "warning",
+		"libraries.ip.files[1]":                          "error",
+		"files[0].file":                                  "error",
+	}
+	got := make(map[string]string)
+	for _, p := range problems {
+		got[p.Field] = p.Severity
+	}
+	for field, severity := range want {
+		if got[field] != severity {
+			t.Errorf("expected %s problem for %s, got %q (all: %v)", severity, field, got[field], problems)
+		}
+	}
+	if len(problems) != len(want) {
+		t.Errorf("expected %d problems, got %d: %v", len(want), len(problems), problems)
+	}
+}
+
+func TestValidateDefaultConfigIsClean(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "top.vhd"), []byte("-- top"), 0o644); err != nil {
+		t.Fatalf("write top: %v", err)
+	}
+	if problems := DefaultConfig().Validate(root); len(problems) != 0 {
+		t.Fatalf("expected default config to validate cleanly, got %v", problems)
+	}
+}
+
+func TestLoadFileRejectsInvalidSeverityOverride(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "vhdl_lint.json")
+	data := `{"lint": {"severityOverrides": {"latch_inferred": "warning", "magic_number": "fatal"}}}`
+	if err := os.WriteFile(cfgPath, []byte(data), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if _, err := LoadFile(cfgPath); err == nil || !strings.Contains(err.Error(), "lint.severityOverrides.magic_number") {
+		t.Fatalf("expected magic_number override to be rejected, got %v", err)
+	}
+
+	data = `{"lint": {"severityOverrides": {"latch_inferred": "warning"}}}`
+	if err := os.WriteFile(cfgPath, []byte(data), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	cfg, err := LoadFile(cfgPath)
+	if err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+	if cfg.Lint.SeverityOverrides["latch_inferred"] != "warning" {
+		t.Fatalf("expected latch_inferred override, got %v", cfg.Lint.SeverityOverrides)
+	}
+}
+
+func TestLoadFileRejectsInvalidLibrarySeverityOverride(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "vhdl_lint.json")
+	data := `{"libraries": {"legacy": {"files": ["legacy/*.vhd"], "severityOverrides": {"magic_number": "off"}}}}`
+	if err := os.WriteFile(cfgPath, []byte(data), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if _, err := LoadFile(cfgPath); err == nil || !strings.Contains(err.Error(), "libraries.legacy.severityOverrides.magic_number") {
+		t.Fatalf("expected the library override to be rejected, got %v", err)
+	}
+}
+
+func TestLibraryRuleOverrides(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Libraries["Legacy"] = LibraryConfig{
+		Files:             []string{"legacy/*.vhd"},
+		Rules:             map[string]string{"magic_number": "off", "latch_inferred": "warning"},
+		SeverityOverrides: map[string]string{"latch_inferred": "info"},
+	}
+	got := cfg.LibraryRuleOverrides("legacy")
+	if len(got) != 2 || got["magic_number"] != "off" || got["latch_inferred"] != "info" {
+		t.Fatalf("unexpected overrides %v", got)
+	}
+	if got := cfg.LibraryRuleOverrides("work"); got != nil {
+		t.Fatalf("expected no overrides for work, got %v", got)
+	}
+}
+
+func TestLoadFileKeepsZeroMagicNumberThreshold(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "vhdl_lint.json")
+	for data, want := range map[string]int{
+		`{"lint": {"magicNumberThreshold": 0}}`:  0,
+		`{"lint": {"magicNumberThreshold": 12}}`: 12,
+		`{"lint": {}}`:                           1,
+	} {
+		if err := os.WriteFile(cfgPath, []byte(data), 0o644); err != nil {
+			t.Fatalf("write config: %v", err)
+		}
+		cfg, err := LoadFile(cfgPath)
+		if err != nil {
+			t.Fatalf("LoadFile(%s): %v", data, err)
+		}
+		if got := cfg.Lint.MagicNumberThreshold; got == nil || *got != want {
+			t.Errorf("LoadFile(%s): threshold = %v, want %d", data, got, want)
+		}
+	}
+
+	cfg := DefaultConfig()
+	cfg.Lint.MagicNumberThreshold = intPtr(-1)
+	for _, p := range cfg.Validate(t.TempDir()) {
+		if p.Field == "lint.magicNumberThreshold" {
+			return
+		}
+	}
+	t.Fatalf("expected a negative threshold to be reported")
+}
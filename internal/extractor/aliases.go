@@ -0,0 +1,178 @@
+package extractor
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// AliasDeclaration represents an alias: alias name [: subtype] is target;
+type AliasDeclaration struct {
+	Name      string
+	Target    string // Aliased name as written (e.g., "bus_record.flags(3)")
+	Type      string // Optional subtype indication ("" if omitted)
+	Line      int
+	InArch    string // Architecture (or process/block scope) declaring the alias
+	InPackage string // Package declaring the alias
+}
+
+// extractAliasDeclaration reads an alias_declaration. The "is" keyword is
+// hidden by the grammar, so the subtype and target are split on the source
+// text between children.
+func (e *Extractor) extractAliasDeclaration(node *sitter.Node, source []byte, archContext, pkgContext string) AliasDeclaration {
+	alias := AliasDeclaration{Line: int(node.StartPoint().Row) + 1}
+	if pkgContext != "" {
+		alias.InPackage = pkgContext
+	} else {
+		alias.InArch = archContext
+	}
+	nameNode := node.ChildByFieldName("name")
+	if nameNode != nil {
+		alias.Name = nameNode.Content(source)
+	}
+
+	var typeStart, typeEnd, targetStart, targetEnd uint32
+	section := ""
+	prevEnd := node.StartByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		gap := strings.Fields(strings.ToLower(string(source[prevEnd:child.StartByte()])))
+		prevEnd = child.EndByte()
+		if len(gap) > 0 && gap[len(gap)-1] == "is" {
+			section = "target"
+		}
+		switch {
+		case nameNode != nil && child.StartByte() == nameNode.StartByte():
+			continue
+		case child.Type() == ":" && section == "":
+			section = "type"
+			continue
+		case child.Type() == ";" || child.Type() == "[":
+			section = "done"
+		}
+		switch section {
+		case "type":
+			if typeEnd == 0 {
+				typeStart = child.StartByte()
+			}
+			typeEnd = child.EndByte()
+		case "target":
+			if targetEnd == 0 {
+				targetStart = child.StartByte()
+			}
+			targetEnd = child.EndByte()
+		}
+	}
+	if typeEnd > 0 {
+		alias.Type = strings.TrimSpace(string(source[typeStart:typeEnd]))
+	}
+	if targetEnd > 0 {
+		alias.Target = strings.TrimSpace(string(source[targetStart:targetEnd]))
+	}
+	return alias
+}
+
+// AliasBaseSignal returns the object an alias target ultimately names:
+// "bus_record.flags(3)" -> "bus_record". Returns "" for targets that are not
+// plain names (qualified names, operators).
+func AliasBaseSignal(target string) string {
+	base := extractBaseSignalName(target)
+	if dot := strings.Index(base, "."); dot != -1 {
+		base = base[:dot]
+	}
+	return strings.TrimSpace(base)
+}
+
+// resolveAliasUsages rewrites reads and writes made through an alias to the
+// aliased base signal, so an assignment to "data_valid" (an alias of
+// bus_record.flags(3)) counts as a write of bus_record. Aliases apply within
+// the architecture that declares them. Package aliases apply in every scope,
+// and facts without a scope use any alias of that name in the file, in both
+// cases only when the name is unambiguous.
+func resolveAliasUsages(facts *FileFacts) {
+	if len(facts.Aliases) == 0 {
+		return
+	}
+	byArch := make(map[string]map[string]string) // arch (lowercase) -> alias (lowercase) -> base
+	fileWide := make(map[string]string)
+	ambiguous := make(map[string]bool)
+	packageWide := make(map[string]string)
+	ambiguousPackage := make(map[string]bool)
+	for _, alias := range facts.Aliases {
+		base := AliasBaseSignal(alias.Target)
+		if alias.Name == "" || base == "" {
+			continue
+		}
+		name := strings.ToLower(alias.Name)
+		if prev, ok := fileWide[name]; ok && !strings.EqualFold(prev, base) {
+			ambiguous[name] = true
+		}
+		fileWide[name] = base
+		if alias.InPackage != "" {
+			if prev, ok := packageWide[name]; ok && !strings.EqualFold(prev, base) {
+				ambiguousPackage[name] = true
+			}
+			packageWide[name] = base
+		}
+		if alias.InArch != "" {
+			arch := strings.ToLower(archBase(alias.InArch))
+			if byArch[arch] == nil {
+				byArch[arch] = make(map[string]string)
+			}
+			byArch[arch][name] = base
+		}
+	}
+
+	resolve := func(scope, name string) string {
+		lower := strings.ToLower(name)
+		if scope == "" {
+			if base, ok := fileWide[lower]; ok && !ambiguous[lower] {
+				return base
+			}
+			return name
+		}
+		if base, ok := byArch[strings.ToLower(archBase(scope))][lower]; ok {
+			return base
+		}
+		if base, ok := packageWide[lower]; ok && !ambiguousPackage[lower] {
+			return base
+		}
+		return name
+	}
+	resolveAll := func(scope string, names []string) []string {
+		if len(names) == 0 {
+			return names
+		}
+		seen := make(map[string]bool, len(names))
+		out := names[:0]
+		for _, name := range names {
+			resolved := resolve(scope, name)
+			if seen[strings.ToLower(resolved)] {
+				continue
+			}
+			seen[strings.ToLower(resolved)] = true
+			out = append(out, resolved)
+		}
+		return out
+	}
+
+	for i := range facts.Processes {
+		proc := &facts.Processes[i]
+		proc.ReadSignals = resolveAll(proc.InArch, proc.ReadSignals)
+		proc.AssignedSignals = resolveAll(proc.InArch, proc.AssignedSignals)
+		proc.SensitivityList = resolveAll(proc.InArch, proc.SensitivityList)
+	}
+	for i := range facts.ConcurrentAssignments {
+		ca := &facts.ConcurrentAssignments[i]
+		ca.Target = resolve(ca.InArch, ca.Target)
+		ca.ReadSignals = resolveAll(ca.InArch, ca.ReadSignals)
+	}
+	for i := range facts.SignalDeps {
+		dep := &facts.SignalDeps[i]
+		dep.Source = resolve(dep.InArch, dep.Source)
+		dep.Target = resolve(dep.InArch, dep.Target)
+	}
+	for i := range facts.SignalUsages {
+		facts.SignalUsages[i].Signal = resolve("", facts.SignalUsages[i].Signal)
+	}
+}
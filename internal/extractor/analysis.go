@@ -0,0 +1,784 @@
+package extractor
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ConstantInSensitivityList reports a sensitivity list entry that names a
+// constant or generic. Such entries never change, so they are either a
+// copy-paste error or a misunderstanding of what the list is for.
+type ConstantInSensitivityList struct {
+	Name     string // Sensitivity list entry as written
+	Kind     string // "constant" or "generic"
+	DeclLine int    // Line of the constant/generic declaration
+	Process  string
+	Line     int // Line of the process
+	InArch   string
+}
+
+// archBase returns the architecture name for a scope such as "rtl.gen_a.gen_b".
+func archBase(scope string) string {
+	if idx := strings.Index(scope, "."); idx != -1 {
+		return scope[:idx]
+	}
+	return scope
+}
+
+// entityForArch returns the entity an architecture in this file implements.
+func entityForArch(facts *FileFacts, archName string) (Entity, bool) {
+	for _, arch := range facts.Architectures {
+		if !strings.EqualFold(arch.Name, archName) {
+			continue
+		}
+		for _, ent := range facts.Entities {
+			if strings.EqualFold(ent.Name, arch.EntityName) {
+				return ent, true
+			}
+		}
+	}
+	return Entity{}, false
+}
+
+// DetectConstantsInSensitivityLists finds sensitivity list entries that resolve
+// to a constant or generic rather than a signal or port. Resolution is scoped to
+// the process's architecture, its entity's generics, and package constants in
+// the same file; a same-named signal or port always wins.
+func DetectConstantsInSensitivityLists(facts *FileFacts) []ConstantInSensitivityList {
+	var found []ConstantInSensitivityList
+	for _, proc := range facts.Processes {
+		if len(proc.SensitivityList) == 0 {
+			continue
+		}
+		arch := archBase(proc.InArch)
+		entity, hasEntity := entityForArch(facts, arch)
+
+		signalNames := make(map[string]bool)
+		for _, sig := range facts.Signals {
+			if strings.EqualFold(archBase(sig.InEntity), arch) {
+				signalNames[strings.ToLower(sig.Name)] = true
+			}
+		}
+		if hasEntity {
+			for _, port := range facts.Ports {
+				if strings.EqualFold(port.InEntity, entity.Name) {
+					signalNames[strings.ToLower(port.Name)] = true
+				}
+			}
+		}
+
+		constLines := make(map[string]int)
+		for _, c := range facts.ConstantDecls {
+			inScope := strings.EqualFold(archBase(c.InArch), arch) || (c.InArch == "" && c.InPackage != "")
+			if inScope {
+				constLines[strings.ToLower(c.Name)] = c.Line
+			}
+		}
+		genericLines := make(map[string]int)
+		if hasEntity {
+			for _, g := range entity.Generics {
+				if g.Kind == "" || g.Kind == "constant" {
+					genericLines[strings.ToLower(g.Name)] = g.Line
+				}
+			}
+		}
+
+		for _, entry := range proc.SensitivityList {
+			base := strings.ToLower(extractBaseSignalName(entry))
+			if base == "" || base == "all" || signalNames[base] {
+				continue
+			}
+			item := ConstantInSensitivityList{
+				Name:    entry,
+				Process: proc.Label,
+				Line:    proc.Line,
+				InArch:  proc.InArch,
+			}
+			if line, ok := constLines[base]; ok {
+				item.Kind = "constant"
+				item.DeclLine = line
+			} else if line, ok := genericLines[base]; ok {
+				item.Kind = "generic"
+				item.DeclLine = line
+			} else {
+				continue
+			}
+			found = append(found, item)
+		}
+	}
+	return found
+}
+
+// ClockLevelCondition reports a concurrent conditional or selected assignment
+// whose condition tests a clock against a level. Such an assignment describes
+// a level-sensitive latch rather than a register.
+type ClockLevelCondition struct {
+	Target    string // Assigned signal
+	Clock     string // Clock signal tested
+	Level     string // "'1'" or "'0'", or empty when the clock is the selector
+	Condition string // Condition (or selector) expression as written
+	Kind      string // "conditional" or "selected"
+	Line      int
+	InArch    string
+}
+
+var (
+	clockLevelLeftRe  = regexp.MustCompile(`(?i)\b([a-z][a-z0-9_]*)\s*(?:/=|=)\s*('[01]')`)
+	clockLevelRightRe = regexp.MustCompile(`(?i)('[01]')\s*(?:/=|=)\s*([a-z][a-z0-9_]*)\b`)
+	clockEdgeRe       = regexp.MustCompile(`(?i)'event|'stable|rising_edge|falling_edge`)
+)
+
+// DetectClockLevelConditions finds conditional assignments that compare a
+// clock to '0'/'1' and selected assignments that select on a clock. A signal
+// counts as a clock when a process in the same file uses it as one. Conditions
+// that also test an edge ('event, rising_edge) describe registers and are
+// skipped.
+func DetectClockLevelConditions(facts *FileFacts) []ClockLevelCondition {
+	clocks := clockNames(facts)
+	if len(clocks) == 0 {
+		return nil
+	}
+
+	var found []ClockLevelCondition
+	for _, ca := range facts.ConcurrentAssignments {
+		switch ca.Kind {
+		case "selected":
+			if clocks[strings.ToLower(extractBaseSignalName(ca.Selector))] {
+				found = append(found, ClockLevelCondition{
+					Target:    ca.Target,
+					Clock:     ca.Selector,
+					Condition: ca.Selector,
+					Kind:      ca.Kind,
+					Line:      ca.Line,
+					InArch:    ca.InArch,
+				})
+			}
+		case "conditional":
+			for _, cond := range ca.Conditions {
+				if clockEdgeRe.MatchString(cond) {
+					continue
+				}
+				clock, level := clockLevelComparison(cond, clocks)
+				if clock == "" {
+					continue
+				}
+				found = append(found, ClockLevelCondition{
+					Target:    ca.Target,
+					Clock:     clock,
+					Level:     level,
+					Condition: cond,
+					Kind:      ca.Kind,
+					Line:      ca.Line,
+					InArch:    ca.InArch,
+				})
+			}
+		}
+	}
+	return found
+}
+
+// clockNames returns the lowercase names of the signals a process in the file
+// uses as a clock.
+func clockNames(facts *FileFacts) map[string]bool {
+	clocks := make(map[string]bool)
+	for _, cd := range facts.ClockDomains {
+		if cd.Clock != "" {
+			clocks[strings.ToLower(cd.Clock)] = true
+		}
+	}
+	for _, proc := range facts.Processes {
+		if proc.ClockSignal != "" {
+			clocks[strings.ToLower(proc.ClockSignal)] = true
+		}
+	}
+	return clocks
+}
+
+// clockLevelComparison returns the first clock compared to a level in cond.
+func clockLevelComparison(cond string, clocks map[string]bool) (string, string) {
+	for _, m := range clockLevelLeftRe.FindAllStringSubmatch(cond, -1) {
+		if clocks[strings.ToLower(m[1])] {
+			return m[1], m[2]
+		}
+	}
+	for _, m := range clockLevelRightRe.FindAllStringSubmatch(cond, -1) {
+		if clocks[strings.ToLower(m[2])] {
+			return m[2], m[1]
+		}
+	}
+	return "", ""
+}
+
+// NoEffectProcess reports a process that has no observable effect: it assigns
+// no signals or non-local variables, calls no procedures, and contains no
+// assertions or reports. Such processes are usually debugging leftovers or
+// logic whose assignments were lost.
+type NoEffectProcess struct {
+	Process     string
+	ReadSignals []string // Signals the process reads (and then discards)
+	Line        int
+	InArch      string
+}
+
+// DetectNoEffectProcesses finds processes without side effects. Calls to an
+// impure function declared in the same file count as a side effect since the
+// function may update shared state.
+func DetectNoEffectProcesses(facts *FileFacts) []NoEffectProcess {
+	impure := make(map[string]bool)
+	for _, fn := range facts.Functions {
+		if !fn.IsPure {
+			impure[strings.ToLower(fn.Name)] = true
+		}
+	}
+
+	var found []NoEffectProcess
+	for _, proc := range facts.Processes {
+		if len(proc.AssignedSignals) > 0 || len(proc.ProcedureCalls) > 0 ||
+			proc.HasAssertion || proc.AssignsNonLocalVariable {
+			continue
+		}
+		callsImpure := false
+		for _, call := range proc.FunctionCalls {
+			if impure[strings.ToLower(call.Name)] {
+				callsImpure = true
+				break
+			}
+		}
+		if callsImpure {
+			continue
+		}
+		found = append(found, NoEffectProcess{
+			Process:     proc.Label,
+			ReadSignals: proc.ReadSignals,
+			Line:        proc.Line,
+			InArch:      proc.InArch,
+		})
+	}
+	return found
+}
+
+// CombinationalMultiDriver reports a signal written by more than one
+// combinational process in the same architecture. Each process is a separate
+// driver, so the signal is either shorted (unresolved types) or resolved to
+// 'X' in simulation while synthesis rejects it.
+type CombinationalMultiDriver struct {
+	Signal    string
+	Processes []string // Labels of the driving processes ("" for unlabeled)
+	Lines     []int    // Line of each driving process
+	InArch    string
+}
+
+// DetectCombinationalMultiDrivers groups the signals assigned by combinational
+// processes by architecture scope and reports those with more than one
+// driving process. Sequential drivers are covered by the multi-clock and
+// general multi-driver checks.
+func DetectCombinationalMultiDrivers(facts *FileFacts) []CombinationalMultiDriver {
+	type driverKey struct {
+		arch   string
+		signal string
+	}
+	drivers := make(map[driverKey][]int) // indices into facts.Processes
+	var order []driverKey
+	for i, proc := range facts.Processes {
+		if !proc.IsCombinational {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, sig := range proc.AssignedSignals {
+			name := strings.ToLower(sig)
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			key := driverKey{arch: strings.ToLower(proc.InArch), signal: name}
+			if _, ok := drivers[key]; !ok {
+				order = append(order, key)
+			}
+			drivers[key] = append(drivers[key], i)
+		}
+	}
+
+	var found []CombinationalMultiDriver
+	for _, key := range order {
+		procs := drivers[key]
+		if len(procs) < 2 {
+			continue
+		}
+		first := facts.Processes[procs[0]]
+		md := CombinationalMultiDriver{InArch: first.InArch}
+		for _, i := range procs {
+			md.Processes = append(md.Processes, facts.Processes[i].Label)
+			md.Lines = append(md.Lines, facts.Processes[i].Line)
+		}
+		for _, sig := range first.AssignedSignals {
+			if strings.EqualFold(sig, key.signal) {
+				md.Signal = sig
+				break
+			}
+		}
+		found = append(found, md)
+	}
+	return found
+}
+
+// PermanentHighZ reports a signal whose every assignment drives 'Z'. Nothing
+// ever puts a real value on it, so readers only ever see high-impedance.
+type PermanentHighZ struct {
+	Signal      string
+	Assignments int // Number of assignments, all high-impedance
+	Line        int // First assignment
+	InArch      string
+}
+
+// DetectPermanentHighZ reports signals declared in this file whose every
+// assignment in an architecture drives only 'Z'. A conditional assignment with
+// a real value in any branch is a real driver. Signals connected to an
+// instance port or passed to a procedure may be driven there, so they are
+// skipped.
+func DetectPermanentHighZ(facts *FileFacts) []PermanentHighZ {
+	declared := make(map[string]bool)
+	for _, sig := range facts.Signals {
+		declared[strings.ToLower(sig.Name)] = true
+	}
+
+	type driveKey struct {
+		arch   string
+		signal string
+	}
+	otherDrivers := make(map[driveKey]bool)
+	markDriven := func(scope, expr string) {
+		name := strings.ToLower(extractBaseSignalName(expr))
+		if dot := strings.Index(name, "."); dot != -1 {
+			name = name[:dot]
+		}
+		otherDrivers[driveKey{strings.ToLower(archBase(scope)), name}] = true
+	}
+	for _, inst := range facts.Instances {
+		for _, actual := range inst.PortMap {
+			markDriven(inst.InArch, actual)
+		}
+	}
+	for _, proc := range facts.Processes {
+		for _, call := range proc.ProcedureCalls {
+			for _, arg := range call.Args {
+				markDriven(call.InArch, arg)
+			}
+		}
+	}
+
+	drives := make(map[driveKey][]AssignmentDrive)
+	var order []driveKey
+	for _, drive := range facts.AssignmentDrives {
+		name := strings.ToLower(drive.Target)
+		if !declared[name] {
+			continue
+		}
+		key := driveKey{arch: strings.ToLower(archBase(drive.InArch)), signal: name}
+		if _, ok := drives[key]; !ok {
+			order = append(order, key)
+		}
+		drives[key] = append(drives[key], drive)
+	}
+
+	var found []PermanentHighZ
+	for _, key := range order {
+		if otherDrivers[key] {
+			continue
+		}
+		group := drives[key]
+		allHighZ := true
+		for _, drive := range group {
+			if !drive.HighZ {
+				allHighZ = false
+				break
+			}
+		}
+		if !allHighZ {
+			continue
+		}
+		found = append(found, PermanentHighZ{
+			Signal:      group[0].Target,
+			Assignments: len(group),
+			Line:        group[0].Line,
+			InArch:      archBase(group[0].InArch),
+		})
+	}
+	return found
+}
+
+// MultiDriver reports a signal of an unresolved type written by more than one
+// process or concurrent assignment in the same architecture.
+type MultiDriver struct {
+	Signal     string
+	Drivers    []DriverLocation
+	InGenerate bool // Some driver is inside a generate block (legal if the branches are mutually exclusive)
+	InArch     string
+}
+
+// DriverLocation is one process or concurrent assignment writing a signal.
+type DriverLocation struct {
+	Process    string // Driving process label ("" for concurrent assignments and unlabeled processes)
+	Line       int    // Line of the process or concurrent assignment
+	InGenerate bool
+}
+
+// DetectMultipleDrivers aggregates written SignalUsages by architecture and
+// signal and reports signals with more than one distinct driver. Resolved
+// types (std_logic, std_logic_vector, signed, unsigned) legally merge drivers
+// and are skipped, as are signals whose declaration is not in this file.
+// Declaration defaults and port-map actuals are not drivers.
+func DetectMultipleDrivers(facts *FileFacts) []MultiDriver {
+	type procKey struct {
+		label string
+		line  int
+	}
+	procs := make(map[procKey]Process)
+	for _, proc := range facts.Processes {
+		procs[procKey{strings.ToLower(proc.Label), proc.Line}] = proc
+	}
+	assigns := make(map[int][]ConcurrentAssignment)
+	for _, ca := range facts.ConcurrentAssignments {
+		assigns[ca.Line] = append(assigns[ca.Line], ca)
+	}
+
+	type driverKey struct {
+		arch   string
+		signal string
+	}
+	drivers := make(map[driverKey][]DriverLocation)
+	names := make(map[driverKey]string)
+	var order []driverKey
+	for _, u := range facts.SignalUsages {
+		if !u.IsWritten || u.InPortMap || u.Signal == "" {
+			continue
+		}
+		var loc DriverLocation
+		var scope string
+		if proc, ok := procs[procKey{strings.ToLower(u.InProcess), u.Line}]; ok {
+			// Processes inside a generate carry the generate label in their scope
+			loc = DriverLocation{Process: proc.Label, Line: proc.Line, InGenerate: strings.Contains(proc.InArch, ".")}
+			scope = proc.InArch
+		} else if u.InProcess == "" {
+			found := false
+			for _, ca := range assigns[u.Line] {
+				if strings.EqualFold(ca.Target, u.Signal) {
+					loc = DriverLocation{Line: ca.Line, InGenerate: ca.InGenerate}
+					scope = ca.InArch
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue // Declaration default, not a driver
+			}
+		} else {
+			continue
+		}
+
+		key := driverKey{arch: strings.ToLower(archBase(scope)), signal: strings.ToLower(u.Signal)}
+		if _, ok := drivers[key]; !ok {
+			order = append(order, key)
+			names[key] = u.Signal
+		}
+		duplicate := false
+		for _, existing := range drivers[key] {
+			if existing.Line == loc.Line && strings.EqualFold(existing.Process, loc.Process) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			drivers[key] = append(drivers[key], loc)
+		}
+	}
+
+	var found []MultiDriver
+	for _, key := range order {
+		locs := drivers[key]
+		if len(locs) < 2 {
+			continue
+		}
+		typ, ok := declaredSignalType(facts, key.arch, key.signal)
+		if !ok || isResolvedType(typ) {
+			continue
+		}
+		md := MultiDriver{Signal: names[key], Drivers: locs, InArch: key.arch}
+		for _, loc := range locs {
+			if loc.InGenerate {
+				md.InGenerate = true
+			}
+		}
+		for _, arch := range facts.Architectures {
+			if strings.EqualFold(arch.Name, key.arch) {
+				md.InArch = arch.Name
+			}
+		}
+		found = append(found, md)
+	}
+	return found
+}
+
+// declaredSignalType returns the declared type of a signal or port visible in
+// an architecture of this file (its own signals, its entity's ports, then
+// package signals).
+func declaredSignalType(facts *FileFacts, arch, name string) (string, bool) {
+	for _, sig := range facts.Signals {
+		if strings.EqualFold(sig.Name, name) && strings.EqualFold(archBase(sig.InEntity), arch) {
+			return sig.Type, true
+		}
+	}
+	if entity, ok := entityForArch(facts, arch); ok {
+		for _, port := range facts.Ports {
+			if strings.EqualFold(port.Name, name) && strings.EqualFold(port.InEntity, entity.Name) {
+				return port.Type, true
+			}
+		}
+	}
+	for _, pkg := range facts.Packages {
+		for _, sig := range facts.Signals {
+			if strings.EqualFold(sig.Name, name) && strings.EqualFold(sig.InEntity, pkg.Name) {
+				return sig.Type, true
+			}
+		}
+	}
+	return "", false
+}
+
+// resolvedTypeMarks are the standard types that resolve multiple drivers:
+// std_logic, and the std_logic_1164 and numeric_std arrays whose elements are
+// std_logic. Their unresolved counterparts (std_ulogic_vector, u_unsigned,
+// unresolved_signed) are not.
+var resolvedTypeMarks = map[string]bool{
+	"std_logic": true, "std_logic_vector": true, "signed": true, "unsigned": true,
+}
+
+// isResolvedType reports whether a type resolves multiple drivers. Matches the
+// policy engine's is_resolved_type: the type mark, not a substring of it,
+// must be one of resolvedTypeMarks.
+func isResolvedType(typ string) bool {
+	return resolvedTypeMarks[baseTypeName(typ)]
+}
+
+// UnusedSignal reports a declared signal that is never read.
+type UnusedSignal struct {
+	Name     string
+	Kind     string // "write-only" (assigned but never read) or "dead" (never referenced)
+	Line     int
+	InEntity string
+}
+
+// DetectUnusedSignals cross-references declared signals against their
+// usages. A read, a port-map actual, or a PSL reference counts as a use;
+// a signal that is only assigned is write-only and one with no usage at all
+// (a declaration default is not an assignment) is dead. Names sharing a
+// port's name are skipped, since their usages cannot be told apart from the
+// port's.
+func DetectUnusedSignals(facts *FileFacts) []UnusedSignal {
+	ports := make(map[string]bool, len(facts.Ports))
+	for _, p := range facts.Ports {
+		ports[strings.ToLower(p.Name)] = true
+	}
+	type declKey struct {
+		name string
+		line int
+	}
+	declared := make(map[declKey]bool, len(facts.Signals))
+	for _, sig := range facts.Signals {
+		declared[declKey{strings.ToLower(sig.Name), sig.Line}] = true
+	}
+	read := make(map[string]bool)
+	written := make(map[string]bool)
+	for _, u := range facts.SignalUsages {
+		name := strings.ToLower(u.Signal)
+		if u.IsRead || u.InPortMap || u.InPSL {
+			read[name] = true
+		}
+		if u.IsWritten && !(u.InProcess == "" && declared[declKey{name, u.Line}]) {
+			written[name] = true
+		}
+	}
+
+	var found []UnusedSignal
+	for _, sig := range facts.Signals {
+		name := strings.ToLower(sig.Name)
+		if ports[name] || read[name] {
+			continue
+		}
+		kind := "dead"
+		if written[name] {
+			kind = "write-only"
+		}
+		found = append(found, UnusedSignal{Name: sig.Name, Kind: kind, Line: sig.Line, InEntity: sig.InEntity})
+	}
+	return found
+}
+
+// ClockMisuse reports a clock signal used outside its role as a clock.
+type ClockMisuse struct {
+	Clock   string
+	Kind    string // "data_read" or "combinational_assignment"
+	Process string // Process label; empty for a concurrent assignment
+	Line    int
+	InArch  string
+}
+
+// DetectClockMisuse flags signals used as a clock by some process that are
+// also read as data by another process or a concurrent assignment, and clocks
+// driven by a concurrent assignment or a combinational process. A process's
+// own clock is not a data read, nor is a sensitivity-list entry or a port-map
+// actual. Concurrent assignments that test an edge describe registers, and
+// those already reported as clock-level conditions are skipped.
+func DetectClockMisuse(facts *FileFacts) []ClockMisuse {
+	clocks := clockNames(facts)
+	if len(clocks) == 0 {
+		return nil
+	}
+	type levelKey struct {
+		clock string
+		line  int
+	}
+	levelConditions := make(map[levelKey]bool, len(facts.ClockLevelConditions))
+	for _, c := range facts.ClockLevelConditions {
+		levelConditions[levelKey{strings.ToLower(c.Clock), c.Line}] = true
+	}
+
+	var found []ClockMisuse
+	for _, proc := range facts.Processes {
+		for _, sig := range proc.ReadSignals {
+			if clocks[strings.ToLower(sig)] && !strings.EqualFold(sig, proc.ClockSignal) {
+				found = append(found, ClockMisuse{Clock: sig, Kind: "data_read", Process: proc.Label, Line: proc.Line, InArch: proc.InArch})
+			}
+		}
+		if !proc.IsCombinational {
+			continue
+		}
+		for _, sig := range proc.AssignedSignals {
+			if clocks[strings.ToLower(sig)] {
+				found = append(found, ClockMisuse{Clock: sig, Kind: "combinational_assignment", Process: proc.Label, Line: proc.Line, InArch: proc.InArch})
+			}
+		}
+	}
+	for _, ca := range facts.ConcurrentAssignments {
+		if clocks[strings.ToLower(ca.Target)] {
+			found = append(found, ClockMisuse{Clock: ca.Target, Kind: "combinational_assignment", Line: ca.Line, InArch: ca.InArch})
+		}
+		edge := false
+		for _, cond := range ca.Conditions {
+			if clockEdgeRe.MatchString(cond) {
+				edge = true
+				break
+			}
+		}
+		if edge {
+			continue
+		}
+		for _, sig := range ca.ReadSignals {
+			name := strings.ToLower(sig)
+			if clocks[name] && !levelConditions[levelKey{name, ca.Line}] {
+				found = append(found, ClockMisuse{Clock: sig, Kind: "data_read", Line: ca.Line, InArch: ca.InArch})
+			}
+		}
+	}
+	return found
+}
+
+// ResetCrossing reports an asynchronous reset and the clock domains whose
+// registers it resets.
+type ResetCrossing struct {
+	Reset          string
+	Domains        []string // Clocks of the processes using the reset asynchronously (sorted)
+	Unsynchronized []string // Domains with no reset synchronizer for this reset (sorted)
+	Synchronized   bool     // Every domain has a reset synchronizer
+	Line           int      // First process using the reset
+	InArch         string
+}
+
+// DetectResetDomainCrossings groups the clocked processes that use a reset
+// asynchronously by reset net and checks each clock domain for a reset
+// synchronizer. A domain is synchronized when it has a reset bridge (a
+// process asynchronously reset by the net that only reads back its own
+// registers, such as rst_meta <= '1'; rst_sync <= rst_meta) or when the net
+// heads a register chain found by detectSynchronizers and a single-register
+// process in the domain samples it.
+func DetectResetDomainCrossings(facts *FileFacts) []ResetCrossing {
+	type resetUse struct {
+		reset   string
+		domains map[string]string // lowercase clock -> clock as written
+		line    int
+		inArch  string
+	}
+	var order []string
+	uses := make(map[string]*resetUse)
+	for _, proc := range facts.Processes {
+		if !proc.IsSequential || !proc.HasReset || !proc.ResetAsync || proc.ResetSignal == "" || proc.ClockSignal == "" || proc.InSynthOffRegion {
+			continue
+		}
+		key := strings.ToLower(proc.ResetSignal)
+		use, ok := uses[key]
+		if !ok {
+			use = &resetUse{reset: proc.ResetSignal, domains: make(map[string]string), line: proc.Line, inArch: proc.InArch}
+			uses[key] = use
+			order = append(order, key)
+		}
+		use.domains[strings.ToLower(proc.ClockSignal)] = proc.ClockSignal
+	}
+	if len(uses) == 0 {
+		return nil
+	}
+
+	syncStages := detectSynchronizers(facts.Processes)
+	var found []ResetCrossing
+	for _, key := range order {
+		use := uses[key]
+		synced := resetSynchronizerDomains(facts.Processes, key, syncStages[key] >= 1)
+		crossing := ResetCrossing{Reset: use.reset, Line: use.line, InArch: use.inArch}
+		for clock, display := range use.domains {
+			crossing.Domains = append(crossing.Domains, display)
+			if !synced[clock] {
+				crossing.Unsynchronized = append(crossing.Unsynchronized, display)
+			}
+		}
+		sort.Strings(crossing.Domains)
+		sort.Strings(crossing.Unsynchronized)
+		crossing.Synchronized = len(crossing.Unsynchronized) == 0
+		found = append(found, crossing)
+	}
+	return found
+}
+
+// resetSynchronizerDomains returns the lowercase clocks of the processes that
+// synchronize the reset net: reset bridges, and, when the net heads a
+// synchronizer chain, single-register processes sampling only the net.
+func resetSynchronizerDomains(processes []Process, reset string, chained bool) map[string]bool {
+	domains := make(map[string]bool)
+	for _, proc := range processes {
+		if !proc.IsSequential || proc.ClockSignal == "" {
+			continue
+		}
+		assigned := make(map[string]bool, len(proc.AssignedSignals))
+		for _, sig := range proc.AssignedSignals {
+			assigned[strings.ToLower(sig)] = true
+		}
+		readsReset, foreign := false, false
+		for _, sig := range proc.ReadSignals {
+			name := strings.ToLower(sig)
+			switch {
+			case name == reset:
+				readsReset = true
+			case strings.EqualFold(sig, proc.ClockSignal):
+			case assigned[name]:
+			default:
+				foreign = true
+			}
+		}
+		bridge := proc.HasReset && proc.ResetAsync && strings.EqualFold(proc.ResetSignal, reset) &&
+			len(proc.AssignedSignals) >= 2 && !foreign
+		sampler := chained && readsReset && len(proc.AssignedSignals) == 1 && !foreign &&
+			!strings.EqualFold(proc.ResetSignal, reset)
+		if bridge || sampler {
+			domains[strings.ToLower(proc.ClockSignal)] = true
+		}
+	}
+	return domains
+}
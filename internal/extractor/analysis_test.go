@@ -0,0 +1,834 @@
+package extractor
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDetectConstantsInSensitivityLists(t *testing.T) {
+	facts := FileFacts{
+		Entities: []Entity{{
+			Name:     "core",
+			Generics: []GenericDecl{{Name: "WIDTH", Kind: "constant", Line: 3}},
+		}},
+		Architectures: []Architecture{{Name: "rtl", EntityName: "core"}},
+		Ports:         []Port{{Name: "clk", Direction: "in", InEntity: "core"}},
+		Signals: []Signal{
+			{Name: "data", InEntity: "rtl"},
+			{Name: "C_SHADOW", InEntity: "rtl"},
+		},
+		ConstantDecls: []ConstantDeclaration{
+			{Name: "C_EN", Line: 8, InArch: "rtl"},
+			{Name: "C_SHADOW", Line: 9, InArch: "rtl"},
+			{Name: "C_PKG", Line: 2, InPackage: "pkg"},
+			{Name: "C_OTHER", Line: 40, InArch: "other"},
+		},
+		Processes: []Process{{
+			Label:           "p",
+			InArch:          "rtl",
+			Line:            20,
+			SensitivityList: []string{"clk", "data", "C_EN", "width", "C_PKG", "C_SHADOW", "C_OTHER"},
+		}},
+	}
+
+	got := DetectConstantsInSensitivityLists(&facts)
+	want := map[string]string{"C_EN": "constant", "width": "generic", "C_PKG": "constant"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d findings, got %+v", len(want), got)
+	}
+	for _, f := range got {
+		if want[f.Name] != f.Kind {
+			t.Fatalf("unexpected finding %+v", f)
+		}
+	}
+}
+
+func TestDetectClockLevelConditions(t *testing.T) {
+	facts := FileFacts{
+		Processes: []Process{{Label: "p", ClockSignal: "clk", IsSequential: true}},
+		ConcurrentAssignments: []ConcurrentAssignment{
+			{Target: "q", Kind: "conditional", Conditions: []string{"en = '1' and CLK = '1'"}, Line: 10, InArch: "rtl"},
+			{Target: "r", Kind: "conditional", Conditions: []string{"sel = '1'", "'0' = clk"}, Line: 11, InArch: "rtl"},
+			{Target: "s", Kind: "conditional", Conditions: []string{"clk'event and clk = '1'"}, Line: 12, InArch: "rtl"},
+			{Target: "t", Kind: "selected", Selector: "clk", Line: 13, InArch: "rtl"},
+			{Target: "u", Kind: "selected", Selector: "sel", Line: 14, InArch: "rtl"},
+			{Target: "v", Kind: "simple", Line: 15, InArch: "rtl"},
+		},
+	}
+
+	got := DetectClockLevelConditions(&facts)
+	want := map[string]string{"q": "'1'", "r": "'0'", "t": ""}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d findings, got %+v", len(want), got)
+	}
+	for _, f := range got {
+		level, ok := want[f.Target]
+		if !ok || f.Level != level || !strings.EqualFold(f.Clock, "clk") {
+			t.Fatalf("unexpected finding %+v", f)
+		}
+	}
+}
+
+func TestDetectNoEffectProcesses(t *testing.T) {
+	facts := FileFacts{
+		Functions: []FunctionDeclaration{{Name: "next_rand", IsPure: false}},
+		Processes: []Process{
+			{Label: "dbg", ReadSignals: []string{"a"}, Line: 10},
+			{Label: "drives", AssignedSignals: []string{"y"}, Line: 20},
+			{Label: "checker", HasAssertion: true, Line: 30},
+			{Label: "logger", ProcedureCalls: []ProcedureCall{{Name: "writeline"}}, Line: 40},
+			{Label: "shared", AssignsNonLocalVariable: true, Line: 50},
+			{Label: "rand", FunctionCalls: []FunctionCall{{Name: "NEXT_RAND"}}, Line: 60},
+			{Label: "pure", FunctionCalls: []FunctionCall{{Name: "to_integer"}}, Line: 70},
+		},
+	}
+
+	got := DetectNoEffectProcesses(&facts)
+	if len(got) != 2 || got[0].Process != "dbg" || got[1].Process != "pure" {
+		t.Fatalf("expected dbg and pure, got %+v", got)
+	}
+}
+
+func TestDetectCombinationalMultiDrivers(t *testing.T) {
+	facts := &FileFacts{
+		Processes: []Process{
+			{Label: "comb_a", Line: 10, InArch: "rtl", IsCombinational: true, AssignedSignals: []string{"y", "z"}},
+			{Label: "", Line: 20, InArch: "rtl", IsCombinational: true, AssignedSignals: []string{"Y"}},
+			{Label: "seq_p", Line: 30, InArch: "rtl", IsSequential: true, AssignedSignals: []string{"z"}},
+			{Label: "comb_c", Line: 40, InArch: "other", IsCombinational: true, AssignedSignals: []string{"y"}},
+		},
+	}
+
+	found := DetectCombinationalMultiDrivers(facts)
+	if len(found) != 1 {
+		t.Fatalf("expected 1 combinational multi-driver, got %+v", found)
+	}
+	md := found[0]
+	if md.Signal != "y" || md.InArch != "rtl" {
+		t.Fatalf("unexpected multi-driver: %+v", md)
+	}
+	if len(md.Processes) != 2 || md.Processes[0] != "comb_a" || md.Processes[1] != "" {
+		t.Fatalf("unexpected driving processes: %+v", md.Processes)
+	}
+	if len(md.Lines) != 2 || md.Lines[0] != 10 || md.Lines[1] != 20 {
+		t.Fatalf("unexpected driver lines: %+v", md.Lines)
+	}
+}
+
+func TestDetectPermanentHighZ(t *testing.T) {
+	facts := &FileFacts{
+		Signals: []Signal{
+			{Name: "float_bus"}, {Name: "tri_bus"}, {Name: "shared"}, {Name: "inst_out"},
+		},
+		AssignmentDrives: []AssignmentDrive{
+			{Target: "float_bus", HighZ: true, Line: 10, InArch: "rtl"},
+			{Target: "FLOAT_BUS", HighZ: true, Line: 12, InProcess: "p", InArch: "rtl.gen"},
+			// 'Z' when oe = '0' else data: the else branch is a real value
+			{Target: "tri_bus", HighZ: false, Line: 14, InArch: "rtl"},
+			{Target: "shared", HighZ: true, Line: 16, InArch: "rtl"},
+			{Target: "shared", HighZ: false, Line: 18, InProcess: "p", InArch: "rtl"},
+			{Target: "inst_out", HighZ: true, Line: 20, InArch: "rtl"},
+			{Target: "out_port", HighZ: true, Line: 22, InArch: "rtl"},
+		},
+		Instances: []Instance{
+			{Name: "u0", InArch: "rtl", PortMap: map[string]string{"q": "inst_out(0)"}},
+		},
+	}
+
+	found := DetectPermanentHighZ(facts)
+	if len(found) != 1 {
+		t.Fatalf("expected 1 permanent high-Z signal, got %+v", found)
+	}
+	hz := found[0]
+	if hz.Signal != "float_bus" || hz.Assignments != 2 || hz.Line != 10 || hz.InArch != "rtl" {
+		t.Fatalf("unexpected permanent high-Z: %+v", hz)
+	}
+}
+
+func TestIsHighZValue(t *testing.T) {
+	cases := map[string]bool{
+		"'Z'":                         true,
+		"'z'":                         true,
+		`"ZZZZ"`:                      true,
+		`x"ZZ"`:                       true,
+		"(others => 'Z')":             true,
+		"(others => (others => 'Z'))": true,
+		"'0'":                         false,
+		`"Z0Z0"`:                      false,
+		"data":                        false,
+		"(others => '0')":             false,
+	}
+	for expr, want := range cases {
+		if got := isHighZValue(expr); got != want {
+			t.Errorf("isHighZValue(%q) = %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestIsTristateValue(t *testing.T) {
+	cases := map[string]bool{
+		"'Z'":              true,
+		`"ZZ--"`:           true,
+		`"--Z-"`:           true,
+		"(others => 'Z')":  true,
+		`(others => "Z-")`: true,
+		"'-'":              false,
+		`"----"`:           false,
+		`"Z0Z0"`:           false,
+		"oe_data":          false,
+		"(others => '-')":  false,
+	}
+	for expr, want := range cases {
+		if got := isTristateValue(expr); got != want {
+			t.Errorf("isTristateValue(%q) = %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestMarkSynthOffRegions(t *testing.T) {
+	facts := &FileFacts{
+		Signals:               []Signal{{Name: "live", Line: 3}, {Name: "sim", Line: 6}},
+		Processes:             []Process{{Label: "rtl_p", Line: 10}, {Label: "monitor_p", Line: 40}},
+		ConcurrentAssignments: []ConcurrentAssignment{{Target: "live", Line: 8}, {Target: "sim", Line: 7}},
+	}
+	markSynthOffRegions(facts, []lineRange{{Start: 5, End: 7}, {Start: 30, End: math.MaxInt}})
+
+	if facts.Signals[0].InSynthOffRegion || !facts.Signals[1].InSynthOffRegion {
+		t.Fatalf("unexpected signal tags: %+v", facts.Signals)
+	}
+	if facts.Processes[0].InSynthOffRegion || !facts.Processes[1].InSynthOffRegion {
+		t.Fatalf("unexpected process tags: %+v", facts.Processes)
+	}
+	if facts.ConcurrentAssignments[0].InSynthOffRegion || !facts.ConcurrentAssignments[1].InSynthOffRegion {
+		t.Fatalf("unexpected assignment tags: %+v", facts.ConcurrentAssignments)
+	}
+}
+
+func TestDetectCDCCrossingsSkipsSynthOffProcesses(t *testing.T) {
+	facts := &FileFacts{
+		Processes: []Process{
+			{Label: "writer", IsSequential: true, ClockSignal: "clk_a", AssignedSignals: []string{"flag"}, InArch: "rtl"},
+			{Label: "monitor", IsSequential: true, ClockSignal: "clk_b", ReadSignals: []string{"flag"}, InArch: "rtl", InSynthOffRegion: true},
+		},
+	}
+	if found := DetectCDCCrossings(facts); len(found) != 0 {
+		t.Fatalf("expected no crossings into a translate_off process, got %+v", found)
+	}
+	facts.Processes[1].InSynthOffRegion = false
+	if found := DetectCDCCrossings(facts); len(found) != 1 {
+		t.Fatalf("expected the crossing once the reader is synthesized, got %+v", found)
+	}
+}
+
+func TestDetectCDCCrossingsMatchesRecordFields(t *testing.T) {
+	facts := &FileFacts{
+		Processes: []Process{
+			{Label: "core", IsSequential: true, ClockSignal: "clk_a", AssignedSignals: []string{"trap"}, AssignedPaths: []string{"trap.cause"}},
+			{Label: "buf", IsSequential: true, ClockSignal: "clk_b", AssignedSignals: []string{"trap"}, AssignedPaths: []string{"trap.exc_buf"}},
+			{Label: "log", IsSequential: true, ClockSignal: "clk_b", ReadSignals: []string{"trap"}, ReadPaths: []string{"trap.exc_buf"}},
+			{Label: "dump", IsSequential: true, ClockSignal: "clk_b", ReadSignals: []string{"trap"}},
+		},
+	}
+	found := DetectCDCCrossings(facts)
+	// log only reads the field written in its own domain; dump reads the
+	// whole record, which includes trap.cause from clk_a
+	if len(found) != 1 {
+		t.Fatalf("expected one crossing, got %+v", found)
+	}
+	if c := found[0]; c.Signal != "trap.cause" || c.SourceProc != "core" || c.DestProc != "dump" {
+		t.Fatalf("unexpected crossing %+v", c)
+	}
+}
+
+func TestResolveAliasUsages(t *testing.T) {
+	facts := &FileFacts{
+		Aliases: []AliasDeclaration{
+			{Name: "ready", Target: "status(0)", InArch: "rtl"},
+			{Name: "mode", Target: "cfg.mode", InArch: "rtl"},
+			{Name: "ready", Target: "other_status(0)", InArch: "tb"},
+			{Name: "cnt", Target: "counter_q", InPackage: "util_pkg"},
+		},
+		Processes: []Process{{
+			Label:           "p",
+			InArch:          "rtl",
+			ReadSignals:     []string{"mode", "cfg"},
+			AssignedSignals: []string{"ready", "status"},
+		}},
+		ConcurrentAssignments: []ConcurrentAssignment{
+			{Target: "ready", ReadSignals: []string{"mode", "cnt"}, InArch: "tb"},
+		},
+		SignalUsages: []SignalUsage{{Signal: "mode"}, {Signal: "ready"}},
+	}
+	resolveAliasUsages(facts)
+
+	proc := facts.Processes[0]
+	if strings.Join(proc.ReadSignals, ",") != "cfg" {
+		t.Fatalf("expected reads deduplicated to cfg, got %v", proc.ReadSignals)
+	}
+	if strings.Join(proc.AssignedSignals, ",") != "status" {
+		t.Fatalf("expected writes deduplicated to status, got %v", proc.AssignedSignals)
+	}
+	// tb sees its own aliases and the package's, not rtl's "mode"
+	if ca := facts.ConcurrentAssignments[0]; ca.Target != "other_status" || strings.Join(ca.ReadSignals, ",") != "mode,counter_q" {
+		t.Fatalf("expected tb assignment to resolve only tb and package aliases, got %+v", ca)
+	}
+	if facts.SignalUsages[0].Signal != "cfg" {
+		t.Fatalf("expected unambiguous alias usage to resolve, got %q", facts.SignalUsages[0].Signal)
+	}
+	if facts.SignalUsages[1].Signal != "ready" {
+		t.Fatalf("expected ambiguous alias usage to stay unresolved, got %q", facts.SignalUsages[1].Signal)
+	}
+}
+
+func TestDetectCombinationalLoops(t *testing.T) {
+	facts := &FileFacts{SignalDeps: []SignalDep{
+		// a -> b -> c -> a, all combinational
+		{Source: "a", Target: "b", Line: 10, InArch: "rtl"},
+		{Source: "b", Target: "c", Line: 11, InArch: "rtl"},
+		{Source: "c", Target: "a", Line: 12, InArch: "rtl"},
+		// d -> e -> d broken by a register
+		{Source: "d", Target: "e", Line: 20, InArch: "rtl"},
+		{Source: "e", Target: "d", Line: 21, InArch: "rtl", IsSequential: true},
+		// Self-dependency in a generate scope
+		{Source: "acc", Target: "acc", Line: 30, InArch: "rtl.gen_a"},
+		// Same names in another architecture do not join the rtl graph
+		{Source: "c", Target: "x", Line: 40, InArch: "other"},
+	}}
+
+	loops := DetectCombinationalLoops(facts)
+	if len(loops) != 2 {
+		t.Fatalf("expected 2 loops, got %+v", loops)
+	}
+	var triple, self *CombinationalLoop
+	for i := range loops {
+		switch len(loops[i].Signals) {
+		case 3:
+			triple = &loops[i]
+		case 1:
+			self = &loops[i]
+		}
+	}
+	if triple == nil || strings.Join(triple.Signals, ",") != "a,b,c" || fmt.Sprint(triple.Lines) != "[10 11 12]" || triple.InArch != "rtl" {
+		t.Fatalf("unexpected three-signal loop: %+v", loops)
+	}
+	if self == nil || self.Signals[0] != "acc" || self.Lines[0] != 30 {
+		t.Fatalf("unexpected self loop: %+v", loops)
+	}
+}
+
+func TestDetectMultipleDrivers(t *testing.T) {
+	facts := &FileFacts{
+		Entities:      []Entity{{Name: "top"}},
+		Architectures: []Architecture{{Name: "rtl", EntityName: "top"}},
+		Ports:         []Port{{Name: "done", Type: "boolean", InEntity: "top"}},
+		Signals: []Signal{
+			{Name: "count", Type: "integer", InEntity: "rtl", Line: 5},
+			{Name: "bus_s", Type: "std_logic_vector(7 downto 0)", InEntity: "rtl", Line: 6},
+			{Name: "lane", Type: "bit", InEntity: "rtl", Line: 7},
+		},
+		Processes: []Process{
+			{Label: "p_inc", Line: 10, InArch: "rtl", AssignedSignals: []string{"count", "bus_s", "done"}},
+			{Label: "g0", Line: 30, InArch: "rtl.gen_a"},
+		},
+		ConcurrentAssignments: []ConcurrentAssignment{
+			{Target: "count", Line: 20, InArch: "rtl"},
+			{Target: "bus_s", Line: 21, InArch: "rtl"},
+			{Target: "done", Line: 22, InArch: "rtl"},
+			{Target: "lane", Line: 31, InArch: "rtl.gen_a", InGenerate: true},
+			{Target: "lane", Line: 32, InArch: "rtl.gen_b", InGenerate: true},
+		},
+		SignalUsages: []SignalUsage{
+			{Signal: "count", IsWritten: true, Line: 5}, // Declaration default
+			{Signal: "count", IsWritten: true, InProcess: "p_inc", Line: 10},
+			{Signal: "count", IsWritten: true, InProcess: "p_inc", Line: 10},
+			{Signal: "count", IsWritten: true, Line: 20},
+			{Signal: "bus_s", IsWritten: true, InProcess: "p_inc", Line: 10},
+			{Signal: "bus_s", IsWritten: true, Line: 21},
+			{Signal: "done", IsWritten: true, InProcess: "p_inc", Line: 10},
+			{Signal: "done", IsWritten: true, Line: 22},
+			{Signal: "lane", IsWritten: true, Line: 31},
+			{Signal: "lane", IsWritten: true, Line: 32},
+			{Signal: "lane", InPortMap: true, Line: 40},
+		},
+	}
+
+	found := DetectMultipleDrivers(facts)
+	if len(found) != 3 {
+		t.Fatalf("expected count, done, and lane, got %+v", found)
+	}
+	count := found[0]
+	if count.Signal != "count" || len(count.Drivers) != 2 || count.Drivers[0].Process != "p_inc" || count.Drivers[1].Line != 20 || count.InGenerate || count.InArch != "rtl" {
+		t.Fatalf("unexpected count drivers: %+v", count)
+	}
+	if found[1].Signal != "done" {
+		t.Fatalf("expected port done to be checked against its entity type, got %+v", found[1])
+	}
+	if lane := found[2]; lane.Signal != "lane" || !lane.InGenerate {
+		t.Fatalf("expected lane drivers inside generates, got %+v", lane)
+	}
+}
+
+func TestIsResolvedType(t *testing.T) {
+	for typ, want := range map[string]bool{
+		"std_logic":                             true,
+		"STD_LOGIC_VECTOR(7 downto 0)":          true,
+		"ieee.numeric_std.unsigned(3 downto 0)": true,
+		"signed (7 downto 0)":                   true,
+		"std_ulogic":                            false,
+		"std_ulogic_vector(7 downto 0)":         false,
+		"u_unsigned(3 downto 0)":                false,
+		"unresolved_signed(3 downto 0)":         false,
+		"my_unsigned_t":                         false,
+		"bit_vector(1 downto 0)":                false,
+	} {
+		if got := isResolvedType(typ); got != want {
+			t.Errorf("isResolvedType(%q) = %v, want %v", typ, got, want)
+		}
+	}
+}
+
+func TestDetectUnusedSignals(t *testing.T) {
+	facts := &FileFacts{
+		Ports: []Port{{Name: "data_o", Direction: "out"}},
+		Signals: []Signal{
+			{Name: "Count", Line: 5, InEntity: "rtl"},
+			{Name: "dbg", Line: 6, InEntity: "rtl"},
+			{Name: "spare", Line: 7, InEntity: "rtl"},
+			{Name: "link", Line: 8, InEntity: "rtl"},
+			{Name: "init_only", Line: 9, InEntity: "rtl"},
+			{Name: "data_o", Line: 10, InEntity: "rtl"},
+		},
+		SignalUsages: []SignalUsage{
+			{Signal: "count", IsWritten: true, InProcess: "p", Line: 20},
+			{Signal: "COUNT", IsRead: true, InProcess: "p", Line: 21},
+			{Signal: "dbg", IsWritten: true, Line: 22},
+			{Signal: "link", InPortMap: true, InstanceName: "u0", Line: 30},
+			{Signal: "init_only", IsWritten: true, Line: 9}, // Declaration default
+			{Signal: "data_o", IsWritten: true, Line: 23},
+		},
+	}
+
+	found := DetectUnusedSignals(facts)
+	got := make(map[string]string)
+	for _, us := range found {
+		got[us.Name] = us.Kind
+	}
+	want := map[string]string{"dbg": "write-only", "spare": "dead", "init_only": "dead"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestDetectClockMisuse(t *testing.T) {
+	facts := &FileFacts{
+		ClockDomains: []ClockDomain{{Clock: "clk", Process: "regs"}},
+		Processes: []Process{
+			{Label: "regs", ClockSignal: "clk", IsSequential: true, ReadSignals: []string{"clk", "d"}, Line: 10, InArch: "rtl"},
+			{Label: "comb", IsCombinational: true, ReadSignals: []string{"CLK", "en"}, AssignedSignals: []string{"y"}, Line: 20, InArch: "rtl"},
+			{Label: "div", IsCombinational: true, ReadSignals: []string{"en"}, AssignedSignals: []string{"clk"}, Line: 30, InArch: "rtl"},
+		},
+		ClockLevelConditions: []ClockLevelCondition{{Target: "q", Clock: "clk", Line: 40}},
+		ConcurrentAssignments: []ConcurrentAssignment{
+			{Target: "q", Kind: "conditional", Conditions: []string{"clk = '1'"}, ReadSignals: []string{"d", "clk"}, Line: 40, InArch: "rtl"},
+			{Target: "r", Kind: "conditional", Conditions: []string{"rising_edge(clk)"}, ReadSignals: []string{"d", "clk"}, Line: 41, InArch: "rtl"},
+			{Target: "g", Kind: "simple", ReadSignals: []string{"clk", "en"}, Line: 42, InArch: "rtl"},
+		},
+	}
+
+	got := DetectClockMisuse(facts)
+	want := []ClockMisuse{
+		{Clock: "CLK", Kind: "data_read", Process: "comb", Line: 20, InArch: "rtl"},
+		{Clock: "clk", Kind: "combinational_assignment", Process: "div", Line: 30, InArch: "rtl"},
+		{Clock: "clk", Kind: "data_read", Line: 42, InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectResetDomainCrossings(t *testing.T) {
+	facts := &FileFacts{
+		Processes: []Process{
+			// Reset bridge for rst_n in clk_a
+			{Label: "bridge", IsSequential: true, ClockSignal: "clk_a", HasReset: true, ResetAsync: true, ResetSignal: "rst_n",
+				AssignedSignals: []string{"rst_meta", "rst_sync"}, ReadSignals: []string{"rst_n", "rst_meta"}, Line: 10, InArch: "rtl"},
+			{Label: "regs_a", IsSequential: true, ClockSignal: "clk_a", HasReset: true, ResetAsync: true, ResetSignal: "RST_N",
+				AssignedSignals: []string{"qa"}, ReadSignals: []string{"rst_n", "da"}, Line: 20, InArch: "rtl"},
+			{Label: "regs_b", IsSequential: true, ClockSignal: "clk_b", HasReset: true, ResetAsync: true, ResetSignal: "rst_n",
+				AssignedSignals: []string{"qb"}, ReadSignals: []string{"rst_n", "db"}, Line: 30, InArch: "rtl"},
+			// Synchronous reset is not a crossing
+			{Label: "regs_c", IsSequential: true, ClockSignal: "clk_c", HasReset: true, ResetSignal: "srst",
+				AssignedSignals: []string{"qc"}, ReadSignals: []string{"srst", "dc"}, Line: 40, InArch: "rtl"},
+			// arst heads a two-stage chain in clk_c
+			{Label: "meta", IsSequential: true, ClockSignal: "clk_c", AssignedSignals: []string{"arst_meta"}, ReadSignals: []string{"arst"}, Line: 50, InArch: "rtl"},
+			{Label: "sync", IsSequential: true, ClockSignal: "clk_c", AssignedSignals: []string{"arst_sync"}, ReadSignals: []string{"arst_meta"}, Line: 60, InArch: "rtl"},
+			{Label: "regs_d", IsSequential: true, ClockSignal: "clk_c", HasReset: true, ResetAsync: true, ResetSignal: "arst",
+				AssignedSignals: []string{"qd"}, ReadSignals: []string{"arst", "dd"}, Line: 70, InArch: "rtl"},
+		},
+	}
+
+	got := DetectResetDomainCrossings(facts)
+	want := []ResetCrossing{
+		{Reset: "rst_n", Domains: []string{"clk_a", "clk_b"}, Unsynchronized: []string{"clk_b"}, Line: 10, InArch: "rtl"},
+		{Reset: "arst", Domains: []string{"clk_c"}, Synchronized: true, Line: 70, InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectConditionalLatches(t *testing.T) {
+	facts := &FileFacts{
+		Processes: []Process{
+			{Label: "gate", IsCombinational: true, InArch: "rtl",
+				PartialAssignments: []PartialAssignment{{Signal: "q", Line: 12, MissingBranch: "else"}}},
+			{Label: "sim_only", IsCombinational: true, InSynthOffRegion: true, InArch: "rtl",
+				PartialAssignments: []PartialAssignment{{Signal: "dbg", Line: 30, MissingBranch: "else"}}},
+		},
+	}
+
+	got := DetectConditionalLatches(facts)
+	want := []LatchInference{{Signal: "q", Process: "gate", Line: 12, MissingBranch: "else", InArch: "rtl"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectReadBeforeWrite(t *testing.T) {
+	facts := &FileFacts{
+		Processes: []Process{
+			{Label: "comb", IsCombinational: true, InArch: "rtl",
+				SignalAccesses: []SignalAccess{
+					{Signal: "tmp", FirstRead: 12, FirstWrite: 14, Writes: 1},
+					// Default assignment overridden later: written twice
+					{Signal: "y", FirstRead: 13, FirstWrite: 15, Writes: 2},
+					// Read on the assignment's own line
+					{Signal: "acc", FirstRead: 16, FirstWrite: 16, Writes: 1},
+					{Signal: "a", FirstRead: 12},
+				}},
+			{Label: "reg", IsSequential: true, InArch: "rtl",
+				SignalAccesses: []SignalAccess{{Signal: "q", FirstRead: 20, FirstWrite: 22, Writes: 1}}},
+		},
+	}
+
+	got := DetectReadBeforeWrite(facts)
+	want := []ReadBeforeWrite{{Signal: "tmp", Process: "comb", ReadLine: 12, WriteLine: 14, InArch: "rtl"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestAnalyzeStateMachines(t *testing.T) {
+	facts := &FileFacts{
+		Types: []TypeDeclaration{
+			{Name: "state_t", Kind: "enum", EnumLiterals: []string{"IDLE", "RUN", "WAIT", "DONE"}},
+			// No case statement selects on mode_t signals: not a state machine
+			{Name: "mode_t", Kind: "enum", EnumLiterals: []string{"FAST", "SLOW"}},
+		},
+		Signals: []Signal{
+			{Name: "state", Type: "state_t", Line: 8, InEntity: "rtl"},
+			{Name: "next_state", Type: "work.fsm_pkg.state_t", Line: 9, InEntity: "rtl"},
+			{Name: "mode", Type: "mode_t", Line: 10, InEntity: "rtl"},
+		},
+		CaseStatements: []CaseStatement{
+			{Expression: "state", Choices: []string{"IDLE", "RUN | others"}, HasOthers: true},
+		},
+		Comparisons: []Comparison{
+			{LeftOperand: "state", Operator: "=", RightOperand: "DONE"},
+		},
+		AssignmentDrives: []AssignmentDrive{
+			{Target: "state", Values: []string{"next_state"}},
+			{Target: "next_state", Values: []string{"run", "IDLE"}},
+			{Target: "next_state", Values: []string{"WAIT"}},
+		},
+	}
+
+	got := AnalyzeStateMachines(facts)
+	want := []FSMIssue{
+		{Signal: "state", Type: "state_t", State: "WAIT", Kind: "dead", Line: 8, InArch: "rtl"},
+		{Signal: "state", Type: "state_t", State: "DONE", Kind: "unreachable", Line: 8, InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectGatedClocks(t *testing.T) {
+	facts := &FileFacts{
+		Ports: []Port{{Name: "clk", Direction: "in"}},
+		ClockDomains: []ClockDomain{
+			{Clock: "clk"}, {Clock: "gclk"}, {Clock: "clk_div"},
+		},
+		AssignmentDrives: []AssignmentDrive{
+			{Target: "GCLK", Values: []string{"clk and en"}, Line: 12, InArch: "rtl"},
+			{Target: "clk_div", Values: []string{"not clk_div"}, Line: 20, InProcess: "p_div", InArch: "rtl"},
+			{Target: "clk", Values: []string{"'0'"}, Line: 30, InArch: "tb"},
+			{Target: "data", Values: []string{"clk"}, Line: 31, InArch: "rtl"},
+		},
+	}
+
+	got := DetectGatedClocks(facts)
+	want := []GatedClock{
+		{Clock: "GCLK", Expression: "clk and en", Line: 12, InArch: "rtl"},
+		{Clock: "clk_div", Expression: "not clk_div", Line: 20, InProcess: "p_div", InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectUninitializedReads(t *testing.T) {
+	facts := &FileFacts{
+		Ports: []Port{{Name: "a", Direction: "in", Type: "std_logic"}},
+		Signals: []Signal{
+			{Name: "en", Type: "std_logic", Line: 5, InEntity: "rtl"},
+			{Name: "acc", Type: "UNSIGNED(7 downto 0)", Line: 6, InEntity: "rtl"},
+			{Name: "init", Type: "std_logic", Line: 7, InEntity: "rtl"},    // Declared with a default
+			{Name: "count", Type: "integer", Line: 8, InEntity: "rtl"},     // No 'U' value
+			{Name: "q", Type: "std_logic", Line: 9, InEntity: "rtl"},       // Reset register
+			{Name: "early", Type: "std_logic", Line: 10, InEntity: "rtl"},  // Assigned above the read
+			{Name: "unread", Type: "std_logic", Line: 11, InEntity: "rtl"}, // Never read
+		},
+		SignalUsages: []SignalUsage{
+			{Signal: "init", IsWritten: true, Line: 7},
+			{Signal: "early", IsWritten: true, Line: 14},
+		},
+		Processes: []Process{
+			{
+				Label: "comb_p", IsCombinational: true, InArch: "rtl",
+				SignalAccesses: []SignalAccess{
+					{Signal: "acc", FirstRead: 22, FirstWrite: 24, Writes: 1},
+					{Signal: "init", FirstRead: 23},
+					{Signal: "count", FirstRead: 23},
+					{Signal: "q", FirstRead: 25},
+				},
+			},
+			{Label: "reg_p", IsSequential: true, HasReset: true, AssignedSignals: []string{"q"}, InArch: "rtl"},
+		},
+		ConcurrentAssignments: []ConcurrentAssignment{
+			{Target: "y", ReadSignals: []string{"a", "EN", "early"}, Line: 30, InArch: "rtl"},
+		},
+	}
+
+	got := DetectUninitializedReads(facts)
+	want := []UninitRead{
+		{Signal: "acc", Type: "UNSIGNED(7 downto 0)", Line: 22, InProcess: "comb_p", InArch: "rtl"},
+		{Signal: "en", Type: "std_logic", Line: 30, InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectFeedthroughs(t *testing.T) {
+	facts := &FileFacts{
+		Architectures: []Architecture{{Name: "rtl", EntityName: "top"}},
+		Ports: []Port{
+			{Name: "a_i", Direction: "in", InEntity: "top"},
+			{Name: "b_i", Direction: "in", InEntity: "top"},
+			{Name: "y_o", Direction: "out", InEntity: "top"},
+			{Name: "z_o", Direction: "out", InEntity: "top"},
+			{Name: "w_o", Direction: "out", InEntity: "top"},
+			{Name: "v_o", Direction: "out", InEntity: "top"},
+		},
+		Signals: []Signal{{Name: "s", InEntity: "rtl"}},
+		ConcurrentAssignments: []ConcurrentAssignment{
+			{Target: "y_o", ReadSignals: []string{"a_i"}, Line: 10, InArch: "rtl", Kind: "simple"},
+			{Target: "z_o", ReadSignals: []string{"a_i"}, Line: 11, InArch: "rtl", Kind: "simple"},
+			{Target: "w_o", ReadSignals: []string{"s"}, Line: 12, InArch: "rtl", Kind: "simple"},
+			{Target: "v_o", ReadSignals: []string{"b_i"}, Line: 13, InArch: "rtl.gen_a", Kind: "simple"},
+			{Target: "y_o", ReadSignals: []string{"b_i"}, Line: 14, InArch: "rtl", Kind: "simple", InSynthOffRegion: true},
+		},
+		AssignmentDrives: []AssignmentDrive{
+			{Target: "y_o", Whole: true, Values: []string{"a_i"}, Line: 10, InArch: "rtl"},
+			{Target: "z_o", Whole: true, Values: []string{"not a_i"}, Line: 11, InArch: "rtl"},
+			{Target: "w_o", Whole: true, Values: []string{"s"}, Line: 12, InArch: "rtl"},
+			{Target: "v_o", Whole: true, Values: []string{"(B_I)"}, Line: 13, InArch: "rtl.gen_a"},
+			{Target: "y_o", Whole: true, Values: []string{"b_i"}, Line: 14, InArch: "rtl"},
+		},
+	}
+
+	got := DetectFeedthroughs(facts)
+	want := []Feedthrough{
+		{Input: "a_i", Output: "y_o", Line: 10, InArch: "rtl"},
+		{Input: "b_i", Output: "v_o", Line: 13, InArch: "rtl.gen_a"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectResetDefaultMismatches(t *testing.T) {
+	facts := &FileFacts{
+		Signals: []Signal{
+			{Name: "cnt", InEntity: "rtl", Default: "(others => '0')"},
+			{Name: "mask", InEntity: "rtl", Default: "(others => '1')"},
+			{Name: "state", InEntity: "rtl", Default: "IDLE"},
+			{Name: "acc", InEntity: "rtl", Default: "to_unsigned(0, 8)"},
+			{Name: "q", InEntity: "rtl"},
+		},
+		Processes: []Process{
+			{Label: "p_reg", InArch: "rtl", ResetValues: []ResetValue{
+				{Signal: "cnt", Value: `x"00"`, Line: 10},
+				{Signal: "MASK", Value: "(others => '0')", Line: 11},
+				{Signal: "state", Value: "idle", Line: 12},
+				{Signal: "acc", Value: "(others => '1')", Line: 13},
+				{Signal: "q", Value: "'1'", Line: 14},
+			}},
+			{Label: "p_sim", InArch: "rtl", InSynthOffRegion: true, ResetValues: []ResetValue{
+				{Signal: "mask", Value: "'0'", Line: 20},
+			}},
+		},
+	}
+
+	got := DetectResetDefaultMismatches(facts)
+	want := []ResetDefaultMismatch{
+		{Signal: "mask", Default: "(others => '1')", Reset: "(others => '0')", Line: 11, InProcess: "p_reg", InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestLiteralValueKey(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		ok    bool
+	}{
+		{"(others => '0')", "all:0", true},
+		{`"0000"`, "all:0", true},
+		{`X"FF"`, "all:1", true},
+		{`b"01_10"`, "0110", true},
+		{"('1')", "'1'", true},
+		{"16#FF#", "16#ff#", true},
+		{"-1", "-1", true},
+		{"a + b", "", false},
+		{"resize(x, 8)", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := literalValueKey(tt.value)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("literalValueKey(%q) = %q, %v, want %q, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestDetectMixedSensitivity(t *testing.T) {
+	facts := &FileFacts{
+		Processes: []Process{
+			{Label: "p_ok", IsSequential: true, ClockSignal: "clk", ResetSignal: "rst_n", SensitivityList: []string{"clk", "rst_n"}, Line: 3, InArch: "rtl"},
+			{Label: "p_data", IsSequential: true, ClockSignal: "clk", SensitivityList: []string{"CLK", "d", "en"}, Line: 10, InArch: "rtl"},
+			{Label: "p_no_clk", IsSequential: true, ClockSignal: "clk", SensitivityList: []string{"rst"}, Line: 20, InArch: "rtl"},
+			{Label: "p_all", IsSequential: true, ClockSignal: "clk", SensitivityList: []string{"all"}, Line: 30, InArch: "rtl"},
+			{Label: "p_wait", IsSequential: true, ClockSignal: "clk", WaitStatements: []WaitStatement{{Line: 41}}, Line: 40, InArch: "rtl"},
+			{Label: "p_comb", IsCombinational: true, SensitivityList: []string{"a", "b"}, Line: 50, InArch: "rtl"},
+		},
+	}
+
+	got := DetectMixedSensitivity(facts)
+	want := []MixedSensitivity{
+		{Process: "p_data", Clock: "clk", Extra: []string{"d", "en"}, Line: 10, InArch: "rtl"},
+		{Process: "p_no_clk", Clock: "clk", MissingClock: true, Line: 20, InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectFSMEncodings(t *testing.T) {
+	facts := &FileFacts{
+		Types: []TypeDeclaration{
+			{Name: "state_t", Kind: "enum", EnumLiterals: []string{"IDLE", "RUN", "DONE"}, InArch: "rtl"},
+			{Name: "word_t", Kind: "array", InArch: "rtl"},
+		},
+		Signals: []Signal{
+			{Name: "state", Type: "state_t", InEntity: "rtl"},
+			{Name: "data", Type: "word_t", InEntity: "rtl"},
+		},
+		AttributeSpecs: []AttributeSpec{
+			{Attribute: "fsm_encoding", Type: "string", Line: 4},
+			{Attribute: "fsm_encoding", Target: "state", TargetKind: "signal", Value: `"one_hot"`, Line: 5, InArch: "rtl"},
+			{Attribute: "enum_encoding", Target: "STATE_T", TargetKind: "type", Value: `"00 01 11"`, Line: 6, InArch: "rtl"},
+			{Attribute: "fsm_encoding", Target: "data", TargetKind: "signal", Value: `"gray"`, Line: 7, InArch: "rtl"},
+			{Attribute: "keep", Target: "state", TargetKind: "signal", Value: "true", Line: 8, InArch: "rtl"},
+		},
+	}
+
+	got := DetectFSMEncodings(facts)
+	want := []FSMEncoding{
+		{TypeName: "state_t", Signal: "state", Attribute: "fsm_encoding", Encoding: "one-hot", Value: `"one_hot"`, StateCount: 3, Line: 5, InArch: "rtl"},
+		{TypeName: "state_t", Attribute: "enum_encoding", Encoding: "user", Value: `"00 01 11"`, StateCount: 3, Line: 6, InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestNormalizeEncoding(t *testing.T) {
+	for value, want := range map[string]string{
+		`"one_hot"`: "one-hot", `"ONEHOT"`: "one-hot", `"sequential"`: "binary",
+		`"compact"`: "binary", `"gray"`: "gray", `"auto"`: "auto",
+		`"0001 0010 0100"`: "user", `"safe,one-hot"`: "safe,one-hot",
+	} {
+		if got := normalizeEncoding(value); got != want {
+			t.Errorf("normalizeEncoding(%s) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestDetectStandardShadowing(t *testing.T) {
+	facts := &FileFacts{
+		Types: []TypeDeclaration{
+			{Name: "Unsigned", Kind: "array", Line: 3, InPackage: "my_pkg"},
+			{Name: "state_t", Kind: "enum", Line: 4, InPackage: "my_pkg"},
+			{Name: "std_logic", Kind: "enum", Line: 5, InArch: "rtl"},
+		},
+		Subtypes: []SubtypeDeclaration{{Name: "natural", BaseType: "integer", Line: 6, InPackage: "my_pkg"}},
+		Functions: []FunctionDeclaration{
+			{Name: "to_integer", Line: 7, InPackage: "my_pkg"},
+			{Name: "to_integer", Line: 8, InPackage: "my_pkg"},
+			{Name: "to_integer", Line: 20, HasBody: true, InPackage: "my_pkg"},
+			{Name: "resize", Line: 30, InPackage: "numeric_std"},
+		},
+		ConstantDecls: []ConstantDeclaration{
+			{Name: "time", Line: 9, InPackage: "my_pkg"},
+			{Name: "WIDTH", Line: 10, InPackage: "my_pkg"},
+		},
+	}
+
+	got := (&Extractor{}).detectStandardShadowing(facts)
+	want := []StandardShadowing{
+		{Name: "Unsigned", Kind: "type", Package: "my_pkg", Line: 3},
+		{Name: "natural", Kind: "subtype", Package: "my_pkg", Line: 6},
+		{Name: "to_integer", Kind: "function", Package: "my_pkg", Line: 7},
+		{Name: "time", Kind: "constant", Package: "my_pkg", Line: 9},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectSensitivityListSmells(t *testing.T) {
+	facts := &FileFacts{
+		Processes: []Process{
+			{Label: "p_ok", SensitivityList: []string{"a", "b"}, AssignedSignals: []string{"y"}, Line: 3, InArch: "rtl"},
+			{Label: "p_dup", SensitivityList: []string{"clk", "CLK", "data(0)", "data (0)", "clk"}, Line: 10, InArch: "rtl"},
+			{Label: "p_self", SensitivityList: []string{"a", "y(1)"}, AssignedSignals: []string{"y"}, Line: 20, InArch: "rtl"},
+			{Label: "p_all", SensitivityList: []string{"all", "all"}, AssignedSignals: []string{"all"}, Line: 30, InArch: "rtl"},
+			{Label: "p_wait", AssignedSignals: []string{"y"}, Line: 40, InArch: "rtl"},
+		},
+	}
+
+	got := DetectSensitivityListSmells(facts)
+	want := []SensitivityListSmell{
+		{Process: "p_dup", Duplicates: []string{"CLK", "data (0)"}, Line: 10, InArch: "rtl"},
+		{Process: "p_self", SelfReferences: []string{"y(1)"}, Line: 20, InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+package extractor
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Assertion is an assert or report statement. Standalone report statements
+// have no condition.
+type Assertion struct {
+	Condition     string // Asserted expression ("" for report statements)
+	ReportMessage string // Report expression as written ("" if omitted)
+	Severity      string // Severity clause, lowercased; VHDL defaults apply when omitted
+	Line          int
+	InProcess     string // Empty for concurrent assertions
+	InArch        string
+}
+
+// extractAssertion reads the parts of an assert_statement or report_statement.
+// The assert/report/severity keywords are hidden by the grammar, so each part
+// is the source text of the children following its keyword. When the severity
+// clause is omitted, asserts default to "error" and reports to "note".
+func extractAssertion(node *sitter.Node, source []byte, archContext, processLabel string) Assertion {
+	parts := make(map[string][2]uint32) // keyword -> [start, end) of its expression
+	section := ""
+	prevEnd := node.StartByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		gap := strings.Fields(strings.ToLower(string(source[prevEnd:child.StartByte()])))
+		prevEnd = child.EndByte()
+		for _, word := range gap {
+			switch word {
+			case "assert", "report", "severity":
+				section = word
+			}
+		}
+		if section == "" || child.Type() == ";" {
+			continue
+		}
+		span, ok := parts[section]
+		if !ok {
+			span[0] = child.StartByte()
+		}
+		span[1] = child.EndByte()
+		parts[section] = span
+	}
+	text := func(section string) string {
+		span, ok := parts[section]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(string(source[span[0]:span[1]]))
+	}
+
+	assertion := Assertion{
+		Condition:     text("assert"),
+		ReportMessage: text("report"),
+		Severity:      strings.ToLower(text("severity")),
+		Line:          int(node.StartPoint().Row) + 1,
+		InProcess:     processLabel,
+		InArch:        archContext,
+	}
+	if assertion.Severity == "" {
+		if node.Type() == "report_statement" {
+			assertion.Severity = "note"
+		} else {
+			assertion.Severity = "error"
+		}
+	}
+	return assertion
+}
+
+// extractAssertionsFromProcess records the sequential assertions and reports
+// of a process.
+func (e *Extractor) extractAssertionsFromProcess(node *sitter.Node, source []byte, archContext, processLabel string, facts *FileFacts) {
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		switch n.Type() {
+		case "assert_statement", "report_statement":
+			facts.Assertions = append(facts.Assertions, extractAssertion(n, source, archContext, processLabel))
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(node)
+}
+
+// isConcurrentAssertion reports whether an assert_statement sits directly in
+// an architecture or block rather than inside a process or subprogram.
+func isConcurrentAssertion(node *sitter.Node) bool {
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		switch parent.Type() {
+		case "process_statement", "subprogram_body", "function_body", "procedure_body":
+			return false
+		}
+	}
+	return true
+}
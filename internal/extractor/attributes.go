@@ -0,0 +1,106 @@
+package extractor
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// AttributeSpec represents an attribute declaration ("attribute keep :
+// boolean;") or one target of an attribute specification ("attribute keep
+// of data_r : signal is true;"). Synthesis directives such as keep and
+// dont_touch are carried this way.
+type AttributeSpec struct {
+	Attribute  string // Attribute name; well-known vendor attributes are lowercased
+	Target     string // Named entity, "others", or "all" ("" for declarations)
+	TargetKind string // Entity class, lowercase ("signal", "entity", "label", ...; "" for declarations)
+	Value      string // Value expression as written ("" for declarations)
+	Type       string // Declared attribute type (declarations only)
+	Line       int
+	InArch     string
+}
+
+// vendorAttributes are synthesis attributes recognized across Xilinx,
+// Intel, Synopsys, and Lattice flows. Their names are case-insensitive, so
+// they are stored lowercase for policies to match directly.
+var vendorAttributes = map[string]bool{
+	"keep": true, "dont_touch": true, "mark_debug": true, "preserve": true,
+	"noprune": true, "syn_keep": true, "syn_preserve": true, "syn_noprune": true,
+	"async_reg": true, "max_fanout": true, "iob": true, "shreg_extract": true,
+	"ram_style": true, "rom_style": true, "ramstyle": true, "romstyle": true,
+	"syn_ramstyle": true, "syn_romstyle": true, "use_dsp": true, "use_dsp48": true,
+	"fsm_encoding": true, "fsm_safe_state": true, "syn_encoding": true,
+	"enum_encoding": true, "syn_maxfan": true, "direct_enable": true,
+	"direct_reset": true, "loc": true, "io_standard": true, "chip_pin": true,
+}
+
+// normalizeAttributeName lowercases well-known vendor attribute names and
+// leaves user-defined ones as written.
+func normalizeAttributeName(name string) string {
+	if lower := strings.ToLower(name); vendorAttributes[lower] {
+		return lower
+	}
+	return name
+}
+
+// extractAttributeDeclaration reads "attribute name : type;".
+func (e *Extractor) extractAttributeDeclaration(node *sitter.Node, source []byte, archContext string) AttributeSpec {
+	spec := AttributeSpec{Line: int(node.StartPoint().Row) + 1, InArch: archContext}
+	if nameNode := node.ChildByFieldName("name"); nameNode != nil {
+		spec.Attribute = normalizeAttributeName(nameNode.Content(source))
+	}
+	if typeNode := node.ChildByFieldName("type"); typeNode != nil {
+		spec.Type = typeNode.Content(source)
+	}
+	return spec
+}
+
+// extractAttributeSpecifications reads "attribute name of targets : class is
+// value;", returning one AttributeSpec per target. The "of", "is", "others",
+// "all", and keyword entity classes are hidden by the grammar, so they are
+// recovered from the source text between children.
+func (e *Extractor) extractAttributeSpecifications(node *sitter.Node, source []byte, archContext string) []AttributeSpec {
+	base := AttributeSpec{Line: int(node.StartPoint().Row) + 1, InArch: archContext}
+	nameNode := node.ChildByFieldName("name")
+	valueNode := node.ChildByFieldName("value")
+	if nameNode == nil || valueNode == nil {
+		return nil
+	}
+	base.Attribute = normalizeAttributeName(nameNode.Content(source))
+	base.Value = strings.TrimSpace(valueNode.Content(source))
+
+	var targets []string
+	var colon *sitter.Node
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if colon == nil && node.FieldNameForChild(i) == "target" {
+			targets = append(targets, child.Content(source))
+		}
+		if colon == nil && child.Type() == ":" {
+			colon = child
+		}
+	}
+	if colon == nil {
+		return nil
+	}
+	if len(targets) == 0 {
+		// "of others :" / "of all :"
+		gap := strings.Fields(strings.ToLower(string(source[nameNode.EndByte():colon.StartByte()])))
+		if len(gap) > 0 {
+			targets = append(targets, gap[len(gap)-1])
+		}
+	}
+	// Between ':' and the value: "<class> is"
+	class := strings.Fields(strings.ToLower(string(source[colon.EndByte():valueNode.StartByte()])))
+	if len(class) > 0 {
+		base.TargetKind = class[0]
+	}
+
+	specs := make([]AttributeSpec, 0, len(targets))
+	for _, target := range targets {
+		spec := base
+		spec.Target = target
+		specs = append(specs, spec)
+	}
+	return specs
+}
@@ -0,0 +1,331 @@
+package extractor
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// ChoiceRange is a case/selected choice normalized to a closed numeric interval.
+// Discrete values become a single-point range (Low == High).
+type ChoiceRange struct {
+	Text    string // Original choice text (e.g., "0 to 3", "16#F#", "\"01\"")
+	Low     int
+	High    int
+	IsRange bool // True if written as "a to b" / "a downto b"
+}
+
+// OverlappingChoice reports two choices of one case/selected statement that
+// cover at least one common value. VHDL requires choices to be mutually
+// exclusive, so this is always an error.
+type OverlappingChoice struct {
+	Kind        string // "case" or "selected"
+	Expression  string // Case expression / selector
+	First       string // Earlier choice text
+	Second      string // Later choice text that overlaps First
+	IsDuplicate bool   // True if both choices cover exactly the same values
+	Line        int
+	InProcess   string
+	InArch      string
+}
+
+// parseChoiceRange converts a single choice into a numeric interval.
+// Handles integer and based literals, bit-string literals ("0101", X"0F"),
+// and ranges between them. Returns false for symbolic or non-static choices.
+func parseChoiceRange(choice string) (ChoiceRange, bool) {
+	// Ranges may be split across lines or padded: "0  to\n 3"
+	text := strings.Join(strings.Fields(choice), " ")
+	cr := ChoiceRange{Text: text}
+	lower := strings.ToLower(text)
+
+	// Check for "downto" first (it contains "to")
+	if idx := strings.Index(lower, " downto "); idx != -1 {
+		high, okHigh := parseChoiceValue(text[:idx])
+		low, okLow := parseChoiceValue(text[idx+8:])
+		if !okHigh || !okLow || low > high {
+			return cr, false
+		}
+		cr.Low, cr.High, cr.IsRange = low, high, true
+		return cr, true
+	}
+	if idx := strings.Index(lower, " to "); idx != -1 {
+		low, okLow := parseChoiceValue(text[:idx])
+		high, okHigh := parseChoiceValue(text[idx+4:])
+		if !okLow || !okHigh || low > high {
+			return cr, false
+		}
+		cr.Low, cr.High, cr.IsRange = low, high, true
+		return cr, true
+	}
+
+	val, ok := parseChoiceValue(text)
+	if !ok {
+		return cr, false
+	}
+	cr.Low, cr.High = val, val
+	return cr, true
+}
+
+// parseChoiceValue parses a static choice literal into an integer.
+func parseChoiceValue(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, false
+	}
+
+	// Bit-string literals: "0101", B"0101", X"0F", O"17"
+	if strings.HasSuffix(s, "\"") {
+		base := 2
+		body := s
+		if len(s) >= 3 && s[1] == '"' {
+			switch strings.ToUpper(s[:1]) {
+			case "B":
+				base = 2
+			case "X":
+				base = 16
+			case "O":
+				base = 8
+			default:
+				return 0, false
+			}
+			body = s[1:]
+		}
+		body = strings.ReplaceAll(strings.Trim(body, "\""), "_", "")
+		if body == "" {
+			return 0, false
+		}
+		val, err := strconv.ParseInt(body, base, 64)
+		if err != nil {
+			return 0, false
+		}
+		return int(val), true
+	}
+
+	if strings.Contains(s, "#") {
+		val, err := parseBasedLiteral(s)
+		if err != nil {
+			return 0, false
+		}
+		return val, true
+	}
+
+	val, err := strconv.Atoi(strings.ReplaceAll(s, "_", ""))
+	if err != nil {
+		return 0, false
+	}
+	return val, true
+}
+
+// choiceOverlap is a pair of choices from one statement that cover a common value.
+type choiceOverlap struct {
+	first, second ChoiceRange
+	duplicate     bool
+}
+
+// findOverlappingChoices compares every pair of choices in one statement.
+// Numeric choices are compared as intervals (adjacent ranges like 0 to 3 and
+// 4 to 7 do not overlap); symbolic choices are only flagged when repeated.
+func findOverlappingChoices(choices []string) []choiceOverlap {
+	var parsed []ChoiceRange
+	var numeric []bool
+	for _, choice := range choices {
+		for _, alt := range strings.Split(choice, "|") {
+			alt = strings.TrimSpace(alt)
+			if alt == "" || strings.EqualFold(alt, "others") {
+				continue
+			}
+			cr, ok := parseChoiceRange(alt)
+			parsed = append(parsed, cr)
+			numeric = append(numeric, ok)
+		}
+	}
+
+	var overlaps []choiceOverlap
+	for i := 0; i < len(parsed); i++ {
+		for j := i + 1; j < len(parsed); j++ {
+			a, b := parsed[i], parsed[j]
+			switch {
+			case numeric[i] && numeric[j]:
+				if a.Low <= b.High && b.Low <= a.High {
+					overlaps = append(overlaps, choiceOverlap{
+						first:     a,
+						second:    b,
+						duplicate: a.Low == b.Low && a.High == b.High,
+					})
+				}
+			case !numeric[i] && !numeric[j]:
+				if strings.EqualFold(a.Text, b.Text) {
+					overlaps = append(overlaps, choiceOverlap{first: a, second: b, duplicate: true})
+				}
+			}
+		}
+	}
+	return overlaps
+}
+
+// DetectOverlappingChoices finds duplicate or overlapping choices within each
+// case statement and selected signal assignment of a file.
+func DetectOverlappingChoices(facts *FileFacts) []OverlappingChoice {
+	var overlaps []OverlappingChoice
+	for _, cs := range facts.CaseStatements {
+		for _, o := range findOverlappingChoices(cs.Choices) {
+			overlaps = append(overlaps, OverlappingChoice{
+				Kind:        "case",
+				Expression:  strings.TrimSpace(cs.Expression),
+				First:       o.first.Text,
+				Second:      o.second.Text,
+				IsDuplicate: o.duplicate,
+				Line:        cs.Line,
+				InProcess:   cs.InProcess,
+				InArch:      cs.InArch,
+			})
+		}
+	}
+	for _, ca := range facts.ConcurrentAssignments {
+		if ca.Kind != "selected" {
+			continue
+		}
+		for _, o := range findOverlappingChoices(ca.Choices) {
+			overlaps = append(overlaps, OverlappingChoice{
+				Kind:        "selected",
+				Expression:  ca.Selector,
+				First:       o.first.Text,
+				Second:      o.second.Text,
+				IsDuplicate: o.duplicate,
+				Line:        ca.Line,
+				InArch:      ca.InArch,
+			})
+		}
+	}
+	return overlaps
+}
+
+// IfChain is an if/elsif chain whose every condition compares the same
+// signal for equality with one value, e.g.
+// if sel = "00" then ... elsif sel = "01" then ...
+type IfChain struct {
+	Subject   string
+	Values    []string // Compared values in branch order
+	Line      int
+	InProcess string
+	InArch    string
+}
+
+// CaseChoiceConflict reports an if/elsif chain whose structure contradicts
+// its intent. Kind "unreachable": a later branch compares against a value an
+// earlier branch already took, so it can never be taken. Kind "parallel":
+// every branch compares against a distinct constant, so the branches are
+// mutually exclusive and the priority encoder the chain synthesizes is
+// unneeded; a case statement states the parallel intent.
+type CaseChoiceConflict struct {
+	Kind       string   // "unreachable" or "parallel"
+	Expression string   // Compared signal
+	Choices    []string // Compared values in branch order
+	First      string   // unreachable: the earlier value
+	Second     string   // unreachable: the repeated value
+	Line       int
+	InProcess  string
+	InArch     string
+}
+
+// ifChainConditionRe matches an equality test of a name against a value,
+// optionally parenthesized: "sel = "01"", "(state = IDLE)".
+var ifChainConditionRe = regexp.MustCompile(`^\(?\s*([A-Za-z][\w.]*)\s*=\s*([^=<>/()\s]+)\s*\)?$`)
+
+// charLiteralRe matches a character literal such as '1' or 'Z'.
+var charLiteralRe = regexp.MustCompile(`^'.'$`)
+
+// parallelChainMin is the number of branches from which a chain of distinct
+// constant comparisons is reported as parallel.
+const parallelChainMin = 3
+
+// extractIfChains records every if statement under node whose conditions
+// all compare one signal for equality (see IfChain). Chains with a single
+// condition are ignored.
+func (e *Extractor) extractIfChains(node *sitter.Node, source []byte, archContext, processLabel string, facts *FileFacts) {
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		if n.Type() == "if_statement" {
+			if chain, ok := ifChainOf(n, source); ok {
+				chain.InProcess = processLabel
+				chain.InArch = archContext
+				facts.IfChains = append(facts.IfChains, chain)
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(node)
+}
+
+// ifChainOf parses the conditions of an if statement into an IfChain.
+func ifChainOf(n *sitter.Node, source []byte) (IfChain, bool) {
+	chain := IfChain{Line: int(n.StartPoint().Row) + 1}
+	for _, branch := range ifBranches(n, source) {
+		if branch.label == "else" {
+			continue
+		}
+		cond := strings.TrimPrefix(strings.TrimPrefix(branch.label, "elsif "), "if ")
+		m := ifChainConditionRe.FindStringSubmatch(cond)
+		if m == nil {
+			return IfChain{}, false
+		}
+		if chain.Subject == "" {
+			chain.Subject = m[1]
+		} else if !strings.EqualFold(chain.Subject, m[1]) {
+			return IfChain{}, false
+		}
+		chain.Values = append(chain.Values, m[2])
+	}
+	return chain, len(chain.Values) >= 2
+}
+
+// DetectCaseChoiceConflicts reports if/elsif chains with an unreachable
+// branch, and chains of at least parallelChainMin branches comparing against
+// distinct constants (numeric, based, and bit-string literals compared by
+// value, character literals, and the file's enumeration literals). Values
+// are compared like case choices, so 15 and 16#F# are the same value.
+func DetectCaseChoiceConflicts(facts *FileFacts) []CaseChoiceConflict {
+	enums := make(map[string]bool, len(facts.EnumLiterals))
+	for _, lit := range facts.EnumLiterals {
+		enums[strings.ToLower(lit)] = true
+	}
+
+	var conflicts []CaseChoiceConflict
+	for _, chain := range facts.IfChains {
+		conflict := CaseChoiceConflict{
+			Expression: chain.Subject,
+			Choices:    chain.Values,
+			Line:       chain.Line,
+			InProcess:  chain.InProcess,
+			InArch:     chain.InArch,
+		}
+		overlaps := findOverlappingChoices(chain.Values)
+		for _, o := range overlaps {
+			c := conflict
+			c.Kind, c.First, c.Second = "unreachable", o.first.Text, o.second.Text
+			conflicts = append(conflicts, c)
+		}
+		if len(overlaps) > 0 || len(chain.Values) < parallelChainMin {
+			continue
+		}
+		constant := true
+		for _, v := range chain.Values {
+			if _, ok := parseChoiceValue(v); !ok && !charLiteralRe.MatchString(v) && !enums[strings.ToLower(v)] {
+				constant = false
+				break
+			}
+		}
+		if constant {
+			conflict.Kind = "parallel"
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	return conflicts
+}
@@ -0,0 +1,119 @@
+package extractor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDetectOverlappingChoices(t *testing.T) {
+	tests := []struct {
+		name      string
+		choices   []string
+		want      int
+		duplicate bool
+	}{
+		{"overlapping ranges", []string{"0 to 3", "2 to 5", "others"}, 1, false},
+		{"adjacent ranges", []string{"0 to 3", "4 to 7", "others"}, 0, false},
+		{"value inside downto range", []string{"7 downto 4", "5"}, 1, false},
+		{"based literal duplicate", []string{"15", "16#F#"}, 1, true},
+		{"bit string duplicate", []string{"\"01\"", "X\"1\""}, 1, true},
+		{"alternatives in one choice", []string{"1 | 2", "3 | 2"}, 1, true},
+		{"symbolic duplicate", []string{"IDLE", "RUN", "idle"}, 1, true},
+		{"distinct symbolic", []string{"IDLE", "RUN"}, 0, false},
+		{"ranges with extra whitespace", []string{"0  to   3", "2 to\n\t5"}, 1, false},
+		{"downto range across lines", []string{"7\n  downto 4", "4"}, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			facts := FileFacts{
+				CaseStatements: []CaseStatement{{
+					Expression: "sel",
+					Choices:    tt.choices,
+					Line:       10,
+					InProcess:  "p",
+					InArch:     "rtl",
+				}},
+			}
+			got := DetectOverlappingChoices(&facts)
+			if len(got) != tt.want {
+				t.Fatalf("expected %d overlaps for %v, got %+v", tt.want, tt.choices, got)
+			}
+			if tt.want > 0 && got[0].IsDuplicate != tt.duplicate {
+				t.Fatalf("expected IsDuplicate=%v, got %+v", tt.duplicate, got[0])
+			}
+		})
+	}
+}
+
+func TestDetectOverlappingChoicesSelected(t *testing.T) {
+	facts := FileFacts{
+		ConcurrentAssignments: []ConcurrentAssignment{
+			{Target: "y", Kind: "selected", Selector: "sel", Choices: []string{"0 to 3", "3", "4 to 7"}, HasOthers: true, Line: 12, InArch: "rtl"},
+			{Target: "z", Kind: "selected", Selector: "sel", Choices: []string{"0 to 3", "4 to 7"}, Line: 14, InArch: "rtl"},
+			{Target: "w", Kind: "simple", Line: 16, InArch: "rtl"},
+		},
+	}
+	got := DetectOverlappingChoices(&facts)
+	want := []OverlappingChoice{{
+		Kind:       "selected",
+		Expression: "sel",
+		First:      "0 to 3",
+		Second:     "3",
+		Line:       12,
+		InArch:     "rtl",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestDetectCaseChoiceConflicts(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		kinds  []string
+	}{
+		{"repeated literal", []string{`"00"`, `"01"`, `"00"`}, []string{"unreachable"}},
+		{"based literal repeats decimal", []string{"15", "16#F#"}, []string{"unreachable"}},
+		{"distinct constants", []string{"0", "1", "2"}, []string{"parallel"}},
+		{"distinct enum literals", []string{"IDLE", "RUN", "DONE"}, []string{"parallel"}},
+		{"character literals", []string{"'0'", "'1'", "'Z'"}, []string{"parallel"}},
+		{"too short for parallel", []string{"0", "1"}, nil},
+		{"signals are not constants", []string{"a", "b", "c"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			facts := FileFacts{
+				EnumLiterals: []string{"IDLE", "RUN", "DONE"},
+				IfChains:     []IfChain{{Subject: "sel", Values: tt.values, Line: 4, InProcess: "p", InArch: "rtl"}},
+			}
+			got := DetectCaseChoiceConflicts(&facts)
+			if len(got) != len(tt.kinds) {
+				t.Fatalf("expected %v, got %+v", tt.kinds, got)
+			}
+			for i, kind := range tt.kinds {
+				if got[i].Kind != kind || got[i].Line != 4 || got[i].Expression != "sel" {
+					t.Errorf("conflict %d: expected kind %s, got %+v", i, kind, got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestIfChainConditionRe(t *testing.T) {
+	for cond, want := range map[string]bool{
+		`sel = "01"`:          true,
+		`(state = IDLE)`:      true,
+		`cnt = 16#F#`:         true,
+		`a = '1' and b = '0'`: false,
+		`rising_edge(clk)`:    false,
+		`sel /= "01"`:         false,
+		`data = other_sig(3)`: false,
+	} {
+		if got := ifChainConditionRe.MatchString(cond); got != want {
+			t.Errorf("%q: expected match %v, got %v", cond, want, got)
+		}
+	}
+}
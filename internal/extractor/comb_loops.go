@@ -0,0 +1,159 @@
+package extractor
+
+import (
+	"sort"
+	"strings"
+)
+
+// CombinationalLoop is a cycle of combinational signal dependencies within one
+// architecture, e.g. a <= b; b <= a. Dependencies through a register
+// (IsSequential) break the cycle and are not part of the graph.
+type CombinationalLoop struct {
+	Signals []string // Cycle path, each signal feeding the next; the last feeds the first
+	Lines   []int    // Line of each dependency along the path
+	InArch  string
+}
+
+// DetectCombinationalLoops builds a graph of combinational SignalDeps per
+// architecture and reports one loop per strongly connected component
+// (Tarjan's algorithm). A component with several cycles reports the shortest
+// cycle through its first signal.
+func DetectCombinationalLoops(facts *FileFacts) []CombinationalLoop {
+	type edge struct {
+		to   string
+		line int
+	}
+	graphs := make(map[string]map[string][]edge) // arch -> source -> edges
+	names := make(map[string]string)             // lowercase -> as written
+	archNames := make(map[string]string)         // lowercase -> as written
+	var archs []string
+	for _, dep := range facts.SignalDeps {
+		if dep.IsSequential || dep.Source == "" || dep.Target == "" {
+			continue
+		}
+		arch := strings.ToLower(archBase(dep.InArch))
+		if graphs[arch] == nil {
+			graphs[arch] = make(map[string][]edge)
+			archNames[arch] = archBase(dep.InArch)
+			archs = append(archs, arch)
+		}
+		src, dst := strings.ToLower(dep.Source), strings.ToLower(dep.Target)
+		if _, ok := names[src]; !ok {
+			names[src] = dep.Source
+		}
+		if _, ok := names[dst]; !ok {
+			names[dst] = dep.Target
+		}
+		graphs[arch][src] = append(graphs[arch][src], edge{to: dst, line: dep.Line})
+	}
+	sort.Strings(archs)
+
+	var loops []CombinationalLoop
+	for _, arch := range archs {
+		graph := graphs[arch]
+		nodes := make([]string, 0, len(graph))
+		for n := range graph {
+			nodes = append(nodes, n)
+		}
+		sort.Strings(nodes)
+
+		// Tarjan's strongly connected components
+		index := make(map[string]int)
+		lowlink := make(map[string]int)
+		onStack := make(map[string]bool)
+		var stack []string
+		var sccs [][]string
+		next := 0
+		var strongConnect func(v string)
+		strongConnect = func(v string) {
+			index[v] = next
+			lowlink[v] = next
+			next++
+			stack = append(stack, v)
+			onStack[v] = true
+			for _, e := range graph[v] {
+				if _, seen := index[e.to]; !seen {
+					strongConnect(e.to)
+					lowlink[v] = min(lowlink[v], lowlink[e.to])
+				} else if onStack[e.to] {
+					lowlink[v] = min(lowlink[v], index[e.to])
+				}
+			}
+			if lowlink[v] != index[v] {
+				return
+			}
+			var scc []string
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				scc = append(scc, w)
+				if w == v {
+					break
+				}
+			}
+			sccs = append(sccs, scc)
+		}
+		for _, n := range nodes {
+			if _, seen := index[n]; !seen {
+				strongConnect(n)
+			}
+		}
+
+		for _, scc := range sccs {
+			members := make(map[string]bool, len(scc))
+			for _, n := range scc {
+				members[n] = true
+			}
+			sort.Strings(scc)
+			start := scc[0]
+
+			// Breadth-first search for the shortest path back to start
+			type step struct {
+				prev string
+				line int
+			}
+			visited := map[string]step{}
+			queue := []string{start}
+			closed := false
+			for len(queue) > 0 && !closed {
+				v := queue[0]
+				queue = queue[1:]
+				for _, e := range graph[v] {
+					if e.to == start {
+						visited[start] = step{prev: v, line: e.line}
+						closed = true
+						break
+					}
+					if _, ok := visited[e.to]; ok || !members[e.to] {
+						continue
+					}
+					visited[e.to] = step{prev: v, line: e.line}
+					queue = append(queue, e.to)
+				}
+			}
+			if !closed {
+				continue // Single signal without a self-dependency
+			}
+
+			// Walk predecessors back from start to recover the cycle
+			var path []string
+			var lines []int
+			for v := start; ; {
+				s := visited[v]
+				path = append(path, names[s.prev])
+				lines = append(lines, s.line)
+				v = s.prev
+				if v == start {
+					break
+				}
+			}
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+				lines[i], lines[j] = lines[j], lines[i]
+			}
+			loops = append(loops, CombinationalLoop{Signals: path, Lines: lines, InArch: archNames[arch]})
+		}
+	}
+	return loops
+}
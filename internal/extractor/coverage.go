@@ -0,0 +1,113 @@
+package extractor
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// ParseCoverage measures how much of a file the grammar failed to parse.
+// Grammar maintainers use it to find constructs that end up as ERROR nodes.
+type ParseCoverage struct {
+	TotalBytes     int // Size of the source
+	ErrorBytes     int // Bytes covered by ERROR nodes (outermost only)
+	ErrorNodes     int // Number of outermost ERROR nodes
+	MissingNodes   int // Tokens the parser inserted to recover (zero width)
+	FirstErrorLine int // Line of the first ERROR or MISSING node, 0 if none
+}
+
+// ErrorRatio returns the fraction of source bytes covered by ERROR nodes.
+func (c ParseCoverage) ErrorRatio() float64 {
+	if c.TotalBytes == 0 {
+		return 0
+	}
+	return float64(c.ErrorBytes) / float64(c.TotalBytes)
+}
+
+// collectErrorNodes returns the outermost ERROR nodes and all MISSING nodes
+// below root, in source order. Subtrees without errors are skipped, and ERROR
+// nodes are not descended into so nested errors are not counted twice.
+func collectErrorNodes(root *sitter.Node) []*sitter.Node {
+	var nodes []*sitter.Node
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		if n.IsError() || n.IsMissing() {
+			nodes = append(nodes, n)
+			return
+		}
+		if !n.HasError() {
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return nodes
+}
+
+// measureParseCoverage sums the byte spans of the error nodes under root.
+func measureParseCoverage(root *sitter.Node, source []byte) ParseCoverage {
+	cov := ParseCoverage{TotalBytes: len(source)}
+	for _, n := range collectErrorNodes(root) {
+		if cov.FirstErrorLine == 0 {
+			cov.FirstErrorLine = int(n.StartPoint().Row) + 1
+		}
+		if n.IsMissing() {
+			cov.MissingNodes++
+			continue
+		}
+		cov.ErrorNodes++
+		cov.ErrorBytes += int(n.EndByte() - n.StartByte())
+	}
+	return cov
+}
+
+// ParseErrorLimitError reports a file whose parse tree held a larger share
+// of ERROR and MISSING nodes than Extractor.MaxParseErrors allows. Its facts are not
+// extracted, since a tree that broken (a non-VHDL file, or an unsupported
+// dialect) yields only garbage facts.
+type ParseErrorLimitError struct {
+	ErrorNodes int     // ERROR and MISSING nodes, nested ones included
+	TotalNodes int     // All nodes in the tree
+	Limit      float64 // Percentage of error nodes allowed
+}
+
+func (e *ParseErrorLimitError) Error() string {
+	ratio := 0.0
+	if e.TotalNodes > 0 {
+		ratio = float64(e.ErrorNodes) / float64(e.TotalNodes)
+	}
+	return fmt.Sprintf("%d parse error nodes (%.1f%% of %d) exceed --max-parse-errors %g%%; facts not extracted",
+		e.ErrorNodes, ratio*100, e.TotalNodes, e.Limit)
+}
+
+// exceedsParseErrorLimit reports whether errs error nodes out of total are
+// more than limit percent of the tree. Large files that are mostly valid stay
+// under the limit however many errors they hold.
+func exceedsParseErrorLimit(errs, total int, limit float64) bool {
+	return total > 0 && float64(errs)*100 > limit*float64(total)
+}
+
+// countErrorNodes counts every ERROR and MISSING node under root, nested
+// ones included, and every node in the tree.
+func countErrorNodes(root *sitter.Node) (errs, total int) {
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		total++
+		if n.IsError() || n.IsMissing() {
+			errs++
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return errs, total
+}
@@ -0,0 +1,21 @@
+package extractor
+
+import "testing"
+
+func TestExceedsParseErrorLimit(t *testing.T) {
+	cases := []struct {
+		errs, total int
+		limit       float64
+		want        bool
+	}{
+		{errs: 120, total: 400, limit: 20, want: true},     // 30% of a broken file
+		{errs: 300, total: 100000, limit: 20, want: false}, // Many errors in a large, mostly valid file
+		{errs: 20, total: 100, limit: 20, want: false},     // Exactly at the limit
+		{errs: 0, total: 0, limit: 20, want: false},
+	}
+	for _, c := range cases {
+		if got := exceedsParseErrorLimit(c.errs, c.total, c.limit); got != c.want {
+			t.Errorf("exceedsParseErrorLimit(%d, %d, %g) = %v, want %v", c.errs, c.total, c.limit, got, c.want)
+		}
+	}
+}
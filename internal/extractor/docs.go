@@ -0,0 +1,100 @@
+package extractor
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// docComment is the text of a comment on one source line.
+type docComment struct {
+	Text     string
+	Trailing bool // Code precedes the comment on its line
+}
+
+// collectDocComments returns the single-line comments by 1-based line, with
+// the comment markers stripped. Lint directives, synthesis pragmas, and
+// rules made only of punctuation ("-------") carry no documentation and are
+// kept with empty text, so they still join a leading comment block.
+func collectDocComments(root *sitter.Node, source []byte) map[int]docComment {
+	comments := make(map[int]docComment)
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		if t := n.Type(); t == "comment" || t == "block_comment" {
+			if n.StartPoint().Row != n.EndPoint().Row {
+				return
+			}
+			raw := strings.TrimSpace(n.Content(source))
+			text := ""
+			if !suppressionRe.MatchString(raw) && !synthOffPragmaRe.MatchString(raw) {
+				text = strings.TrimSuffix(raw, "*/")
+				text = strings.TrimPrefix(strings.TrimPrefix(text, "/*"), "--")
+				if strings.Trim(text, "-=*#~ \t") == "" {
+					text = ""
+				}
+				text = strings.TrimSpace(text)
+			}
+			comments[int(n.StartPoint().Row)+1] = docComment{
+				Text:     text,
+				Trailing: codeBefore(source, int(n.StartByte())),
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return comments
+}
+
+// codeBefore reports whether anything but whitespace precedes offset on its
+// line.
+func codeBefore(source []byte, offset int) bool {
+	for i := offset - 1; i >= 0 && source[i] != '\n'; i-- {
+		if source[i] != ' ' && source[i] != '\t' && source[i] != '\r' {
+			return true
+		}
+	}
+	return false
+}
+
+// attachDocs sets the Doc of each port and signal from its comments: a
+// trailing comment on the declaration's line, or else the block of
+// whole-line comments directly above it, joined line by line.
+func attachDocs(facts *FileFacts, comments map[int]docComment) {
+	if len(comments) == 0 {
+		return
+	}
+	for i := range facts.Ports {
+		facts.Ports[i].Doc = docFor(comments, facts.Ports[i].Line)
+	}
+	for i := range facts.Signals {
+		facts.Signals[i].Doc = docFor(comments, facts.Signals[i].Line)
+	}
+}
+
+// docFor returns the documentation for a declaration on line.
+func docFor(comments map[int]docComment, line int) string {
+	if c, ok := comments[line]; ok && c.Trailing && c.Text != "" {
+		return c.Text
+	}
+	var block []string
+	for l := line - 1; l > 0; l-- {
+		c, ok := comments[l]
+		if !ok || c.Trailing {
+			break
+		}
+		if c.Text != "" {
+			block = append(block, c.Text)
+		}
+	}
+	// Collected bottom-up
+	for i, j := 0, len(block)-1; i < j; i, j = i+1, j-1 {
+		block[i], block[j] = block[j], block[i]
+	}
+	return strings.Join(block, "\n")
+}
@@ -0,0 +1,64 @@
+package extractor
+
+import "testing"
+
+func TestAttachDocs(t *testing.T) {
+	// 1  -- Sample clock
+	// 2  -- (rising edge)
+	// 3  clk : in std_logic;
+	// 4  rst : in std_logic; -- Active-high reset
+	// 5  d   : in std_logic;
+	// 6  -------------------
+	// 7  -- Registered data
+	// 8  signal q : std_logic;
+	// 9  -- vhdl-lint: disable-next-line
+	// 10 signal r : std_logic;
+	comments := map[int]docComment{
+		1: {Text: "Sample clock"},
+		2: {Text: "(rising edge)"},
+		4: {Text: "Active-high reset", Trailing: true},
+		6: {},
+		7: {Text: "Registered data"},
+		9: {},
+	}
+	facts := FileFacts{
+		Ports: []Port{
+			{Name: "clk", Line: 3},
+			{Name: "rst", Line: 4},
+			{Name: "d", Line: 5},
+		},
+		Signals: []Signal{
+			{Name: "q", Line: 8},
+			{Name: "r", Line: 10},
+		},
+	}
+	attachDocs(&facts, comments)
+
+	want := map[string]string{
+		"clk": "Sample clock\n(rising edge)",
+		"rst": "Active-high reset",
+		"d":   "", // A trailing comment above does not document the next line
+		"q":   "Registered data",
+		"r":   "",
+	}
+	for _, p := range facts.Ports {
+		if p.Doc != want[p.Name] {
+			t.Errorf("port %s: Doc = %q, want %q", p.Name, p.Doc, want[p.Name])
+		}
+	}
+	for _, s := range facts.Signals {
+		if s.Doc != want[s.Name] {
+			t.Errorf("signal %s: Doc = %q, want %q", s.Name, s.Doc, want[s.Name])
+		}
+	}
+}
+
+func TestCodeBefore(t *testing.T) {
+	source := []byte("a : in bit; -- doc\n   -- lead\n")
+	if !codeBefore(source, 12) {
+		t.Errorf("expected code before the trailing comment")
+	}
+	if codeBefore(source, 22) {
+		t.Errorf("expected only whitespace before the leading comment")
+	}
+}
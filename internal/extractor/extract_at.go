@@ -0,0 +1,201 @@
+package extractor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// ConstructInfo describes the innermost named construct at a source position.
+// Exactly one of the fact pointers is set, matching Kind.
+type ConstructInfo struct {
+	Kind    string // "entity", "architecture", "process", "signal", "instance"
+	Name    string
+	Line    int // 1-based start line of the construct
+	EndLine int // 1-based end line of the construct
+	InArch  string
+
+	Entity       *Entity
+	Architecture *Architecture
+	Process      *Process
+	Signal       *Signal
+	Instance     *Instance
+}
+
+// constructKinds maps grammar node types to the construct kinds ExtractAt reports.
+var constructKinds = map[string]string{
+	"entity_declaration":      "entity",
+	"architecture_body":       "architecture",
+	"process_statement":       "process",
+	"signal_declaration":      "signal",
+	"component_instantiation": "instance",
+}
+
+// ExtractAt parses a VHDL file and returns the innermost entity, architecture,
+// process, signal, or instance containing the given position. line and col are
+// 1-based, as reported by editors. Only the matched construct is extracted, so
+// this is cheap enough for hover/definition requests.
+func (e *Extractor) ExtractAt(filePath string, line, col int) (ConstructInfo, error) {
+	var info ConstructInfo
+	if line < 1 || col < 1 {
+		return info, fmt.Errorf("invalid position %d:%d", line, col)
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return info, fmt.Errorf("reading file: %w", err)
+	}
+	if e.lang == nil {
+		return info, fmt.Errorf("parsing: no VHDL language loaded")
+	}
+
+	parser := sitter.NewParser()
+	parser.SetLanguage(e.lang)
+	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	if err != nil {
+		return info, fmt.Errorf("parsing: %w", err)
+	}
+	defer tree.Close()
+
+	point := sitter.Point{Row: uint32(line - 1), Column: uint32(col - 1)}
+	target := tree.RootNode().NamedDescendantForPointRange(point, point)
+
+	var construct *sitter.Node
+	for n := target; n != nil; n = n.Parent() {
+		if _, ok := constructKinds[n.Type()]; ok {
+			construct = n
+			break
+		}
+	}
+	if construct == nil {
+		return info, fmt.Errorf("no construct at %s:%d:%d", filePath, line, col)
+	}
+
+	info.Kind = constructKinds[construct.Type()]
+	info.Line = int(construct.StartPoint().Row) + 1
+	info.EndLine = int(construct.EndPoint().Row) + 1
+	info.InArch = enclosingArchName(construct, content)
+
+	switch info.Kind {
+	case "entity":
+		entity := e.extractEntity(construct, content)
+		info.Name = entity.Name
+		info.Entity = &entity
+	case "architecture":
+		arch := e.extractArchitecture(construct, content)
+		info.Name = arch.Name
+		info.InArch = arch.Name
+		info.Architecture = &arch
+	case "process":
+		proc := e.extractProcess(construct, content, info.InArch, e.declaredSignalsInScope(construct, content))
+		info.Name = proc.Label
+		info.Process = &proc
+	case "signal":
+		signals := e.extractSignals(construct, content, info.InArch)
+		if len(signals) == 0 {
+			return info, fmt.Errorf("no signal at %s:%d:%d", filePath, line, col)
+		}
+		sig := signals[0]
+		// A declaration can name several signals; prefer the one under the cursor.
+		if target != nil && target.Type() == "identifier" {
+			for _, s := range signals {
+				if strings.EqualFold(s.Name, target.Content(content)) {
+					sig = s
+					break
+				}
+			}
+		}
+		info.Name = sig.Name
+		info.Signal = &sig
+	case "instance":
+		inst := e.extractInstance(construct, content, info.InArch)
+		info.Name = inst.Name
+		info.Instance = &inst
+	}
+
+	return info, nil
+}
+
+// enclosingArchName returns the name of the architecture containing node, or ""
+func enclosingArchName(node *sitter.Node, source []byte) string {
+	for n := node; n != nil; n = n.Parent() {
+		if n.Type() == "architecture_body" {
+			if nameNode := n.ChildByFieldName("name"); nameNode != nil {
+				return nameNode.Content(source)
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+// declaredSignalsInScope collects signal and port names visible in the
+// architecture enclosing node, so process read/write analysis can tell signals
+// from function calls without walking the whole file.
+func (e *Extractor) declaredSignalsInScope(node *sitter.Node, source []byte) map[string]bool {
+	declared := make(map[string]bool)
+	var arch *sitter.Node
+	for n := node; n != nil; n = n.Parent() {
+		if n.Type() == "architecture_body" {
+			arch = n
+			break
+		}
+	}
+	if arch == nil {
+		return declared
+	}
+
+	// Ports of the architecture's entity, when it lives in the same file
+	if entityNode := arch.ChildByFieldName("entity"); entityNode != nil {
+		entityName := entityNode.Content(source)
+		root := arch
+		for root.Parent() != nil {
+			root = root.Parent()
+		}
+		var scratch FileFacts
+		for i := 0; i < int(root.NamedChildCount()); i++ {
+			e.collectEntityPorts(root.NamedChild(i), source, entityName, &scratch, declared)
+		}
+	}
+
+	for i := 0; i < int(arch.NamedChildCount()); i++ {
+		e.collectSignalDecls(arch.NamedChild(i), source, declared)
+	}
+	return declared
+}
+
+func (e *Extractor) collectEntityPorts(node *sitter.Node, source []byte, entityName string, scratch *FileFacts, declared map[string]bool) {
+	if node == nil {
+		return
+	}
+	if node.Type() == "entity_declaration" {
+		if nameNode := node.ChildByFieldName("name"); nameNode != nil && strings.EqualFold(nameNode.Content(source), entityName) {
+			e.extractPortsFromEntity(node, source, entityName, scratch, declared)
+		}
+		return
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		e.collectEntityPorts(node.NamedChild(i), source, entityName, scratch, declared)
+	}
+}
+
+func (e *Extractor) collectSignalDecls(node *sitter.Node, source []byte, declared map[string]bool) {
+	if node == nil {
+		return
+	}
+	switch node.Type() {
+	case "signal_declaration":
+		for _, sig := range e.extractSignals(node, source, "") {
+			addDeclaredSignalName(declared, sig.Name)
+		}
+		return
+	case "process_statement", "component_instantiation":
+		return
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		e.collectSignalDecls(node.NamedChild(i), source, declared)
+	}
+}
@@ -42,8 +42,10 @@ package extractor
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -54,11 +56,16 @@ import (
 // Extractor uses Tree-sitter to parse VHDL files and extract facts
 type Extractor struct {
 	lang *sitter.Language
+	// MaxParseErrors skips fact extraction for files in which ERROR and
+	// MISSING nodes are more than this percentage of all parse tree nodes,
+	// returning a *ParseErrorLimitError instead (0 = no limit)
+	MaxParseErrors float64
 }
 
 // FileFacts contains all extracted information from a single VHDL file
 type FileFacts struct {
 	File           string
+	ParseCoverage  ParseCoverage // Share of the source the grammar could not parse
 	Entities       []Entity
 	Architectures  []Architecture
 	Packages       []Package
@@ -66,21 +73,26 @@ type FileFacts struct {
 	UseClauses     []UseClause
 	LibraryClauses []LibraryClause
 	ContextClauses []ContextClause
+	ContextDecls   []ContextDeclaration // VHDL-2008 context declarations
 	Dependencies   []Dependency
 	Signals        []Signal
 	Ports          []Port
 	Processes      []Process
 	Instances      []Instance          // Component/entity instantiations
 	CaseStatements []CaseStatement     // Case statements for latch detection
+	IfChains       []IfChain           // if/elsif chains comparing one signal for equality
+	Loops          []LoopStatement     // For loops inside processes (bound analysis)
 	Generates      []GenerateStatement // Generate statements (for-generate, if-generate, case-generate)
+	Blocks         []BlockStatement    // Block statements, nested blocks included
 	// Type system
-	Types          []TypeDeclaration            // Type declarations (enum, record, array, etc.)
-	Subtypes       []SubtypeDeclaration         // Subtype declarations
-	Functions      []FunctionDeclaration        // Function declarations/bodies
-	Procedures     []ProcedureDeclaration       // Procedure declarations/bodies
-	ConstantDecls  []ConstantDeclaration        // Constant declarations with full info
-	Configurations []ConfigurationDeclaration   // Configuration declarations
-	Disconnections []DisconnectionSpecification // Disconnection specifications
+	Types               []TypeDeclaration            // Type declarations (enum, record, array, etc.)
+	Subtypes            []SubtypeDeclaration         // Subtype declarations
+	Functions           []FunctionDeclaration        // Function declarations/bodies
+	Procedures          []ProcedureDeclaration       // Procedure declarations/bodies
+	ConstantDecls       []ConstantDeclaration        // Constant declarations with full info
+	SharedVariableDecls []SharedVariableDeclaration  // Shared variable declarations with their type
+	Configurations      []ConfigurationDeclaration   // Configuration declarations
+	Disconnections      []DisconnectionSpecification // Disconnection specifications
 	// Type system information (for filtering false positives) - LEGACY, use Types/ConstantDecls instead
 	EnumLiterals    []string // Enum literals from type declarations (e.g., S_IDLE, S_RUN)
 	Constants       []string // Constants from constant declarations (names only)
@@ -93,9 +105,49 @@ type FileFacts struct {
 	ResetInfos   []ResetInfo
 	// Advanced analysis for security/power/correctness
 	Comparisons   []Comparison   // Comparisons for trojan detection
-	ArithmeticOps []ArithmeticOp // Expensive operations for power analysis
+	ArithmeticOps []ArithmeticOp // Arithmetic in processes (power analysis, operand types)
 	SignalDeps    []SignalDep    // Signal dependencies for loop detection
 	CDCCrossings  []CDCCrossing  // Clock domain crossing detection
+	// Numeric literals on assignment right-hand sides (for magic number detection)
+	AssignmentLiterals []AssignmentLiteral
+	// Names sliced with an explicit to/downto range (for slice direction checks)
+	SliceAccesses []SliceAccess
+	// Whether each signal assignment drives only 'Z' (for permanent high-Z checks)
+	AssignmentDrives []AssignmentDrive
+	// Assignments that drive 'Z' (tri-state buffers)
+	TristateAssignments []TristateAssignment
+	// Assert and report statements (concurrent and sequential)
+	Assertions []Assertion
+	// Alias declarations (reads/writes through them are resolved to the base signal)
+	Aliases []AliasDeclaration
+	// Attribute declarations and specifications (synthesis directives like keep)
+	AttributeSpecs []AttributeSpec
+	// Inline "-- vhdl-lint: disable" directives
+	Suppressions []Suppression
+	// Analysis findings
+	OverlappingChoices         []OverlappingChoice         // Duplicate/overlapping case choices
+	CaseChoiceConflicts        []CaseChoiceConflict        // Unreachable or parallel if/elsif chains
+	ConstantsInSensitivityList []ConstantInSensitivityList // Constants/generics named in sensitivity lists
+	ClockLevelConditions       []ClockLevelCondition       // Concurrent assignments gated on a clock level
+	NoEffectProcesses          []NoEffectProcess           // Processes with no signal writes, calls, or assertions
+	CombinationalMultiDrivers  []CombinationalMultiDriver  // Signals written by several combinational processes
+	PermanentHighZs            []PermanentHighZ            // Signals only ever assigned 'Z'
+	CombinationalLoops         []CombinationalLoop         // Cycles of combinational signal dependencies
+	MultiDrivers               []MultiDriver               // Unresolved signals with several drivers
+	UnusedSignals              []UnusedSignal              // Signals never read (write-only or dead)
+	ClockMisuses               []ClockMisuse               // Clocks read as data or driven combinationally
+	ResetCrossings             []ResetCrossing             // Async resets and the clock domains they reach
+	ConditionalLatches         []LatchInference            // Signals left unassigned on some branch of a combinational if/case
+	ReadsBeforeWrite           []ReadBeforeWrite           // Combinational reads above the signal's only assignment
+	FSMIssues                  []FSMIssue                  // Enum states never assigned (unreachable) or never decoded (dead)
+	GatedClocks                []GatedClock                // Clocks driven by an assignment instead of an input port
+	Feedthroughs               []Feedthrough               // Output ports assigned straight from an input port
+	ResetDefaultMismatches     []ResetDefaultMismatch      // Reset values that differ from the declared initial value
+	MixedSensitivityIssues     []MixedSensitivity          // Clocked processes sensitive to data or missing their clock
+	SensitivityListSmells      []SensitivityListSmell      // Sensitivity lists repeating an entry or naming the process's own outputs
+	FSMEncodings               []FSMEncoding               // Encoding attributes applied to enumeration types
+	StandardShadowings         []StandardShadowing         // Package members named like standard types or functions
+	UninitializedReads         []UninitRead                // Signals without a default read combinationally before any assignment
 	// Verification contract
 	VerificationBlocks    []VerificationBlock
 	VerificationTags      []VerificationTag
@@ -114,6 +166,7 @@ type ClockDomain struct {
 // SignalUsage tracks where a signal is read or written
 type SignalUsage struct {
 	Signal       string
+	FieldPath    string // Record field accessed ("trap.cause"); empty when the whole signal is
 	IsRead       bool   // Appears on RHS of assignment
 	IsWritten    bool   // Appears on LHS of assignment
 	InProcess    string // Which process (empty if concurrent)
@@ -143,13 +196,34 @@ type Process struct {
 	IsSequential    bool     // Has clock edge (rising_edge/falling_edge)
 	IsCombinational bool     // No clock edge and no wait statements
 	HasWait         bool     // Contains wait statements (not combinational)
+	IsStimulus      bool     // Only "wait for" delays: a testbench stimulus generator
 	ClockSignal     string   // Clock signal if sequential
 	ClockEdge       string   // "rising" or "falling"
 	HasReset        bool     // Has reset logic
 	ResetSignal     string   // Reset signal name
 	ResetAsync      bool     // Is reset asynchronous
+	ResetPolarity   string   // "active_high", "active_low", or "" if unknown
 	AssignedSignals []string // Signals assigned in this process
 	ReadSignals     []string // Signals read in this process
+	HasAssertion    bool     // Contains assert or report statements
+	// AssignedPaths and ReadPaths list the record field paths ("trap.cause")
+	// of signals the process only assigns (or reads) field by field; signals
+	// accessed as a whole appear in AssignedSignals/ReadSignals only
+	AssignedPaths []string
+	ReadPaths     []string
+	// PartialAssignments lists signals a combinational process assigns on
+	// some but not all branches of a conditional (latch candidates)
+	PartialAssignments []PartialAssignment
+	// SignalAccesses records, per signal, the first line the process reads
+	// it, the first line it writes it, and how many statements write it
+	SignalAccesses []SignalAccess
+	// AssignsNonLocalVariable is true when a variable not declared in this
+	// process (e.g., a shared variable) is assigned
+	AssignsNonLocalVariable bool
+	// ResetValues lists the signals the reset branch assigns as a whole
+	ResetValues []ResetValue
+	// InSynthOffRegion is true inside a "-- synthesis translate_off" region
+	InSynthOffRegion bool
 	// Additional structured details
 	Variables      []VariableDecl
 	ProcedureCalls []ProcedureCall
@@ -170,6 +244,25 @@ type ConcurrentAssignment struct {
 	Kind          string // "simple", "conditional", "selected"
 	InGenerate    bool   // True if inside a generate block (for multi-driver analysis)
 	GenerateLabel string // Label of the containing generate block
+	// InSynthOffRegion is true inside a "-- synthesis translate_off" region
+	InSynthOffRegion bool
+	// Conditions holds the condition expression after each "when" of a
+	// conditional assignment
+	Conditions []string
+	// Selected assignments: the "with" expression and each waveform with its choices
+	Selector          string
+	SelectedWaveforms []SelectedWaveform
+	// Choices lists every explicit choice of a selected assignment in order,
+	// and HasOthers is true when one waveform is selected "when others"
+	Choices   []string
+	HasOthers bool
+}
+
+// SelectedWaveform is one "waveform when choice | choice" alternative of a
+// selected signal assignment
+type SelectedWaveform struct {
+	Waveform string   // Value expression as written (e.g., "a", "'0' after 1 ns")
+	Choices  []string // Choices as written (e.g., "00", "1 to 3", "others")
 }
 
 // Comparison represents a comparison operation for trojan/trigger detection
@@ -189,7 +282,7 @@ type Comparison struct {
 
 // ArithmeticOp represents an expensive arithmetic operation for power analysis
 type ArithmeticOp struct {
-	Operator    string   // *, /, mod, rem, **
+	Operator    string   // +, -, *, /, mod, rem, **
 	Operands    []string // Input signals/expressions
 	Result      string   // Output signal
 	IsGuarded   bool     // True if inputs are gated by enable
@@ -252,6 +345,36 @@ type GenerateStatement struct {
 	Generates             []GenerateStatement    // Nested generate statements
 }
 
+// BlockStatement represents a block statement (guarded or not). Like a
+// generate, a block is a scope: its signals, processes, and concurrent
+// assignments are attributed to "arch.block_label" and also flattened into
+// FileFacts.
+type BlockStatement struct {
+	Label     string
+	GuardExpr string // Guard condition of a guarded block; empty otherwise
+	Line      int
+	InArch    string // Enclosing architecture (or block) scope
+	// Nested declarations and statements (scoped to this block)
+	Signals               []Signal
+	ConcurrentAssignments []ConcurrentAssignment
+	Processes             []Process
+}
+
+// LoopStatement represents a for loop inside a process. While loops and
+// bare loops are not recorded.
+type LoopStatement struct {
+	LoopVar   string // Loop parameter name
+	RangeLow  string // Range low bound (or the whole range for attribute ranges)
+	RangeHigh string // Range high bound
+	RangeDir  string // "to" or "downto"
+	// Elaboration results (see ElaborateLoops)
+	IterationCount int  // Number of iterations (-1 if cannot evaluate)
+	CanElaborate   bool // True if range was successfully evaluated
+	Line           int
+	InProcess      string // Process label
+	InArch         string
+}
+
 // Entity represents a VHDL entity declaration
 type Entity struct {
 	Name     string
@@ -281,6 +404,10 @@ type Component struct {
 	IsInstance bool
 	Ports      []Port
 	Generics   []GenericDecl
+	// Declaration scope (declarations only): the architecture (with any
+	// generate/block suffix) or package whose declarative part holds it
+	InArch    string
+	InPackage string
 }
 
 // Instance represents a component/entity instantiation with port mapping
@@ -322,6 +449,10 @@ type Signal struct {
 	Type     string
 	Line     int
 	InEntity string // Which entity/arch it belongs to
+	Doc      string // Trailing or leading "--" comment documenting it
+	Default  string // Initial value after ":=" ("" if none)
+	// InSynthOffRegion is true inside a "-- synthesis translate_off" region
+	InSynthOffRegion bool
 }
 
 // Port represents an entity port
@@ -332,6 +463,7 @@ type Port struct {
 	Default   string
 	Line      int
 	InEntity  string // Which entity this port belongs to
+	Doc       string // Trailing or leading "--" comment documenting it
 }
 
 // GenericDecl represents a generic declaration (entity/component)
@@ -364,6 +496,16 @@ type ContextClause struct {
 	Line int
 }
 
+// ContextDeclaration represents a VHDL-2008 context declaration and the
+// clauses it provides to every design unit that references it
+type ContextDeclaration struct {
+	Name        string
+	Libraries   []string // e.g., ["ieee", "work"]
+	UseItems    []string // e.g., ["ieee.std_logic_1164.all"]
+	ContextRefs []string // Nested context references, e.g., ["work.base_ctx"]
+	Line        int
+}
+
 // Association represents a port/generic association element
 type Association struct {
 	Kind          string // "port" or "generic"
@@ -379,9 +521,10 @@ type Association struct {
 
 // VariableDecl represents a process-local variable declaration
 type VariableDecl struct {
-	Name string
-	Type string
-	Line int
+	Name    string
+	Type    string
+	Line    int
+	Default string // Initial value after ":=" ("" if none)
 }
 
 // ProcedureCall represents a procedure call statement
@@ -529,6 +672,15 @@ type ConstantDeclaration struct {
 	InArch    string // Architecture if local constant
 }
 
+// SharedVariableDeclaration is one name of a shared variable declaration
+type SharedVariableDeclaration struct {
+	Name      string
+	Type      string // Type mark as written
+	Line      int
+	InPackage string // Package containing this variable
+	InArch    string // Architecture if declared there
+}
+
 // ConfigurationDeclaration represents a VHDL configuration declaration
 type ConfigurationDeclaration struct {
 	Name       string
@@ -568,14 +720,29 @@ func New() *Extractor {
 // Extract parses a VHDL file and extracts facts
 // Creates a new parser per call for thread safety
 func (e *Extractor) Extract(filePath string) (FileFacts, error) {
-	facts := FileFacts{File: filePath}
-	declaredSignals := make(map[string]bool)
+	return e.ExtractCtx(context.Background(), filePath)
+}
 
-	// Read file
+// ExtractCtx is Extract with a context that cancels the parse.
+func (e *Extractor) ExtractCtx(ctx context.Context, filePath string) (FileFacts, error) {
 	content, err := os.ReadFile(filePath)
 	if err != nil {
-		return facts, fmt.Errorf("reading file: %w", err)
+		return FileFacts{File: filePath}, fmt.Errorf("reading file: %w", err)
 	}
+	return e.ExtractBytesCtx(ctx, filePath, content)
+}
+
+// ExtractBytes extracts facts from content as if it were the file at
+// filePath, which need not exist (e.g. an unsaved editor buffer on stdin).
+func (e *Extractor) ExtractBytes(filePath string, content []byte) (FileFacts, error) {
+	return e.ExtractBytesCtx(context.Background(), filePath, content)
+}
+
+// ExtractBytesCtx is ExtractBytes with a context that cancels the parse. A
+// cancelled parse returns an error wrapping ctx.Err().
+func (e *Extractor) ExtractBytesCtx(ctx context.Context, filePath string, content []byte) (FileFacts, error) {
+	facts := FileFacts{File: filePath}
+	declaredSignals := make(map[string]bool)
 
 	// If no language set, use simple regex-based extraction as fallback
 	if e.lang == nil {
@@ -587,17 +754,76 @@ func (e *Extractor) Extract(filePath string) (FileFacts, error) {
 	parser.SetLanguage(e.lang)
 
 	// Parse with Tree-sitter
-	tree, err := parser.ParseCtx(context.Background(), nil, content)
+	tree, err := parser.ParseCtx(ctx, nil, content)
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return facts, fmt.Errorf("parsing: %w", ctxErr)
+		}
 		return facts, fmt.Errorf("parsing: %w", err)
 	}
 	defer tree.Close()
 
+	if e.MaxParseErrors > 0 {
+		if errs, total := countErrorNodes(tree.RootNode()); exceedsParseErrorLimit(errs, total, e.MaxParseErrors) {
+			return facts, &ParseErrorLimitError{ErrorNodes: errs, TotalNodes: total, Limit: e.MaxParseErrors}
+		}
+	}
+	facts.ParseCoverage = measureParseCoverage(tree.RootNode(), content)
+
 	// Walk the tree and extract facts
 	e.walkTree(tree.RootNode(), content, &facts, "", declaredSignals)
+	// Tag facts inside translate_off/translate_on pragma regions
+	markSynthOffRegions(&facts, collectSynthOffRegions(tree.RootNode(), content))
+	// Attribute reads/writes through aliases to the aliased signal
+	resolveAliasUsages(&facts)
+	// Collect inline lint suppressions
+	facts.Suppressions = collectSuppressions(tree.RootNode(), content)
+	// Document ports and signals with their comments
+	attachDocs(&facts, collectDocComments(tree.RootNode(), content))
 
 	// Detect clock domain crossings
 	facts.CDCCrossings = DetectCDCCrossings(&facts)
+	// Detect overlapping case choices
+	facts.OverlappingChoices = DetectOverlappingChoices(&facts)
+	// Detect unreachable and parallel if/elsif chains
+	facts.CaseChoiceConflicts = DetectCaseChoiceConflicts(&facts)
+	// Detect constants/generics named in sensitivity lists
+	facts.ConstantsInSensitivityList = DetectConstantsInSensitivityLists(&facts)
+	// Detect conditional/selected assignments gated on a clock level
+	facts.ClockLevelConditions = DetectClockLevelConditions(&facts)
+	// Detect processes without observable effects
+	facts.NoEffectProcesses = DetectNoEffectProcesses(&facts)
+	// Detect signals driven by more than one combinational process
+	facts.CombinationalMultiDrivers = DetectCombinationalMultiDrivers(&facts)
+	// Detect signals that are only ever driven to high-impedance
+	facts.PermanentHighZs = DetectPermanentHighZ(&facts)
+	// Detect cycles of combinational signal dependencies
+	facts.CombinationalLoops = DetectCombinationalLoops(&facts)
+	// Detect unresolved signals written by more than one driver
+	facts.MultiDrivers = DetectMultipleDrivers(&facts)
+	// Detect signals that are declared but never read
+	facts.UnusedSignals = DetectUnusedSignals(&facts)
+	// Detect clocks read as data or assigned combinationally
+	facts.ClockMisuses = DetectClockMisuse(&facts)
+	// Detect async resets released into clock domains without a synchronizer
+	facts.ResetCrossings = DetectResetDomainCrossings(&facts)
+	// Detect combinational signals not assigned on every if/case branch
+	facts.ConditionalLatches = DetectConditionalLatches(&facts)
+	// Detect combinational reads that precede the signal's only assignment
+	facts.ReadsBeforeWrite = DetectReadBeforeWrite(&facts)
+	// Correlate enum states against case choices and assignments
+	facts.FSMIssues = AnalyzeStateMachines(&facts)
+	// Detect clocks produced by logic rather than an input port
+	facts.GatedClocks = DetectGatedClocks(&facts)
+	// Detect combinational reads of signals that still hold 'U'
+	facts.UninitializedReads = DetectUninitializedReads(&facts)
+	// Detect output ports wired straight to an input port
+	facts.Feedthroughs = DetectFeedthroughs(&facts)
+	facts.ResetDefaultMismatches = DetectResetDefaultMismatches(&facts)
+	facts.MixedSensitivityIssues = DetectMixedSensitivity(&facts)
+	facts.SensitivityListSmells = DetectSensitivityListSmells(&facts)
+	facts.FSMEncodings = DetectFSMEncodings(&facts)
+	facts.StandardShadowings = e.detectStandardShadowing(&facts)
 	e.extractVerificationTags(content, &facts)
 
 	return facts, nil
@@ -630,11 +856,16 @@ func (e *Extractor) walkTreeWithPkg(node *sitter.Node, source []byte, facts *Fil
 		arch := e.extractArchitecture(node, source)
 		facts.Architectures = append(facts.Architectures, arch)
 		archContext = arch.Name
+		e.extractSliceAccesses(node, source, arch.Name, facts)
 
 	case "package_declaration":
 		pkg := e.extractPackage(node, source)
 		facts.Packages = append(facts.Packages, pkg)
 		pkgContext = pkg.Name
+	case "context_declaration":
+		if ctx := e.extractContextDeclaration(node, source, facts.File); ctx.Name != "" {
+			facts.ContextDecls = append(facts.ContextDecls, ctx)
+		}
 	case "configuration_declaration":
 		cfg := e.extractConfigurationDeclaration(node, source)
 		facts.Configurations = append(facts.Configurations, cfg)
@@ -658,6 +889,15 @@ func (e *Extractor) walkTreeWithPkg(node *sitter.Node, source []byte, facts *Fil
 				})
 			}
 		}
+		// Walk the block body in the block's scope, then record what it declared
+		blk := e.extractBlockStatement(node, source, archContext)
+		mark := markBlockContents(facts)
+		scope := joinScopePath(archContext, blockScopeLabel(&blk))
+		for i := 0; i < int(node.ChildCount()); i++ {
+			e.walkTreeWithPkg(node.Child(i), source, facts, pkgContext, scope, declaredSignals)
+		}
+		e.flattenBlockToFacts(&blk, mark, facts)
+		return
 
 	case "use_clause":
 		dep := e.extractUseClause(node, source, facts.File)
@@ -715,6 +955,10 @@ func (e *Extractor) walkTreeWithPkg(node *sitter.Node, source []byte, facts *Fil
 		if hasPSLChild(node) {
 			e.extractPSLSignalReads(node, source, facts, declaredSignals)
 		}
+		// Sequential assertions are recorded with their process
+		if isConcurrentAssertion(node) {
+			facts.Assertions = append(facts.Assertions, extractAssertion(node, source, archContext, ""))
+		}
 
 	case "component_instantiation":
 		comp := e.extractComponentInst(node, source)
@@ -768,6 +1012,14 @@ func (e *Extractor) walkTreeWithPkg(node *sitter.Node, source []byte, facts *Fil
 			}
 		}
 
+	case "alias_declaration":
+		alias := e.extractAliasDeclaration(node, source, archContext, pkgContext)
+		if alias.Name != "" {
+			facts.Aliases = append(facts.Aliases, alias)
+			// Alias reads like "flag(0)" are names, not function calls
+			addDeclaredSignalName(declaredSignals, alias.Name)
+		}
+
 	case "type_declaration":
 		// Extract full type declaration
 		td := e.extractTypeDeclaration(node, source, pkgContext, archContext)
@@ -805,19 +1057,30 @@ func (e *Extractor) walkTreeWithPkg(node *sitter.Node, source []byte, facts *Fil
 		// Also extract names for legacy filtering
 		constNames := e.extractConstantNames(node, source)
 		facts.Constants = append(facts.Constants, constNames...)
+	case "attribute_declaration":
+		if spec := e.extractAttributeDeclaration(node, source, archContext); spec.Attribute != "" {
+			facts.AttributeSpecs = append(facts.AttributeSpecs, spec)
+		}
+	case "attribute_specification":
+		facts.AttributeSpecs = append(facts.AttributeSpecs, e.extractAttributeSpecifications(node, source, archContext)...)
 	case "shared_variable_declaration":
-		sharedVars := e.extractSharedVariableNames(node, source)
-		if len(sharedVars) > 0 {
-			facts.SharedVariables = append(facts.SharedVariables, sharedVars...)
-			for _, name := range sharedVars {
-				addDeclaredSignalName(declaredSignals, name)
-			}
+		sharedDecls := e.extractSharedVariableDeclarations(node, source, pkgContext, archContext)
+		facts.SharedVariableDecls = append(facts.SharedVariableDecls, sharedDecls...)
+		// Also keep names for legacy filtering
+		for _, decl := range sharedDecls {
+			facts.SharedVariables = append(facts.SharedVariables, decl.Name)
+			addDeclaredSignalName(declaredSignals, decl.Name)
 		}
 
 	case "component_declaration":
 		comp := e.extractComponentDecl(node, source)
 		comp.Generics = e.extractGenericDeclsFromNode(node, source, "", comp.Name)
 		comp.Ports = e.extractPortsFromComponent(node, source, comp.Name)
+		if pkgContext != "" {
+			comp.InPackage = pkgContext
+		} else {
+			comp.InArch = archContext
+		}
 		facts.Components = append(facts.Components, comp)
 
 	case "group_declaration":
@@ -874,16 +1137,30 @@ func (e *Extractor) walkTreeWithPkg(node *sitter.Node, source []byte, facts *Fil
 		// Extract signal dependencies for loop detection
 		deps := e.extractSignalDepsFromConcurrent(node, source, archContext)
 		facts.SignalDeps = append(facts.SignalDeps, deps...)
+		// Extract assignment literals for magic number detection
+		e.extractAssignmentLiterals(node, source, archContext, "", facts)
+		// Extract assignment drive values for permanent high-Z detection
+		e.extractAssignmentDrives(node, source, archContext, "", facts)
 
 	case "process_statement":
 		proc := e.extractProcess(node, source, archContext, declaredSignals)
 		facts.Processes = append(facts.Processes, proc)
 		// Extract case statements within the process for latch detection
 		e.extractCaseStatementsFromProcess(node, source, archContext, proc.Label, facts)
+		// Extract if/elsif chains comparing one signal for choice conflicts
+		e.extractIfChains(node, source, archContext, proc.Label, facts)
+		// Extract for loops within the process for bound analysis
+		e.extractLoopsFromProcess(node, source, archContext, proc.Label, facts)
 		// Extract comparisons for trojan/trigger detection
 		e.extractComparisonsFromProcess(node, source, archContext, proc.Label, facts)
 		// Extract arithmetic operations for power analysis
 		e.extractArithmeticOpsFromProcess(node, source, archContext, proc.Label, facts)
+		// Extract assignment literals for magic number detection
+		e.extractAssignmentLiterals(node, source, archContext, proc.Label, facts)
+		// Extract assignment drive values for permanent high-Z detection
+		e.extractAssignmentDrives(node, source, archContext, proc.Label, facts)
+		// Extract assert/report statements for self-checking testbench rules
+		e.extractAssertionsFromProcess(node, source, archContext, proc.Label, facts)
 		// Extract signal dependencies for loop detection
 		isSequential := proc.IsSequential || proc.HasWait
 		e.extractSignalDepsFromProcess(node, source, archContext, proc.Label, isSequential, facts)
@@ -902,7 +1179,7 @@ func (e *Extractor) walkTreeWithPkg(node *sitter.Node, source []byte, facts *Fil
 		if proc.HasReset {
 			facts.ResetInfos = append(facts.ResetInfos, ResetInfo{
 				Signal:    proc.ResetSignal,
-				Polarity:  "active_high", // TODO: detect from comparison value
+				Polarity:  proc.ResetPolarity,
 				IsAsync:   proc.ResetAsync,
 				Registers: proc.AssignedSignals,
 				Process:   proc.Label,
@@ -910,22 +1187,28 @@ func (e *Extractor) walkTreeWithPkg(node *sitter.Node, source []byte, facts *Fil
 			})
 		}
 
-		// Add signal usages
+		// Add signal usages, one per field of records accessed field by field
 		for _, sig := range proc.AssignedSignals {
-			facts.SignalUsages = append(facts.SignalUsages, SignalUsage{
-				Signal:    sig,
-				IsWritten: true,
-				InProcess: proc.Label,
-				Line:      proc.Line,
-			})
+			for _, path := range usagePaths(sig, proc.AssignedPaths) {
+				facts.SignalUsages = append(facts.SignalUsages, SignalUsage{
+					Signal:    sig,
+					FieldPath: path,
+					IsWritten: true,
+					InProcess: proc.Label,
+					Line:      proc.Line,
+				})
+			}
 		}
 		for _, sig := range proc.ReadSignals {
-			facts.SignalUsages = append(facts.SignalUsages, SignalUsage{
-				Signal:    sig,
-				IsRead:    true,
-				InProcess: proc.Label,
-				Line:      proc.Line,
-			})
+			for _, path := range usagePaths(sig, proc.ReadPaths) {
+				facts.SignalUsages = append(facts.SignalUsages, SignalUsage{
+					Signal:    sig,
+					FieldPath: path,
+					IsRead:    true,
+					InProcess: proc.Label,
+					Line:      proc.Line,
+				})
+			}
 		}
 
 	case "generate_statement":
@@ -944,6 +1227,73 @@ func (e *Extractor) walkTreeWithPkg(node *sitter.Node, source []byte, facts *Fil
 	}
 }
 
+// extractBlockStatement extracts a block statement's label and guard
+// condition. Its body is walked by walkTreeWithPkg in the block's scope.
+func (e *Extractor) extractBlockStatement(node *sitter.Node, source []byte, context string) BlockStatement {
+	blk := BlockStatement{
+		Line:   int(node.StartPoint().Row) + 1,
+		InArch: context,
+	}
+	labelNode := node.ChildByFieldName("label")
+	if labelNode == nil {
+		return blk
+	}
+	blk.Label = labelNode.Content(source)
+
+	// The guard is the parenthesized expression right after "label : block"
+	rest := string(source[labelNode.EndByte():node.EndByte()])
+	if loc := blockGuardRe.FindStringIndex(rest); loc != nil {
+		depth := 1
+		for i := loc[1]; i < len(rest); i++ {
+			switch rest[i] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			if depth == 0 {
+				blk.GuardExpr = strings.TrimSpace(rest[loc[1]:i])
+				break
+			}
+		}
+	}
+	return blk
+}
+
+var blockGuardRe = regexp.MustCompile(`(?i)^\s*:\s*block\s*\(`)
+
+// blockContentsMark records how many signals, concurrent assignments, and
+// processes FileFacts held before a block body was walked.
+type blockContentsMark struct {
+	signals, assignments, processes int
+}
+
+func markBlockContents(facts *FileFacts) blockContentsMark {
+	return blockContentsMark{
+		signals:     len(facts.Signals),
+		assignments: len(facts.ConcurrentAssignments),
+		processes:   len(facts.Processes),
+	}
+}
+
+// flattenBlockToFacts records the signals, concurrent assignments, and
+// processes the block body added to facts (already scoped by the walk) on
+// the block itself, and appends the block to facts.Blocks after any blocks
+// nested inside it.
+func (e *Extractor) flattenBlockToFacts(blk *BlockStatement, mark blockContentsMark, facts *FileFacts) {
+	blk.Signals = append([]Signal{}, facts.Signals[mark.signals:]...)
+	blk.ConcurrentAssignments = append([]ConcurrentAssignment{}, facts.ConcurrentAssignments[mark.assignments:]...)
+	blk.Processes = append([]Process{}, facts.Processes[mark.processes:]...)
+	facts.Blocks = append(facts.Blocks, *blk)
+}
+
+func blockScopeLabel(blk *BlockStatement) string {
+	if blk.Label != "" {
+		return blk.Label
+	}
+	return fmt.Sprintf("block@%d", blk.Line)
+}
+
 func generateScopeLabel(gen *GenerateStatement) string {
 	if gen.Label != "" {
 		return gen.Label
@@ -1349,6 +1699,31 @@ func (e *Extractor) extractContextReference(node *sitter.Node, source []byte, fi
 	}
 }
 
+// extractContextDeclaration extracts a context declaration's name and the
+// library, use, and nested context clauses it contains
+func (e *Extractor) extractContextDeclaration(node *sitter.Node, source []byte, file string) ContextDeclaration {
+	ctx := ContextDeclaration{
+		Line: int(node.StartPoint().Row) + 1,
+	}
+	if nameNode := node.ChildByFieldName("name"); nameNode != nil {
+		ctx.Name = nameNode.Content(source)
+	}
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		child := node.NamedChild(i)
+		switch child.Type() {
+		case "library_clause":
+			ctx.Libraries = append(ctx.Libraries, e.extractLibraryClauseItems(child, source)...)
+		case "use_clause":
+			ctx.UseItems = append(ctx.UseItems, e.extractUseClauseItems(child, source)...)
+		case "context_reference":
+			if ref := e.extractContextReference(child, source, file); ref.Target != "" {
+				ctx.ContextRefs = append(ctx.ContextRefs, ref.Target)
+			}
+		}
+	}
+	return ctx
+}
+
 func (e *Extractor) extractPackageInstantiation(node *sitter.Node, source []byte, file string) Dependency {
 	var target string
 	if prefix := node.ChildByFieldName("prefix"); prefix != nil {
@@ -1720,23 +2095,36 @@ func (e *Extractor) extractConcurrentAssignment(node *sitter.Node, source []byte
 		Kind:   "simple",
 	}
 
-	// Determine kind based on content
-	// VHDL selected assignment: "with expr select target <= value when choice, ..."
-	// VHDL conditional assignment: "target <= value when condition else other"
-	content := strings.ToLower(node.Content(source))
-	// Selected assignments must start with "with" keyword (not just contain "select" in a signal name)
-	isSelected := strings.HasPrefix(strings.TrimSpace(content), "with ") && strings.Contains(content, " select ")
-	if strings.Contains(content, " when ") && strings.Contains(content, " else ") && !isSelected {
-		ca.Kind = "conditional"
-	} else if isSelected {
-		ca.Kind = "selected"
-	}
-
 	// Extract target using grammar's field('target', assignment_target) wrapper
 	if sig, ok := e.extractAssignmentTarget(node, source); ok {
 		ca.Target = sig
 	}
 
+	// Selected assignments are recognized structurally: the selector expression
+	// is the only child (besides a statement label) that precedes the target.
+	// VHDL selected assignment: "[label:] with expr select target <= value when choice, ..."
+	// VHDL conditional assignment: "target <= value when condition else other"
+	if selector := selectedAssignmentSelector(node); selector != nil {
+		ca.Kind = "selected"
+		ca.Selector = strings.TrimSpace(selector.Content(source))
+		ca.SelectedWaveforms = selectedWaveforms(node, source)
+		for _, wf := range ca.SelectedWaveforms {
+			for _, choice := range wf.Choices {
+				if strings.EqualFold(choice, "others") {
+					ca.HasOthers = true
+					continue
+				}
+				ca.Choices = append(ca.Choices, strings.Join(strings.Fields(choice), " "))
+			}
+		}
+	} else {
+		content := strings.ToLower(node.Content(source))
+		if strings.Contains(content, " when ") && strings.Contains(content, " else ") {
+			ca.Kind = "conditional"
+			ca.Conditions = expressionsAfterKeyword(node, source, "when")
+		}
+	}
+
 	// Extract reads from the RHS (everything after the assignment target)
 	readSet := make(map[string]bool)
 	e.extractReadsFromNode(node, source, readSet, true, declaredSignals, nil)
@@ -1752,6 +2140,121 @@ func (e *Extractor) extractConcurrentAssignment(node *sitter.Node, source []byte
 	return ca
 }
 
+// selectedAssignmentSelector returns the selector expression of a selected
+// signal assignment, or nil if node is not one. The with/select keywords are
+// hidden tokens, so the selector is identified by position: it is the only
+// child before the target that is not part of a "label :" prefix.
+func selectedAssignmentSelector(node *sitter.Node) *sitter.Node {
+	target := node.ChildByFieldName("target")
+	if target == nil {
+		return nil
+	}
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child.StartByte() >= target.StartByte() {
+			return nil
+		}
+		if child.Type() == ":" {
+			continue
+		}
+		if next := child.NextSibling(); next != nil && next.Type() == ":" {
+			continue
+		}
+		return child
+	}
+	return nil
+}
+
+// selectedWaveforms splits the body of a selected assignment into its
+// "waveform when choices" alternatives. Alternatives are delimited by the
+// visible ',' and ';' tokens that follow a "when" keyword; commas inside a
+// multi-element waveform come before the keyword and stay in the waveform.
+func selectedWaveforms(node *sitter.Node, source []byte) []SelectedWaveform {
+	var waveforms []SelectedWaveform
+	inBody := false
+	inChoices := false
+	var valueStart, choiceStart uint32
+	prevEnd := node.StartByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		gapStart := prevEnd
+		gap := strings.ToLower(string(source[gapStart:child.StartByte()]))
+		prevEnd = child.EndByte()
+
+		if !inBody {
+			if child.Type() == "<=" {
+				inBody = true
+				valueStart = child.EndByte()
+			}
+			continue
+		}
+		if !inChoices {
+			if idx := lastKeywordIndex(gap, "when"); idx != -1 {
+				inChoices = true
+				choiceStart = gapStart + uint32(idx) + uint32(len("when"))
+				waveforms = append(waveforms, SelectedWaveform{
+					Waveform: strings.TrimSpace(string(source[valueStart : gapStart+uint32(idx)])),
+				})
+			}
+		}
+		if inChoices && (child.Type() == "," || child.Type() == ";") {
+			current := &waveforms[len(waveforms)-1]
+			for _, choice := range strings.Split(string(source[choiceStart:child.StartByte()]), "|") {
+				if choice = strings.TrimSpace(choice); choice != "" {
+					current.Choices = append(current.Choices, choice)
+				}
+			}
+			inChoices = false
+			valueStart = child.EndByte()
+		}
+	}
+	return waveforms
+}
+
+// lastKeywordIndex returns the byte offset of the last whole-word occurrence
+// of keyword in lowercase text, or -1.
+func lastKeywordIndex(text, keyword string) int {
+	for end := len(text); end > 0; {
+		idx := strings.LastIndex(text[:end], keyword)
+		if idx == -1 {
+			return -1
+		}
+		before := idx == 0 || !isIdentChar(text[idx-1])
+		after := idx+len(keyword) >= len(text) || !isIdentChar(text[idx+len(keyword)])
+		if before && after {
+			return idx
+		}
+		end = idx
+	}
+	return -1
+}
+
+// expressionsAfterKeyword returns the text of each child expression that
+// directly follows keyword. Keywords such as when/with are hidden tokens in the
+// grammar, so they are recognized from the source text between children.
+func expressionsAfterKeyword(node *sitter.Node, source []byte, keyword string) []string {
+	var exprs []string
+	prevEnd := node.StartByte()
+	afterKeyword := false
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		gap := strings.Fields(strings.ToLower(string(source[prevEnd:child.StartByte()])))
+		prevEnd = child.EndByte()
+		if len(gap) > 0 && gap[len(gap)-1] == keyword {
+			afterKeyword = true
+		}
+		if strings.EqualFold(child.Content(source), keyword) {
+			afterKeyword = true
+			continue
+		}
+		if afterKeyword {
+			exprs = append(exprs, strings.TrimSpace(child.Content(source)))
+			afterKeyword = false
+		}
+	}
+	return exprs
+}
+
 // extractBaseSignal extracts the base signal from selected_name or indexed_name
 // For "rec.field" returns "rec"
 // For "arr(i)" returns "arr"
@@ -1769,7 +2272,29 @@ func (e *Extractor) extractAssignmentTarget(node *sitter.Node, source []byte) (s
 	if targetNode == nil {
 		return "", false
 	}
+	return e.assignmentTargetName(targetNode, source)
+}
+
+// assignmentTargetPath returns the full path of an assignment_target node,
+// keeping record fields ("trap.cause") but dropping indices.
+func (e *Extractor) assignmentTargetPath(targetNode *sitter.Node, source []byte) string {
+	if full := fullPathFromPrefixSuffixNode(targetNode, source); full != "" {
+		return full
+	}
+	for i := 0; i < int(targetNode.ChildCount()); i++ {
+		child := targetNode.Child(i)
+		if child.Type() == "identifier" {
+			return child.Content(source)
+		}
+		if child.Type() == "selected_name" || child.Type() == "indexed_name" {
+			return e.extractFullSignalPath(child, source)
+		}
+	}
+	return ""
+}
 
+// assignmentTargetName returns the base signal of an assignment_target node.
+func (e *Extractor) assignmentTargetName(targetNode *sitter.Node, source []byte) (signal string, ok bool) {
 	// assignment_target wraps: identifier, selected_name, indexed_name, or aggregate
 	for i := 0; i < int(targetNode.ChildCount()); i++ {
 		child := targetNode.Child(i)
@@ -2035,6 +2560,38 @@ func (e *Extractor) extractSharedVariableNames(node *sitter.Node, source []byte)
 	return names
 }
 
+// extractSharedVariableDeclarations extracts shared variable declarations with their type
+// Example: shared variable foo, bar : counter_t; -> returns [{Name: "foo", Type: "counter_t"}, ...]
+func (e *Extractor) extractSharedVariableDeclarations(node *sitter.Node, source []byte, pkgContext, archContext string) []SharedVariableDeclaration {
+	var decls []SharedVariableDeclaration
+	line := int(node.StartPoint().Row) + 1
+	typeStr := ""
+	sawColon := false
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child.Type() == ":" {
+			sawColon = true
+			continue
+		}
+		if child.Type() == ":=" {
+			break
+		}
+		if sawColon && typeStr == "" {
+			typeStr = e.extractTypeName(child, source)
+		}
+	}
+	for _, name := range e.extractSharedVariableNames(node, source) {
+		decls = append(decls, SharedVariableDeclaration{
+			Name:      name,
+			Type:      typeStr,
+			Line:      line,
+			InPackage: pkgContext,
+			InArch:    archContext,
+		})
+	}
+	return decls
+}
+
 // extractConstantDeclarations extracts full constant declarations with type and context
 // Example: constant WIDTH : integer := 8; -> returns [{Name: "WIDTH", Type: "integer", Value: "8"}]
 func (e *Extractor) extractConstantDeclarations(node *sitter.Node, source []byte, pkgContext, archContext string) []ConstantDeclaration {
@@ -2215,6 +2772,7 @@ func (e *Extractor) extractSignals(node *sitter.Node, source []byte, context str
 	if sigType == "" {
 		sigType = typeIdent
 	}
+	defaultValue := declarationDefault(node, source)
 
 	for _, name := range names {
 		signals = append(signals, Signal{
@@ -2222,6 +2780,7 @@ func (e *Extractor) extractSignals(node *sitter.Node, source []byte, context str
 			Type:     sigType,
 			Line:     line,
 			InEntity: context,
+			Default:  defaultValue,
 		})
 	}
 
@@ -2765,15 +3324,83 @@ func (e *Extractor) extractProcess(node *sitter.Node, source []byte, context str
 		// Only mark as combinational if no wait statements
 		// Processes with wait statements shouldn't have sensitivity lists
 		proc.IsCombinational = true
+	} else {
+		proc.IsStimulus = isStimulusProcess(&proc)
+	}
+	if proc.IsCombinational {
+		proc.PartialAssignments = e.partialAssignments(node, source)
 	}
 
 	return proc
 }
 
+// variableAssignmentTarget returns the base name assigned by a variable
+// assignment statement ("[label:] target := expr;").
+func variableAssignmentTarget(node *sitter.Node, source []byte) string {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if child.Type() == ":=" {
+			return ""
+		}
+		if next := child.NextSibling(); child.Type() == "identifier" && next != nil && next.Type() == ":" {
+			continue
+		}
+		if child.Type() == ":" {
+			continue
+		}
+		return extractBaseSignalName(child.Content(source))
+	}
+	return ""
+}
+
 // analyzeProcessSemantics walks the process body to extract semantic information
 func (e *Extractor) analyzeProcessSemantics(node *sitter.Node, source []byte, proc *Process, declaredSignals map[string]bool) {
 	assignedSet := make(map[string]bool)
 	readSet := make(map[string]bool)
+	accesses := make(map[string]*SignalAccess) // Lowercase name -> statement ordering
+
+	access := func(name string) *SignalAccess {
+		key := strings.ToLower(name)
+		if accesses[key] == nil {
+			accesses[key] = &SignalAccess{Signal: name}
+		}
+		return accesses[key]
+	}
+	// Field paths accessed per lowercase base signal; the "" path marks an
+	// access to the whole signal
+	writePaths := make(map[string]map[string]bool)
+	readPaths := make(map[string]map[string]bool)
+	notePath := func(paths map[string]map[string]bool, path string) {
+		base := strings.ToLower(recordBase(path))
+		if base == "" {
+			return
+		}
+		if paths[base] == nil {
+			paths[base] = make(map[string]bool)
+		}
+		if len(path) > len(base) {
+			paths[base][path] = true
+		} else {
+			paths[base][""] = true
+		}
+	}
+	// noteReads merges one statement's reads into readSet, recording line as
+	// the read position of each. fullPaths holds the statement's reads with
+	// record fields kept; without it every read is of the whole signal.
+	noteReads := func(reads map[string]bool, line int, fullPaths map[string]bool) {
+		for sig := range reads {
+			readSet[sig] = true
+			if a := access(sig); a.FirstRead == 0 || line < a.FirstRead {
+				a.FirstRead = line
+			}
+			if fullPaths == nil {
+				notePath(readPaths, sig)
+			}
+		}
+		for path := range fullPaths {
+			notePath(readPaths, path)
+		}
+	}
 
 	// First pass: collect all variable names declared in this process
 	// Variables should not be included in read_signals/assigned_signals since
@@ -2844,17 +3471,38 @@ func (e *Extractor) analyzeProcessSemantics(node *sitter.Node, source []byte, pr
 		case "sequential_signal_assignment":
 			// Extract LHS (assigned signal) using grammar's target field
 			// LHS can be identifier, selected_name (record.field), or indexed_name (arr(i))
+			line := int(n.StartPoint().Row) + 1
+			targetNode := n.ChildByFieldName("target")
 			if sig, ok := e.extractAssignmentTarget(n, source); ok {
 				assignedSet[sig] = true
+				a := access(sig)
+				if a.FirstWrite == 0 || line < a.FirstWrite {
+					a.FirstWrite = line
+				}
+				a.Writes++
+				notePath(writePaths, e.assignmentTargetPath(targetNode, source))
 			}
 			// Walk RHS for reads
-			targetNode := n.ChildByFieldName("target")
-			e.extractReadsFromNodeSkipping(n, source, readSet, false, declaredSignals, variableSet, targetNode)
+			reads := make(map[string]bool)
+			e.extractReadsFromNodeSkipping(n, source, reads, false, declaredSignals, variableSet, targetNode)
+			fullPaths := make(map[string]bool)
+			e.extractReadsWithFullPathsSkipping(n, source, fullPaths, false, targetNode)
+			noteReads(reads, line, fullPaths)
 
 		case "assignment_statement":
 			// Variable/generic assignments (tmp := expr) don't assign signals,
 			// but the RHS may read signals that we need to track
-			e.extractReadsFromNode(n, source, readSet, true, declaredSignals, variableSet)
+			reads := make(map[string]bool)
+			e.extractReadsFromNode(n, source, reads, true, declaredSignals, variableSet)
+			fullPaths := make(map[string]bool)
+			e.extractReadsWithFullPaths(n, source, fullPaths, true)
+			noteReads(reads, int(n.StartPoint().Row)+1, fullPaths)
+			if target := variableAssignmentTarget(n, source); target != "" && !variableSet[strings.ToLower(target)] {
+				proc.AssignsNonLocalVariable = true
+			}
+
+		case "assert_statement", "report_statement":
+			proc.HasAssertion = true
 
 		case "if_statement":
 			// Check for clock edge pattern in condition
@@ -2868,7 +3516,9 @@ func (e *Extractor) analyzeProcessSemantics(node *sitter.Node, source []byte, pr
 			// Pattern: elsif reset = '1' then (after clock edge = sync)
 			e.checkResetPattern(n, source, proc)
 			// Extract reads from condition (identifiers before first statement)
-			e.extractIfConditionReads(n, source, readSet, declaredSignals, variableSet)
+			reads := make(map[string]bool)
+			e.extractIfConditionReads(n, source, reads, declaredSignals, variableSet)
+			noteReads(reads, int(n.StartPoint().Row)+1, nil)
 			// Continue walking children for nested statements
 			for i := 0; i < int(n.ChildCount()); i++ {
 				walk(n.Child(i), false)
@@ -2877,7 +3527,9 @@ func (e *Extractor) analyzeProcessSemantics(node *sitter.Node, source []byte, pr
 
 		case "case_statement":
 			// Extract reads from case expression
-			e.extractCaseExpressionReads(n, source, readSet, declaredSignals, variableSet)
+			reads := make(map[string]bool)
+			e.extractCaseExpressionReads(n, source, reads, declaredSignals, variableSet)
+			noteReads(reads, int(n.StartPoint().Row)+1, nil)
 			// Continue walking children for case alternatives
 			for i := 0; i < int(n.ChildCount()); i++ {
 				walk(n.Child(i), false)
@@ -2891,17 +3543,24 @@ func (e *Extractor) analyzeProcessSemantics(node *sitter.Node, source []byte, pr
 			}
 			callReads := make(map[string]bool)
 			e.extractReadsFromNode(n, source, callReads, false, declaredSignals, variableSet)
+			reads := make(map[string]bool)
 			for sig := range callReads {
 				if isDeclaredSignalName(sig, declaredSignals, variableSet) {
-					readSet[sig] = true
+					reads[sig] = true
 				}
 			}
+			noteReads(reads, int(n.StartPoint().Row)+1, nil)
 
 		case "wait_statement":
 			// Process contains wait statement - not combinational
 			// Wait-based processes are sequential and don't need sensitivity lists
 			proc.HasWait = true
-			proc.WaitStatements = append(proc.WaitStatements, e.extractWaitStatement(n, source))
+			wait := e.extractWaitStatement(n, source)
+			proc.WaitStatements = append(proc.WaitStatements, wait)
+			// "wait until rising_edge(clk)" clocks the process like an edge test in an if
+			if proc.ClockSignal == "" {
+				proc.ClockSignal, proc.ClockEdge = waitClockEdge(wait.UntilExpr)
+			}
 
 		case "function_call":
 			if call := e.extractFunctionCall(n, source, proc.Label, proc.InArch); call.Name != "" {
@@ -2911,7 +3570,7 @@ func (e *Extractor) analyzeProcessSemantics(node *sitter.Node, source []byte, pr
 		case "identifier":
 			// In expression context, this is a read
 			if inCondition {
-				readSet[n.Content(source)] = true
+				noteReads(map[string]bool{n.Content(source): true}, int(n.StartPoint().Row)+1, nil)
 			}
 		}
 
@@ -2946,6 +3605,58 @@ func (e *Extractor) analyzeProcessSemantics(node *sitter.Node, source []byte, pr
 			proc.ReadSignals = append(proc.ReadSignals, sig)
 		}
 	}
+	proc.AssignedPaths = fieldOnlyPaths(proc.AssignedSignals, writePaths)
+	proc.ReadPaths = fieldOnlyPaths(proc.ReadSignals, readPaths)
+	for key, a := range accesses {
+		if !variableSet[key] {
+			proc.SignalAccesses = append(proc.SignalAccesses, *a)
+		}
+	}
+	sort.Slice(proc.SignalAccesses, func(i, j int) bool {
+		return strings.ToLower(proc.SignalAccesses[i].Signal) < strings.ToLower(proc.SignalAccesses[j].Signal)
+	})
+}
+
+// recordBase returns the signal a record field path belongs to:
+// "trap.cause" -> "trap".
+func recordBase(path string) string {
+	if i := strings.IndexAny(path, ".("); i != -1 {
+		path = path[:i]
+	}
+	return strings.TrimSpace(path)
+}
+
+// fieldOnlyPaths returns the sorted field paths of the signals in sigs that
+// were only ever accessed field by field, per the base -> paths map built by
+// analyzeProcessSemantics. Signals also accessed whole are left out.
+func fieldOnlyPaths(sigs []string, paths map[string]map[string]bool) []string {
+	var out []string
+	for _, sig := range sigs {
+		fields := paths[strings.ToLower(sig)]
+		if len(fields) == 0 || fields[""] {
+			continue
+		}
+		for path := range fields {
+			out = append(out, path)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// usagePaths returns the field paths of sig among paths, or a single ""
+// when sig is accessed as a whole.
+func usagePaths(sig string, paths []string) []string {
+	var out []string
+	for _, path := range paths {
+		if strings.EqualFold(recordBase(path), sig) {
+			out = append(out, path)
+		}
+	}
+	if len(out) == 0 {
+		return []string{""}
+	}
+	return out
 }
 
 // collectProcessVariables collects all variable names declared in a process
@@ -2977,11 +3688,13 @@ func (e *Extractor) collectProcessVariables(node *sitter.Node, source []byte, va
 			}
 			if vars != nil && len(varNames) > 0 {
 				varType := e.extractVariableType(n, source)
+				defaultValue := declarationDefault(n, source)
 				for _, name := range varNames {
 					*vars = append(*vars, VariableDecl{
-						Name: name,
-						Type: varType,
-						Line: line,
+						Name:    name,
+						Type:    varType,
+						Line:    line,
+						Default: defaultValue,
 					})
 				}
 			}
@@ -3497,15 +4210,55 @@ func signalDeclarationHasDefault(node *sitter.Node, source []byte) bool {
 	return strings.Contains(node.Content(source), ":=")
 }
 
-// extractReadsFromNode finds all identifiers read in an expression
-// Uses extractNameInfo for unified handling of name structures (identifier, selected_name, indexed_name)
-// Handles flat prefix/suffix patterns from wrapper nodes (like condition wrapper)
-func (e *Extractor) extractReadsFromNode(node *sitter.Node, source []byte, readSet map[string]bool, skipFirst bool, declaredSignals map[string]bool, variableSet map[string]bool) {
-	e.extractReadsFromNodeSkipping(node, source, readSet, skipFirst, declaredSignals, variableSet, nil)
-}
-
-func (e *Extractor) extractReadsFromNodeSkipping(node *sitter.Node, source []byte, readSet map[string]bool, skipFirst bool, declaredSignals map[string]bool, variableSet map[string]bool, skipSubtree *sitter.Node) {
-	first := true
+// declarationDefault returns the initial value of a signal or variable
+// declaration ("(others => '0')" in "signal q : t := (others => '0');") with
+// comments dropped and whitespace collapsed, or "" when it has none.
+func declarationDefault(node *sitter.Node, source []byte) string {
+	if node == nil {
+		return ""
+	}
+	var raw string
+	if valueNode := node.ChildByFieldName("value"); valueNode != nil {
+		raw = valueNode.Content(source)
+	} else {
+		content := node.Content(source)
+		idx := strings.Index(content, ":=")
+		if idx == -1 {
+			return ""
+		}
+		raw = strings.TrimSuffix(strings.TrimSpace(content[idx+2:]), ";")
+	}
+	var parts []string
+	for _, line := range strings.Split(raw, "\n") {
+		parts = append(parts, strings.Fields(line[:lineCommentStart(line)])...)
+	}
+	return strings.Join(parts, " ")
+}
+
+// lineCommentStart returns the index of the "--" starting a comment on line,
+// skipping dashes inside string literals ("--01"), or len(line).
+func lineCommentStart(line string) int {
+	inString := false
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '"':
+			inString = !inString
+		case !inString && strings.HasPrefix(line[i:], "--"):
+			return i
+		}
+	}
+	return len(line)
+}
+
+// extractReadsFromNode finds all identifiers read in an expression
+// Uses extractNameInfo for unified handling of name structures (identifier, selected_name, indexed_name)
+// Handles flat prefix/suffix patterns from wrapper nodes (like condition wrapper)
+func (e *Extractor) extractReadsFromNode(node *sitter.Node, source []byte, readSet map[string]bool, skipFirst bool, declaredSignals map[string]bool, variableSet map[string]bool) {
+	e.extractReadsFromNodeSkipping(node, source, readSet, skipFirst, declaredSignals, variableSet, nil)
+}
+
+func (e *Extractor) extractReadsFromNodeSkipping(node *sitter.Node, source []byte, readSet map[string]bool, skipFirst bool, declaredSignals map[string]bool, variableSet map[string]bool, skipSubtree *sitter.Node) {
+	first := true
 	var walk func(n *sitter.Node, parent *sitter.Node, childIdx int)
 	walk = func(n *sitter.Node, parent *sitter.Node, childIdx int) {
 		if n == nil {
@@ -3686,57 +4439,143 @@ func (e *Extractor) checkResetPattern(node *sitter.Node, source []byte, proc *Pr
 		return
 	}
 
-	// Walk the condition wrapper's children to find reset pattern
-	var resetSignal string
-	var hasComparison bool
-	var hasValue bool
+	resetSignal, polarity, ok := e.resetCondition(condNode, source)
+	if !ok || !isResetName(resetSignal) {
+		return
+	}
+
+	// If we found a comparison with a reset-named signal, mark it as reset
+	if !proc.HasReset {
+		proc.HasReset = true
+		proc.ResetSignal = resetSignal
+		proc.ResetPolarity = polarity
+		proc.ResetValues = e.resetBranchValues(node, source)
+		// Async reset only if it's not nested under a clocked if-statement
+		if !isWithinClockedIf(node, source) {
+			proc.ResetAsync = true
+		}
+	} else if !proc.ResetAsync {
+		if !isWithinClockedIf(node, source) {
+			proc.ResetAsync = true
+		}
+	}
+}
 
+// resetBranchValues returns the signals a reset if statement's then-branch
+// assigns as a whole to a single value, in order.
+func (e *Extractor) resetBranchValues(node *sitter.Node, source []byte) []ResetValue {
+	var values []ResetValue
+	for _, stmt := range ifBranches(node, source)[0].statements {
+		if stmt.Type() != "sequential_signal_assignment" {
+			continue
+		}
+		target, ok := e.extractAssignmentTarget(stmt, source)
+		targetNode := stmt.ChildByFieldName("target")
+		if !ok || targetNode == nil || !strings.EqualFold(strings.TrimSpace(targetNode.Content(source)), target) {
+			continue
+		}
+		waveform := assignmentWaveformValues(stmt, source)
+		if len(waveform) != 1 {
+			continue
+		}
+		values = append(values, ResetValue{
+			Signal: target,
+			Value:  strings.Join(strings.Fields(waveform[0]), " "),
+			Line:   int(stmt.StartPoint().Row) + 1,
+		})
+	}
+	return values
+}
+
+// resetConditionRe matches a bare boolean test of a name, optionally negated:
+// "rst", "not rst_n", "not (rst_n)".
+var resetConditionRe = regexp.MustCompile(`(?i)^(not\s*)?\(?\s*([a-z][a-z0-9_]*)\s*\)?$`)
+
+// resetCondition extracts the signal a reset-style condition tests and the
+// polarity that asserts it. The value compared against decides polarity:
+// '1'/true is active-high and '0'/false active-low, inverted for "/=".
+// Boolean resets tested directly ("if rst") are active-high and negated ones
+// ("if not rst_n") active-low. Comparisons against other character literals
+// still count as resets with unknown ("") polarity.
+func (e *Extractor) resetCondition(condNode *sitter.Node, source []byte) (signal, polarity string, ok bool) {
+	var operator, value string
 	for i := 0; i < int(condNode.ChildCount()); i++ {
 		child := condNode.Child(i)
-		childType := child.Type()
 		childContent := child.Content(source)
 
-		switch childType {
+		switch child.Type() {
 		case "identifier":
-			if resetSignal == "" {
-				resetSignal = childContent
+			if signal == "" {
+				signal = childContent
+			} else if operator != "" && value == "" {
+				value = childContent // rst = true
 			}
 		case "relational_operator":
-			hasComparison = true
+			operator = childContent
 		case "character_literal":
-			hasValue = true
+			value = childContent
 		case "relational_expression":
 			// If we have a structured relational_expression, use its fields
 			if leftNode := child.ChildByFieldName("left"); leftNode != nil {
 				info := e.extractNameInfo(leftNode, source)
 				if info.Base != "" {
-					resetSignal = info.Base
+					signal = info.Base
 				}
 			}
-			hasComparison = true
+			if opNode := child.ChildByFieldName("operator"); opNode != nil {
+				operator = opNode.Content(source)
+			}
 			if rightNode := child.ChildByFieldName("right"); rightNode != nil {
-				if rightNode.Type() == "character_literal" {
-					hasValue = true
+				if rightNode.Type() == "character_literal" || isBooleanLiteral(rightNode.Content(source)) {
+					value = rightNode.Content(source)
 				}
 			}
 		}
 	}
 
-	// If we found a comparison with a reset-named signal, mark it as reset
-	if resetSignal != "" && hasComparison && hasValue {
-		if !proc.HasReset && isResetName(resetSignal) {
-			proc.HasReset = true
-			proc.ResetSignal = resetSignal
-			// Async reset only if it's not nested under a clocked if-statement
-			if !isWithinClockedIf(node, source) {
-				proc.ResetAsync = true
-			}
-		} else if proc.HasReset && !proc.ResetAsync && isResetName(resetSignal) {
-			if !isWithinClockedIf(node, source) {
-				proc.ResetAsync = true
-			}
+	if operator == "" {
+		m := resetConditionRe.FindStringSubmatch(strings.TrimSpace(condNode.Content(source)))
+		if m == nil {
+			return "", "", false
 		}
+		if m[1] != "" {
+			return m[2], "active_low", true
+		}
+		return m[2], "active_high", true
+	}
+
+	if signal == "" || value == "" {
+		return "", "", false
+	}
+	if !strings.HasPrefix(value, "'") && !isBooleanLiteral(value) {
+		return "", "", false
 	}
+	var high bool
+	switch strings.ToLower(strings.Trim(value, "'")) {
+	case "1", "h", "true":
+		high = true
+	case "0", "l", "false":
+		high = false
+	default:
+		return signal, "", true
+	}
+	switch operator {
+	case "=":
+	case "/=":
+		high = !high
+	default:
+		return signal, "", true
+	}
+	if high {
+		return signal, "active_high", true
+	}
+	return signal, "active_low", true
+}
+
+// isBooleanLiteral reports whether s is the literal true or false.
+func isBooleanLiteral(s string) bool {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return s == "true" || s == "false"
 }
 
 func isWithinClockedIf(node *sitter.Node, source []byte) bool {
@@ -4116,6 +4955,46 @@ func (e *Extractor) extractFunctionCallFromPrefixContent(node *sitter.Node, sour
 	return call
 }
 
+var (
+	waitEdgeCallRe = regexp.MustCompile(`(?i)\b(rising|falling)_edge\s*\(\s*([A-Za-z]\w*)\s*\)`)
+	waitEventRe    = regexp.MustCompile(`(?i)\b([A-Za-z]\w*)\s*'\s*event\b`)
+)
+
+// waitClockEdge returns the clock and edge a "wait until" condition waits
+// for: rising_edge(clk), falling_edge(clk), or clk'event and clk = '1'/'0'.
+// An event test without a level defaults to rising, as in if conditions.
+func waitClockEdge(untilExpr string) (string, string) {
+	if m := waitEdgeCallRe.FindStringSubmatch(untilExpr); m != nil {
+		return m[2], strings.ToLower(m[1])
+	}
+	m := waitEventRe.FindStringSubmatch(untilExpr)
+	if m == nil {
+		return "", ""
+	}
+	clk := m[1]
+	levelRe := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(clk) + `\s*=\s*'([01])'`)
+	if level := levelRe.FindStringSubmatch(untilExpr); level != nil && level[1] == "0" {
+		return clk, "falling"
+	}
+	return clk, "rising"
+}
+
+// isStimulusProcess reports whether every wait in an unclocked process is a
+// plain timed delay ("wait for 10 ns;" or a final "wait;"), with at least one
+// delay, so the process generates stimulus rather than reacting to signals.
+func isStimulusProcess(proc *Process) bool {
+	delays := 0
+	for _, w := range proc.WaitStatements {
+		if len(w.OnSignals) > 0 || w.UntilExpr != "" {
+			return false
+		}
+		if w.ForExpr != "" {
+			delays++
+		}
+	}
+	return delays > 0
+}
+
 func (e *Extractor) extractWaitStatement(node *sitter.Node, source []byte) WaitStatement {
 	wait := WaitStatement{
 		Line: int(node.StartPoint().Row) + 1,
@@ -4448,7 +5327,8 @@ func (e *Extractor) extractCaseStatement(node *sitter.Node, source []byte, archC
 		}
 	}
 
-	// IsComplete if it has "others" (conservative check - full coverage analysis would need type info)
+	// IsComplete if it has "others"; the indexer also marks enum selectors
+	// whose every literal is a choice, once types from all files are known
 	caseStmt.IsComplete = caseStmt.HasOthers
 
 	return caseStmt
@@ -4472,6 +5352,62 @@ func (e *Extractor) extractCaseStatementsFromProcess(node *sitter.Node, source [
 	walk(node)
 }
 
+// extractLoopStatement extracts a for loop's parameter and range. The grammar
+// hides the for/in/loop keywords and flattens the discrete range into the
+// loop_statement, so the parameter is the identifier after "for" and the range
+// spans the nodes between it and the "loop" keyword. Returns false for while
+// and bare loops.
+func (e *Extractor) extractLoopStatement(node *sitter.Node, source []byte, archContext, processLabel string) (LoopStatement, bool) {
+	loop := LoopStatement{
+		Line:      int(node.StartPoint().Row) + 1,
+		InProcess: processLabel,
+		InArch:    archContext,
+	}
+	var rangeNodes []*sitter.Node
+	prevEnd := node.StartByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		gap := strings.ToLower(string(source[prevEnd:child.StartByte()]))
+		prevEnd = child.EndByte()
+		if loop.LoopVar == "" {
+			if child.Type() == "identifier" && lastKeywordIndex(gap, "for") != -1 {
+				loop.LoopVar = child.Content(source)
+			}
+			continue
+		}
+		if lastKeywordIndex(gap, "loop") != -1 {
+			break
+		}
+		rangeNodes = append(rangeNodes, child)
+	}
+	if loop.LoopVar == "" || len(rangeNodes) == 0 {
+		return LoopStatement{}, false
+	}
+	whole := strings.TrimSpace(string(source[rangeNodes[0].StartByte():rangeNodes[len(rangeNodes)-1].EndByte()]))
+	loop.RangeLow, loop.RangeDir, loop.RangeHigh = rangeBounds(rangeNodes, whole, source)
+	loop.IterationCount = -1
+	return loop, true
+}
+
+// extractLoopsFromProcess walks a process body to find all for loops
+func (e *Extractor) extractLoopsFromProcess(node *sitter.Node, source []byte, archContext, processLabel string, facts *FileFacts) {
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		if n.Type() == "loop_statement" {
+			if loop, ok := e.extractLoopStatement(n, source, archContext, processLabel); ok {
+				facts.Loops = append(facts.Loops, loop)
+			}
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(node)
+}
+
 // extractComparisonsFromProcess extracts comparison operations for trojan/trigger detection
 // Strategy: Look for relational_operator nodes and extract their sibling operands
 // Note: When the grammar produces proper relational_expression nodes with fields,
@@ -4742,15 +5678,32 @@ func estimateBitWidth(literal string) int {
 		}
 	}
 
-	// Handle decimal numbers
+	// Handle decimal (255) and based (16#FF#) integers by their value: a
+	// value v needs ceil(log2(v+1)) bits
 	if len(literal) > 0 && (literal[0] >= '0' && literal[0] <= '9') {
-		// Rough estimate: number of decimal digits * 3.32 bits
+		digits, base := strings.ReplaceAll(literal, "_", ""), 10
+		if m := basedIntegerRe.FindStringSubmatch(digits); m != nil {
+			base, _ = strconv.Atoi(m[1])
+			digits = m[2]
+		}
+		if base >= 2 && base <= 16 {
+			if v, ok := new(big.Int).SetString(digits, base); ok {
+				if v.Sign() == 0 {
+					return 1
+				}
+				return v.BitLen()
+			}
+		}
+		// Reals and other forms: rough estimate of 4 bits per character
 		return len(literal) * 4
 	}
 
 	return 0
 }
 
+// basedIntegerRe matches a based integer literal such as 16#FF# or 2#1010#.
+var basedIntegerRe = regexp.MustCompile(`^(\d+)#([0-9A-Fa-f]+)#$`)
+
 // extractArithmeticOpsFromProcess extracts expensive arithmetic operations for power analysis
 // Uses grammar's visible `multiplicative_expression` and `exponential_expression` nodes
 func (e *Extractor) extractArithmeticOpsFromProcess(node *sitter.Node, source []byte, archContext, processLabel string, facts *FileFacts) {
@@ -4816,6 +5769,16 @@ func (e *Extractor) extractArithmeticOpsFromProcess(node *sitter.Node, source []
 			return
 		}
 
+		// Additive expressions are hidden in the grammar, so "+" and "-"
+		// appear as operators between their operand siblings ("&" is
+		// concatenation, not arithmetic)
+		if nodeType == "additive_operator" {
+			if parent := n.Parent(); parent != nil && n.Content(source) != "&" {
+				op := e.extractArithmeticOpFromSiblings(n, parent, source, archContext, processLabel, guardStack)
+				facts.ArithmeticOps = append(facts.ArithmeticOps, op)
+			}
+		}
+
 		// Fallback: flat arithmetic operators (when expressions are not structured)
 		// Only fires when operator is NOT inside a structured expression
 		if nodeType == "arithmetic_operator" || nodeType == "multiplicative_operator" {
@@ -4997,21 +5960,7 @@ func (e *Extractor) extractSignalDepsFromAssignment(node *sitter.Node, source []
 		if nodeHasField(targetNode, "content") {
 			targetIsIndexed = true
 		}
-		if full := fullPathFromPrefixSuffixNode(targetNode, source); full != "" {
-			target = full
-		}
-		// Extract full path from inside the wrapper
-		for i := 0; i < int(targetNode.ChildCount()) && target == ""; i++ {
-			child := targetNode.Child(i)
-			if child.Type() == "identifier" {
-				target = child.Content(source)
-				break
-			}
-			if child.Type() == "selected_name" || child.Type() == "indexed_name" {
-				target = e.extractFullSignalPath(child, source)
-				break
-			}
-		}
+		target = e.assignmentTargetPath(targetNode, source)
 	}
 
 	// Second pass: extract reads with full paths (skip the target subtree)
@@ -5271,30 +6220,36 @@ func (e *Extractor) extractRangeFromNode(node *sitter.Node, source []byte, gen *
 	// The node is discrete_range which wraps either:
 	// 1. range_expression with fields: low, direction, high
 	// 2. Just an expression (for attribute ranges)
+	children := make([]*sitter.Node, 0, node.ChildCount())
+	for i := 0; i < int(node.ChildCount()); i++ {
+		children = append(children, node.Child(i))
+	}
+	gen.RangeLow, gen.RangeDir, gen.RangeHigh = rangeBounds(children, strings.TrimSpace(node.Content(source)), source)
+}
 
+// rangeBounds returns the low bound, direction, and high bound of a discrete
+// range given its nodes. Without a range_expression (attribute ranges like
+// vec'range), the whole range text is returned as low.
+func rangeBounds(nodes []*sitter.Node, whole string, source []byte) (low, dir, high string) {
 	// Look for range_expression child with named fields
-	for i := 0; i < int(node.ChildCount()); i++ {
-		child := node.Child(i)
+	for _, child := range nodes {
 		if child.Type() == "range_expression" {
 			// Use grammar fields for clean extraction
 			if lowNode := child.ChildByFieldName("low"); lowNode != nil {
-				gen.RangeLow = strings.TrimSpace(lowNode.Content(source))
+				low = strings.TrimSpace(lowNode.Content(source))
 			}
 			if dirNode := child.ChildByFieldName("direction"); dirNode != nil {
-				gen.RangeDir = strings.ToLower(strings.TrimSpace(dirNode.Content(source)))
+				dir = strings.ToLower(strings.TrimSpace(dirNode.Content(source)))
 			}
 			if highNode := child.ChildByFieldName("high"); highNode != nil {
-				gen.RangeHigh = strings.TrimSpace(highNode.Content(source))
+				high = strings.TrimSpace(highNode.Content(source))
 			}
-			return
+			return low, dir, high
 		}
 	}
 
 	// Fallback: attribute range like vec'range - store the entire expression
-	content := strings.TrimSpace(node.Content(source))
-	if content != "" {
-		gen.RangeLow = content
-	}
+	return whole, "", ""
 }
 
 // isVHDLKeyword checks if a string is a VHDL keyword (used to filter out keywords in range extraction)
@@ -5619,27 +6574,29 @@ func (e *Extractor) extractArrayTypeDetails(node *sitter.Node, source []byte, td
 		td.Unconstrained = true
 	}
 
-	// Walk children to find index types and element type
+	// Index constraints are flattened into the definition between the outer
+	// '(' and ')', separated by ','; record each one's text ("7 downto 0",
+	// "natural range <>", "integer range 0 to 3")
+	indexStart := uint32(0)
 	for i := 0; i < int(node.ChildCount()); i++ {
 		child := node.Child(i)
-		childType := child.Type()
-
-		// Index constraints come before 'of' keyword
-		if childType == "identifier" {
-			// Could be index type or element type
-			// Element type comes after 'of'
-			if td.ElementType == "" {
-				// Check if previous sibling was 'of' keyword
-				if i > 0 {
-					prev := node.Child(i - 1)
-					if strings.ToLower(prev.Content(source)) == "of" {
-						td.ElementType = child.Content(source)
-						continue
-					}
-				}
-				// Otherwise it's an index type
-				td.IndexTypes = append(td.IndexTypes, child.Content(source))
+		switch child.Type() {
+		case "(":
+			if indexStart == 0 {
+				indexStart = child.EndByte()
 			}
+			continue
+		case ",", ")":
+			if indexStart == 0 {
+				continue
+			}
+			if index := strings.Join(strings.Fields(string(source[indexStart:child.StartByte()])), " "); index != "" {
+				td.IndexTypes = append(td.IndexTypes, index)
+			}
+			indexStart = child.EndByte()
+		}
+		if child.Type() == ")" {
+			break
 		}
 	}
 
@@ -6045,61 +7002,155 @@ func splitLines(s string) []string {
 // This is called after extraction when constants are available.
 
 // ElaborateGenerates evaluates for-generate ranges using available constants
+// and signal widths (lowercase name -> bits, for 'range and 'length)
 // Returns the number of generates that were successfully elaborated
-func ElaborateGenerates(generates []GenerateStatement, constants map[string]int) int {
+func ElaborateGenerates(generates []GenerateStatement, constants map[string]int, signalWidths map[string]int) int {
 	count := 0
 	for i := range generates {
-		if elaborateGenerate(&generates[i], constants) {
+		if elaborateGenerate(&generates[i], constants, signalWidths) {
 			count++
 		}
 		// Recursively elaborate nested generates
-		count += ElaborateGenerates(generates[i].Generates, constants)
+		count += ElaborateGenerates(generates[i].Generates, constants, signalWidths)
 	}
 	return count
 }
 
+// attributeRangeRe matches a whole-object range attribute: data'range, data'reverse_range
+var attributeRangeRe = regexp.MustCompile(`(?i)^([a-z]\w*)\s*'\s*(?:reverse_)?range$`)
+
 // elaborateGenerate evaluates a single for-generate's range
-func elaborateGenerate(gen *GenerateStatement, constants map[string]int) bool {
+func elaborateGenerate(gen *GenerateStatement, constants map[string]int, signalWidths map[string]int) bool {
 	if gen.Kind != "for" {
 		return false
 	}
+	gen.IterationCount, gen.CanElaborate = rangeIterations(gen.RangeLow, gen.RangeDir, gen.RangeHigh, constants, signalWidths)
+	return gen.CanElaborate
+}
 
-	// Try to evaluate low and high bounds
-	low, okLow := evaluateRangeExpr(gen.RangeLow, constants)
-	high, okHigh := evaluateRangeExpr(gen.RangeHigh, constants)
+// ElaborateLoops evaluates for-loop ranges like ElaborateGenerates and
+// returns the number of loops whose iteration count is known
+func ElaborateLoops(loops []LoopStatement, constants map[string]int, signalWidths map[string]int) int {
+	count := 0
+	for i := range loops {
+		loop := &loops[i]
+		loop.IterationCount, loop.CanElaborate = rangeIterations(loop.RangeLow, loop.RangeDir, loop.RangeHigh, constants, signalWidths)
+		if loop.CanElaborate {
+			count++
+		}
+	}
+	return count
+}
 
+// ForGenerateIterations evaluates a for-generate's range like
+// ElaborateGenerates without recording the result, for elaborating one
+// instance of its entity under that instance's generic values
+func ForGenerateIterations(gen GenerateStatement, constants map[string]int, signalWidths map[string]int) (int, bool) {
+	if gen.Kind != "for" {
+		return -1, false
+	}
+	return rangeIterations(gen.RangeLow, gen.RangeDir, gen.RangeHigh, constants, signalWidths)
+}
+
+// EvaluateGenericMap returns the integer value of each constant generic for
+// one instance, keyed by lowercase name: the actual its generic map binds
+// (by name or position), evaluated over constants, or else the generic's
+// default, evaluated over constants and the generics before it. Generics
+// whose value is not an integer expression, or bound to open without a
+// default, are left out
+func EvaluateGenericMap(inst Instance, generics []GenericDecl, constants map[string]int) map[string]int {
+	byName := make(map[string]int, len(generics))
+	for i, g := range generics {
+		byName[strings.ToLower(g.Name)] = i
+	}
+	actuals := make(map[int]string)
+	hasAssociations := false
+	for _, assoc := range inst.Associations {
+		if assoc.Kind != "generic" {
+			continue
+		}
+		hasAssociations = true
+		if assoc.IsPositional {
+			actuals[assoc.PositionIndex] = assoc.Actual
+			continue
+		}
+		formal := strings.ToLower(strings.TrimSpace(assoc.Formal))
+		if i, ok := byName[formal]; ok {
+			actuals[i] = assoc.Actual
+		}
+	}
+	if !hasAssociations {
+		for formal, actual := range inst.GenericMap {
+			if i, ok := byName[strings.ToLower(strings.TrimSpace(formal))]; ok {
+				actuals[i] = actual
+			}
+		}
+	}
+
+	values := make(map[string]int)
+	scope := make(map[string]int, len(constants)+len(generics))
+	for k, v := range constants {
+		scope[k] = v
+	}
+	for i, g := range generics {
+		if g.Kind != "constant" {
+			continue
+		}
+		var val int
+		var ok bool
+		if actual, bound := actuals[i]; bound && !strings.EqualFold(strings.TrimSpace(actual), "open") {
+			val, ok = evaluateRangeExpr(actual, constants, nil)
+		} else if g.Default != "" {
+			val, ok = evaluateRangeExpr(g.Default, scope, nil)
+		}
+		if ok {
+			name := strings.ToLower(g.Name)
+			values[name] = val
+			scope[name] = val
+		}
+	}
+	return values
+}
+
+// rangeIterations returns the number of values in a discrete range, or -1
+// and false when a bound cannot be evaluated
+func rangeIterations(rangeLow, rangeDir, rangeHigh string, constants map[string]int, signalWidths map[string]int) (int, bool) {
+	// Attribute range (for i in data'range) iterates once per bit of data
+	if rangeDir == "" {
+		if m := attributeRangeRe.FindStringSubmatch(strings.TrimSpace(rangeLow)); m != nil {
+			if width, ok := signalWidths[strings.ToLower(m[1])]; ok && width > 0 {
+				return width, true
+			}
+		}
+	}
+
+	// Try to evaluate low and high bounds
+	low, okLow := evaluateRangeExpr(rangeLow, constants, signalWidths)
+	high, okHigh := evaluateRangeExpr(rangeHigh, constants, signalWidths)
 	if !okLow || !okHigh {
-		gen.IterationCount = -1
-		gen.CanElaborate = false
-		return false
+		return -1, false
 	}
 
 	// Calculate iteration count based on direction
-	switch gen.RangeDir {
+	count := -1
+	switch rangeDir {
 	case "to":
-		gen.IterationCount = high - low + 1
+		count = high - low + 1
 	case "downto":
-		gen.IterationCount = low - high + 1
-	default:
-		gen.IterationCount = -1
-		gen.CanElaborate = false
-		return false
+		count = low - high + 1
 	}
 
 	// Sanity check - iteration count should be positive
-	if gen.IterationCount < 0 {
-		gen.IterationCount = -1
-		gen.CanElaborate = false
-		return false
+	if count < 0 {
+		return -1, false
 	}
-
-	gen.CanElaborate = true
-	return true
+	return count, true
 }
 
 // evaluateRangeExpr evaluates a simple range expression
-// Handles: integer literals, identifiers (from constants), simple arithmetic
-func evaluateRangeExpr(expr string, constants map[string]int) (int, bool) {
+// Handles: integer literals, identifiers (from constants), sig'length (from
+// signalWidths, which may be nil), and arithmetic over those
+func evaluateRangeExpr(expr string, constants map[string]int, signalWidths map[string]int) (int, bool) {
 	expr = strings.TrimSpace(expr)
 	if expr == "" {
 		return 0, false
@@ -6115,9 +7166,9 @@ func evaluateRangeExpr(expr string, constants map[string]int) (int, bool) {
 		return val, true
 	}
 
-	// Try to evaluate simple arithmetic expressions
-	// Handle: CONST - 1, CONST + 1, CONST * 2, CONST / 2
-	return evaluateSimpleArithmetic(expr, constants)
+	// Try to evaluate arithmetic expressions
+	// Handle: CONST - 1, N*M-1, (A + B) / 2, sig'length - 1, I mod 4
+	return evaluateSimpleArithmetic(expr, constants, signalWidths)
 }
 
 // parseIntLiteral parses an integer literal (decimal, hex, binary, octal)
@@ -6129,10 +7180,8 @@ func parseIntLiteral(s string) (int, error) {
 		return parseBasedLiteral(s)
 	}
 
-	// Standard Go parsing handles decimal
-	var val int
-	_, err := fmt.Sscanf(s, "%d", &val)
-	return val, err
+	// Decimal, with optional underscores (1_000); trailing text is an error
+	return strconv.Atoi(strings.ReplaceAll(s, "_", ""))
 }
 
 // parseBasedLiteral parses VHDL based literals like 16#FF#, 2#1010#
@@ -6168,38 +7217,198 @@ func parseBasedLiteral(s string) (int, error) {
 	return result, nil
 }
 
-// evaluateSimpleArithmetic handles simple expressions like "WIDTH - 1"
-func evaluateSimpleArithmetic(expr string, constants map[string]int) (int, bool) {
-	// Try common patterns: X - N, X + N, X * N, X / N
-	operators := []string{" - ", " + ", " * ", " / ", "-", "+", "*", "/"}
-
-	for _, op := range operators {
-		if idx := strings.Index(expr, op); idx > 0 {
-			left := strings.TrimSpace(expr[:idx])
-			right := strings.TrimSpace(expr[idx+len(op):])
-
-			leftVal, okLeft := evaluateRangeExpr(left, constants)
-			rightVal, okRight := evaluateRangeExpr(right, constants)
-
-			if okLeft && okRight {
-				opChar := strings.TrimSpace(op)
-				switch opChar {
-				case "-":
-					return leftVal - rightVal, true
-				case "+":
-					return leftVal + rightVal, true
-				case "*":
-					return leftVal * rightVal, true
-				case "/":
-					if rightVal != 0 {
-						return leftVal / rightVal, true
-					}
+// evaluateSimpleArithmetic evaluates an integer expression over literals,
+// constants, and sig'length attributes, with VHDL precedence: ** binds
+// tightest, then * / mod rem, then + -. Parentheses nest. Returns false if
+// any operand is unknown or the expression is malformed.
+func evaluateSimpleArithmetic(expr string, constants map[string]int, signalWidths map[string]int) (int, bool) {
+	tokens, ok := tokenizeArithmetic(expr)
+	if !ok || len(tokens) == 0 {
+		return 0, false
+	}
+	p := arithParser{tokens: tokens, constants: constants, signalWidths: signalWidths}
+	val, ok := p.parseSum()
+	if !ok || p.pos != len(p.tokens) {
+		return 0, false
+	}
+	return val, true
+}
+
+// tokenizeArithmetic splits an expression into numbers, names (with an
+// optional 'attribute suffix), and operators.
+func tokenizeArithmetic(expr string) ([]string, bool) {
+	var tokens []string
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '*' && i+1 < len(expr) && expr[i+1] == '*':
+			tokens = append(tokens, "**")
+			i += 2
+		case strings.IndexByte("+-*/()", c) >= 0:
+			tokens = append(tokens, string(c))
+			i++
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(expr) && (isIdentByte(expr[j]) || expr[j] == '#') {
+				j++
+			}
+			tokens = append(tokens, expr[i:j])
+			i = j
+		case isIdentByte(c):
+			j := i
+			for j < len(expr) && isIdentByte(expr[j]) {
+				j++
+			}
+			// Attribute suffix: sig'length
+			if j+1 < len(expr) && expr[j] == '\'' && isIdentByte(expr[j+1]) {
+				j++
+				for j < len(expr) && isIdentByte(expr[j]) {
+					j++
 				}
 			}
+			tokens = append(tokens, strings.ToLower(expr[i:j]))
+			i = j
+		default:
+			return nil, false
+		}
+	}
+	return tokens, true
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// arithParser is a recursive-descent evaluator over tokenizeArithmetic output.
+type arithParser struct {
+	tokens       []string
+	pos          int
+	constants    map[string]int
+	signalWidths map[string]int
+}
+
+func (p *arithParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+// parseSum handles + and - (lowest precedence).
+func (p *arithParser) parseSum() (int, bool) {
+	val, ok := p.parseTerm()
+	for ok {
+		op := p.peek()
+		if op != "+" && op != "-" {
+			break
+		}
+		p.pos++
+		var rhs int
+		if rhs, ok = p.parseTerm(); ok {
+			if op == "+" {
+				val += rhs
+			} else {
+				val -= rhs
+			}
+		}
+	}
+	return val, ok
+}
+
+// parseTerm handles *, /, mod, and rem.
+func (p *arithParser) parseTerm() (int, bool) {
+	val, ok := p.parseFactor()
+	for ok {
+		op := p.peek()
+		if op != "*" && op != "/" && op != "mod" && op != "rem" {
+			break
+		}
+		p.pos++
+		var rhs int
+		if rhs, ok = p.parseFactor(); !ok {
+			break
+		}
+		if op != "*" && rhs == 0 {
+			return 0, false
+		}
+		switch op {
+		case "*":
+			val *= rhs
+		case "/", "rem":
+			// VHDL / truncates toward zero and rem takes the sign of the left operand, as in Go
+			if op == "/" {
+				val /= rhs
+			} else {
+				val %= rhs
+			}
+		case "mod":
+			// mod takes the sign of the right operand
+			r := val % rhs
+			if r != 0 && (r < 0) != (rhs < 0) {
+				r += rhs
+			}
+			val = r
 		}
 	}
+	return val, ok
+}
 
-	return 0, false
+// parseFactor handles unary signs and ** (right-associative).
+func (p *arithParser) parseFactor() (int, bool) {
+	if op := p.peek(); op == "-" || op == "+" {
+		p.pos++
+		val, ok := p.parseFactor()
+		if op == "-" {
+			val = -val
+		}
+		return val, ok
+	}
+	base, ok := p.parsePrimary()
+	if !ok || p.peek() != "**" {
+		return base, ok
+	}
+	p.pos++
+	exp, ok := p.parseFactor()
+	if !ok || exp < 0 || exp > 62 {
+		return 0, false
+	}
+	result := 1
+	for i := 0; i < exp; i++ {
+		result *= base
+	}
+	return result, true
+}
+
+// parsePrimary handles literals, names, attributes, and parentheses.
+func (p *arithParser) parsePrimary() (int, bool) {
+	tok := p.peek()
+	if tok == "" {
+		return 0, false
+	}
+	p.pos++
+	if tok == "(" {
+		val, ok := p.parseSum()
+		if !ok || p.peek() != ")" {
+			return 0, false
+		}
+		p.pos++
+		return val, true
+	}
+	if val, err := parseIntLiteral(tok); err == nil {
+		return val, true
+	}
+	if name, attr, found := strings.Cut(tok, "'"); found {
+		if attr == "length" {
+			if width, ok := p.signalWidths[name]; ok && width > 0 {
+				return width, true
+			}
+		}
+		return 0, false
+	}
+	val, ok := p.constants[tok]
+	return val, ok
 }
 
 // BuildConstantMap creates a map of constant names to integer values
@@ -6215,15 +7424,19 @@ func BuildConstantMap(constants []ConstantDeclaration) map[string]int {
 }
 
 // DetectCDCCrossings analyzes processes to find signals crossing clock domains
-// A CDC crossing occurs when a signal written in one clock domain is read in another
+// A CDC crossing occurs when a signal written in one clock domain is read in another.
+// Records accessed field by field are matched per field path, so a record
+// whose fields live in different domains only crosses on the fields that do.
 func DetectCDCCrossings(facts *FileFacts) []CDCCrossing {
 	var crossings []CDCCrossing
 
-	// Build map: signal -> list of (process, clock) that write it
+	// Build map: base signal -> list of (process, clock, path) that write it
 	type writeInfo struct {
 		process string
 		clock   string
 		arch    string
+		path    string // Field path written, or the signal name when written whole
+		proc    int    // Index of the writing process
 	}
 	signalWriters := make(map[string][]writeInfo)
 
@@ -6234,17 +7447,21 @@ func DetectCDCCrossings(facts *FileFacts) []CDCCrossing {
 	}
 
 	// Collect all sequential processes and their writes
-	for _, proc := range facts.Processes {
-		if !proc.IsSequential || proc.ClockSignal == "" {
-			continue // Only care about clocked processes
+	for i, proc := range facts.Processes {
+		if !proc.IsSequential || proc.ClockSignal == "" || proc.InSynthOffRegion {
+			continue // Only care about clocked processes synthesis sees
 		}
 		for _, sig := range proc.AssignedSignals {
 			sigLower := strings.ToLower(sig)
-			signalWriters[sigLower] = append(signalWriters[sigLower], writeInfo{
-				process: proc.Label,
-				clock:   proc.ClockSignal,
-				arch:    proc.InArch,
-			})
+			for _, path := range accessPaths(sig, proc.AssignedPaths) {
+				signalWriters[sigLower] = append(signalWriters[sigLower], writeInfo{
+					process: proc.Label,
+					clock:   proc.ClockSignal,
+					arch:    proc.InArch,
+					path:    path,
+					proc:    i,
+				})
+			}
 		}
 	}
 
@@ -6254,42 +7471,62 @@ func DetectCDCCrossings(facts *FileFacts) []CDCCrossing {
 
 	// Check each sequential process for reads from different clock domains
 	for _, proc := range facts.Processes {
-		if !proc.IsSequential || proc.ClockSignal == "" {
+		if !proc.IsSequential || proc.ClockSignal == "" || proc.InSynthOffRegion {
 			continue
 		}
 		destClock := strings.ToLower(proc.ClockSignal)
 
+		type crossingKey struct {
+			signal string
+			writer int
+		}
+		seen := make(map[crossingKey]bool)
 		for _, readSig := range proc.ReadSignals {
 			readLower := strings.ToLower(readSig)
 			writers := signalWriters[readLower]
 
-			for _, w := range writers {
-				srcClock := strings.ToLower(w.clock)
-				// Skip same clock domain
-				if srcClock == destClock {
-					continue
-				}
+			for _, readPath := range accessPaths(readSig, proc.ReadPaths) {
+				for _, w := range writers {
+					srcClock := strings.ToLower(w.clock)
+					// Skip same clock domain
+					if srcClock == destClock {
+						continue
+					}
+					if !fieldPathsOverlap(readPath, w.path) {
+						continue
+					}
+					// Report the more specific of the two paths
+					signal := readPath
+					if len(w.path) > len(signal) {
+						signal = w.path
+					}
+					key := crossingKey{strings.ToLower(signal), w.proc}
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+
+					// Found a CDC crossing
+					crossing := CDCCrossing{
+						Signal:      signal,
+						SourceClock: w.clock,
+						SourceProc:  w.process,
+						DestClock:   proc.ClockSignal,
+						DestProc:    proc.Label,
+						Line:        proc.Line,
+						File:        facts.File,
+						InArch:      proc.InArch,
+						IsMultiBit:  signalWidths[readLower] > 1,
+					}
 
-				// Found a CDC crossing
-				crossing := CDCCrossing{
-					Signal:      readSig,
-					SourceClock: w.clock,
-					SourceProc:  w.process,
-					DestClock:   proc.ClockSignal,
-					DestProc:    proc.Label,
-					Line:        proc.Line,
-					File:        facts.File,
-					InArch:      proc.InArch,
-					IsMultiBit:  signalWidths[readLower] > 1,
-				}
+					// Check if this signal goes through a synchronizer
+					if stages, ok := syncStages[readLower]; ok && stages >= 1 {
+						crossing.IsSynchronized = true
+						crossing.SyncStages = stages
+					}
 
-				// Check if this signal goes through a synchronizer
-				if stages, ok := syncStages[readLower]; ok && stages >= 1 {
-					crossing.IsSynchronized = true
-					crossing.SyncStages = stages
+					crossings = append(crossings, crossing)
 				}
-
-				crossings = append(crossings, crossing)
 			}
 		}
 	}
@@ -6297,6 +7534,23 @@ func DetectCDCCrossings(facts *FileFacts) []CDCCrossing {
 	return crossings
 }
 
+// accessPaths returns the field paths of sig among paths, or sig itself when
+// it is accessed as a whole.
+func accessPaths(sig string, paths []string) []string {
+	out := usagePaths(sig, paths)
+	if len(out) == 1 && out[0] == "" {
+		out[0] = sig
+	}
+	return out
+}
+
+// fieldPathsOverlap reports whether two signal paths name overlapping
+// storage: the same path, or a record and one of its fields.
+func fieldPathsOverlap(a, b string) bool {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	return a == b || strings.HasPrefix(a, b+".") || strings.HasPrefix(b, a+".")
+}
+
 // detectSynchronizers looks for common synchronizer patterns
 // Returns map of signal name -> number of synchronizer stages detected
 func detectSynchronizers(processes []Process) map[string]int {
@@ -6455,6 +7709,70 @@ func CalculateWidth(typeStr string) int {
 	return 0
 }
 
+// vectorRangeRe captures the bounds of a vector type's index constraint,
+// e.g. "std_logic_vector(WIDTH-1 downto 0)" -> "WIDTH-1", "0".
+var vectorRangeRe = regexp.MustCompile(`(?i)\(\s*(.+?)\s+(?:downto|to)\s+(.+?)\s*\)\s*$`)
+
+// CalculateWidthWithConstants computes the bit width of a VHDL type like
+// CalculateWidth, but also resolves parameterized bounds such as
+// std_logic_vector(WIDTH-1 downto 0) using a constant map (lowercase name ->
+// value). Returns 0 when a bound references a symbol that cannot be resolved.
+func CalculateWidthWithConstants(typeStr string, constants map[string]int) int {
+	if width := CalculateWidth(typeStr); width > 0 || len(constants) == 0 {
+		return width
+	}
+
+	typeLower := strings.ToLower(strings.TrimSpace(typeStr))
+	if !strings.Contains(typeLower, "vector") &&
+		!strings.HasPrefix(typeLower, "unsigned") &&
+		!strings.HasPrefix(typeLower, "signed") {
+		return 0
+	}
+	match := vectorRangeRe.FindStringSubmatch(typeLower)
+	if match == nil {
+		return 0
+	}
+	left, ok := evaluateRangeExpr(match[1], constants, nil)
+	if !ok {
+		return 0
+	}
+	right, ok := evaluateRangeExpr(match[2], constants, nil)
+	if !ok {
+		return 0
+	}
+	width := left - right
+	if width < 0 {
+		width = -width
+	}
+	return width + 1
+}
+
+// sliceRangeRe splits a discrete range such as "7 downto 0" into its bounds.
+var sliceRangeRe = regexp.MustCompile(`(?i)^\s*(.+?)\s+(?:downto|to)\s+(.+?)\s*$`)
+
+// RangeWidth returns the number of elements in a slice range such as
+// "WIDTH-1 downto 0", resolving names through constants. Returns 0 when the
+// range is not a "downto"/"to" range or a bound cannot be evaluated.
+func RangeWidth(rangeExpr string, constants map[string]int) int {
+	match := sliceRangeRe.FindStringSubmatch(rangeExpr)
+	if match == nil {
+		return 0
+	}
+	left, ok := evaluateRangeExpr(match[1], constants, nil)
+	if !ok {
+		return 0
+	}
+	right, ok := evaluateRangeExpr(match[2], constants, nil)
+	if !ok {
+		return 0
+	}
+	width := left - right
+	if width < 0 {
+		width = -width
+	}
+	return width + 1
+}
+
 // extractBaseSignalName extracts the base signal name from an expression
 // Examples: "sig" -> "sig", "sig(0)" -> "sig", "sig(7 downto 0)" -> "sig"
 // Returns empty string for complex expressions or literals
@@ -2,6 +2,7 @@ package extractor
 
 import (
 	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -181,6 +182,246 @@ func TestExtractorE2EAssignmentsInstancesGenerates(t *testing.T) {
 	assertSignalInScope(t, facts.Signals, "ifsig", "rtl.gen_if")
 }
 
+func TestExtractorE2ESelectedAssignment(t *testing.T) {
+	fixture := fixturePath(t, "selected_assignment.vhd")
+
+	ext := New()
+	facts, err := ext.Extract(fixture)
+	if err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+
+	y := mustFindConcurrentAssignment(t, facts.ConcurrentAssignments, "y")
+	if y.Kind != "selected" || y.Selector != "sel" {
+		t.Fatalf("y: expected selected on sel, got %+v", y)
+	}
+	if !hasReadSignal(y.ReadSignals, "sel") || hasReadSignal(y.ReadSignals, "y") {
+		t.Fatalf("y reads: expected sel and not y, got %v", y.ReadSignals)
+	}
+	assertSelectedWaveforms(t, y.SelectedWaveforms, []SelectedWaveform{
+		{Waveform: "a", Choices: []string{`"00"`}},
+		{Waveform: "b", Choices: []string{"others"}},
+	})
+
+	// Labeled form with a multi-choice alternative
+	z := mustFindConcurrentAssignment(t, facts.ConcurrentAssignments, "z")
+	if z.Kind != "selected" || z.Selector != "sel" {
+		t.Fatalf("z: expected selected on sel, got %+v", z)
+	}
+	assertSelectedWaveforms(t, z.SelectedWaveforms, []SelectedWaveform{
+		{Waveform: "a", Choices: []string{`"00"`}},
+		{Waveform: "c", Choices: []string{`"01"`, `"10"`}},
+		{Waveform: "b", Choices: []string{"others"}},
+	})
+	if !z.HasOthers || strings.Join(z.Choices, "|") != `"00"|"01"|"10"` {
+		t.Fatalf("z: expected others and choices \"00\"|\"01\"|\"10\", got %v %v", z.HasOthers, z.Choices)
+	}
+
+	w := mustFindConcurrentAssignment(t, facts.ConcurrentAssignments, "w")
+	if w.HasOthers || strings.Join(w.Choices, "|") != `"00"|"01"|"10"|"11"` {
+		t.Fatalf("w: expected no others and four choices, got %v %v", w.HasOthers, w.Choices)
+	}
+}
+
+func assertSelectedWaveforms(t *testing.T, got, want []SelectedWaveform) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d selected waveforms, got %#v", len(want), got)
+	}
+	for i := range want {
+		if got[i].Waveform != want[i].Waveform || strings.Join(got[i].Choices, "|") != strings.Join(want[i].Choices, "|") {
+			t.Fatalf("waveform %d: expected %#v, got %#v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestExtractorE2ESliceAccesses(t *testing.T) {
+	fixture := fixturePath(t, "slice_accesses.vhd")
+
+	ext := New()
+	facts, err := ext.Extract(fixture)
+	if err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+
+	want := map[string]bool{
+		"din(3 downto 0)|downto|15":  true,
+		"dout(0 to 3)|to|16":         true,
+		"dout(7 downto 4)|downto|17": true,
+		"din(7 downto 4)|downto|17":  true,
+	}
+	for _, sa := range facts.SliceAccesses {
+		if sa.InArch != "rtl" {
+			t.Fatalf("expected slice in rtl, got %+v", sa)
+		}
+		delete(want, sa.Expr+"|"+sa.Direction+"|"+strconv.Itoa(sa.Line))
+	}
+	if len(want) != 0 {
+		t.Fatalf("missing slice accesses %v, got %+v", want, facts.SliceAccesses)
+	}
+}
+
+func TestExtractorE2ETranslateOffRegions(t *testing.T) {
+	fixture := fixturePath(t, "translate_off.vhd")
+
+	ext := New()
+	facts, err := ext.Extract(fixture)
+	if err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+
+	wantSignals := map[string]bool{"q_r": false, "sim_count": true, "nested": true, "still_off": true}
+	for _, sig := range facts.Signals {
+		if want, ok := wantSignals[sig.Name]; ok && sig.InSynthOffRegion != want {
+			t.Fatalf("signal %s: InSynthOffRegion = %v, want %v", sig.Name, sig.InSynthOffRegion, want)
+		}
+	}
+	for _, proc := range facts.Processes {
+		// The second translate_off is never closed and runs to end of file
+		if want := proc.Label == "monitor_p"; proc.InSynthOffRegion != want {
+			t.Fatalf("process %s: InSynthOffRegion = %v, want %v", proc.Label, proc.InSynthOffRegion, want)
+		}
+	}
+	for _, ca := range facts.ConcurrentAssignments {
+		if want := ca.Target == "nested"; ca.InSynthOffRegion != want {
+			t.Fatalf("assignment to %s: InSynthOffRegion = %v, want %v", ca.Target, ca.InSynthOffRegion, want)
+		}
+	}
+}
+
+func TestExtractorE2EResetPolarity(t *testing.T) {
+	fixture := fixturePath(t, "reset_polarity.vhd")
+
+	ext := New()
+	facts, err := ext.Extract(fixture)
+	if err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+
+	want := map[string]string{
+		"high_p":     "active_high",
+		"low_p":      "active_low",
+		"inverted_p": "active_low",
+		"bool_p":     "active_high",
+		"not_p":      "active_low",
+	}
+	for _, proc := range facts.Processes {
+		expected, ok := want[proc.Label]
+		if !ok {
+			continue
+		}
+		if !proc.HasReset || proc.ResetPolarity != expected {
+			t.Fatalf("process %s: HasReset=%v ResetPolarity=%q, want %q", proc.Label, proc.HasReset, proc.ResetPolarity, expected)
+		}
+		delete(want, proc.Label)
+	}
+	if len(want) != 0 {
+		t.Fatalf("missing processes %v", want)
+	}
+	for _, ri := range facts.ResetInfos {
+		if ri.Process == "low_p" && ri.Polarity != "active_low" {
+			t.Fatalf("expected ResetInfo polarity from the comparison, got %+v", ri)
+		}
+	}
+}
+
+func TestExtractorE2EAssertions(t *testing.T) {
+	fixture := fixturePath(t, "assertions.vhd")
+
+	ext := New()
+	facts, err := ext.Extract(fixture)
+	if err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+
+	want := []Assertion{
+		{Condition: "clk /= 'X'", ReportMessage: `"clock is X"`, Severity: "failure", Line: 11, InArch: "sim"},
+		{Condition: "done", ReportMessage: `"not done"`, Severity: "error", Line: 16, InProcess: "check_p", InArch: "sim"},
+		{Condition: "clk = '0'", Severity: "warning", Line: 17, InProcess: "check_p", InArch: "sim"},
+		{ReportMessage: `"finished"`, Severity: "note", Line: 18, InProcess: "check_p", InArch: "sim"},
+	}
+	if len(facts.Assertions) != len(want) {
+		t.Fatalf("expected %d assertions, got %+v", len(want), facts.Assertions)
+	}
+	for i, a := range facts.Assertions {
+		if a != want[i] {
+			t.Fatalf("assertion %d: got %+v, want %+v", i, a, want[i])
+		}
+	}
+}
+
+func TestExtractorE2EAliases(t *testing.T) {
+	fixture := fixturePath(t, "aliases.vhd")
+
+	ext := New()
+	facts, err := ext.Extract(fixture)
+	if err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+
+	want := []AliasDeclaration{
+		{Name: "ready", Target: "status(0)", Type: "std_logic", Line: 13, InArch: "rtl"},
+		{Name: "flags", Target: "status(7 downto 4)", Type: "std_logic_vector(3 downto 0)", Line: 14, InArch: "rtl"},
+	}
+	if len(facts.Aliases) != len(want) {
+		t.Fatalf("expected %d aliases, got %+v", len(want), facts.Aliases)
+	}
+	for i, a := range facts.Aliases {
+		if a != want[i] {
+			t.Fatalf("alias %d: got %+v, want %+v", i, a, want[i])
+		}
+	}
+
+	var concurrent *ConcurrentAssignment
+	for i := range facts.ConcurrentAssignments {
+		if facts.ConcurrentAssignments[i].Line == 16 {
+			concurrent = &facts.ConcurrentAssignments[i]
+		}
+	}
+	if concurrent == nil || concurrent.Target != "status" {
+		t.Fatalf("expected assignment through alias to target status, got %+v", facts.ConcurrentAssignments)
+	}
+	for _, proc := range facts.Processes {
+		if proc.Label != "reg_p" {
+			continue
+		}
+		if !containsAllSignals(proc.AssignedSignals, []string{"status"}) || containsAllSignals(proc.AssignedSignals, []string{"flags"}) {
+			t.Fatalf("expected reg_p to assign status, got %v", proc.AssignedSignals)
+		}
+		if !containsAllSignals(proc.ReadSignals, []string{"status"}) {
+			t.Fatalf("expected reg_p to read status, got %v", proc.ReadSignals)
+		}
+	}
+}
+
+func TestExtractorE2EAttributes(t *testing.T) {
+	fixture := fixturePath(t, "attributes.vhd")
+
+	ext := New()
+	facts, err := ext.Extract(fixture)
+	if err != nil {
+		t.Fatalf("extract: %v", err)
+	}
+
+	want := []AttributeSpec{
+		{Attribute: "keep", Type: "boolean", Line: 14, InArch: "rtl"},
+		{Attribute: "keep", Target: "dbg_a", TargetKind: "signal", Value: "true", Line: 15, InArch: "rtl"},
+		{Attribute: "keep", Target: "dbg_b", TargetKind: "signal", Value: "true", Line: 15, InArch: "rtl"},
+		{Attribute: "async_reg", Type: "string", Line: 16, InArch: "rtl"},
+		{Attribute: "async_reg", Target: "sync_r", TargetKind: "signal", Value: `"TRUE"`, Line: 17, InArch: "rtl"},
+		{Attribute: "my_note", Type: "string", Line: 18, InArch: "rtl"},
+		{Attribute: "my_note", Target: "others", TargetKind: "signal", Value: `"spare"`, Line: 19, InArch: "rtl"},
+	}
+	if len(facts.AttributeSpecs) != len(want) {
+		t.Fatalf("expected %d attribute specs, got %+v", len(want), facts.AttributeSpecs)
+	}
+	for i, a := range facts.AttributeSpecs {
+		if a != want[i] {
+			t.Fatalf("attribute spec %d: got %+v, want %+v", i, a, want[i])
+		}
+	}
+}
+
 func TestExtractorE2ECDCARithCompare(t *testing.T) {
 	fixture := fixturePath(t, "cdc_arith_compare.vhd")
 
@@ -1164,3 +1405,42 @@ func countAssignmentsTo(assigns []ConcurrentAssignment, target string) int {
 	}
 	return count
 }
+
+func TestExtractorE2EExtractAt(t *testing.T) {
+	fixture := fixturePath(t, "ports_signals_process.vhd")
+	ext := New()
+
+	info, err := ext.ExtractAt(fixture, 25, 9)
+	if err != nil {
+		t.Fatalf("extract at process: %v", err)
+	}
+	if info.Kind != "process" || info.Name != "p_sync" || info.Process == nil {
+		t.Fatalf("expected process p_sync, got %+v", info)
+	}
+	if info.InArch != "rtl" || info.Line != 20 || info.EndLine != 27 {
+		t.Fatalf("unexpected process location: %+v", info)
+	}
+	if info.Process.ClockSignal != "clk" {
+		t.Fatalf("expected clock clk, got %q", info.Process.ClockSignal)
+	}
+
+	info, err = ext.ExtractAt(fixture, 17, 13)
+	if err != nil {
+		t.Fatalf("extract at signal: %v", err)
+	}
+	if info.Kind != "signal" || info.Signal == nil || info.Name != "b" {
+		t.Fatalf("expected signal b, got %+v", info)
+	}
+
+	info, err = ext.ExtractAt(fixture, 7, 5)
+	if err != nil {
+		t.Fatalf("extract at entity: %v", err)
+	}
+	if info.Kind != "entity" || info.Name != "demo" || info.Entity == nil {
+		t.Fatalf("expected entity demo, got %+v", info)
+	}
+
+	if _, err := ext.ExtractAt(fixture, 1, 1); err == nil {
+		t.Fatalf("expected no construct at 1:1")
+	}
+}
@@ -3,6 +3,7 @@ package extractor
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -305,6 +306,12 @@ begin
     wait until rising_edge(clk);
     y <= r2;
   end process;
+
+  p_stim : process
+  begin
+    wait for 10 ns;
+    wait;
+  end process;
 end;
 `
 
@@ -330,6 +337,14 @@ end;
 	if !pWait.HasWait || pWait.IsCombinational {
 		t.Fatalf("expected p_wait to use wait and not be combinational, got %#v", pWait)
 	}
+	if !pWait.IsSequential || !strings.EqualFold(pWait.ClockSignal, "clk") || pWait.ClockEdge != "rising" || pWait.IsStimulus {
+		t.Fatalf("expected p_wait to be sequential on rising clk, got %#v", pWait)
+	}
+
+	pStim := mustFindProcess(t, facts.Processes, "p_stim")
+	if !pStim.IsStimulus || pStim.IsSequential || pStim.IsCombinational {
+		t.Fatalf("expected p_stim to be a stimulus process, got %#v", pStim)
+	}
 
 	if !hasClockDomainForProc(facts.ClockDomains, "clk", "p_seq") {
 		t.Fatalf("expected clock domain for clk in p_seq")
@@ -352,6 +367,65 @@ end;
 	}
 }
 
+func TestWaitClockEdge(t *testing.T) {
+	cases := []struct {
+		until, clock, edge string
+	}{
+		{"rising_edge(clk)", "clk", "rising"},
+		{"Falling_Edge( sys_clk ) and en = '1'", "sys_clk", "falling"},
+		{"clk'event and clk = '0'", "clk", "falling"},
+		{"clk = '1' and clk'event", "clk", "rising"},
+		{"clk'event", "clk", "rising"},
+		{"done = '1'", "", ""},
+		{"", "", ""},
+	}
+	for _, tc := range cases {
+		clock, edge := waitClockEdge(tc.until)
+		if clock != tc.clock || edge != tc.edge {
+			t.Errorf("waitClockEdge(%q) = %q, %q; want %q, %q", tc.until, clock, edge, tc.clock, tc.edge)
+		}
+	}
+}
+
+func TestEstimateBitWidth(t *testing.T) {
+	cases := []struct {
+		literal string
+		bits    int
+	}{
+		{`X"DEADBEEF"`, 32},
+		{`B"1010"`, 4},
+		{`O"777"`, 9},
+		{"255", 8},
+		{"256", 9},
+		{"0", 1},
+		{"1_000_000", 20},
+		{"16#FF#", 8},
+		{"2#1010#", 4},
+		{"4294967295", 32},
+		{"ident", 0},
+	}
+	for _, tc := range cases {
+		if got := estimateBitWidth(tc.literal); got != tc.bits {
+			t.Errorf("estimateBitWidth(%q) = %d, want %d", tc.literal, got, tc.bits)
+		}
+	}
+}
+
+func TestIsStimulusProcess(t *testing.T) {
+	stim := &Process{WaitStatements: []WaitStatement{{ForExpr: "10 ns"}, {}}}
+	if !isStimulusProcess(stim) {
+		t.Fatalf("expected wait for delays to be stimulus")
+	}
+	bare := &Process{WaitStatements: []WaitStatement{{}}}
+	reactive := &Process{WaitStatements: []WaitStatement{{ForExpr: "10 ns"}, {UntilExpr: "done = '1'"}}}
+	sensitive := &Process{WaitStatements: []WaitStatement{{OnSignals: []string{"req"}}}}
+	for _, proc := range []*Process{bare, reactive, sensitive} {
+		if isStimulusProcess(proc) {
+			t.Fatalf("expected %#v not to be stimulus", proc.WaitStatements)
+		}
+	}
+}
+
 func TestExtractorPortMapPreservesSlices(t *testing.T) {
 	vhdl := `library ieee;
 use ieee.std_logic_1164.all;
@@ -588,6 +662,188 @@ end;
 	}
 }
 
+func TestExtractorPartialAssignments(t *testing.T) {
+	vhdl := `library ieee;
+use ieee.std_logic_1164.all;
+
+entity latch_top is
+  port(
+    a   : in std_logic;
+    b   : in std_logic;
+    sel : in std_logic_vector(1 downto 0);
+    q   : out std_logic;
+    r   : out std_logic;
+    s   : out std_logic;
+    t   : out std_logic
+  );
+end;
+
+architecture rtl of latch_top is
+begin
+  p_comb: process(a, b, sel)
+  begin
+    t <= '0';
+    if a = '1' then
+      q <= b;
+      t <= b;
+    elsif b = '1' then
+      q <= a;
+    end if;
+    case sel is
+      when "00" => r <= a;
+      when "01" | "10" => r <= b;
+      when others => null;
+    end case;
+    if a = '1' then
+      s <= b;
+    else
+      s <= a;
+    end if;
+  end process;
+end;
+`
+
+	facts := parseVHDL(t, vhdl)
+	proc := findProcess(t, facts.Processes, "p_comb")
+	want := []PartialAssignment{
+		{Signal: "q", Line: 21, MissingBranch: "else"},
+		{Signal: "r", Line: 27, MissingBranch: "when others"},
+	}
+	if !reflect.DeepEqual(proc.PartialAssignments, want) {
+		t.Fatalf("got %+v, want %+v", proc.PartialAssignments, want)
+	}
+}
+
+func TestExtractorSignalAccesses(t *testing.T) {
+	vhdl := `library ieee;
+use ieee.std_logic_1164.all;
+
+entity order_top is
+  port(
+    a : in std_logic;
+    b : in std_logic;
+    y : out std_logic
+  );
+end;
+
+architecture rtl of order_top is
+  signal tmp : std_logic;
+begin
+  p_comb: process(a, b, tmp)
+  begin
+    y <= tmp;
+    if b = '1' then
+      y <= a;
+    end if;
+    tmp <= a and b;
+  end process;
+end;
+`
+
+	facts := parseVHDL(t, vhdl)
+	proc := findProcess(t, facts.Processes, "p_comb")
+	want := []SignalAccess{
+		{Signal: "a", FirstRead: 19},
+		{Signal: "b", FirstRead: 18},
+		{Signal: "tmp", FirstRead: 17, FirstWrite: 21, Writes: 1},
+		{Signal: "y", FirstWrite: 17, Writes: 2},
+	}
+	if !reflect.DeepEqual(proc.SignalAccesses, want) {
+		t.Fatalf("got %+v, want %+v", proc.SignalAccesses, want)
+	}
+	if len(facts.ReadsBeforeWrite) != 1 || facts.ReadsBeforeWrite[0].Signal != "tmp" {
+		t.Fatalf("expected tmp read before write, got %+v", facts.ReadsBeforeWrite)
+	}
+}
+
+func TestExtractorTristateAssignments(t *testing.T) {
+	vhdl := `library ieee;
+use ieee.std_logic_1164.all;
+
+entity tri_top is
+  port(
+    oe    : in std_logic;
+    d     : in std_logic_vector(3 downto 0);
+    pad   : inout std_logic_vector(3 downto 0)
+  );
+end;
+
+architecture rtl of tri_top is
+  signal bus_a : std_logic_vector(3 downto 0);
+  signal bus_b : std_logic;
+begin
+  pad <= d when oe = '1' else (others => 'Z');
+  bus_a <= "ZZZZ";
+
+  p_drv: process(oe, d)
+  begin
+    bus_b <= 'Z';
+    if oe = '1' then
+      bus_b <= d(0);
+    end if;
+  end process;
+end;
+`
+
+	facts := parseVHDL(t, vhdl)
+	want := []TristateAssignment{
+		{Target: "pad", Line: 16, InArch: "rtl", Conditional: true},
+		{Target: "bus_a", Line: 17, InArch: "rtl"},
+		{Target: "bus_b", Line: 21, InProcess: "p_drv", InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(facts.TristateAssignments, want) {
+		t.Fatalf("got %+v, want %+v", facts.TristateAssignments, want)
+	}
+}
+
+func TestExtractorProcessLoops(t *testing.T) {
+	vhdl := `library ieee;
+use ieee.std_logic_1164.all;
+
+entity loop_top is
+  port(
+    clk  : in std_logic;
+    data : in std_logic_vector(7 downto 0);
+    q    : out std_logic
+  );
+end;
+
+architecture rtl of loop_top is
+begin
+  p_reduce: process(clk)
+    variable acc : std_logic;
+  begin
+    if rising_edge(clk) then
+      acc := '0';
+      scan: for i in 0 to 7 loop
+        acc := acc xor data(i);
+      end loop scan;
+      for j in data'range loop
+        acc := acc or data(j);
+      end loop;
+      while acc = '1' loop
+        acc := '0';
+      end loop;
+      q <= acc;
+    end if;
+  end process;
+end;
+`
+
+	facts := parseVHDL(t, vhdl)
+	if len(facts.Loops) != 2 {
+		t.Fatalf("expected 2 for loops, got %+v", facts.Loops)
+	}
+	scan := facts.Loops[0]
+	if scan.LoopVar != "i" || scan.RangeLow != "0" || scan.RangeHigh != "7" || scan.RangeDir != "to" ||
+		scan.Line != 19 || scan.InProcess != "p_reduce" || scan.InArch != "rtl" {
+		t.Fatalf("unexpected loop: %+v", scan)
+	}
+	if attr := facts.Loops[1]; attr.LoopVar != "j" || attr.RangeLow != "data'range" || attr.RangeDir != "" {
+		t.Fatalf("unexpected attribute-range loop: %+v", attr)
+	}
+}
+
 func TestExtractorConcurrentAssignmentKinds(t *testing.T) {
 	vhdl := `library ieee;
 use ieee.std_logic_1164.all;
@@ -967,3 +1223,97 @@ func hasCDCCrossing(crossings []CDCCrossing, signal, srcClock, dstClock string,
 	}
 	return false
 }
+
+func TestParseSuppression(t *testing.T) {
+	tests := []struct {
+		comment string
+		want    []Suppression
+	}{
+		{"-- vhdl-lint: disable=latch_inference", []Suppression{{Rule: "latch_inference", Line: 7, Scope: "line"}}},
+		{"-- vhdl-lint: disable-next-line=cdc_unsync, magic_number", []Suppression{
+			{Rule: "cdc_unsync", Line: 7, Scope: "next-line"},
+			{Rule: "magic_number", Line: 7, Scope: "next-line"},
+		}},
+		{"-- VHDL-LINT: disable-file=Magic_Number", []Suppression{{Rule: "magic_number", Line: 7, Scope: "file"}}},
+		{"-- vhdl-lint: disable", []Suppression{{Line: 7, Scope: "line"}}},
+		{"-- ordinary comment", nil},
+	}
+	for _, tt := range tests {
+		got := parseSuppression(tt.comment, 7)
+		if len(got) != len(tt.want) {
+			t.Fatalf("parseSuppression(%q) = %+v, want %+v", tt.comment, got, tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Fatalf("parseSuppression(%q)[%d] = %+v, want %+v", tt.comment, i, got[i], tt.want[i])
+			}
+		}
+	}
+
+	next := Suppression{Rule: "cdc_unsync", Line: 7, Scope: "next-line"}
+	if !next.Suppresses("cdc_unsync", 8) || next.Suppresses("cdc_unsync", 7) || next.Suppresses("latch_inference", 8) {
+		t.Fatalf("next-line suppression matched the wrong line or rule")
+	}
+	if all := (Suppression{Line: 7, Scope: "line"}); !all.Suppresses("anything", 7) {
+		t.Fatalf("bare disable should suppress every rule on its line")
+	}
+}
+
+func TestExtractorBlockStatements(t *testing.T) {
+	vhdl := `library ieee;
+use ieee.std_logic_1164.all;
+
+entity blk_top is
+  port(
+    clk : in std_logic;
+    en  : in std_logic;
+    d   : in std_logic;
+    q   : out std_logic
+  );
+end;
+
+architecture rtl of blk_top is
+  signal top_s : std_logic;
+begin
+  gated: block (en = '1')
+    signal inner_s : std_logic;
+  begin
+    inner_s <= guarded d;
+    plain: block
+      signal deep_s : std_logic;
+    begin
+      deep_s <= inner_s;
+    end block plain;
+  end block gated;
+
+  q <= top_s;
+end;
+`
+
+	facts := parseVHDL(t, vhdl)
+	scopes := make(map[string]string)
+	for _, sig := range facts.Signals {
+		scopes[sig.Name] = sig.InEntity
+	}
+	wantScopes := map[string]string{"top_s": "rtl", "inner_s": "rtl.gated", "deep_s": "rtl.gated.plain"}
+	if !reflect.DeepEqual(scopes, wantScopes) {
+		t.Fatalf("signal scopes = %v, want %v", scopes, wantScopes)
+	}
+
+	if len(facts.Blocks) != 2 {
+		t.Fatalf("expected 2 blocks, got %+v", facts.Blocks)
+	}
+	plain, gated := facts.Blocks[0], facts.Blocks[1]
+	if plain.Label != "plain" || plain.InArch != "rtl.gated" || plain.GuardExpr != "" || plain.Line != 20 {
+		t.Fatalf("unexpected nested block: %+v", plain)
+	}
+	if gated.Label != "gated" || gated.InArch != "rtl" || gated.GuardExpr != "en = '1'" || gated.Line != 16 {
+		t.Fatalf("unexpected guarded block: %+v", gated)
+	}
+	if len(gated.Signals) != 2 || len(gated.ConcurrentAssignments) != 2 {
+		t.Fatalf("guarded block should hold its own and nested contents: %+v", gated)
+	}
+	if ca := gated.ConcurrentAssignments[0]; ca.Target != "inner_s" || ca.InArch != "rtl.gated" {
+		t.Fatalf("unexpected block assignment: %+v", ca)
+	}
+}
@@ -0,0 +1,74 @@
+package extractor
+
+import "strings"
+
+// Feedthrough is an output port driven straight from an input port by a
+// concurrent assignment, with no logic in between.
+type Feedthrough struct {
+	Input  string // Input port read
+	Output string // Output port driven
+	Line   int
+	InArch string
+}
+
+// DetectFeedthroughs flags simple concurrent assignments of a whole input
+// port to a whole output port of the same entity ("y_o <= a_i;"). A wire
+// through a level of hierarchy is sometimes intended, but often marks a
+// missing register or a stub left behind. Values with any operator,
+// conversion, slice, or delay are logic and are not reported, nor are
+// assignments in synthesis-off regions.
+func DetectFeedthroughs(facts *FileFacts) []Feedthrough {
+	entityOf := make(map[string]string)
+	for _, a := range facts.Architectures {
+		entityOf[strings.ToLower(a.Name)] = strings.ToLower(a.EntityName)
+	}
+	type portKey struct{ entity, name string }
+	ports := make(map[portKey]Port)
+	for _, p := range facts.Ports {
+		ports[portKey{strings.ToLower(p.InEntity), strings.ToLower(p.Name)}] = p
+	}
+	direction := func(entity, name string) string {
+		return strings.ToLower(ports[portKey{entity, strings.ToLower(name)}].Direction)
+	}
+
+	// Concurrent assignment drives by line and target, for their values
+	type driveKey struct {
+		line   int
+		target string
+	}
+	drives := make(map[driveKey]AssignmentDrive)
+	for _, d := range facts.AssignmentDrives {
+		if d.InProcess == "" {
+			drives[driveKey{d.Line, strings.ToLower(d.Target)}] = d
+		}
+	}
+
+	var found []Feedthrough
+	for _, ca := range facts.ConcurrentAssignments {
+		if ca.Kind != "simple" || ca.InSynthOffRegion || len(ca.ReadSignals) != 1 {
+			continue
+		}
+		entity, ok := entityOf[strings.ToLower(archBase(ca.InArch))]
+		if !ok || direction(entity, ca.Target) != "out" {
+			continue
+		}
+		drive, ok := drives[driveKey{ca.Line, strings.ToLower(ca.Target)}]
+		if !ok || !drive.Whole || len(drive.Values) != 1 {
+			continue
+		}
+		value := strings.TrimSpace(drive.Values[0])
+		for len(value) > 2 && value[0] == '(' && value[len(value)-1] == ')' {
+			value = strings.TrimSpace(value[1 : len(value)-1])
+		}
+		if !strings.EqualFold(value, ca.ReadSignals[0]) || direction(entity, value) != "in" {
+			continue
+		}
+		found = append(found, Feedthrough{
+			Input:  ports[portKey{entity, strings.ToLower(value)}].Name,
+			Output: ca.Target,
+			Line:   ca.Line,
+			InArch: ca.InArch,
+		})
+	}
+	return found
+}
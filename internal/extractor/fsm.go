@@ -0,0 +1,151 @@
+package extractor
+
+import "strings"
+
+// FSMIssue reports an enum state that a state machine never enters or never
+// decodes. A state machine is the set of signals of one enum type that some
+// case statement selects on (typically state and next_state).
+type FSMIssue struct {
+	Signal string // State signal the case statement selects on
+	Type   string // Enum type of the state signals
+	State  string // Enum literal as declared
+	Kind   string // "unreachable" (never assigned) or "dead" (never decoded)
+	Line   int    // Declaration line of Signal
+	InArch string // Entity/architecture declaring Signal
+}
+
+// AnalyzeStateMachines correlates the literals of each enum type declared in
+// the file against the case statements selecting on signals of that type
+// and the values assigned to them. A literal no assignment drives is
+// unreachable; one no case choice names is dead. Selected assignments and
+// equality comparisons on a state signal also decode a state. The leftmost
+// literal is the power-up value, so it is never unreachable, and types whose
+// signals are never assigned a literal (driven by ports or functions) are
+// only checked for dead states.
+func AnalyzeStateMachines(facts *FileFacts) []FSMIssue {
+	if len(facts.Types) == 0 || len(facts.Signals) == 0 {
+		return nil
+	}
+
+	// Enum-typed signals, grouped by lowercase type name
+	enums := make(map[string]TypeDeclaration)
+	for _, t := range facts.Types {
+		if t.Kind == "enum" && len(t.EnumLiterals) > 0 {
+			enums[strings.ToLower(t.Name)] = t
+		}
+	}
+	signalsByType := make(map[string][]Signal)
+	for _, sig := range facts.Signals {
+		typeName := normalizeTypeMark(sig.Type)
+		if _, ok := enums[typeName]; ok {
+			signalsByType[typeName] = append(signalsByType[typeName], sig)
+		}
+	}
+
+	var issues []FSMIssue
+	for _, t := range facts.Types {
+		typeName := strings.ToLower(t.Name)
+		sigs := signalsByType[typeName]
+		if t.Kind != "enum" || len(sigs) == 0 {
+			continue
+		}
+		stateSignals := make(map[string]Signal, len(sigs))
+		for _, sig := range sigs {
+			stateSignals[strings.ToLower(sig.Name)] = sig
+		}
+		literals := make(map[string]bool, len(t.EnumLiterals))
+		for _, lit := range t.EnumLiterals {
+			literals[strings.ToLower(lit)] = true
+		}
+
+		// Without a case statement over the type there is no state machine
+		var selector *Signal
+		decoded := make(map[string]bool)
+		for _, cs := range facts.CaseStatements {
+			sig, ok := stateSignals[strings.ToLower(strings.TrimSpace(cs.Expression))]
+			if !ok {
+				continue
+			}
+			if selector == nil {
+				selector = &sig
+			}
+			for _, choice := range cs.Choices {
+				for _, alt := range strings.Split(choice, "|") {
+					decoded[strings.ToLower(strings.TrimSpace(alt))] = true
+				}
+			}
+		}
+		if selector == nil {
+			continue
+		}
+		for _, ca := range facts.ConcurrentAssignments {
+			if _, ok := stateSignals[strings.ToLower(strings.TrimSpace(ca.Selector))]; !ok {
+				continue
+			}
+			for _, wf := range ca.SelectedWaveforms {
+				for _, choice := range wf.Choices {
+					decoded[strings.ToLower(strings.TrimSpace(choice))] = true
+				}
+			}
+		}
+		for _, cmp := range facts.Comparisons {
+			if cmp.Operator != "=" && cmp.Operator != "/=" {
+				continue
+			}
+			left := strings.ToLower(strings.TrimSpace(cmp.LeftOperand))
+			right := strings.ToLower(strings.TrimSpace(cmp.RightOperand))
+			if _, ok := stateSignals[left]; ok && literals[right] {
+				decoded[right] = true
+			}
+			if _, ok := stateSignals[right]; ok && literals[left] {
+				decoded[left] = true
+			}
+		}
+
+		assigned := make(map[string]bool)
+		for _, drive := range facts.AssignmentDrives {
+			if _, ok := stateSignals[strings.ToLower(drive.Target)]; !ok {
+				continue
+			}
+			for _, v := range drive.Values {
+				if v = strings.ToLower(strings.TrimSpace(v)); literals[v] {
+					assigned[v] = true
+				}
+			}
+		}
+
+		for i, lit := range t.EnumLiterals {
+			lower := strings.ToLower(lit)
+			issue := FSMIssue{
+				Signal: selector.Name,
+				Type:   t.Name,
+				State:  lit,
+				Line:   selector.Line,
+				InArch: selector.InEntity,
+			}
+			if i > 0 && len(assigned) > 0 && !assigned[lower] {
+				issue.Kind = "unreachable"
+				issues = append(issues, issue)
+			}
+			if !decoded[lower] {
+				issue.Kind = "dead"
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues
+}
+
+// normalizeTypeMark lowercases a type indication and drops any library or
+// package prefix. Constrained indications are not enum type marks and
+// return "".
+func normalizeTypeMark(typeStr string) string {
+	mark := strings.ToLower(strings.TrimSpace(typeStr))
+	if strings.ContainsAny(mark, "( ") {
+		return ""
+	}
+	if dot := strings.LastIndex(mark, "."); dot != -1 {
+		mark = mark[dot+1:]
+	}
+	return mark
+}
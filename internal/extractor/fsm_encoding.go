@@ -0,0 +1,110 @@
+package extractor
+
+import "strings"
+
+// FSMEncoding is a synthesis encoding attribute ("enum_encoding",
+// "fsm_encoding", or "syn_encoding") applied to an enumeration type, either
+// directly or through a signal of that type.
+type FSMEncoding struct {
+	TypeName   string
+	Signal     string // Signal the attribute targets ("" when it targets the type)
+	Attribute  string // Attribute name, lowercase
+	Encoding   string // "one-hot", "gray", "binary", "johnson", "auto", "user", or the value as written
+	Value      string // Attribute value as written
+	StateCount int    // Number of enumeration literals
+	Line       int    // Line of the attribute specification
+	InArch     string
+	InPackage  string
+}
+
+// encodingAttributes are the vendor attributes that select a state encoding.
+var encodingAttributes = map[string]bool{
+	"enum_encoding": true, "fsm_encoding": true, "syn_encoding": true,
+}
+
+// DetectFSMEncodings joins encoding attribute specifications with the
+// enumeration types declared in the same file. Attributes on a type are
+// matched by type name; attributes on a signal are matched through the
+// signal's declared type. Attributes on other targets, or on types that are
+// not enumerations, are skipped.
+func DetectFSMEncodings(facts *FileFacts) []FSMEncoding {
+	enums := make(map[string]TypeDeclaration)
+	for _, t := range facts.Types {
+		if t.Kind == "enum" {
+			enums[strings.ToLower(t.Name)] = t
+		}
+	}
+	signalTypes := make(map[string]string)
+	for _, sig := range facts.Signals {
+		signalTypes[strings.ToLower(sig.Name)] = typeBaseName(sig.Type)
+	}
+
+	var found []FSMEncoding
+	for _, spec := range facts.AttributeSpecs {
+		if !encodingAttributes[spec.Attribute] || spec.Target == "" {
+			continue
+		}
+		enc := FSMEncoding{
+			Attribute: spec.Attribute,
+			Encoding:  normalizeEncoding(spec.Value),
+			Value:     spec.Value,
+			Line:      spec.Line,
+		}
+		typeName := strings.ToLower(spec.Target)
+		switch spec.TargetKind {
+		case "type", "subtype":
+		case "signal":
+			enc.Signal = spec.Target
+			typeName = signalTypes[typeName]
+		default:
+			continue
+		}
+		t, ok := enums[typeName]
+		if !ok {
+			continue
+		}
+		enc.TypeName = t.Name
+		enc.StateCount = len(t.EnumLiterals)
+		enc.InArch = t.InArch
+		enc.InPackage = t.InPackage
+		found = append(found, enc)
+	}
+	return found
+}
+
+// typeBaseName returns the lowercase type mark of a subtype indication,
+// without library or package prefixes and constraints.
+func typeBaseName(indication string) string {
+	name := strings.ToLower(strings.TrimSpace(indication))
+	if i := strings.IndexAny(name, " ("); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// normalizeEncoding maps the vendor spellings of an encoding attribute value
+// onto a common name. A value made only of bit strings ("000 001 011") is an
+// explicit user encoding.
+func normalizeEncoding(value string) string {
+	v := strings.ToLower(strings.Trim(strings.TrimSpace(value), `"`))
+	v = strings.Join(strings.Fields(v), " ")
+	switch strings.NewReplacer("_", "", "-", "", " ", "").Replace(v) {
+	case "onehot":
+		return "one-hot"
+	case "gray":
+		return "gray"
+	case "binary", "sequential", "compact":
+		return "binary"
+	case "johnson":
+		return "johnson"
+	case "auto", "default", "none":
+		return "auto"
+	}
+	if v != "" && strings.Trim(v, "01 ") == "" {
+		return "user"
+	}
+	return v
+}
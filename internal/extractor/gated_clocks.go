@@ -0,0 +1,47 @@
+package extractor
+
+import "strings"
+
+// GatedClock is a clock produced by logic in the design rather than entering
+// on an input port.
+type GatedClock struct {
+	Clock      string // Clock signal as the clocked process names it
+	Expression string // Value the assignment drives; the waveforms of a conditional or selected assignment are joined with ", "
+	Line       int    // Line of the assignment
+	InProcess  string // Driving process; empty for a concurrent assignment
+	InArch     string
+}
+
+// DetectGatedClocks flags every assignment whose target some process uses as
+// a clock. A clock driven by an assignment comes out of a gate, mux, or
+// divider register instead of a primary input or clock buffer, which skews
+// it against the clocks it is meant to align with. Names of input ports are
+// exempt, since the port is the clock's source.
+func DetectGatedClocks(facts *FileFacts) []GatedClock {
+	clocks := clockNames(facts)
+	if len(clocks) == 0 {
+		return nil
+	}
+	inputs := make(map[string]bool)
+	for _, p := range facts.Ports {
+		if strings.EqualFold(p.Direction, "in") {
+			inputs[strings.ToLower(p.Name)] = true
+		}
+	}
+
+	var found []GatedClock
+	for _, drive := range facts.AssignmentDrives {
+		name := strings.ToLower(drive.Target)
+		if !clocks[name] || inputs[name] {
+			continue
+		}
+		found = append(found, GatedClock{
+			Clock:      drive.Target,
+			Expression: strings.Join(drive.Values, ", "),
+			Line:       drive.Line,
+			InProcess:  drive.InProcess,
+			InArch:     drive.InArch,
+		})
+	}
+	return found
+}
@@ -0,0 +1,203 @@
+package extractor
+
+import (
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// AssignmentDrive summarizes the values one signal assignment can drive onto
+// its target. Every waveform of a conditional or selected assignment counts;
+// conditions, choices, and after-delays do not.
+type AssignmentDrive struct {
+	Target    string   // Assigned signal (base name)
+	Whole     bool     // The target is the bare signal name, not an index, slice, or field
+	HighZ     bool     // Every driven value is high-impedance ('Z', "ZZZZ", (others => 'Z'))
+	Values    []string // Every value the assignment can drive, as written
+	Line      int
+	InProcess string // Empty for concurrent assignments
+	InArch    string
+}
+
+// TristateAssignment is a signal assignment that drives high-impedance ('Z',
+// "ZZZZ", (others => 'Z')) in at least one waveform. Conditional is true when
+// the assignment is guarded (a conditional or selected waveform, or a
+// sequential assignment under if/case), the legal output-enable pattern.
+type TristateAssignment struct {
+	Target      string // Assigned signal (base name)
+	Line        int
+	InProcess   string // Empty for concurrent assignments
+	InArch      string
+	Conditional bool
+}
+
+var (
+	highZStringRe    = regexp.MustCompile(`^[box]?"[z_]+"$`)
+	highZAggregateRe = regexp.MustCompile(`^\(others=>(.+)\)$`)
+	tristateStringRe = regexp.MustCompile(`^[box]?"[z\-_]*z[z\-_]*"$`)
+	whenKeywordRe    = regexp.MustCompile(`(?i)\bwhen\b`)
+)
+
+// extractAssignmentDrives records, for each signal assignment under node,
+// whether every value it drives is high-impedance, and records assignments
+// that drive 'Z' in any waveform as tri-state. The grammar hides the
+// when/else/after keywords, so waveforms are separated from conditions,
+// choices, and delays by the source text between children.
+func (e *Extractor) extractAssignmentDrives(node *sitter.Node, source []byte, archContext, processLabel string, facts *FileFacts) {
+	var walk func(n *sitter.Node, guarded bool)
+	walk = func(n *sitter.Node, guarded bool) {
+		if n == nil {
+			return
+		}
+		switch n.Type() {
+		case "if_statement", "case_statement":
+			guarded = true
+		case "signal_assignment", "sequential_signal_assignment":
+			target, ok := e.extractAssignmentTarget(n, source)
+			if !ok || target == "" {
+				return
+			}
+			values := assignmentWaveformValues(n, source)
+			if len(values) == 0 {
+				return
+			}
+			highZ, tristate := true, false
+			for _, v := range values {
+				if !isHighZValue(v) {
+					highZ = false
+				}
+				if isTristateValue(v) {
+					tristate = true
+				}
+			}
+			line := int(n.StartPoint().Row) + 1
+			targetNode := n.ChildByFieldName("target")
+			facts.AssignmentDrives = append(facts.AssignmentDrives, AssignmentDrive{
+				Target:    target,
+				Whole:     targetNode != nil && strings.EqualFold(strings.TrimSpace(targetNode.Content(source)), target),
+				HighZ:     highZ,
+				Values:    values,
+				Line:      line,
+				InProcess: processLabel,
+				InArch:    archContext,
+			})
+			if tristate {
+				facts.TristateAssignments = append(facts.TristateAssignments, TristateAssignment{
+					Target:      target,
+					Line:        line,
+					InProcess:   processLabel,
+					InArch:      archContext,
+					Conditional: guarded || len(values) > 1 || whenKeywordRe.MatchString(n.Content(source)),
+				})
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i), guarded)
+		}
+	}
+	walk(node, false)
+}
+
+// assignmentWaveformValues returns the source text of each value an
+// assignment can drive. "unaffected" and force/release forms drive nothing.
+func assignmentWaveformValues(n *sitter.Node, source []byte) []string {
+	var values []string
+	inRHS, inValue := false, false
+	var start, end uint32
+	depth := 0
+	flush := func() {
+		if inValue && end > start {
+			value := strings.TrimSpace(string(source[start:end]))
+			if !strings.EqualFold(value, "unaffected") {
+				values = append(values, value)
+			}
+		}
+		start, end = 0, 0
+	}
+
+	prevEnd := n.StartByte()
+	for i := 0; i < int(n.ChildCount()); i++ {
+		child := n.Child(i)
+		gap := strings.Fields(strings.ToLower(string(source[prevEnd:child.StartByte()])))
+		prevEnd = child.EndByte()
+		if !inRHS {
+			if child.Type() == "<=" {
+				inRHS, inValue = true, true
+			}
+			continue
+		}
+		if child.ChildCount() == 0 {
+			gap = append(gap, strings.ToLower(child.Content(source)))
+		}
+		for _, word := range gap {
+			switch word {
+			case "when", "after":
+				flush()
+				inValue = false
+			case "else":
+				flush()
+				inValue = true
+			case "force", "release":
+				// Forces are simulation overrides, not drivers
+				return nil
+			}
+		}
+		switch child.Type() {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case ",":
+			if depth == 0 {
+				flush()
+				inValue = true
+				continue
+			}
+		case ";":
+			flush()
+			return values
+		}
+		if !inValue {
+			continue
+		}
+		word := strings.ToLower(child.Content(source))
+		if word == "when" || word == "else" || word == "after" {
+			continue
+		}
+		if end == 0 {
+			start = child.StartByte()
+		}
+		end = child.EndByte()
+	}
+	flush()
+	return values
+}
+
+// isHighZValue reports whether an expression drives only high-impedance:
+// 'Z', a string of Zs, or an others aggregate of such a value.
+func isHighZValue(expr string) bool {
+	v := strings.ToLower(strings.Join(strings.Fields(expr), ""))
+	if v == "'z'" || highZStringRe.MatchString(v) {
+		return true
+	}
+	if m := highZAggregateRe.FindStringSubmatch(v); m != nil {
+		return isHighZValue(m[1])
+	}
+	return false
+}
+
+// isTristateValue reports whether an expression is a tri-state literal: a
+// character or string of Z and don't-care ('-') bits with at least one Z, or
+// an others aggregate of one.
+func isTristateValue(expr string) bool {
+	v := strings.ToLower(strings.Join(strings.Fields(expr), ""))
+	if v == "'z'" || tristateStringRe.MatchString(v) {
+		return true
+	}
+	if m := highZAggregateRe.FindStringSubmatch(v); m != nil {
+		return isTristateValue(m[1])
+	}
+	return false
+}
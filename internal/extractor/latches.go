@@ -0,0 +1,222 @@
+package extractor
+
+import (
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// PartialAssignment is a signal a combinational process assigns on some but
+// not all branches of an if or case statement, so its previous value must be
+// held on the remaining paths.
+type PartialAssignment struct {
+	Signal        string // Assigned signal (base name)
+	Line          int    // Line of the if/case statement
+	MissingBranch string // "else", "if <cond>", "elsif <cond>", or "when <choices>"
+}
+
+// LatchInference is a signal inferred as a latch because a combinational
+// process leaves it unassigned on some path through an if or case statement.
+type LatchInference struct {
+	Signal        string
+	Process       string // Process label
+	Line          int    // Line of the if/case statement
+	MissingBranch string // First branch that does not assign the signal
+	InArch        string
+}
+
+// ifBranch is one then/elsif/else arm of an if statement.
+type ifBranch struct {
+	label      string
+	statements []*sitter.Node
+}
+
+// partialAssignments walks the statements of a process and returns the
+// signals assigned on some but not every branch of an if or case statement.
+// An if without else has an implicit empty else branch; a case without others
+// is left to the case completeness checks. Signals that end up assigned on
+// every path through the process, for example by a later unconditional
+// assignment, are not reported. Loops are walked for candidates but never
+// count as definite assignments.
+func (e *Extractor) partialAssignments(node *sitter.Node, source []byte) []PartialAssignment {
+	var found []PartialAssignment
+	reported := make(map[string]bool)
+
+	var walkSeq func(statements []*sitter.Node, assigned map[string]string) map[string]string
+	walkBranches := func(n *sitter.Node, branches []ifBranch, assigned map[string]string) map[string]string {
+		results := make([]map[string]string, len(branches))
+		union := make(map[string]string)
+		for i, branch := range branches {
+			results[i] = walkSeq(branch.statements, copyAssigned(assigned))
+			for key, name := range results[i] {
+				union[key] = name
+			}
+		}
+		keys := make([]string, 0, len(union))
+		for key := range union {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		line := int(n.StartPoint().Row) + 1
+		definite := make(map[string]string)
+		for _, key := range keys {
+			name := union[key]
+			missing := ""
+			for i, result := range results {
+				if _, ok := result[key]; !ok {
+					missing = branches[i].label
+					break
+				}
+			}
+			if missing == "" {
+				definite[key] = name
+				continue
+			}
+			if !reported[key] {
+				reported[key] = true
+				found = append(found, PartialAssignment{Signal: name, Line: line, MissingBranch: missing})
+			}
+		}
+		return definite
+	}
+	walkSeq = func(statements []*sitter.Node, assigned map[string]string) map[string]string {
+		for _, stmt := range statements {
+			switch stmt.Type() {
+			case "sequential_signal_assignment":
+				if target, ok := e.extractAssignmentTarget(stmt, source); ok && target != "" {
+					assigned[strings.ToLower(target)] = target
+				}
+			case "assignment_target":
+				// Conditional and selected assignments are flattened into
+				// the enclosing statement list
+				if target, ok := e.assignmentTargetName(stmt, source); ok && target != "" {
+					assigned[strings.ToLower(target)] = target
+				}
+			case "if_statement":
+				assigned = walkBranches(stmt, ifBranches(stmt, source), assigned)
+			case "case_statement":
+				assigned = walkBranches(stmt, caseBranches(stmt, source), assigned)
+			case "loop_statement":
+				walkSeq(namedChildren(stmt), copyAssigned(assigned))
+			case "sequential_block_statement":
+				assigned = walkSeq(namedChildren(stmt), assigned)
+			}
+		}
+		return assigned
+	}
+
+	final := walkSeq(namedChildren(node), make(map[string]string))
+	var partial []PartialAssignment
+	for _, p := range found {
+		if _, ok := final[strings.ToLower(p.Signal)]; !ok {
+			partial = append(partial, p)
+		}
+	}
+	return partial
+}
+
+// ifBranches splits an if statement's children into its then, elsif, and
+// else arms. The grammar hides the else keyword, so the else arm starts at
+// the first child whose leading source gap contains "else"; gaps inside a
+// flattened conditional assignment (up to its ';') are not considered. An if
+// without else gets an empty else arm.
+func ifBranches(node *sitter.Node, source []byte) []ifBranch {
+	var branches []ifBranch
+	hasElse, inAssignment := false, false
+	prevEnd := node.StartByte()
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		gap := strings.ToLower(string(source[prevEnd:child.StartByte()]))
+		prevEnd = child.EndByte()
+		if !hasElse && !inAssignment && len(branches) > 0 && lastKeywordIndex(gap, "else") != -1 {
+			hasElse = true
+			branches = append(branches, ifBranch{label: "else"})
+		}
+		switch {
+		case child.Type() == "condition" && !hasElse && !inAssignment:
+			keyword := "elsif "
+			if len(branches) == 0 {
+				keyword = "if "
+			}
+			branches = append(branches, ifBranch{label: keyword + strings.Join(strings.Fields(child.Content(source)), " ")})
+			continue
+		case child.Type() == "assignment_target":
+			inAssignment = true
+		case child.Type() == ";":
+			inAssignment = false
+		}
+		if len(branches) > 0 && child.IsNamed() {
+			current := &branches[len(branches)-1]
+			current.statements = append(current.statements, child)
+		}
+	}
+	if !hasElse {
+		branches = append(branches, ifBranch{label: "else"})
+	}
+	return branches
+}
+
+// caseBranches returns one arm per case alternative, labelled with its
+// choices.
+func caseBranches(node *sitter.Node, source []byte) []ifBranch {
+	var branches []ifBranch
+	for i := 0; i < int(node.ChildCount()); i++ {
+		alt := node.Child(i)
+		if alt.Type() != "case_alternative" {
+			continue
+		}
+		var choices []string
+		var statements []*sitter.Node
+		for j := 0; j < int(alt.ChildCount()); j++ {
+			child := alt.Child(j)
+			if child.Type() == "case_choice" {
+				choices = append(choices, strings.Join(strings.Fields(child.Content(source)), " "))
+			} else if child.IsNamed() {
+				statements = append(statements, child)
+			}
+		}
+		branches = append(branches, ifBranch{label: "when " + strings.Join(choices, " | "), statements: statements})
+	}
+	return branches
+}
+
+// namedChildren returns the named children of node in order.
+func namedChildren(node *sitter.Node) []*sitter.Node {
+	children := make([]*sitter.Node, 0, node.NamedChildCount())
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		children = append(children, node.NamedChild(i))
+	}
+	return children
+}
+
+func copyAssigned(assigned map[string]string) map[string]string {
+	out := make(map[string]string, len(assigned))
+	for key, name := range assigned {
+		out[key] = name
+	}
+	return out
+}
+
+// DetectConditionalLatches reports signals that combinational processes
+// assign on some but not all paths through an if or case statement, which
+// synthesizes to a latch holding the previous value. Processes in
+// synthesis-off regions are skipped.
+func DetectConditionalLatches(facts *FileFacts) []LatchInference {
+	var latches []LatchInference
+	for _, proc := range facts.Processes {
+		if !proc.IsCombinational || proc.InSynthOffRegion {
+			continue
+		}
+		for _, p := range proc.PartialAssignments {
+			latches = append(latches, LatchInference{
+				Signal:        p.Signal,
+				Process:       proc.Label,
+				Line:          p.Line,
+				MissingBranch: p.MissingBranch,
+				InArch:        proc.InArch,
+			})
+		}
+	}
+	return latches
+}
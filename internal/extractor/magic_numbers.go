@@ -0,0 +1,193 @@
+package extractor
+
+import (
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// DefaultMagicNumberThreshold is the largest literal value never reported as a
+// magic number. Values at or below it (0 and 1 by default) are always allowed.
+const DefaultMagicNumberThreshold = 1
+
+// magicNumberPowerOfTwoLimit bounds the powers of two that are exempt from
+// magic number detection (2, 4, ... 64 are common widths and strides).
+const magicNumberPowerOfTwoLimit = 64
+
+// AssignmentLiteral is a numeric literal appearing on the right-hand side of a
+// signal or variable assignment.
+type AssignmentLiteral struct {
+	Target    string // Assigned signal/variable (base name)
+	Value     string // Literal as written
+	Line      int
+	InProcess string // Empty for concurrent assignments
+	InArch    string
+}
+
+// MagicNumber reports an integer literal used directly in an expression
+// instead of through a named constant or generic.
+type MagicNumber struct {
+	Value     string // Literal as written
+	Numeric   int    // Parsed integer value
+	Context   string // "comparison", "arithmetic", or "assignment"
+	Line      int
+	InProcess string
+	InArch    string
+}
+
+// extractAssignmentLiterals records integer literals on assignment right-hand
+// sides. Literals used as array indices, range bounds, or time values are
+// skipped since they describe structure rather than behavior.
+func (e *Extractor) extractAssignmentLiterals(node *sitter.Node, source []byte, archContext, processLabel string, facts *FileFacts) {
+	var collect func(n *sitter.Node, target string)
+	collect = func(n *sitter.Node, target string) {
+		if n == nil {
+			return
+		}
+		switch n.Type() {
+		case "physical_literal", "range_expression", "attribute_name":
+			return
+		case "number", "based_literal":
+			if !isIndexOrBoundLiteral(n, source) {
+				facts.AssignmentLiterals = append(facts.AssignmentLiterals, AssignmentLiteral{
+					Target:    target,
+					Value:     n.Content(source),
+					Line:      int(n.StartPoint().Row) + 1,
+					InProcess: processLabel,
+					InArch:    archContext,
+				})
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			collect(n.Child(i), target)
+		}
+	}
+
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		switch n.Type() {
+		case "signal_assignment", "sequential_signal_assignment",
+			"assignment_statement", "selected_variable_assignment":
+			target, _ := e.extractAssignmentTarget(n, source)
+			if target == "" && n.ChildCount() > 0 {
+				target = e.extractExpressionSignal(n.Child(0), source)
+			}
+			// Everything after the assignment operator is the right-hand side
+			inRHS := false
+			for i := 0; i < int(n.ChildCount()); i++ {
+				child := n.Child(i)
+				if !inRHS {
+					inRHS = child.Type() == "<=" || child.Type() == ":="
+					continue
+				}
+				collect(child, target)
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(node)
+}
+
+// isIndexOrBoundLiteral reports whether a literal is a range bound (7 downto 0)
+// or the sole index of a name (data(7)).
+func isIndexOrBoundLiteral(n *sitter.Node, source []byte) bool {
+	prev, next := n.PrevSibling(), n.NextSibling()
+	for _, sib := range []*sitter.Node{prev, next} {
+		if sib == nil {
+			continue
+		}
+		word := strings.ToLower(sib.Content(source))
+		if word == "to" || word == "downto" {
+			return true
+		}
+	}
+	return prev != nil && next != nil && prev.Type() == "(" && next.Type() == ")"
+}
+
+// parseIntegerLiteral parses a decimal or based integer literal. Real literals
+// and bit-string literals are rejected.
+func parseIntegerLiteral(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" || s[0] < '0' || s[0] > '9' || strings.Contains(s, ".") {
+		return 0, false
+	}
+	if strings.Contains(s, "#") {
+		val, err := parseBasedLiteral(s)
+		if err != nil {
+			return 0, false
+		}
+		return val, true
+	}
+	val, err := strconv.Atoi(strings.ReplaceAll(s, "_", ""))
+	if err != nil {
+		return 0, false
+	}
+	return val, true
+}
+
+// isExemptMagicNumber reports whether a literal value is too common to be
+// worth naming: anything at or below the threshold, or a small power of two.
+func isExemptMagicNumber(val, threshold int) bool {
+	if val <= threshold {
+		return true
+	}
+	return val <= magicNumberPowerOfTwoLimit && val&(val-1) == 0
+}
+
+// DetectMagicNumbers finds integer literals above threshold used directly in
+// comparisons, expensive arithmetic, and assignment right-hand sides. The same
+// literal on the same line is reported once, preferring the most specific
+// context. A threshold below zero selects DefaultMagicNumberThreshold.
+func DetectMagicNumbers(facts *FileFacts, threshold int) []MagicNumber {
+	if threshold < 0 {
+		threshold = DefaultMagicNumberThreshold
+	}
+
+	var found []MagicNumber
+	seen := make(map[string]bool)
+	add := func(value, context string, line int, process, arch string) {
+		val, ok := parseIntegerLiteral(value)
+		if !ok || isExemptMagicNumber(val, threshold) {
+			return
+		}
+		key := arch + "|" + process + "|" + strconv.Itoa(line) + "|" + strconv.Itoa(val)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		found = append(found, MagicNumber{
+			Value:     value,
+			Numeric:   val,
+			Context:   context,
+			Line:      line,
+			InProcess: process,
+			InArch:    arch,
+		})
+	}
+
+	for _, comp := range facts.Comparisons {
+		if comp.IsLiteral {
+			add(comp.LiteralValue, "comparison", comp.Line, comp.InProcess, comp.InArch)
+		}
+	}
+	for _, op := range facts.ArithmeticOps {
+		if op.Operator == "+" || op.Operator == "-" {
+			continue
+		}
+		for _, operand := range op.Operands {
+			add(operand, "arithmetic", op.Line, op.InProcess, op.InArch)
+		}
+	}
+	for _, lit := range facts.AssignmentLiterals {
+		add(lit.Value, "assignment", lit.Line, lit.InProcess, lit.InArch)
+	}
+	return found
+}
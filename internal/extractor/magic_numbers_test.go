@@ -0,0 +1,47 @@
+package extractor
+
+import "testing"
+
+func TestDetectMagicNumbers(t *testing.T) {
+	facts := FileFacts{
+		Comparisons: []Comparison{
+			{LeftOperand: "cnt", Operator: "=", RightOperand: "100", IsLiteral: true, LiteralValue: "100", Line: 10, InProcess: "p", InArch: "rtl"},
+			{LeftOperand: "cnt", Operator: "=", RightOperand: "1", IsLiteral: true, LiteralValue: "1", Line: 11, InProcess: "p", InArch: "rtl"},
+			{LeftOperand: "data", Operator: "=", RightOperand: `X"FF"`, IsLiteral: true, LiteralValue: `X"FF"`, Line: 12, InProcess: "p", InArch: "rtl"},
+		},
+		ArithmeticOps: []ArithmeticOp{
+			{Operator: "*", Operands: []string{"a", "16"}, Line: 13, InProcess: "p", InArch: "rtl"},
+			{Operator: "*", Operands: []string{"a", "3"}, Line: 14, InProcess: "p", InArch: "rtl"},
+		},
+		AssignmentLiterals: []AssignmentLiteral{
+			{Target: "cnt", Value: "100", Line: 10, InProcess: "p", InArch: "rtl"},
+			{Target: "limit", Value: "16#FF#", Line: 20, InArch: "rtl"},
+			{Target: "ratio", Value: "2.5", Line: 21, InArch: "rtl"},
+		},
+	}
+
+	got := DetectMagicNumbers(&facts, DefaultMagicNumberThreshold)
+	want := []struct {
+		value   string
+		context string
+	}{
+		{"100", "comparison"},
+		{"3", "arithmetic"},
+		{"16#FF#", "assignment"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d findings, got %+v", len(want), got)
+	}
+	for i, w := range want {
+		if got[i].Value != w.value || got[i].Context != w.context {
+			t.Fatalf("finding %d: expected %s/%s, got %+v", i, w.value, w.context, got[i])
+		}
+	}
+	if got[2].Numeric != 255 {
+		t.Fatalf("expected based literal to parse as 255, got %d", got[2].Numeric)
+	}
+
+	if got := DetectMagicNumbers(&facts, 255); len(got) != 0 {
+		t.Fatalf("expected threshold 255 to suppress all findings, got %+v", got)
+	}
+}
@@ -0,0 +1,56 @@
+package extractor
+
+import "strings"
+
+// MixedSensitivity is a clocked process whose sensitivity list does not
+// match the {clock, asynchronous reset} set it should be: it lists data
+// signals besides the clock, or it omits the clock.
+type MixedSensitivity struct {
+	Process      string
+	Clock        string
+	Extra        []string // Sensitivity entries that are neither the clock nor a reset, as written
+	MissingClock bool     // The clock is not in the sensitivity list
+	Line         int      // Line of the process
+	InArch       string
+}
+
+// DetectMixedSensitivity compares the sensitivity list of each sequential
+// process with its clock and reset. Reset-named entries are accepted even
+// when the reset is synchronous, since listing one is harmless. Processes
+// with wait statements or "process(all)", and processes in synthesis-off
+// regions, are skipped.
+func DetectMixedSensitivity(facts *FileFacts) []MixedSensitivity {
+	var found []MixedSensitivity
+	for _, proc := range facts.Processes {
+		if !proc.IsSequential || proc.ClockSignal == "" || proc.InSynthOffRegion ||
+			len(proc.WaitStatements) > 0 || len(proc.SensitivityList) == 0 {
+			continue
+		}
+		issue := MixedSensitivity{
+			Process:      proc.Label,
+			Clock:        proc.ClockSignal,
+			MissingClock: true,
+			Line:         proc.Line,
+			InArch:       proc.InArch,
+		}
+		all := false
+		for _, entry := range proc.SensitivityList {
+			base := extractBaseSignalName(entry)
+			switch {
+			case strings.EqualFold(entry, "all"):
+				all = true
+			case strings.EqualFold(base, proc.ClockSignal):
+				issue.MissingClock = false
+			case strings.EqualFold(base, proc.ResetSignal) || isResetName(base):
+				// Asynchronous resets belong in the list
+			default:
+				issue.Extra = append(issue.Extra, entry)
+			}
+		}
+		if all || (!issue.MissingClock && len(issue.Extra) == 0) {
+			continue
+		}
+		found = append(found, issue)
+	}
+	return found
+}
@@ -0,0 +1,95 @@
+package extractor
+
+import (
+	"math"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// synthOffPragmaRe matches translate_off/translate_on comment pragmas in the
+// spellings synthesis tools accept: "-- synthesis translate_off",
+// "-- pragma translate_on", "-- synopsys translate_off", and the block
+// comment forms.
+var synthOffPragmaRe = regexp.MustCompile(`(?i)^(?:--|/\*)\s*(?:synthesis|pragma|synopsys)\s+translate_(off|on)\b`)
+
+// lineRange is an inclusive range of 1-based source lines.
+type lineRange struct {
+	Start int
+	End   int
+}
+
+// collectSynthOffRegions returns the line ranges between translate_off and
+// the matching translate_on pragma. Regions nest by depth, so only the
+// outermost pair bounds a region; a translate_off that is never closed
+// extends to the end of the file. Tree-sitter keeps comments as extras, so
+// every comment node is visited in source order.
+func collectSynthOffRegions(root *sitter.Node, source []byte) []lineRange {
+	var regions []lineRange
+	depth := 0
+	start := 0
+
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		if t := n.Type(); t == "comment" || t == "block_comment" {
+			m := synthOffPragmaRe.FindStringSubmatch(strings.TrimSpace(n.Content(source)))
+			if m == nil {
+				return
+			}
+			line := int(n.StartPoint().Row) + 1
+			if strings.EqualFold(m[1], "off") {
+				if depth == 0 {
+					start = line
+				}
+				depth++
+			} else if depth > 0 {
+				depth--
+				if depth == 0 {
+					regions = append(regions, lineRange{Start: start, End: line})
+				}
+			}
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+
+	if depth > 0 {
+		regions = append(regions, lineRange{Start: start, End: math.MaxInt})
+	}
+	return regions
+}
+
+// inLineRanges reports whether line falls inside any of the ranges.
+func inLineRanges(ranges []lineRange, line int) bool {
+	for _, r := range ranges {
+		if line >= r.Start && line <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// markSynthOffRegions tags processes, concurrent assignments, and signals
+// declared inside translate_off regions. Synthesis never sees them, so
+// hardware checks (latches, CDC) skip them.
+func markSynthOffRegions(facts *FileFacts, regions []lineRange) {
+	if len(regions) == 0 {
+		return
+	}
+	for i := range facts.Processes {
+		facts.Processes[i].InSynthOffRegion = inLineRanges(regions, facts.Processes[i].Line)
+	}
+	for i := range facts.ConcurrentAssignments {
+		facts.ConcurrentAssignments[i].InSynthOffRegion = inLineRanges(regions, facts.ConcurrentAssignments[i].Line)
+	}
+	for i := range facts.Signals {
+		facts.Signals[i].InSynthOffRegion = inLineRanges(regions, facts.Signals[i].Line)
+	}
+}
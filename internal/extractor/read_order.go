@@ -0,0 +1,52 @@
+package extractor
+
+import "sort"
+
+// SignalAccess records where a process first reads and first writes a signal.
+// Lines are 0 when the process never reads (or never writes) it.
+type SignalAccess struct {
+	Signal     string
+	FirstRead  int
+	FirstWrite int
+	Writes     int // Number of assignment statements targeting the signal
+}
+
+// ReadBeforeWrite reports a combinational process that reads a signal above
+// its only assignment in the same process. The read sees the value from the
+// previous activation, so simulation races the assignment and synthesis may
+// infer a latch or feedback path.
+type ReadBeforeWrite struct {
+	Signal    string
+	Process   string
+	ReadLine  int
+	WriteLine int
+	InArch    string
+}
+
+// DetectReadBeforeWrite flags combinational processes where a signal is read
+// on a line strictly before its single assignment. Signals written more than
+// once are skipped (a default assignment followed by conditional overrides is
+// the intended idiom), as are sequential processes, where reading the
+// registered value is intentional, and synthesis-off regions.
+func DetectReadBeforeWrite(facts *FileFacts) []ReadBeforeWrite {
+	var found []ReadBeforeWrite
+	for _, proc := range facts.Processes {
+		if !proc.IsCombinational || proc.InSynthOffRegion {
+			continue
+		}
+		for _, a := range proc.SignalAccesses {
+			if a.Writes != 1 || a.FirstRead == 0 || a.FirstRead >= a.FirstWrite {
+				continue
+			}
+			found = append(found, ReadBeforeWrite{
+				Signal:    a.Signal,
+				Process:   proc.Label,
+				ReadLine:  a.FirstRead,
+				WriteLine: a.FirstWrite,
+				InArch:    proc.InArch,
+			})
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].ReadLine < found[j].ReadLine })
+	return found
+}
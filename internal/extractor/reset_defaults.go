@@ -0,0 +1,139 @@
+package extractor
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ResetValue is a signal assigned as a whole in a process's reset branch.
+type ResetValue struct {
+	Signal string
+	Value  string // Assigned value, whitespace collapsed
+	Line   int
+}
+
+// ResetDefaultMismatch is a signal whose reset branch assigns a value other
+// than the initial value of its declaration, so simulation before the first
+// reset and hardware after it disagree.
+type ResetDefaultMismatch struct {
+	Signal    string
+	Default   string // Declared initial value
+	Reset     string // Value assigned on reset
+	Line      int    // Line of the reset assignment
+	InProcess string
+	InArch    string
+}
+
+var (
+	othersAggregateRe = regexp.MustCompile(`^\(others=>('.')\)$`)
+	bitStringRe       = regexp.MustCompile(`^([box]?)"([0-9a-fxzuwlh_\-]*)"$`)
+	plainValueRe      = regexp.MustCompile(`^('.'|-?[a-z0-9_.#]+)$`)
+)
+
+// DetectResetDefaultMismatches compares the value each reset branch assigns
+// to a signal with the signal's declared initial value. Only literal values
+// are compared: character and bit-string literals, "(others => 'c')"
+// aggregates, numbers, and names such as enumeration literals, with an
+// aggregate and a bit string of the same repeated bit treated as equal.
+// Function calls and other expressions are skipped, as are signals without
+// an initial value and processes in synthesis-off regions.
+func DetectResetDefaultMismatches(facts *FileFacts) []ResetDefaultMismatch {
+	type signalKey struct{ arch, name string }
+	defaults := make(map[signalKey]Signal)
+	for _, sig := range facts.Signals {
+		if sig.Default != "" {
+			defaults[signalKey{strings.ToLower(archBase(sig.InEntity)), strings.ToLower(sig.Name)}] = sig
+		}
+	}
+
+	var found []ResetDefaultMismatch
+	for _, proc := range facts.Processes {
+		if proc.InSynthOffRegion {
+			continue
+		}
+		arch := strings.ToLower(archBase(proc.InArch))
+		for _, rv := range proc.ResetValues {
+			sig, ok := defaults[signalKey{arch, strings.ToLower(rv.Signal)}]
+			if !ok {
+				continue
+			}
+			want, ok := literalValueKey(sig.Default)
+			if !ok {
+				continue
+			}
+			got, ok := literalValueKey(rv.Value)
+			if !ok || got == want {
+				continue
+			}
+			found = append(found, ResetDefaultMismatch{
+				Signal:    sig.Name,
+				Default:   sig.Default,
+				Reset:     rv.Value,
+				Line:      rv.Line,
+				InProcess: proc.Label,
+				InArch:    proc.InArch,
+			})
+		}
+	}
+	return found
+}
+
+// literalValueKey returns a comparison key for a literal value, or false
+// when the value is an expression that cannot be compared textually.
+// "(others => '0')", "00000000", and x"00" share the key "all:0".
+func literalValueKey(value string) (string, bool) {
+	v := strings.ToLower(strings.Join(strings.Fields(value), ""))
+	for len(v) > 2 && v[0] == '(' && v[len(v)-1] == ')' && !strings.ContainsAny(v, ",=") {
+		v = v[1 : len(v)-1]
+	}
+	if m := othersAggregateRe.FindStringSubmatch(v); m != nil {
+		return "all:" + m[1][1:2], true
+	}
+	if m := bitStringRe.FindStringSubmatch(v); m != nil {
+		bits, ok := expandBitString(m[1], strings.ReplaceAll(m[2], "_", ""))
+		if !ok {
+			return v, true
+		}
+		if bits != "" && strings.Count(bits, bits[:1]) == len(bits) {
+			return "all:" + bits[:1], true
+		}
+		return bits, true
+	}
+	if plainValueRe.MatchString(v) {
+		return v, true
+	}
+	return "", false
+}
+
+// expandBitString returns the bits of a binary, octal, or hexadecimal bit
+// string literal's digits, or false when a digit is not a number.
+func expandBitString(base, digits string) (string, bool) {
+	var width int
+	switch base {
+	case "", "b":
+		return digits, true
+	case "o":
+		width = 3
+	case "x":
+		width = 4
+	}
+	var bits strings.Builder
+	for _, d := range digits {
+		var n int
+		switch {
+		case d >= '0' && d <= '9':
+			n = int(d - '0')
+		case d >= 'a' && d <= 'f':
+			n = int(d-'a') + 10
+		default:
+			return "", false
+		}
+		if n >= 1<<width {
+			return "", false
+		}
+		for shift := width - 1; shift >= 0; shift-- {
+			bits.WriteByte(byte('0' + (n>>shift)&1))
+		}
+	}
+	return bits.String(), true
+}
@@ -0,0 +1,53 @@
+package extractor
+
+import "strings"
+
+// SensitivityListSmell is a process whose sensitivity list names an entry
+// more than once, or names a signal the process itself assigns.
+type SensitivityListSmell struct {
+	Process        string
+	Duplicates     []string // Entries listed more than once, as written
+	SelfReferences []string // Entries the process also assigns, as written
+	Line           int      // Line of the process
+	InArch         string
+}
+
+// DetectSensitivityListSmells checks each process's sensitivity list for
+// repeated entries (compared case-insensitively, ignoring whitespace) and for
+// entries whose signal the process assigns. Feeding an output back into the
+// list of a combinational process makes the simulator run it again after
+// every change it makes. "process(all)" is skipped.
+func DetectSensitivityListSmells(facts *FileFacts) []SensitivityListSmell {
+	var found []SensitivityListSmell
+	for _, proc := range facts.Processes {
+		if len(proc.SensitivityList) == 0 {
+			continue
+		}
+		assigned := make(map[string]bool, len(proc.AssignedSignals))
+		for _, sig := range proc.AssignedSignals {
+			assigned[strings.ToLower(extractBaseSignalName(sig))] = true
+		}
+
+		smell := SensitivityListSmell{Process: proc.Label, Line: proc.Line, InArch: proc.InArch}
+		seen := make(map[string]int) // normalized entry -> times listed
+		for _, entry := range proc.SensitivityList {
+			if strings.EqualFold(entry, "all") {
+				continue
+			}
+			key := strings.ToLower(strings.Join(strings.Fields(entry), ""))
+			seen[key]++
+			switch seen[key] {
+			case 1:
+				if assigned[strings.ToLower(extractBaseSignalName(entry))] {
+					smell.SelfReferences = append(smell.SelfReferences, entry)
+				}
+			case 2:
+				smell.Duplicates = append(smell.Duplicates, entry)
+			}
+		}
+		if len(smell.Duplicates) > 0 || len(smell.SelfReferences) > 0 {
+			found = append(found, smell)
+		}
+	}
+	return found
+}
@@ -0,0 +1,92 @@
+package extractor
+
+import (
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// SliceAccess is a name sliced with an explicit range direction, such as
+// v(7 downto 0) or bus.data(0 to 3).
+type SliceAccess struct {
+	Signal    string // Sliced name including record fields (e.g., "bus.data")
+	Expr      string // Slice as written
+	Direction string // "to" or "downto"
+	Line      int
+	InArch    string
+}
+
+var sliceDirectionRe = regexp.MustCompile(`(?i)\b(downto|to)\b`)
+
+// extractSliceAccesses records every explicitly ranged slice under node. Names
+// are flattened by the grammar, so a slice appears as sibling tokens
+// "name ( expr <to|downto> expr )" with the hidden direction keyword only
+// visible in the source between children. Type marks such as
+// std_logic_vector(7 downto 0) are recorded too; consumers filter to names
+// that resolve to signals or ports.
+func (e *Extractor) extractSliceAccesses(node *sitter.Node, source []byte, archContext string, facts *FileFacts) {
+	type parenFrame struct {
+		name      string
+		start     *sitter.Node
+		direction string
+	}
+
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		var stack []parenFrame
+		var prev *sitter.Node
+		for i := 0; i < int(n.ChildCount()); i++ {
+			child := n.Child(i)
+			if prev != nil && len(stack) > 0 && stack[len(stack)-1].direction == "" {
+				gap := string(source[prev.EndByte():child.StartByte()])
+				if m := sliceDirectionRe.FindStringSubmatch(gap); m != nil {
+					stack[len(stack)-1].direction = strings.ToLower(m[1])
+				}
+			}
+			switch child.Type() {
+			case "(":
+				frame := parenFrame{}
+				if prev != nil && prev.Type() == "identifier" {
+					frame.name, frame.start = slicePrefix(n, i-1, source)
+				}
+				stack = append(stack, frame)
+			case ")":
+				if len(stack) == 0 {
+					break
+				}
+				frame := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				if frame.name != "" && frame.direction != "" {
+					facts.SliceAccesses = append(facts.SliceAccesses, SliceAccess{
+						Signal:    frame.name,
+						Expr:      string(source[frame.start.StartByte():child.EndByte()]),
+						Direction: frame.direction,
+						Line:      int(frame.start.StartPoint().Row) + 1,
+						InArch:    archContext,
+					})
+				}
+			default:
+				walk(child)
+			}
+			prev = child
+		}
+	}
+	walk(node)
+}
+
+// slicePrefix collects the dotted name ending at child idx of parent
+// ("rec", ".", "field" -> "rec.field") and returns it with its first node.
+func slicePrefix(parent *sitter.Node, idx int, source []byte) (string, *sitter.Node) {
+	start := parent.Child(idx)
+	parts := []string{start.Content(source)}
+	for idx >= 2 && parent.Child(idx-1).Type() == "." && parent.Child(idx-2).Type() == "identifier" {
+		idx -= 2
+		start = parent.Child(idx)
+		parts = append([]string{start.Content(source)}, parts...)
+	}
+	return strings.Join(parts, "."), start
+}
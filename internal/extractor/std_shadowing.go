@@ -0,0 +1,79 @@
+package extractor
+
+import "strings"
+
+// StandardShadowing is a package member named like a standard type or a
+// standard function, which makes overload resolution and "use ... .all"
+// visibility confusing for every design unit that uses the package.
+type StandardShadowing struct {
+	Name    string
+	Kind    string // "type", "subtype", "function", or "constant"
+	Package string
+	Line    int
+}
+
+// standardTypeNames are the types of the std and ieee standard packages.
+var standardTypeNames = map[string]bool{
+	"bit": true, "bit_vector": true, "boolean": true, "boolean_vector": true,
+	"character": true, "string": true, "integer": true, "integer_vector": true,
+	"natural": true, "positive": true, "real": true, "real_vector": true,
+	"time": true, "time_vector": true, "delay_length": true, "severity_level": true,
+	"std_ulogic": true, "std_logic": true, "std_ulogic_vector": true,
+	"std_logic_vector": true, "unsigned": true, "signed": true,
+	"unresolved_unsigned": true, "unresolved_signed": true, "u_unsigned": true,
+	"u_signed": true, "ufixed": true, "sfixed": true, "float": true,
+	"line": true, "text": true,
+}
+
+// IsStandardTypeName reports whether name is a type of the std or ieee
+// standard packages.
+func IsStandardTypeName(name string) bool {
+	return standardTypeNames[strings.ToLower(name)]
+}
+
+// standardPackageNames are the packages that legitimately declare the
+// standard names, for vendor copies of their sources.
+var standardPackageNames = map[string]bool{
+	"standard": true, "textio": true, "env": true, "std_logic_1164": true,
+	"numeric_std": true, "numeric_bit": true, "numeric_std_unsigned": true,
+	"numeric_bit_unsigned": true, "std_logic_arith": true, "std_logic_unsigned": true,
+	"std_logic_signed": true, "std_logic_textio": true, "math_real": true,
+	"math_complex": true, "fixed_pkg": true, "fixed_generic_pkg": true,
+	"float_pkg": true, "float_generic_pkg": true,
+}
+
+// detectStandardShadowing reports the types, subtypes, functions, and
+// constants declared in a package whose names collide with a standard type
+// or a common standard function (isCommonVHDLFunction). Members of the
+// standard packages themselves are not reported.
+func (e *Extractor) detectStandardShadowing(facts *FileFacts) []StandardShadowing {
+	var found []StandardShadowing
+	check := func(name, kind, pkg string, line int) {
+		if pkg == "" || standardPackageNames[strings.ToLower(pkg)] {
+			return
+		}
+		lower := strings.ToLower(name)
+		if standardTypeNames[lower] || e.isCommonVHDLFunction(lower) {
+			found = append(found, StandardShadowing{Name: name, Kind: kind, Package: pkg, Line: line})
+		}
+	}
+	for _, t := range facts.Types {
+		check(t.Name, "type", t.InPackage, t.Line)
+	}
+	for _, st := range facts.Subtypes {
+		check(st.Name, "subtype", st.InPackage, st.Line)
+	}
+	// Overloads and the package body repeat a function's name; report it once
+	seen := make(map[string]bool)
+	for _, fn := range facts.Functions {
+		key := strings.ToLower(fn.InPackage + "." + fn.Name)
+		if !seen[key] {
+			seen[key] = true
+			check(fn.Name, "function", fn.InPackage, fn.Line)
+		}
+	}
+	for _, c := range facts.ConstantDecls {
+		check(c.Name, "constant", c.InPackage, c.Line)
+	}
+	return found
+}
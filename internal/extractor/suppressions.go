@@ -0,0 +1,88 @@
+package extractor
+
+import (
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Suppression silences lint rules from an inline comment:
+//
+//	x <= y; -- vhdl-lint: disable=latch_inference
+//	-- vhdl-lint: disable-next-line=cdc_unsync
+//	-- vhdl-lint: disable-file=magic_number
+//
+// A directive without "=rules" suppresses every rule.
+type Suppression struct {
+	Rule  string // Rule name ("" suppresses all rules)
+	Line  int    // Line of the directive comment
+	Scope string // "line", "next-line", or "file"
+}
+
+// suppressionRe matches a vhdl-lint directive and its optional rule list.
+var suppressionRe = regexp.MustCompile(`(?i)^(?:--|/\*)\s*vhdl-lint:\s*(disable-next-line|disable-file|disable)\b(?:\s*=\s*(\w+(?:\s*,\s*\w+)*))?`)
+
+// collectSuppressions returns the inline suppressions in the file's comments.
+func collectSuppressions(root *sitter.Node, source []byte) []Suppression {
+	var suppressions []Suppression
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		if n == nil {
+			return
+		}
+		if t := n.Type(); t == "comment" || t == "block_comment" {
+			text := strings.TrimSuffix(strings.TrimSpace(n.Content(source)), "*/")
+			suppressions = append(suppressions, parseSuppression(text, int(n.StartPoint().Row)+1)...)
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(root)
+	return suppressions
+}
+
+// parseSuppression parses one comment, returning a Suppression per listed
+// rule, a single rule-less Suppression for a bare directive, or nil when the
+// comment is not a directive.
+func parseSuppression(comment string, line int) []Suppression {
+	m := suppressionRe.FindStringSubmatch(strings.TrimSpace(comment))
+	if m == nil {
+		return nil
+	}
+	scope := "line"
+	switch strings.ToLower(m[1]) {
+	case "disable-next-line":
+		scope = "next-line"
+	case "disable-file":
+		scope = "file"
+	}
+
+	var suppressions []Suppression
+	for _, rule := range strings.Split(m[2], ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			suppressions = append(suppressions, Suppression{Rule: strings.ToLower(rule), Line: line, Scope: scope})
+		}
+	}
+	if len(suppressions) == 0 {
+		suppressions = append(suppressions, Suppression{Line: line, Scope: scope})
+	}
+	return suppressions
+}
+
+// Suppresses reports whether the suppression silences rule at line.
+func (s Suppression) Suppresses(rule string, line int) bool {
+	if s.Rule != "" && !strings.EqualFold(s.Rule, rule) {
+		return false
+	}
+	switch s.Scope {
+	case "file":
+		return true
+	case "next-line":
+		return line == s.Line+1
+	default:
+		return line == s.Line
+	}
+}
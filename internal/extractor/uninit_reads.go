@@ -0,0 +1,146 @@
+package extractor
+
+import (
+	"sort"
+	"strings"
+)
+
+// UninitRead is a signal with no declaration default that combinational
+// logic reads before anything assigns it. Its value starts as 'U', which
+// propagates through the read until the first assignment and can mask bugs
+// in simulation.
+type UninitRead struct {
+	Signal    string
+	Type      string
+	Line      int    // First combinational read
+	InProcess string // Reading process; empty for a concurrent assignment
+	InArch    string
+}
+
+// uninitTypes are the std_logic-based types whose default value is 'U'.
+var uninitTypes = map[string]bool{
+	"std_logic":         true,
+	"std_ulogic":        true,
+	"std_logic_vector":  true,
+	"std_ulogic_vector": true,
+	"unsigned":          true,
+	"signed":            true,
+	"u_unsigned":        true,
+	"u_signed":          true,
+}
+
+// DetectUninitializedReads flags std_logic-based signals with no declaration
+// default whose first combinational read (in a combinational process or a
+// concurrent assignment) comes before any assignment to them. Ports get
+// their value from outside, and registers a process resets are initialized
+// by the reset, so both are exempt, as are synthesis-off regions.
+func DetectUninitializedReads(facts *FileFacts) []UninitRead {
+	ports := make(map[string]bool)
+	for _, p := range facts.Ports {
+		ports[strings.ToLower(p.Name)] = true
+	}
+	candidates := make(map[string]Signal)
+	for _, sig := range facts.Signals {
+		name := strings.ToLower(sig.Name)
+		if ports[name] || sig.InSynthOffRegion || !uninitTypes[baseTypeName(sig.Type)] {
+			continue
+		}
+		candidates[name] = sig
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// A written usage on the declaration line records its default value
+	firstWrite := make(map[string]int)
+	noteWrite := func(name string, line int) {
+		if line > 0 && (firstWrite[name] == 0 || line < firstWrite[name]) {
+			firstWrite[name] = line
+		}
+	}
+	for _, u := range facts.SignalUsages {
+		name := strings.ToLower(u.Signal)
+		sig, ok := candidates[name]
+		if !ok || !u.IsWritten {
+			continue
+		}
+		if u.InProcess == "" && u.Line == sig.Line {
+			delete(candidates, name)
+			continue
+		}
+		noteWrite(name, u.Line)
+	}
+	for _, proc := range facts.Processes {
+		for _, a := range proc.SignalAccesses {
+			noteWrite(strings.ToLower(a.Signal), a.FirstWrite)
+		}
+		if proc.IsSequential && proc.HasReset {
+			for _, s := range proc.AssignedSignals {
+				delete(candidates, strings.ToLower(s))
+			}
+		}
+	}
+
+	firstRead := make(map[string]UninitRead)
+	noteRead := func(signal string, line int, process, arch string) {
+		name := strings.ToLower(signal)
+		if _, ok := candidates[name]; !ok || line == 0 {
+			return
+		}
+		if prev, ok := firstRead[name]; ok && prev.Line <= line {
+			return
+		}
+		firstRead[name] = UninitRead{Line: line, InProcess: process, InArch: arch}
+	}
+	for _, proc := range facts.Processes {
+		if !proc.IsCombinational || proc.InSynthOffRegion {
+			continue
+		}
+		for _, a := range proc.SignalAccesses {
+			noteRead(a.Signal, a.FirstRead, proc.Label, proc.InArch)
+		}
+	}
+	for _, ca := range facts.ConcurrentAssignments {
+		if ca.InSynthOffRegion {
+			continue
+		}
+		for _, s := range ca.ReadSignals {
+			noteRead(s, ca.Line, "", ca.InArch)
+		}
+	}
+
+	var found []UninitRead
+	for name, read := range firstRead {
+		if w := firstWrite[name]; w != 0 && w < read.Line {
+			continue
+		}
+		sig := candidates[name]
+		read.Signal = sig.Name
+		read.Type = sig.Type
+		if read.InArch == "" {
+			read.InArch = sig.InEntity
+		}
+		found = append(found, read)
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].Line != found[j].Line {
+			return found[i].Line < found[j].Line
+		}
+		return found[i].Signal < found[j].Signal
+	})
+	return found
+}
+
+// baseTypeName returns the lowercase type mark of a subtype indication:
+// "std_logic_vector" for "STD_LOGIC_VECTOR(7 downto 0)", "unsigned" for
+// "ieee.numeric_std.unsigned".
+func baseTypeName(typ string) string {
+	typ = strings.ToLower(strings.TrimSpace(typ))
+	if i := strings.IndexAny(typ, "( "); i != -1 {
+		typ = typ[:i]
+	}
+	if i := strings.LastIndex(typ, "."); i != -1 {
+		typ = typ[i+1:]
+	}
+	return typ
+}
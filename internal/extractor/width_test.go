@@ -1,6 +1,9 @@
 package extractor
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestCalculateWidth(t *testing.T) {
 	tests := []struct {
@@ -24,3 +27,144 @@ func TestCalculateWidth(t *testing.T) {
 		}
 	}
 }
+
+func TestCalculateWidthWithConstants(t *testing.T) {
+	constants := map[string]int{"width": 16, "depth": 4}
+	tests := []struct {
+		typ  string
+		want int
+	}{
+		{"std_logic_vector(WIDTH-1 downto 0)", 16},
+		{"unsigned(WIDTH downto 0)", 17},
+		{"std_logic_vector(0 to DEPTH - 1)", 4},
+		{"std_logic_vector(7 downto 0)", 8},
+		{"std_logic", 1},
+		{"std_logic_vector(UNKNOWN-1 downto 0)", 0},
+		{"my_record_t", 0},
+	}
+
+	for _, tt := range tests {
+		if got := CalculateWidthWithConstants(tt.typ, constants); got != tt.want {
+			t.Fatalf("CalculateWidthWithConstants(%q) = %d, want %d", tt.typ, got, tt.want)
+		}
+	}
+}
+
+func TestRangeWidth(t *testing.T) {
+	constants := map[string]int{"width": 16}
+	tests := map[string]int{
+		"3 downto 0":       4,
+		"0 to 7":           8,
+		"WIDTH-1 downto 8": 8,
+		"5":                0,
+		"N-1 downto 0":     0,
+	}
+	for expr, want := range tests {
+		if got := RangeWidth(expr, constants); got != want {
+			t.Errorf("RangeWidth(%q) = %d, want %d", expr, got, want)
+		}
+	}
+}
+
+func TestEvaluateRangeExpr(t *testing.T) {
+	constants := map[string]int{"n": 4, "m": 3, "width": 16}
+	widths := map[string]int{"data": 8}
+	tests := []struct {
+		expr string
+		want int
+		ok   bool
+	}{
+		{"7", 7, true},
+		{"1_000", 1000, true},
+		{"16#FF#", 255, true},
+		{"WIDTH-1", 15, true},
+		{"N*M-1", 11, true},
+		{"(N + M) * (WIDTH / (N - 2)) - 1", 55, true},
+		{"2**N", 16, true},
+		{"-7 mod 3", 2, true},
+		{"-7 rem 3", -1, true},
+		{"WIDTH mod (N + 1)", 1, true},
+		{"data'length - 1", 7, true},
+		{"8-1", 7, true},
+		{"UNKNOWN - 1", 0, false},
+		{"other'length", 0, false},
+		{"N / 0", 0, false},
+		{"(N + 1", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := evaluateRangeExpr(tt.expr, constants, widths)
+		if ok != tt.ok || (ok && got != tt.want) {
+			t.Fatalf("evaluateRangeExpr(%q) = %d, %v; want %d, %v", tt.expr, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestElaborateGenerates(t *testing.T) {
+	constants := map[string]int{"n": 4, "m": 2}
+	widths := map[string]int{"data": 8}
+	generates := []GenerateStatement{
+		{Kind: "for", RangeLow: "data'range"},
+		{Kind: "for", RangeLow: "0", RangeHigh: "N*M-1", RangeDir: "to"},
+		{Kind: "for", RangeLow: "data'length - 1", RangeHigh: "0", RangeDir: "downto", Generates: []GenerateStatement{
+			{Kind: "for", RangeLow: "bus'range"},
+		}},
+	}
+	if count := ElaborateGenerates(generates, constants, widths); count != 3 {
+		t.Fatalf("expected 3 elaborated generates, got %d", count)
+	}
+	for i, want := range []int{8, 8, 8} {
+		if !generates[i].CanElaborate || generates[i].IterationCount != want {
+			t.Fatalf("generate %d: got %+v, want %d iterations", i, generates[i], want)
+		}
+	}
+	if nested := generates[2].Generates[0]; nested.CanElaborate || nested.IterationCount != -1 {
+		t.Fatalf("unknown signal range should not elaborate, got %+v", nested)
+	}
+}
+
+func TestElaborateLoops(t *testing.T) {
+	constants := map[string]int{"depth": 1024}
+	widths := map[string]int{"data": 8}
+	loops := []LoopStatement{
+		{LoopVar: "i", RangeLow: "0", RangeHigh: "DEPTH-1", RangeDir: "to"},
+		{LoopVar: "j", RangeLow: "data'range"},
+		{LoopVar: "k", RangeLow: "0", RangeHigh: "n_words", RangeDir: "to"},
+	}
+	if count := ElaborateLoops(loops, constants, widths); count != 2 {
+		t.Fatalf("expected 2 elaborated loops, got %d", count)
+	}
+	if !loops[0].CanElaborate || loops[0].IterationCount != 1024 {
+		t.Fatalf("loop 0: got %+v", loops[0])
+	}
+	if !loops[1].CanElaborate || loops[1].IterationCount != 8 {
+		t.Fatalf("loop 1: got %+v", loops[1])
+	}
+	if loops[2].CanElaborate || loops[2].IterationCount != -1 {
+		t.Fatalf("non-static bound should not elaborate, got %+v", loops[2])
+	}
+}
+
+func TestEvaluateGenericMap(t *testing.T) {
+	generics := []GenericDecl{
+		{Name: "WIDTH", Kind: "constant", Default: "8"},
+		{Name: "DEPTH", Kind: "constant", Default: "WIDTH * 2"},
+		{Name: "LANES", Kind: "constant"},
+		{Name: "data_t", Kind: "type"},
+		{Name: "NAME", Kind: "constant", Default: `"fifo"`},
+	}
+	named := Instance{GenericMap: map[string]string{"width": "BUS_W + 4", "LANES": "open"}}
+	want := map[string]int{"width": 20, "depth": 40}
+	if got := EvaluateGenericMap(named, generics, map[string]int{"bus_w": 16}); !reflect.DeepEqual(got, want) {
+		t.Fatalf("named: got %v, want %v", got, want)
+	}
+
+	positional := Instance{Associations: []Association{
+		{Kind: "generic", Actual: "4", IsPositional: true, PositionIndex: 0},
+		{Kind: "generic", Formal: "lanes", Actual: "16#10#"},
+		{Kind: "port", Formal: "clk", Actual: "clk"},
+	}}
+	want = map[string]int{"width": 4, "depth": 8, "lanes": 16}
+	if got := EvaluateGenericMap(positional, generics, nil); !reflect.DeepEqual(got, want) {
+		t.Fatalf("positional: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+package facts
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ndjsonRecord is one NDJSON line: a row of one table.
+type ndjsonRecord struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+// metaTable names the record carrying the stream's schema version instead
+// of a table row.
+const metaTable = "meta"
+
+// ndjsonMeta is the row of the meta record.
+type ndjsonMeta struct {
+	SchemaVersion string `json:"schema_version"`
+}
+
+// WriteNDJSON writes every row of t to w as newline-delimited JSON records
+// ({"table":"signals","row":{...}}), table by table in Tables field order,
+// so loaders can ingest the facts incrementally. A versioned t starts with a
+// {"table":"meta","row":{"schema_version":...}} record so consumers can
+// check the version before reading rows; the output reads back with
+// ReadSpool.
+func WriteNDJSON(w io.Writer, t Tables) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	if t.SchemaVersion != "" {
+		raw, err := json.Marshal(ndjsonMeta{SchemaVersion: t.SchemaVersion})
+		if err != nil {
+			return err
+		}
+		if err := enc.Encode(ndjsonRecord{Table: metaTable, Row: raw}); err != nil {
+			return err
+		}
+	}
+	if err := encodeRecords(enc, t); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// encodeRecords encodes one ndjsonRecord per row of every table in t.
+func encodeRecords(enc *json.Encoder, t Tables) error {
+	val := reflect.ValueOf(t)
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := val.Field(i)
+		if field.Kind() != reflect.Slice {
+			continue
+		}
+		table := tableName(typ.Field(i))
+		for j := 0; j < field.Len(); j++ {
+			raw, err := json.Marshal(field.Index(j).Interface())
+			if err != nil {
+				return fmt.Errorf("marshal %s row: %w", table, err)
+			}
+			if err := enc.Encode(ndjsonRecord{Table: table, Row: raw}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
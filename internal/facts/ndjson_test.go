@@ -0,0 +1,89 @@
+package facts
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestWriteNDJSONEmitsOneRecordPerRow(t *testing.T) {
+	files := []extractor.FileFacts{{
+		File:     "rtl/a.vhd",
+		Entities: []extractor.Entity{{Name: "a", Line: 1}},
+		Signals: []extractor.Signal{
+			{Name: "s1", Type: "std_logic", Line: 4, InEntity: "a"},
+			{Name: "s2", Type: "std_logic", Line: 5, InEntity: "a"},
+		},
+	}}
+	want := BuildTables(files, nil, nil, []SymbolRow{{Name: "work.a", Kind: "entity", File: "rtl/a.vhd", Line: 1}})
+
+	path := filepath.Join(t.TempDir(), "facts.ndjson")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteNDJSON(f, want); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err = os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	counts := make(map[string]int)
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		var record ndjsonRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid record %q: %v", scanner.Text(), err)
+		}
+		if line == 1 {
+			var meta ndjsonMeta
+			if record.Table != "meta" || json.Unmarshal(record.Row, &meta) != nil || meta.SchemaVersion != SchemaVersion {
+				t.Fatalf("expected a leading meta record with the schema version, got %q", scanner.Text())
+			}
+		}
+		counts[record.Table]++
+	}
+	if counts["meta"] != 1 || counts["signals"] != 2 || counts["entities"] != 1 || counts["symbols"] != 1 {
+		t.Fatalf("unexpected record counts: %v", counts)
+	}
+
+	got, err := ReadSpool(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("NDJSON tables differ:\ngot  %+v\nwant %+v", got, want)
+	}
+}
+
+func TestReadSpoolKeepsNDJSONSchemaVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "facts.ndjson")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteNDJSON(f, Tables{SchemaVersion: "1.0.0"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadSpool(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.SchemaVersion != "1.0.0" {
+		t.Fatalf("expected the stream's schema version, got %q", got.SchemaVersion)
+	}
+}
@@ -0,0 +1,63 @@
+package facts
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SchemaVersion is the semantic version of the Tables JSON shape. Bump the
+// major version when a table or field is removed, renamed, or changes type,
+// and the minor version when one is added, so consumers can branch on it.
+const SchemaVersion = "1.1.0"
+
+// CompareSchemaVersions compares two "major.minor.patch" versions (minor and
+// patch may be omitted), returning -1, 0, or 1.
+func CompareSchemaVersions(a, b string) (int, error) {
+	pa, err := parseSchemaVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	pb, err := parseSchemaVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range pa {
+		if pa[i] < pb[i] {
+			return -1, nil
+		}
+		if pa[i] > pb[i] {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// CheckMinSchema returns an error if a consumer requiring version min
+// cannot read the emitted schema version, i.e. min is newer than emitted.
+func CheckMinSchema(min, emitted string) error {
+	cmp, err := CompareSchemaVersions(min, emitted)
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		return fmt.Errorf("requested schema %s is newer than emitted schema %s", min, emitted)
+	}
+	return nil
+}
+
+func parseSchemaVersion(v string) ([3]int, error) {
+	var parts [3]int
+	fields := strings.Split(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".")
+	if len(fields) > 3 {
+		return parts, fmt.Errorf("invalid schema version %q (expected major.minor.patch)", v)
+	}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return parts, fmt.Errorf("invalid schema version %q (expected major.minor.patch)", v)
+		}
+		parts[i] = n
+	}
+	return parts, nil
+}
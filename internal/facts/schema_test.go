@@ -0,0 +1,31 @@
+package facts
+
+import "testing"
+
+func TestCheckMinSchema(t *testing.T) {
+	cases := []struct {
+		min     string
+		wantErr bool
+	}{
+		{min: "1.0.0"},
+		{min: "1"},
+		{min: "v0.9"},
+		{min: "1.0.1", wantErr: true},
+		{min: "2.0.0", wantErr: true},
+		{min: "1.x", wantErr: true},
+		{min: "", wantErr: true},
+	}
+	for _, tc := range cases {
+		err := CheckMinSchema(tc.min, "1.0.0")
+		if (err != nil) != tc.wantErr {
+			t.Errorf("CheckMinSchema(%q): err = %v, wantErr %v", tc.min, err, tc.wantErr)
+		}
+	}
+}
+
+func TestBuildTablesSetsSchemaVersion(t *testing.T) {
+	tables := BuildTables(nil, nil, nil, nil)
+	if tables.SchemaVersion != SchemaVersion {
+		t.Fatalf("SchemaVersion = %q, want %q", tables.SchemaVersion, SchemaVersion)
+	}
+}
@@ -0,0 +1,128 @@
+package facts
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Spool appends fact table rows to an NDJSON file ({"table":"signals",
+// "row":{...}}) as each file finishes extraction, so the tables of a large
+// design never have to be built in memory alongside the extracted facts.
+// Append is safe for concurrent use.
+type Spool struct {
+	mu   sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+	enc  *json.Encoder
+	err  error // First write error; later appends and Close return it
+}
+
+// CreateSpool creates an empty spool file in dir (the system temporary
+// directory when dir is empty). The caller removes Path when done.
+func CreateSpool(dir string) (*Spool, error) {
+	f, err := os.CreateTemp(dir, "vhdl_fact_tables_*.ndjson")
+	if err != nil {
+		return nil, fmt.Errorf("create fact table spool: %w", err)
+	}
+	w := bufio.NewWriter(f)
+	return &Spool{file: f, w: w, enc: json.NewEncoder(w)}, nil
+}
+
+// Path returns the spool file's path.
+func (s *Spool) Path() string {
+	return s.file.Name()
+}
+
+// Append writes every row of t. SchemaVersion is not spooled; ReadSpool
+// sets the current one.
+func (s *Spool) Append(t Tables) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.err = encodeRecords(s.enc, t)
+	return s.err
+}
+
+// Close flushes and closes the spool file, returning the first error any
+// Append or the flush hit.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = s.w.Flush()
+	}
+	if err := s.file.Close(); s.err == nil {
+		s.err = err
+	}
+	return s.err
+}
+
+// ReadSpool loads the tables written to a spool or by WriteNDJSON. Rows keep
+// their order within each table; files are sorted by path as in BuildTables.
+// The schema version comes from a meta record, or is the current one for a
+// spool, which has none.
+func ReadSpool(path string) (Tables, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Tables{}, err
+	}
+	defer f.Close()
+
+	tables := emptyTables()
+	tables.SchemaVersion = SchemaVersion
+	val := reflect.ValueOf(&tables).Elem()
+	fields := make(map[string]reflect.Value)
+	for i := 0; i < val.NumField(); i++ {
+		if val.Field(i).Kind() == reflect.Slice {
+			fields[tableName(val.Type().Field(i))] = val.Field(i)
+		}
+	}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var record ndjsonRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return Tables{}, fmt.Errorf("%s:%d: invalid record: %w", path, line, err)
+		}
+		if record.Table == metaTable {
+			var meta ndjsonMeta
+			if err := json.Unmarshal(record.Row, &meta); err != nil {
+				return Tables{}, fmt.Errorf("%s:%d: invalid meta record: %w", path, line, err)
+			}
+			tables.SchemaVersion = meta.SchemaVersion
+			continue
+		}
+		field, ok := fields[record.Table]
+		if !ok {
+			return Tables{}, fmt.Errorf("%s:%d: unknown table %q", path, line, record.Table)
+		}
+		row := reflect.New(field.Type().Elem())
+		if err := json.Unmarshal(record.Row, row.Interface()); err != nil {
+			return Tables{}, fmt.Errorf("%s:%d: invalid %s row: %w", path, line, record.Table, err)
+		}
+		field.Set(reflect.Append(field, row.Elem()))
+	}
+	if err := scanner.Err(); err != nil {
+		return Tables{}, err
+	}
+
+	sort.Slice(tables.Files, func(i, j int) bool { return tables.Files[i].Path < tables.Files[j].Path })
+	return tables, nil
+}
+
+// tableName returns a Tables field's JSON table name.
+func tableName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("json"), ",")[0]
+}
@@ -0,0 +1,53 @@
+package facts
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestSpoolRoundTripsTables(t *testing.T) {
+	files := []extractor.FileFacts{
+		{
+			File:     "rtl/b.vhd",
+			Entities: []extractor.Entity{{Name: "b", Line: 1}},
+			Signals:  []extractor.Signal{{Name: "s", Type: "std_logic", Line: 4, InEntity: "b"}},
+		},
+		{
+			File:      "rtl/a.vhd",
+			Packages:  []extractor.Package{{Name: "pkg", Line: 1}},
+			Generates: []extractor.GenerateStatement{{Label: "g", Kind: "for", Line: 9, CanElaborate: true}},
+		},
+	}
+	libs := map[string]config.FileLibraryInfo{"rtl/a.vhd": {LibraryName: "work"}}
+	symbols := []SymbolRow{{Name: "work.b", Kind: "entity", File: "rtl/b.vhd", Line: 1}}
+
+	spool, err := CreateSpool(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(spool.Path())
+	for _, f := range files {
+		if err := spool.Append(BuildTables([]extractor.FileFacts{f}, libs, nil, nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := spool.Append(Tables{Symbols: symbols}); err != nil {
+		t.Fatal(err)
+	}
+	if err := spool.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadSpool(spool.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := BuildTables(files, libs, nil, symbols)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("spooled tables differ:\ngot  %+v\nwant %+v", got, want)
+	}
+}
@@ -10,6 +10,7 @@ import (
 // Tables is the relational fact model for Datalog engines.
 // Each slice is a relation (table) with flat rows.
 type Tables struct {
+	SchemaVersion  string             `json:"schema_version,omitempty"` // Version of this shape; empty in delta tables
 	Files          []FileRow          `json:"files"`
 	Entities       []EntityRow        `json:"entities"`
 	Architectures  []ArchitectureRow  `json:"architectures"`
@@ -63,6 +64,7 @@ type PortRow struct {
 	Type      string `json:"type"`
 	File      string `json:"file"`
 	Line      int    `json:"line"`
+	Doc       string `json:"doc,omitempty"`
 }
 
 type SignalRow struct {
@@ -71,6 +73,7 @@ type SignalRow struct {
 	File  string `json:"file"`
 	Line  int    `json:"line"`
 	Scope string `json:"scope"`
+	Doc   string `json:"doc,omitempty"`
 }
 
 type InstanceRow struct {
@@ -182,6 +185,7 @@ type SymbolRow struct {
 // BuildTables converts extractor FileFacts into a normalized relational model.
 func BuildTables(facts []extractor.FileFacts, fileLibs map[string]config.FileLibraryInfo, thirdParty map[string]bool, symbols []SymbolRow) Tables {
 	tables := Tables{
+		SchemaVersion:  SchemaVersion,
 		Files:          []FileRow{},
 		Entities:       []EntityRow{},
 		Architectures:  []ArchitectureRow{},
@@ -251,6 +255,7 @@ func BuildTables(facts []extractor.FileFacts, fileLibs map[string]config.FileLib
 				Type:      p.Type,
 				File:      f.File,
 				Line:      p.Line,
+				Doc:       p.Doc,
 			})
 		}
 
@@ -261,6 +266,7 @@ func BuildTables(facts []extractor.FileFacts, fileLibs map[string]config.FileLib
 				File:  f.File,
 				Line:  s.Line,
 				Scope: s.InEntity,
+				Doc:   s.Doc,
 			})
 		}
 
@@ -322,16 +328,7 @@ func BuildTables(facts []extractor.FileFacts, fileLibs map[string]config.FileLib
 			})
 		}
 
-		for _, gen := range f.Generates {
-			tables.Generates = append(tables.Generates, GenerateRow{
-				Label:   gen.Label,
-				Kind:    gen.Kind,
-				File:    f.File,
-				Line:    gen.Line,
-				InArch:  gen.InArch,
-				CanElab: gen.CanElaborate,
-			})
-		}
+		tables.Generates = append(tables.Generates, GenerateRows(f)...)
 
 		for _, t := range f.Types {
 			tables.Types = append(tables.Types, TypeRow{
@@ -400,3 +397,21 @@ func BuildTables(facts []extractor.FileFacts, fileLibs map[string]config.FileLib
 
 	return tables
 }
+
+// GenerateRows returns the generates table rows of one file. Elaboration
+// updates CanElab after extraction, so a Spool filled during extraction
+// gets these rows last.
+func GenerateRows(f extractor.FileFacts) []GenerateRow {
+	rows := make([]GenerateRow, 0, len(f.Generates))
+	for _, gen := range f.Generates {
+		rows = append(rows, GenerateRow{
+			Label:   gen.Label,
+			Kind:    gen.Kind,
+			File:    f.File,
+			Line:    gen.Line,
+			InArch:  gen.InArch,
+			CanElab: gen.CanElaborate,
+		})
+	}
+	return rows
+}
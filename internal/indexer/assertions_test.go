@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/validator"
+)
+
+func TestBuildPolicyInputIncludesAssertions(t *testing.T) {
+	idx := New()
+	idx.Config = config.DefaultConfig()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{}
+	idx.ThirdPartyFiles = map[string]bool{}
+	idx.Facts = []extractor.FileFacts{{
+		File:          "tb.vhd",
+		Architectures: []extractor.Architecture{{Name: "sim", EntityName: "tb", Line: 1}},
+		Assertions: []extractor.Assertion{
+			{Condition: "done", ReportMessage: `"not done"`, Severity: "error", Line: 9, InProcess: "check_p", InArch: "sim"},
+			{ReportMessage: `"finished"`, Severity: "note", Line: 10, InProcess: "check_p", InArch: "sim"},
+		},
+	}}
+
+	input := idx.buildPolicyInput()
+	want := []policy.Assertion{
+		{Condition: "done", ReportMessage: `"not done"`, Severity: "error", File: "tb.vhd", Line: 9, InProcess: "check_p", InArch: "sim"},
+		{ReportMessage: `"finished"`, Severity: "note", File: "tb.vhd", Line: 10, InProcess: "check_p", InArch: "sim"},
+	}
+	if len(input.Assertions) != len(want) {
+		t.Fatalf("expected %d assertions, got %+v", len(want), input.Assertions)
+	}
+	for i, a := range input.Assertions {
+		if a != want[i] {
+			t.Fatalf("assertion %d: got %+v, want %+v", i, a, want[i])
+		}
+	}
+
+	v, err := validator.New()
+	if err != nil {
+		t.Fatalf("validator: %v", err)
+	}
+	if err := v.Validate(input); err != nil {
+		t.Fatalf("policy input with assertions should validate: %v", err)
+	}
+}
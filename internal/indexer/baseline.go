@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// baselineVersion is the format version written by WriteBaselineFile.
+const baselineVersion = 1
+
+// Baseline is a snapshot of accepted violations. Entries carry no line
+// numbers, so edits that only move code keep matching. An entry appears once
+// per accepted violation, so N identical entries absorb at most N violations.
+type Baseline struct {
+	Version    int             `json:"version"`
+	Violations []BaselineEntry `json:"violations"`
+}
+
+// BaselineEntry is the fingerprint of one violation.
+type BaselineEntry struct {
+	Rule    string `json:"rule"`
+	File    string `json:"file"`
+	Message string `json:"message"` // Normalized (see normalizeBaselineMessage)
+}
+
+var (
+	baselineDigitsRe = regexp.MustCompile(`\d+`)
+	baselineSpaceRe  = regexp.MustCompile(`\s+`)
+)
+
+// normalizeBaselineMessage strips the parts of a message that change with
+// unrelated edits: numbers (lines, counts embedded in text) and spacing.
+func normalizeBaselineMessage(msg string) string {
+	msg = baselineDigitsRe.ReplaceAllString(msg, "#")
+	return strings.TrimSpace(baselineSpaceRe.ReplaceAllString(msg, " "))
+}
+
+// baselineEntryFor fingerprints a violation.
+func baselineEntryFor(v policy.Violation) BaselineEntry {
+	return BaselineEntry{
+		Rule:    v.Rule,
+		File:    filepath.ToSlash(v.File),
+		Message: normalizeBaselineMessage(v.Message),
+	}
+}
+
+// LoadBaseline reads a baseline file into a count of accepted violations per
+// fingerprint.
+func LoadBaseline(path string) (map[BaselineEntry]int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("parse baseline %s: %w", path, err)
+	}
+	if b.Version != baselineVersion {
+		return nil, fmt.Errorf("baseline %s: unsupported version %d (expected %d)", path, b.Version, baselineVersion)
+	}
+	counts := make(map[BaselineEntry]int, len(b.Violations))
+	for _, e := range b.Violations {
+		e.File = filepath.ToSlash(e.File)
+		e.Message = normalizeBaselineMessage(e.Message)
+		counts[e]++
+	}
+	return counts, nil
+}
+
+// WriteBaselineFile snapshots violations as a baseline, one entry per
+// violation, sorted so the file diffs cleanly between snapshots.
+func WriteBaselineFile(path string, violations []policy.Violation) error {
+	entries := make([]BaselineEntry, 0, len(violations))
+	for _, v := range violations {
+		entries = append(entries, baselineEntryFor(v))
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].File != entries[j].File {
+			return entries[i].File < entries[j].File
+		}
+		if entries[i].Rule != entries[j].Rule {
+			return entries[i].Rule < entries[j].Rule
+		}
+		return entries[i].Message < entries[j].Message
+	})
+
+	data, err := json.MarshalIndent(Baseline{Version: baselineVersion, Violations: entries}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,148 @@
+package indexer
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestBaselineRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	old := []policy.Violation{
+		{Rule: "multi_driven_signal", Severity: "warning", File: "rtl/top.vhd", Line: 12, Message: "Signal 'x' is assigned in 2 places"},
+		{Rule: "unused_signal", Severity: "info", File: "rtl/top.vhd", Line: 20, Message: "Signal 'tmp' is never used"},
+	}
+	if err := WriteBaselineFile(path, old); err != nil {
+		t.Fatalf("write baseline: %v", err)
+	}
+	baseline, err := LoadBaseline(path)
+	if err != nil {
+		t.Fatalf("load baseline: %v", err)
+	}
+
+	// Same findings after an edit moved them, plus one new error
+	lintResult := LintResult{}
+	applyPolicyResult(&lintResult, &policy.Result{
+		Violations: []policy.Violation{
+			{Rule: "multi_driven_signal", Severity: "warning", File: "rtl/top.vhd", Line: 15, Message: "Signal 'x' is assigned in  3 places"},
+			{Rule: "unused_signal", Severity: "info", File: "rtl/top.vhd", Line: 24, Message: "Signal 'tmp' is never used"},
+			{Rule: "latch_inferred", Severity: "error", File: "rtl/top.vhd", Line: 40, Message: "Latch on 'q'"},
+		},
+		Summary: policy.Summary{TotalViolations: 3, Errors: 1, Warnings: 1, Info: 1},
+	}, baseline, nil)
+
+	if len(lintResult.Violations) != 1 || lintResult.Violations[0].Rule != "latch_inferred" {
+		t.Fatalf("expected only the new violation, got %+v", lintResult.Violations)
+	}
+	want := ResultSummary{
+		TotalViolations: 1, Errors: 1, Baselined: 2,
+		ByRule:   map[string]int{"latch_inferred": 1},
+		TopRules: []RuleCount{{Rule: "latch_inferred", Count: 1}},
+	}
+	if !reflect.DeepEqual(lintResult.Summary, want) {
+		t.Fatalf("summary = %+v, want %+v", lintResult.Summary, want)
+	}
+	if len(lintResult.Files) != 1 || lintResult.Files[0].Errors != 1 || lintResult.Files[0].Warnings != 0 {
+		t.Fatalf("file counts should exclude baselined violations, got %+v", lintResult.Files)
+	}
+}
+
+func TestApplyPolicyResultSeverityOverrides(t *testing.T) {
+	result := &policy.Result{
+		Violations: []policy.Violation{
+			{Rule: "latch_inferred", Severity: "error", File: "rtl/top.vhd", Line: 40, Message: "Latch on 'q'"},
+			{Rule: "latch_inferred", Severity: "error", File: "rtl/top.vhd", Line: 50, Message: "Latch on 'r'"},
+			{Rule: "unused_signal", Severity: "info", File: "rtl/top.vhd", Line: 20, Message: "Signal 'tmp' is never used"},
+		},
+		Summary: policy.Summary{TotalViolations: 3, Errors: 2, Info: 1},
+	}
+	lintResult := LintResult{}
+	applyPolicyResult(&lintResult, result, nil, map[string]string{"latch_inferred": "warning", "magic_number": "error"})
+
+	want := ResultSummary{
+		TotalViolations: 3, Warnings: 2, Info: 1,
+		ByRule:   map[string]int{"latch_inferred": 2, "unused_signal": 1},
+		TopRules: []RuleCount{{Rule: "latch_inferred", Count: 2}, {Rule: "unused_signal", Count: 1}},
+	}
+	if !reflect.DeepEqual(lintResult.Summary, want) {
+		t.Fatalf("summary = %+v, want %+v", lintResult.Summary, want)
+	}
+	if lintResult.Violations[0].Severity != "warning" || lintResult.Violations[2].Severity != "info" {
+		t.Fatalf("unexpected severities: %+v", lintResult.Violations)
+	}
+	if len(lintResult.Files) != 1 || lintResult.Files[0].Errors != 0 || lintResult.Files[0].Warnings != 2 {
+		t.Fatalf("file counts should use the overridden severity, got %+v", lintResult.Files)
+	}
+	// The engine result (possibly a cache entry) keeps its own severities
+	if result.Violations[0].Severity != "error" {
+		t.Fatalf("policy result was modified: %+v", result.Violations[0])
+	}
+}
+
+func TestApplyPolicyResultSeverityOverridesWithBaseline(t *testing.T) {
+	baseline := map[BaselineEntry]int{
+		baselineEntryFor(policy.Violation{Rule: "latch_inferred", File: "rtl/top.vhd", Message: "Latch on 'q'"}): 1,
+	}
+	lintResult := LintResult{}
+	applyPolicyResult(&lintResult, &policy.Result{
+		Violations: []policy.Violation{
+			{Rule: "latch_inferred", Severity: "error", File: "rtl/top.vhd", Line: 40, Message: "Latch on 'q'"},
+			{Rule: "latch_inferred", Severity: "error", File: "rtl/top.vhd", Line: 50, Message: "Latch on 'r'"},
+			{Rule: "unused_signal", Severity: "info", File: "rtl/top.vhd", Line: 20, Message: "Signal 'tmp' is never used"},
+		},
+		Summary: policy.Summary{TotalViolations: 3, Errors: 2, Info: 1},
+	}, baseline, map[string]string{"latch_inferred": "warning"})
+
+	if len(lintResult.Violations) != 2 || lintResult.Violations[0].Severity != "warning" {
+		t.Fatalf("expected the overridden severity on the kept violation, got %+v", lintResult.Violations)
+	}
+	want := ResultSummary{
+		TotalViolations: 2, Warnings: 1, Info: 1, Baselined: 1,
+		ByRule:   map[string]int{"latch_inferred": 1, "unused_signal": 1},
+		TopRules: []RuleCount{{Rule: "latch_inferred", Count: 1}, {Rule: "unused_signal", Count: 1}},
+	}
+	if !reflect.DeepEqual(lintResult.Summary, want) {
+		t.Fatalf("summary = %+v, want %+v", lintResult.Summary, want)
+	}
+}
+
+func TestBaselineAbsorbsOneViolationPerEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	accepted := []policy.Violation{
+		{Rule: "magic_number", Severity: "info", File: "rtl/top.vhd", Line: 10, Message: "Magic number 42 in comparison"},
+	}
+	if err := WriteBaselineFile(path, accepted); err != nil {
+		t.Fatalf("write baseline: %v", err)
+	}
+	baseline, err := LoadBaseline(path)
+	if err != nil {
+		t.Fatalf("load baseline: %v", err)
+	}
+
+	// Numbers are normalized away, so both messages share one fingerprint
+	lintResult := LintResult{}
+	applyPolicyResult(&lintResult, &policy.Result{
+		Violations: []policy.Violation{
+			{Rule: "magic_number", Severity: "info", File: "rtl/top.vhd", Line: 10, Message: "Magic number 42 in comparison"},
+			{Rule: "magic_number", Severity: "info", File: "rtl/top.vhd", Line: 30, Message: "Magic number 77 in comparison"},
+		},
+		Summary: policy.Summary{TotalViolations: 2, Info: 2},
+	}, baseline, nil)
+
+	if len(lintResult.Violations) != 1 || lintResult.Summary.Baselined != 1 || lintResult.Summary.Info != 1 {
+		t.Fatalf("expected one entry to absorb one violation, got %+v (summary %+v)", lintResult.Violations, lintResult.Summary)
+	}
+	if baseline[baselineEntryFor(accepted[0])] != 1 {
+		t.Fatalf("applyPolicyResult consumed the caller's baseline: %v", baseline)
+	}
+
+	// Identical violations are written once each
+	if err := WriteBaselineFile(path, append(accepted, accepted[0])); err != nil {
+		t.Fatalf("write baseline: %v", err)
+	}
+	if baseline, err = LoadBaseline(path); err != nil || baseline[baselineEntryFor(accepted[0])] != 2 {
+		t.Fatalf("expected two entries for two violations, got %v (%v)", baseline, err)
+	}
+}
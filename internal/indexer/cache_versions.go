@@ -1,6 +1,7 @@
 package indexer
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -18,6 +19,15 @@ func cacheEnabled(cfg *config.Config) bool {
 	return *cfg.Analysis.Cache.Enabled
 }
 
+// magicNumberThreshold returns the configured magic_number threshold, or -1
+// to let the extractor apply its default when none is set.
+func magicNumberThreshold(cfg *config.Config) int {
+	if cfg == nil || cfg.Lint.MagicNumberThreshold == nil {
+		return -1
+	}
+	return *cfg.Lint.MagicNumberThreshold
+}
+
 func resolveCacheDir(rootPath string, cfg *config.Config) string {
 	baseDir := rootPath
 	if info, err := os.Stat(rootPath); err == nil && !info.IsDir() {
@@ -33,6 +43,21 @@ func resolveCacheDir(rootPath string, cfg *config.Config) string {
 	return cacheDir
 }
 
+// CheckCacheDir creates the cache directory if needed and verifies that
+// files can be written in it.
+func CheckCacheDir(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("cache directory %s: %w", dir, err)
+	}
+	f, err := os.CreateTemp(dir, ".write-check-*")
+	if err != nil {
+		return fmt.Errorf("cache directory %s is not writable: %w", dir, err)
+	}
+	name := f.Name()
+	f.Close()
+	return os.Remove(name)
+}
+
 func computeCacheVersions(rootPath string) cacheVersions {
 	// Prefer locating the repo root by walking up from this source file.
 	repoRoot := findRepoRootForCache()
@@ -0,0 +1,185 @@
+package indexer
+
+import (
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+// predefinedEnums are the literals of the standard enumeration types a case
+// selector commonly has.
+var predefinedEnums = map[string][]string{
+	"boolean":    {"false", "true"},
+	"bit":        {"'0'", "'1'"},
+	"std_ulogic": {"'u'", "'x'", "'0'", "'1'", "'z'", "'w'", "'l'", "'h'", "'-'"},
+	"std_logic":  {"'u'", "'x'", "'0'", "'1'", "'z'", "'w'", "'l'", "'h'", "'-'"},
+}
+
+// markCompleteEnumCases sets IsComplete on case statements without "when
+// others" whose selector is a variable, signal, or port of an enumeration
+// type and whose choices name every literal of that type. Enumerations
+// come from the file's own declarations first, then from every analyzed
+// package; a selector of unknown type, or a type name declared with
+// different literals in several places, stays incomplete.
+func (idx *Indexer) markCompleteEnumCases() {
+	global := make(map[string][]string)
+	conflicting := make(map[string]bool)
+	for _, facts := range idx.Facts {
+		for _, td := range facts.Types {
+			if td.Kind != "enum" {
+				continue
+			}
+			name := strings.ToLower(td.Name)
+			if prev, ok := global[name]; ok && !sameLiterals(prev, td.EnumLiterals) {
+				conflicting[name] = true
+			}
+			global[name] = td.EnumLiterals
+		}
+	}
+	for name := range conflicting {
+		delete(global, name)
+	}
+
+	for i := range idx.Facts {
+		facts := &idx.Facts[i]
+		cloned := false
+		for j, cs := range facts.CaseStatements {
+			if cs.IsComplete {
+				continue
+			}
+			literals := enumLiteralsOf(*facts, selectorType(*facts, cs), global)
+			if len(literals) == 0 || !coversLiterals(cs.Choices, literals) {
+				continue
+			}
+			// Facts may be shared with the cache or a resident server
+			if !cloned {
+				facts.CaseStatements = append([]extractor.CaseStatement(nil), facts.CaseStatements...)
+				cloned = true
+			}
+			facts.CaseStatements[j].IsComplete = true
+		}
+	}
+}
+
+// selectorType returns the subtype indication of a case selector that names
+// a process variable, a signal, or a port of the case's architecture, or ""
+// when the selector is an expression or unknown.
+func selectorType(facts extractor.FileFacts, cs extractor.CaseStatement) string {
+	name := strings.TrimSpace(cs.Expression)
+	if name == "" || strings.ContainsAny(name, "().' ") {
+		return ""
+	}
+	return objectType(facts, name, cs.InProcess, cs.InArch)
+}
+
+// objectType returns the subtype indication of the process variable, signal,
+// or port called name as seen from a process (empty for concurrent code) of
+// an architecture, or "" when none is declared in the file.
+func objectType(facts extractor.FileFacts, name, inProcess, inArch string) string {
+	arch := archScopeBase(inArch)
+	for _, proc := range facts.Processes {
+		if inProcess == "" || !strings.EqualFold(proc.Label, inProcess) || !strings.EqualFold(archScopeBase(proc.InArch), arch) {
+			continue
+		}
+		for _, v := range proc.Variables {
+			if strings.EqualFold(v.Name, name) {
+				return v.Type
+			}
+		}
+	}
+	for _, sig := range facts.Signals {
+		if strings.EqualFold(sig.Name, name) && strings.EqualFold(archScopeBase(sig.InEntity), arch) {
+			return sig.Type
+		}
+	}
+	for _, a := range facts.Architectures {
+		if !strings.EqualFold(a.Name, arch) {
+			continue
+		}
+		for _, p := range facts.Ports {
+			if strings.EqualFold(p.Name, name) && strings.EqualFold(p.InEntity, a.EntityName) {
+				return p.Type
+			}
+		}
+	}
+	return ""
+}
+
+// enumLiteralsOf returns the lowercase literals of the enumeration type a
+// subtype indication names, following unconstrained subtypes of it, or nil
+// when it is not a known enumeration.
+func enumLiteralsOf(facts extractor.FileFacts, typ string, global map[string][]string) []string {
+	name := typeMark(typ)
+	for depth := 0; name != "" && depth < 8; depth++ {
+		for _, td := range facts.Types {
+			if td.Kind == "enum" && strings.EqualFold(td.Name, name) {
+				return lowerAll(td.EnumLiterals)
+			}
+		}
+		if literals, ok := global[name]; ok {
+			return lowerAll(literals)
+		}
+		if literals, ok := predefinedEnums[name]; ok {
+			return literals
+		}
+		// A subtype with a range constraint covers only part of its type
+		next := ""
+		for _, st := range facts.Subtypes {
+			if strings.EqualFold(st.Name, name) && st.Constraint == "" {
+				next = typeMark(st.BaseType)
+			}
+		}
+		name = next
+	}
+	return nil
+}
+
+// typeMark returns the lowercase type name of a subtype indication without
+// its package prefix or constraint.
+func typeMark(typ string) string {
+	typ = strings.ToLower(strings.TrimSpace(typ))
+	if i := strings.IndexAny(typ, "( "); i != -1 {
+		typ = typ[:i]
+	}
+	if i := strings.LastIndex(typ, "."); i != -1 {
+		typ = typ[i+1:]
+	}
+	return typ
+}
+
+// coversLiterals reports whether the case choices, including "a | b"
+// alternatives, name every literal.
+func coversLiterals(choices, literals []string) bool {
+	covered := make(map[string]bool)
+	for _, choice := range choices {
+		for _, alt := range strings.Split(choice, "|") {
+			covered[strings.ToLower(strings.TrimSpace(alt))] = true
+		}
+	}
+	for _, lit := range literals {
+		if !covered[lit] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameLiterals(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !strings.EqualFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func lowerAll(values []string) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = strings.ToLower(v)
+	}
+	return out
+}
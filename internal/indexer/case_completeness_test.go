@@ -0,0 +1,78 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestMarkCompleteEnumCases(t *testing.T) {
+	pkg := extractor.FileFacts{
+		File: "pkg.vhd",
+		Types: []extractor.TypeDeclaration{
+			{Name: "mode_t", Kind: "enum", EnumLiterals: []string{"MODE_A", "MODE_B", "MODE_C"}, InPackage: "types_pkg"},
+		},
+	}
+	shared := []extractor.CaseStatement{
+		{Expression: "state", Choices: []string{"IDLE", "RUN"}, InProcess: "p", InArch: "rtl"},
+		{Expression: "state", Choices: []string{"IDLE"}, InProcess: "p", InArch: "rtl"},
+		{Expression: "mode", Choices: []string{"MODE_A | MODE_B", "mode_c"}, InProcess: "p", InArch: "rtl"},
+		{Expression: "flag", Choices: []string{"true", "false"}, InProcess: "p", InArch: "rtl"},
+		{Expression: "count", Choices: []string{"0", "1"}, InProcess: "p", InArch: "rtl"},
+		{Expression: "sel_i", Choices: []string{"'0'", "'1'"}, InProcess: "p", InArch: "rtl"},
+		{Expression: "state(0)", Choices: []string{"IDLE", "RUN"}, InProcess: "p", InArch: "rtl"},
+	}
+	top := extractor.FileFacts{
+		File:          "top.vhd",
+		Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top"}},
+		Ports:         []extractor.Port{{Name: "sel_i", Type: "std_logic", InEntity: "top"}},
+		Types: []extractor.TypeDeclaration{
+			{Name: "state_t", Kind: "enum", EnumLiterals: []string{"IDLE", "RUN"}, InArch: "rtl"},
+		},
+		Signals: []extractor.Signal{
+			{Name: "state", Type: "state_t", InEntity: "rtl"},
+			{Name: "mode", Type: "work.types_pkg.mode_t", InEntity: "rtl"},
+			{Name: "count", Type: "integer range 0 to 1", InEntity: "rtl"},
+		},
+		Processes: []extractor.Process{
+			{Label: "p", InArch: "rtl", Variables: []extractor.VariableDecl{{Name: "flag", Type: "boolean"}}},
+		},
+		CaseStatements: shared,
+	}
+
+	idx := New()
+	idx.Facts = []extractor.FileFacts{pkg, top}
+	idx.markCompleteEnumCases()
+
+	want := []bool{true, false, true, true, false, false, false}
+	for i, cs := range idx.Facts[1].CaseStatements {
+		if cs.IsComplete != want[i] {
+			t.Errorf("case %s %v: IsComplete = %v, want %v", cs.Expression, cs.Choices, cs.IsComplete, want[i])
+		}
+	}
+	if shared[0].IsComplete {
+		t.Errorf("expected the original case statements to be left unchanged")
+	}
+}
+
+func TestMarkCompleteEnumCasesConflictingTypes(t *testing.T) {
+	a := extractor.FileFacts{
+		File:  "a_pkg.vhd",
+		Types: []extractor.TypeDeclaration{{Name: "op_t", Kind: "enum", EnumLiterals: []string{"ADD", "SUB"}}},
+	}
+	b := extractor.FileFacts{
+		File:  "b_pkg.vhd",
+		Types: []extractor.TypeDeclaration{{Name: "op_t", Kind: "enum", EnumLiterals: []string{"ADD", "SUB", "MUL"}}},
+	}
+	top := extractor.FileFacts{
+		File:           "top.vhd",
+		Signals:        []extractor.Signal{{Name: "op", Type: "op_t", InEntity: "rtl"}},
+		CaseStatements: []extractor.CaseStatement{{Expression: "op", Choices: []string{"ADD", "SUB"}, InArch: "rtl"}},
+	}
+	idx := New()
+	idx.Facts = []extractor.FileFacts{a, b, top}
+	idx.markCompleteEnumCases()
+	if idx.Facts[2].CaseStatements[0].IsComplete {
+		t.Errorf("expected a type declared with different literals to stay incomplete")
+	}
+}
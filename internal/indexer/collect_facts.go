@@ -0,0 +1,64 @@
+package indexer
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+)
+
+// CollectFacts runs discovery and extraction for rootPath without building the
+// policy input or invoking the policy engine. It populates Facts (sorted by
+// file), Symbols, FileLibraries, and ThirdPartyFiles for exporters that only
+// need the extracted facts. Extraction errors are collected and returned
+// together after every file has been attempted.
+func (idx *Indexer) CollectFacts(rootPath string) error {
+	if idx.Config == nil {
+		cfg, err := config.Load(rootPath)
+		if err != nil {
+			return fmt.Errorf("load config: %w", err)
+		}
+		idx.Config = cfg
+	}
+
+	idx.Symbols = &SymbolTable{symbols: make(map[string]Symbol)}
+	idx.Facts = nil
+	idx.FileLibraries = make(map[string]config.FileLibraryInfo)
+	idx.ThirdPartyFiles = make(map[string]bool)
+
+	files, _, err := idx.discoverFiles(rootPath)
+	if err != nil {
+		return err
+	}
+
+	ext := idx.newExtractor()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	for _, file := range files {
+		wg.Add(1)
+		go func(f string) {
+			defer wg.Done()
+			facts, err := ext.Extract(f)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", f, err))
+				return
+			}
+			idx.Facts = append(idx.Facts, facts)
+		}(file)
+	}
+	wg.Wait()
+
+	sort.Slice(idx.Facts, func(i, j int) bool { return idx.Facts[i].File < idx.Facts[j].File })
+	for _, facts := range idx.Facts {
+		idx.registerSymbolsForFacts(facts, facts.File)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("extraction failed for %d files:\n%s", len(errs), formatPipelineErrors(errs))
+	}
+	return nil
+}
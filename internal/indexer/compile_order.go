@@ -0,0 +1,161 @@
+package indexer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// CompileFile is one file of a compile order and the library it is analyzed into.
+type CompileFile struct {
+	File    string
+	Library string
+}
+
+// DependencyCycleError reports files whose dependencies form a cycle, so no
+// analysis order exists for them.
+type DependencyCycleError struct {
+	Files []string // Files on or between the cycles, sorted
+}
+
+func (e *DependencyCycleError) Error() string {
+	return fmt.Sprintf("dependency cycle between %d files: %s", len(e.Files), strings.Join(e.Files, ", "))
+}
+
+// CompileOrder sorts the collected files into an order a simulator can
+// analyze them in: every file after the files declaring the packages,
+// contexts, and entities its use clauses and instantiations reference, and
+// after the entity of each architecture it contains. Files that do not depend
+// on each other are ordered by path. References that do not resolve through
+// the symbol table (ieee, vendor libraries) are ignored. A cycle is reported
+// as a *DependencyCycleError.
+func (idx *Indexer) CompileOrder() ([]CompileFile, error) {
+	dependsOn := make(map[string]map[string]bool, len(idx.Facts))
+	dependents := make(map[string][]string)
+	for _, facts := range idx.Facts {
+		deps := resolveDependencies(facts, facts.File, idx.Symbols, idx.FileLibraries)
+		lib := idx.FileLibrary(facts.File)
+		for _, arch := range facts.Architectures {
+			if sym, ok := idx.Symbols.Get(lib + "." + strings.ToLower(arch.EntityName)); ok && sym.Kind == "entity" {
+				deps = append(deps, sym.File)
+			}
+		}
+		edges := make(map[string]bool)
+		for _, dep := range deps {
+			if dep == "" || dep == facts.File || edges[dep] {
+				continue
+			}
+			edges[dep] = true
+			dependents[dep] = append(dependents[dep], facts.File)
+		}
+		dependsOn[facts.File] = edges
+	}
+
+	// Kahn's algorithm, one level of ready files at a time
+	pending := make(map[string]int, len(dependsOn))
+	var ready []string
+	for file, edges := range dependsOn {
+		for dep := range edges {
+			if _, known := dependsOn[dep]; known {
+				pending[file]++
+			}
+		}
+		if pending[file] == 0 {
+			ready = append(ready, file)
+		}
+	}
+	order := make([]CompileFile, 0, len(dependsOn))
+	for len(ready) > 0 {
+		sort.Strings(ready)
+		var next []string
+		for _, file := range ready {
+			order = append(order, CompileFile{File: file, Library: idx.FileLibrary(file)})
+			for _, dependent := range dependents[file] {
+				if pending[dependent]--; pending[dependent] == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+		ready = next
+	}
+	if len(order) == len(dependsOn) {
+		return order, nil
+	}
+
+	// The files left depend on a cycle; peel off those nothing left depends
+	// on, which keeps the cycles and the files between them
+	left := make(map[string]bool)
+	for file, n := range pending {
+		if n > 0 {
+			left[file] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for file := range left {
+			needed := false
+			for _, dependent := range dependents[file] {
+				if left[dependent] {
+					needed = true
+					break
+				}
+			}
+			if !needed {
+				delete(left, file)
+				changed = true
+			}
+		}
+	}
+	cycle := make([]string, 0, len(left))
+	for file := range left {
+		cycle = append(cycle, file)
+	}
+	sort.Strings(cycle)
+	return order, &DependencyCycleError{Files: cycle}
+}
+
+// ghdlStandards maps config standards onto ghdl's --std values.
+var ghdlStandards = map[string]string{
+	"1993": "93c", "2002": "02", "2008": "08", "2019": "19",
+}
+
+// WriteCompileOrder prints a compile order as a list of files, or as a shell
+// script running "ghdl -a" on each file into its library when format is
+// "ghdl".
+func WriteCompileOrder(w io.Writer, order []CompileFile, format, standard string) error {
+	if format != "ghdl" {
+		for _, f := range order {
+			if _, err := fmt.Fprintln(w, f.File); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	std := ghdlStandards[standard]
+	if std == "" {
+		std = "08"
+	}
+	if _, err := fmt.Fprintf(w, "#!/bin/sh\nset -e\n"); err != nil {
+		return err
+	}
+	for _, f := range order {
+		if _, err := fmt.Fprintf(w, "ghdl -a --std=%s --work=%s %s\n", std, f.Library, shellQuote(f.File)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shellQuote quotes s for a POSIX shell when it contains anything but safe
+// path characters.
+func shellQuote(s string) string {
+	safe := s != "" && strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("/._-+", r))
+	}) < 0
+	if safe {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,124 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func compileOrderIndexer(facts ...extractor.FileFacts) *Indexer {
+	idx := New()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{
+		"util_pkg.vhd": {LibraryName: "util"},
+	}
+	idx.Facts = facts
+	for _, f := range facts {
+		idx.registerSymbolsForFacts(f, f.File)
+	}
+	return idx
+}
+
+func TestCompileOrder(t *testing.T) {
+	idx := compileOrderIndexer(
+		extractor.FileFacts{
+			File:          "a_top.vhd",
+			Entities:      []extractor.Entity{{Name: "top"}},
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top"}},
+			Dependencies: []extractor.Dependency{
+				{Target: "ieee.std_logic_1164.all", Kind: "use"},
+				{Target: "work.leaf", Kind: "instantiation"},
+			},
+		},
+		extractor.FileFacts{
+			File:     "leaf_ent.vhd",
+			Entities: []extractor.Entity{{Name: "leaf"}},
+			Dependencies: []extractor.Dependency{
+				{Target: "util.util_pkg.all", Kind: "use"},
+			},
+		},
+		extractor.FileFacts{
+			File:          "leaf_rtl.vhd",
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "leaf"}},
+		},
+		extractor.FileFacts{
+			File:     "util_pkg.vhd",
+			Packages: []extractor.Package{{Name: "util_pkg"}},
+		},
+		extractor.FileFacts{File: "b_unrelated.vhd"},
+	)
+
+	order, err := idx.CompileOrder()
+	if err != nil {
+		t.Fatalf("CompileOrder: %v", err)
+	}
+	want := []CompileFile{
+		{File: "b_unrelated.vhd", Library: "work"},
+		{File: "util_pkg.vhd", Library: "util"},
+		{File: "leaf_ent.vhd", Library: "work"},
+		{File: "a_top.vhd", Library: "work"},
+		{File: "leaf_rtl.vhd", Library: "work"},
+	}
+	if !reflect.DeepEqual(order, want) {
+		t.Fatalf("got %+v\nwant %+v", order, want)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCompileOrder(&buf, order[:2], "ghdl", "1993"); err != nil {
+		t.Fatal(err)
+	}
+	wantScript := "#!/bin/sh\nset -e\n" +
+		"ghdl -a --std=93c --work=work b_unrelated.vhd\n" +
+		"ghdl -a --std=93c --work=util util_pkg.vhd\n"
+	if buf.String() != wantScript {
+		t.Fatalf("script:\n%s\nwant:\n%s", buf.String(), wantScript)
+	}
+}
+
+func TestCompileOrderReportsCycle(t *testing.T) {
+	idx := compileOrderIndexer(
+		extractor.FileFacts{
+			File:         "a.vhd",
+			Packages:     []extractor.Package{{Name: "pkg_a"}},
+			Dependencies: []extractor.Dependency{{Target: "work.pkg_b.all", Kind: "use"}},
+		},
+		extractor.FileFacts{
+			File:         "b.vhd",
+			Packages:     []extractor.Package{{Name: "pkg_b"}},
+			Dependencies: []extractor.Dependency{{Target: "work.pkg_a.all", Kind: "use"}},
+		},
+		extractor.FileFacts{
+			File:         "c.vhd",
+			Dependencies: []extractor.Dependency{{Target: "work.pkg_a.all", Kind: "use"}},
+		},
+		extractor.FileFacts{File: "d.vhd"},
+	)
+
+	order, err := idx.CompileOrder()
+	var cycle *DependencyCycleError
+	if !errors.As(err, &cycle) {
+		t.Fatalf("expected a DependencyCycleError, got %v", err)
+	}
+	if want := []string{"a.vhd", "b.vhd"}; !reflect.DeepEqual(cycle.Files, want) {
+		t.Fatalf("cycle files = %v, want %v", cycle.Files, want)
+	}
+	if len(order) != 1 || order[0].File != "d.vhd" {
+		t.Fatalf("expected only d.vhd ordered, got %+v", order)
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	for in, want := range map[string]string{
+		"src/a_b-1.vhd": "src/a_b-1.vhd",
+		"my dir/a.vhd":  "'my dir/a.vhd'",
+		"it's/a.vhd":    `'it'\''s/a.vhd'`,
+		"":              "''",
+	} {
+		if got := shellQuote(in); got != want {
+			t.Errorf("shellQuote(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package indexer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// checkComponentMismatches compares each component declaration with the
+// entity of the same name in the declaring file's library, found through the
+// symbol table, and reports every port that differs: a port only one side
+// declares, a different direction, or a different width. Widths that cannot
+// be computed (generic-dependent bounds, records) are not compared.
+// Components without a matching entity are left to component_resolved.
+func (idx *Indexer) checkComponentMismatches() []policy.ComponentMismatch {
+	sorted, factsByFile, _ := idx.instanceResolutionIndex()
+	types := idx.buildTypeWidths(idx.globalConstants())
+
+	found := []policy.ComponentMismatch{}
+	for _, facts := range sorted {
+		lib := "work"
+		if info, ok := idx.FileLibraries[facts.File]; ok && info.LibraryName != "" {
+			lib = strings.ToLower(info.LibraryName)
+		}
+		for _, comp := range facts.Components {
+			if comp.IsInstance || comp.Name == "" {
+				continue
+			}
+			sym, ok := idx.Symbols.Get(fmt.Sprintf("%s.%s", lib, strings.ToLower(comp.Name)))
+			if !ok || sym.Kind != "entity" {
+				continue
+			}
+			target, ok := factsByFile[sym.File]
+			if !ok {
+				continue
+			}
+			for _, ent := range target.Entities {
+				if !strings.EqualFold(ent.Name, comp.Name) {
+					continue
+				}
+				base := policy.ComponentMismatch{
+					Component:  comp.Name,
+					Entity:     ent.Name,
+					File:       facts.File,
+					Line:       comp.Line,
+					EntityFile: target.File,
+					EntityLine: ent.Line,
+				}
+				found = append(found, comparePorts(base, comp.Ports, ent.Ports, types)...)
+				break
+			}
+		}
+	}
+	return found
+}
+
+// comparePorts reports the differences between a component's ports and its
+// entity's, component ports first, then ports only the entity declares.
+func comparePorts(base policy.ComponentMismatch, compPorts, entPorts []extractor.Port, types *typeWidths) []policy.ComponentMismatch {
+	entByName := make(map[string]extractor.Port, len(entPorts))
+	for _, p := range entPorts {
+		entByName[strings.ToLower(p.Name)] = p
+	}
+	inComponent := make(map[string]bool, len(compPorts))
+
+	var found []policy.ComponentMismatch
+	report := func(port, kind, compValue, entValue string) {
+		m := base
+		m.Port, m.Kind, m.ComponentValue, m.EntityValue = port, kind, compValue, entValue
+		found = append(found, m)
+	}
+	for _, cp := range compPorts {
+		inComponent[strings.ToLower(cp.Name)] = true
+		ep, ok := entByName[strings.ToLower(cp.Name)]
+		if !ok {
+			report(cp.Name, "missing_in_entity", cp.Direction, "")
+			continue
+		}
+		if cd, ed := portDirection(cp), portDirection(ep); cd != ed {
+			report(cp.Name, "direction", cd, ed)
+		}
+		cw, ew := types.width(cp.Type), types.width(ep.Type)
+		if cw > 0 && ew > 0 && cw != ew {
+			report(cp.Name, "width", strconv.Itoa(cw), strconv.Itoa(ew))
+		}
+	}
+	for _, ep := range entPorts {
+		if !inComponent[strings.ToLower(ep.Name)] {
+			report(ep.Name, "missing_in_component", "", ep.Direction)
+		}
+	}
+	return found
+}
+
+// portDirection returns a port's lowercase mode, "in" when omitted.
+func portDirection(p extractor.Port) string {
+	if p.Direction == "" {
+		return "in"
+	}
+	return strings.ToLower(p.Direction)
+}
@@ -0,0 +1,62 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestCheckComponentMismatches(t *testing.T) {
+	idx := New()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{}
+	idx.Facts = []extractor.FileFacts{
+		{
+			File: "fifo.vhd",
+			Entities: []extractor.Entity{{
+				Name: "fifo",
+				Line: 4,
+				Ports: []extractor.Port{
+					{Name: "clk_i", Direction: "in", Type: "std_logic"},
+					{Name: "data_i", Direction: "in", Type: "std_logic_vector(15 downto 0)"},
+					{Name: "full_o", Direction: "out", Type: "std_logic"},
+					{Name: "empty_o", Direction: "out", Type: "std_logic"},
+				},
+			}},
+		},
+		{
+			File: "top.vhd",
+			Components: []extractor.Component{
+				{Name: "FIFO", Line: 12, InArch: "rtl", Ports: []extractor.Port{
+					{Name: "clk_i", Type: "std_logic"},
+					{Name: "data_i", Direction: "in", Type: "std_logic_vector(7 downto 0)"},
+					{Name: "full_o", Direction: "in", Type: "std_logic"},
+					{Name: "level_o", Direction: "out", Type: "std_logic_vector(WIDTH-1 downto 0)"},
+				}},
+				{Name: "fifo", Line: 30, IsInstance: true},
+				{Name: "missing", Line: 40, InArch: "rtl"},
+			},
+		},
+	}
+	for _, facts := range idx.Facts {
+		idx.registerSymbolsForFacts(facts, facts.File)
+	}
+
+	base := policy.ComponentMismatch{Component: "FIFO", Entity: "fifo", File: "top.vhd", Line: 12, EntityFile: "fifo.vhd", EntityLine: 4}
+	mismatch := func(port, kind, compValue, entValue string) policy.ComponentMismatch {
+		m := base
+		m.Port, m.Kind, m.ComponentValue, m.EntityValue = port, kind, compValue, entValue
+		return m
+	}
+	want := []policy.ComponentMismatch{
+		mismatch("data_i", "width", "8", "16"),
+		mismatch("full_o", "direction", "in", "out"),
+		mismatch("level_o", "missing_in_entity", "out", ""),
+		mismatch("empty_o", "missing_in_component", "", "out"),
+	}
+	if got := idx.checkComponentMismatches(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v\nwant %+v", got, want)
+	}
+}
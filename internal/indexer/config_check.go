@@ -0,0 +1,173 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// ConfigReport is the effective configuration for a lint root, as printed by
+// config-check: where it came from and what it resolves to.
+type ConfigReport struct {
+	ConfigFile      string            `json:"config_file"` // "" when the built-in defaults are used
+	Root            string            `json:"root"`
+	Standard        string            `json:"standard"`
+	Libraries       []ConfigLibrary   `json:"libraries"`
+	Files           int               `json:"files"` // Files a lint would analyze, after ignore patterns
+	ThirdPartyFiles []string          `json:"third_party_files"`
+	EnabledRules    []string          `json:"enabled_rules"`
+	RuleSeverities  map[string]string `json:"rule_severities"` // lint.rules as configured
+	IgnorePatterns  []string          `json:"ignore_patterns"`
+	Problems        []config.Problem  `json:"problems"`
+}
+
+// ConfigLibrary is a library and the number of files its patterns resolved to.
+type ConfigLibrary struct {
+	Name         string `json:"name"`
+	Files        int    `json:"files"`
+	IsThirdParty bool   `json:"is_third_party"`
+}
+
+// CheckConfig resolves the libraries of Config, loaded from configFile ("" for
+// the built-in defaults), for rootPath and reports the effective settings.
+// Validation problems are included, along with a warning for each library
+// that resolved to no files.
+func (idx *Indexer) CheckConfig(rootPath, configFile string) (ConfigReport, error) {
+	report := ConfigReport{
+		ConfigFile:      configFile,
+		Root:            rootPath,
+		Libraries:       []ConfigLibrary{},
+		ThirdPartyFiles: []string{},
+		EnabledRules:    []string{},
+		RuleSeverities:  map[string]string{},
+		IgnorePatterns:  []string{},
+		Problems:        []config.Problem{},
+	}
+	if abs, err := filepath.Abs(rootPath); err == nil {
+		report.Root = abs
+	}
+	cfg := idx.Config
+	report.Standard = cfg.Standard
+	report.IgnorePatterns = append(report.IgnorePatterns, cfg.Lint.IgnorePatterns...)
+	for rule, sev := range cfg.Lint.Rules {
+		report.RuleSeverities[rule] = sev
+	}
+	report.Problems = append(report.Problems, cfg.Validate(rootPath)...)
+
+	idx.FileLibraries = make(map[string]config.FileLibraryInfo)
+	idx.ThirdPartyFiles = make(map[string]bool)
+	files, libs, err := idx.discoverFiles(rootPath)
+	if err != nil {
+		return report, err
+	}
+	report.Files = len(files)
+	for f := range idx.ThirdPartyFiles {
+		if !cfg.ShouldIgnoreFile(f) {
+			report.ThirdPartyFiles = append(report.ThirdPartyFiles, f)
+		}
+	}
+	sort.Strings(report.ThirdPartyFiles)
+
+	warned := make(map[string]bool)
+	for _, p := range report.Problems {
+		warned[p.Field] = true
+	}
+	for _, lib := range libs {
+		report.Libraries = append(report.Libraries, ConfigLibrary{
+			Name:         lib.Name,
+			Files:        len(lib.Files),
+			IsThirdParty: lib.IsThirdParty,
+		})
+		field := "libraries." + lib.Name + ".files"
+		if len(lib.Files) == 0 && !warned[field] {
+			report.Problems = append(report.Problems, config.Problem{
+				Severity: "warning",
+				Field:    field,
+				Message:  "library resolved to no files",
+			})
+		}
+	}
+
+	for _, r := range policy.Rules() {
+		sev, configured := cfg.Lint.Rules[r.ID]
+		if configured && sev != "off" || !configured && !r.Optional {
+			report.EnabledRules = append(report.EnabledRules, r.ID)
+		}
+	}
+	return report, nil
+}
+
+// WriteConfigReport prints the result of CheckConfig as text or JSON.
+func WriteConfigReport(w io.Writer, report ConfigReport, jsonOutput bool) error {
+	if jsonOutput {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	source := report.ConfigFile
+	if source == "" {
+		source = "(built-in defaults)"
+	}
+	fmt.Fprintf(w, "Config:     %s\n", source)
+	fmt.Fprintf(w, "Root:       %s\n", report.Root)
+	fmt.Fprintf(w, "Standard:   %s\n", report.Standard)
+	fmt.Fprintf(w, "Files:      %d\n", report.Files)
+
+	fmt.Fprintf(w, "\nLibraries:\n")
+	if len(report.Libraries) == 0 {
+		fmt.Fprintf(w, "  (none; the root directory is scanned into work)\n")
+	}
+	for _, lib := range report.Libraries {
+		thirdParty := ""
+		if lib.IsThirdParty {
+			thirdParty = " (third-party)"
+		}
+		fmt.Fprintf(w, "  %s: %d files%s\n", lib.Name, lib.Files, thirdParty)
+	}
+
+	fmt.Fprintf(w, "\nThird-party files: %d\n", len(report.ThirdPartyFiles))
+	for _, f := range report.ThirdPartyFiles {
+		fmt.Fprintf(w, "  %s\n", f)
+	}
+
+	rules := make([]string, 0, len(report.RuleSeverities))
+	for rule := range report.RuleSeverities {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	fmt.Fprintf(w, "\nConfigured rules: %d\n", len(rules))
+	for _, rule := range rules {
+		fmt.Fprintf(w, "  %s: %s\n", rule, report.RuleSeverities[rule])
+	}
+	fmt.Fprintf(w, "Enabled rules: %d of %d\n", len(report.EnabledRules), len(policy.Rules()))
+	line := " "
+	for _, rule := range report.EnabledRules {
+		if len(line)+1+len(rule) > 80 {
+			fmt.Fprintln(w, line)
+			line = " "
+		}
+		line += " " + rule
+	}
+	if line != " " {
+		fmt.Fprintln(w, line)
+	}
+
+	fmt.Fprintf(w, "\nIgnore patterns: %d\n", len(report.IgnorePatterns))
+	for _, p := range report.IgnorePatterns {
+		fmt.Fprintf(w, "  %s\n", p)
+	}
+
+	if len(report.Problems) > 0 {
+		fmt.Fprintf(w, "\nProblems:\n")
+		for _, p := range report.Problems {
+			fmt.Fprintf(w, "  %s\n", p)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package indexer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+)
+
+func TestCheckConfigReportsEffectiveSettings(t *testing.T) {
+	root := t.TempDir()
+	for _, rel := range []string{"rtl/core.vhd", "vendor/ip.vhd"} {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("-- "+rel), 0o644); err != nil {
+			t.Fatalf("write %s: %v", rel, err)
+		}
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Libraries = map[string]config.LibraryConfig{
+		"work":   {Files: []string{"rtl/*.vhd"}},
+		"vendor": {Files: []string{"vendor/*.vhd"}, IsThirdParty: true},
+		"sim":    {Files: []string{"sim/*.vhd"}},
+		"old":    {Files: []string{"rtl/*.vhd"}, Exclude: []string{"rtl/core.vhd"}},
+	}
+	cfg.Lint.Rules = map[string]string{"magic_number": "warning", "buffer_port": "off"}
+
+	idx := NewWithConfig(cfg)
+	report, err := idx.CheckConfig(root, "")
+	if err != nil {
+		t.Fatalf("CheckConfig: %v", err)
+	}
+	if report.Files != 2 {
+		t.Errorf("expected 2 files, got %d", report.Files)
+	}
+	if len(report.ThirdPartyFiles) != 1 || report.ThirdPartyFiles[0] != filepath.Join(root, "vendor", "ip.vhd") {
+		t.Errorf("unexpected third-party files %v", report.ThirdPartyFiles)
+	}
+	counts := map[string]int{}
+	for _, lib := range report.Libraries {
+		counts[lib.Name] = lib.Files
+	}
+	if counts["work"] != 1 || counts["vendor"] != 1 || counts["sim"] != 0 || counts["old"] != 0 {
+		t.Errorf("unexpected library file counts %v", counts)
+	}
+
+	// sim matches nothing (a validation warning); old matches, then excludes everything
+	fields := map[string]bool{}
+	for _, p := range report.Problems {
+		fields[p.Field] = true
+	}
+	if !fields["libraries.sim.files"] || !fields["libraries.old.files"] || len(report.Problems) != 2 {
+		t.Errorf("expected warnings for the sim and old libraries, got %v", report.Problems)
+	}
+
+	enabled := map[string]bool{}
+	for _, rule := range report.EnabledRules {
+		enabled[rule] = true
+	}
+	if !enabled["magic_number"] || enabled["buffer_port"] {
+		t.Errorf("expected magic_number enabled and buffer_port disabled, got %v", report.EnabledRules)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteConfigReport(&buf, report, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Config:     (built-in defaults)", "vendor: 1 files (third-party)", "buffer_port: off"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected %q in the text report:\n%s", want, buf.String())
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package indexer
+
+import (
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+// expandContextReferences appends the library and use clauses a referenced
+// VHDL-2008 context provides to the dependencies of every file that
+// references it, so "context work.proj_ctx;" makes the context's packages
+// resolvable and their wildcard members visible in the referencing file.
+// Nested context references are followed; "work" inside a context means
+// the context's own library. Expanded clauses carry the line of the
+// context reference. Contexts that are not in the SymbolTable expand to
+// nothing.
+func (idx *Indexer) expandContextReferences() {
+	type libraryContext struct {
+		lib  string
+		decl extractor.ContextDeclaration
+	}
+	contexts := make(map[string]libraryContext) // "lib.ctx" -> declaration
+	for _, facts := range idx.Facts {
+		lib := idx.FileLibrary(facts.File)
+		for _, ctx := range facts.ContextDecls {
+			contexts[lib+"."+strings.ToLower(ctx.Name)] = libraryContext{lib: lib, decl: ctx}
+		}
+	}
+	if len(contexts) == 0 {
+		return
+	}
+
+	for i := range idx.Facts {
+		facts := &idx.Facts[i]
+		fileLib := idx.FileLibrary(facts.File)
+		seen := make(map[string]bool)
+		for _, dep := range facts.Dependencies {
+			seen[dep.Kind+":"+strings.ToLower(dep.Target)] = true
+		}
+
+		var expanded []extractor.Dependency
+		add := func(kind, target string, line int) {
+			key := kind + ":" + strings.ToLower(target)
+			if seen[key] {
+				return
+			}
+			seen[key] = true
+			expanded = append(expanded, extractor.Dependency{
+				Source: facts.File,
+				Target: target,
+				Kind:   kind,
+				Line:   line,
+			})
+		}
+		visited := make(map[string]bool)
+		var expand func(qualName string, line int)
+		expand = func(qualName string, line int) {
+			ctx, ok := contexts[qualName]
+			if !ok || visited[qualName] {
+				return
+			}
+			visited[qualName] = true
+			for _, lib := range ctx.decl.Libraries {
+				if !strings.EqualFold(lib, "work") {
+					add("library", lib, line)
+				}
+			}
+			for _, item := range ctx.decl.UseItems {
+				add("use", resolveWorkPrefix(item, ctx.lib), line)
+			}
+			for _, ref := range ctx.decl.ContextRefs {
+				expand(contextQualName(ref, ctx.lib), line)
+			}
+		}
+		for _, dep := range facts.Dependencies {
+			if dep.Kind == "context" {
+				expand(contextQualName(dep.Target, fileLib), dep.Line)
+			}
+		}
+		facts.Dependencies = append(facts.Dependencies, expanded...)
+	}
+}
+
+// contextQualName qualifies a context reference as "lib.ctx", resolving
+// "work" and bare names to lib.
+func contextQualName(target, lib string) string {
+	qualName := strings.ToLower(strings.Join(strings.Fields(target), ""))
+	qualName = resolveWorkPrefix(qualName, lib)
+	if !strings.Contains(qualName, ".") {
+		qualName = lib + "." + qualName
+	}
+	return qualName
+}
@@ -0,0 +1,73 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestExpandContextReferences(t *testing.T) {
+	idx := New()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{
+		"ctx.vhd": {LibraryName: "common"},
+		"pkg.vhd": {LibraryName: "common"},
+		"top.vhd": {LibraryName: "app"},
+	}
+	idx.Facts = []extractor.FileFacts{
+		{
+			File: "ctx.vhd",
+			ContextDecls: []extractor.ContextDeclaration{
+				{Name: "base_ctx", Libraries: []string{"ieee"}, UseItems: []string{"ieee.std_logic_1164.all"}, Line: 1},
+				{
+					Name:        "Proj_Ctx",
+					Libraries:   []string{"ieee", "common"},
+					UseItems:    []string{"work.my_pkg.all"},
+					ContextRefs: []string{"work.base_ctx"},
+					Line:        5,
+				},
+			},
+		},
+		{
+			File:     "pkg.vhd",
+			Packages: []extractor.Package{{Name: "my_pkg", Line: 1}},
+			Types:    []extractor.TypeDeclaration{{Name: "state_t", Kind: "enum", Line: 2, InPackage: "my_pkg"}},
+		},
+		{
+			File: "top.vhd",
+			Dependencies: []extractor.Dependency{
+				{Source: "top.vhd", Target: "common", Kind: "library", Line: 1},
+				{Source: "top.vhd", Target: "common.proj_ctx", Kind: "context", Line: 2},
+			},
+		},
+	}
+	for _, facts := range idx.Facts {
+		idx.registerSymbolsForFacts(facts, facts.File)
+	}
+	if sym, ok := idx.Symbols.Get("common.proj_ctx"); !ok || sym.Kind != "context" {
+		t.Fatalf("context symbol = %+v, %v", sym, ok)
+	}
+
+	idx.expandContextReferences()
+	got := idx.Facts[2].Dependencies
+	want := []extractor.Dependency{
+		{Source: "top.vhd", Target: "common", Kind: "library", Line: 1},
+		{Source: "top.vhd", Target: "common.proj_ctx", Kind: "context", Line: 2},
+		{Source: "top.vhd", Target: "ieee", Kind: "library", Line: 2},
+		{Source: "top.vhd", Target: "common.my_pkg.all", Kind: "use", Line: 2},
+		{Source: "top.vhd", Target: "ieee.std_logic_1164.all", Kind: "use", Line: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+
+	imports := idx.expandWildcardImports()
+	wantImports := []policy.ImportedName{
+		{Name: "state_t", Kind: "type", FromPackage: "common.my_pkg", File: "top.vhd", Line: 2},
+	}
+	if !reflect.DeepEqual(imports, wantImports) {
+		t.Fatalf("imports = %+v, want %+v", imports, wantImports)
+	}
+}
@@ -0,0 +1,74 @@
+package indexer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+// DefaultCoverageThreshold is the error ratio (1%) at or above which a file is
+// listed by the coverage report.
+const DefaultCoverageThreshold = 0.01
+
+// CoverageEntry is one file's parse coverage.
+type CoverageEntry struct {
+	File     string
+	Coverage extractor.ParseCoverage
+}
+
+// CoverageReport is the parse coverage of a set of files.
+type CoverageReport struct {
+	Files      []CoverageEntry // Files at or above the threshold, worst first
+	TotalFiles int
+	TotalBytes int
+	ErrorBytes int
+	Threshold  float64
+}
+
+// BuildCoverageReport selects files whose ERROR-node ratio is at or above
+// threshold. Files without any ERROR or MISSING nodes are never listed, so a
+// threshold of 0 lists every file the grammar did not fully parse.
+func BuildCoverageReport(allFacts []extractor.FileFacts, threshold float64) CoverageReport {
+	report := CoverageReport{TotalFiles: len(allFacts), Threshold: threshold}
+	for _, facts := range allFacts {
+		cov := facts.ParseCoverage
+		report.TotalBytes += cov.TotalBytes
+		report.ErrorBytes += cov.ErrorBytes
+		if cov.ErrorNodes+cov.MissingNodes == 0 || cov.ErrorRatio() < threshold {
+			continue
+		}
+		report.Files = append(report.Files, CoverageEntry{File: facts.File, Coverage: cov})
+	}
+	sort.Slice(report.Files, func(i, j int) bool {
+		ri, rj := report.Files[i].Coverage.ErrorRatio(), report.Files[j].Coverage.ErrorRatio()
+		if ri != rj {
+			return ri > rj
+		}
+		return report.Files[i].File < report.Files[j].File
+	})
+	return report
+}
+
+// WriteCoverageReport prints one line per listed file followed by a summary.
+func WriteCoverageReport(w io.Writer, report CoverageReport) error {
+	var b strings.Builder
+	for _, entry := range report.Files {
+		cov := entry.Coverage
+		fmt.Fprintf(&b, "%6.2f%%  %d/%d bytes  %d error, %d missing  first at line %d  %s\n",
+			cov.ErrorRatio()*100, cov.ErrorBytes, cov.TotalBytes,
+			cov.ErrorNodes, cov.MissingNodes, cov.FirstErrorLine, entry.File)
+	}
+
+	overall := 0.0
+	if report.TotalBytes > 0 {
+		overall = float64(report.ErrorBytes) / float64(report.TotalBytes)
+	}
+	fmt.Fprintf(&b, "%d of %d files at or above %.2f%% error coverage (overall %.2f%% of %d bytes)\n",
+		len(report.Files), report.TotalFiles, report.Threshold*100, overall*100, report.TotalBytes)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,46 @@
+package indexer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestBuildCoverageReport(t *testing.T) {
+	allFacts := []extractor.FileFacts{
+		{File: "clean.vhd", ParseCoverage: extractor.ParseCoverage{TotalBytes: 1000}},
+		{File: "minor.vhd", ParseCoverage: extractor.ParseCoverage{TotalBytes: 1000, ErrorBytes: 5, ErrorNodes: 1, FirstErrorLine: 3}},
+		{File: "bad.vhd", ParseCoverage: extractor.ParseCoverage{TotalBytes: 1000, ErrorBytes: 250, ErrorNodes: 2, FirstErrorLine: 7}},
+		{File: "missing.vhd", ParseCoverage: extractor.ParseCoverage{TotalBytes: 1000, MissingNodes: 1, FirstErrorLine: 9}},
+	}
+
+	report := BuildCoverageReport(allFacts, DefaultCoverageThreshold)
+	if len(report.Files) != 1 || report.Files[0].File != "bad.vhd" {
+		t.Fatalf("expected only bad.vhd above threshold, got %+v", report.Files)
+	}
+	if report.TotalBytes != 4000 || report.ErrorBytes != 255 {
+		t.Fatalf("unexpected totals: %d/%d", report.ErrorBytes, report.TotalBytes)
+	}
+
+	all := BuildCoverageReport(allFacts, 0)
+	var files []string
+	for _, entry := range all.Files {
+		files = append(files, entry.File)
+	}
+	if strings.Join(files, ",") != "bad.vhd,minor.vhd,missing.vhd" {
+		t.Fatalf("expected every imperfect file worst first, got %v", files)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCoverageReport(&buf, report); err != nil {
+		t.Fatalf("WriteCoverageReport: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{" 25.00%  250/1000 bytes", "first at line 7  bad.vhd", "1 of 4 files at or above 1.00%"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in report:\n%s", want, out)
+		}
+	}
+}
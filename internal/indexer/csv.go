@@ -0,0 +1,44 @@
+package indexer
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// csvHeader is the header row of the CSV report.
+var csvHeader = []string{"file", "line", "severity", "rule", "message"}
+
+// WriteCSV writes one row per violation, after a header row, for spreadsheet
+// triage. Fields containing commas, quotes, or newlines are quoted per
+// RFC 4180.
+func WriteCSV(w io.Writer, violations []policy.Violation) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, v := range violations {
+		row := []string{v.File, strconv.Itoa(v.Line), v.Severity, v.Rule, v.Message}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteCSVFile writes the CSV report for violations to path.
+func WriteCSVFile(path string, violations []policy.Violation) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteCSV(f, violations); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
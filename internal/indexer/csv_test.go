@@ -0,0 +1,46 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestWriteCSVRoundTrip(t *testing.T) {
+	violations := []policy.Violation{
+		{Rule: "unused_signal", Severity: "warning", File: "rtl/top.vhd", Line: 12, Message: "Signal 'a, b' is unused"},
+		{Rule: "magic_number", Severity: "info", File: "rtl/my,file.vhd", Line: 40, Message: `literal "42" used`},
+		{Rule: "latch_inference", Severity: "error", File: "rtl/top.vhd", Line: 30, Message: "line one\nline two"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, violations); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v\n%s", err, buf.String())
+	}
+	want := [][]string{
+		{"file", "line", "severity", "rule", "message"},
+		{"rtl/top.vhd", "12", "warning", "unused_signal", "Signal 'a, b' is unused"},
+		{"rtl/my,file.vhd", "40", "info", "magic_number", `literal "42" used`},
+		{"rtl/top.vhd", "30", "error", "latch_inference", "line one\nline two"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("round trip mismatch:\ngot  %q\nwant %q", rows, want)
+	}
+}
+
+func TestWriteCSVEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, nil); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	if got := buf.String(); got != "file,line,severity,rule,message\n" {
+		t.Fatalf("expected header only, got %q", got)
+	}
+}
@@ -43,8 +43,11 @@ func resolveDependencies(facts extractor.FileFacts, filePath string, symbols *Sy
 		if strings.HasPrefix(qualName, "work.") {
 			qualName = fileLib + qualName[4:]
 		}
+		// "use lib.pkg.all" depends on the package itself
 		if sym, ok := symbols.Get(qualName); ok {
 			deps = append(deps, sym.File)
+		} else if sym, ok := symbols.Get(strings.TrimSuffix(qualName, ".all")); ok {
+			deps = append(deps, sym.File)
 		}
 	}
 	return deps
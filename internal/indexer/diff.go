@@ -0,0 +1,130 @@
+package indexer
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// lineRange is an inclusive range of added lines in the new version of a file.
+type lineRange struct {
+	Start, End int
+}
+
+// DiffRanges maps a file path (slash-separated, as written in the diff) to
+// the lines the diff adds or modifies.
+type DiffRanges map[string][]lineRange
+
+// hunkHeaderRe matches "@@ -old[,count] +new[,count] @@".
+var hunkHeaderRe = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,(\d+))? @@`)
+
+// ParseUnifiedDiff collects the added lines of each file in a unified diff.
+// Paths come from the "+++" headers with git's "b/" prefix removed; deleted
+// files ("+++ /dev/null") contribute nothing.
+func ParseUnifiedDiff(r io.Reader) (DiffRanges, error) {
+	ranges := make(DiffRanges)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	file := ""
+	line, remaining := 0, 0 // Next new-file line and new-file lines left in the hunk
+	add := func(n int) {
+		spans := ranges[file]
+		if last := len(spans) - 1; last >= 0 && spans[last].End == n-1 {
+			spans[last].End = n
+			return
+		}
+		ranges[file] = append(spans, lineRange{Start: n, End: n})
+	}
+	for scanner.Scan() {
+		text := scanner.Text()
+		switch {
+		case remaining == 0 && strings.HasPrefix(text, "+++ "):
+			path := strings.TrimPrefix(text, "+++ ")
+			if tab := strings.IndexByte(path, '\t'); tab != -1 {
+				path = path[:tab]
+			}
+			file = ""
+			if path != "/dev/null" {
+				file = filepath.ToSlash(strings.TrimPrefix(path, "b/"))
+			}
+		case strings.HasPrefix(text, "@@"):
+			m := hunkHeaderRe.FindStringSubmatch(text)
+			if m == nil {
+				return nil, fmt.Errorf("malformed hunk header %q", text)
+			}
+			line, _ = strconv.Atoi(m[1])
+			remaining = 1
+			if m[2] != "" {
+				remaining, _ = strconv.Atoi(m[2])
+			}
+		case remaining > 0 && strings.HasPrefix(text, "+"):
+			if file != "" {
+				add(line)
+			}
+			line++
+			remaining--
+		case remaining > 0 && strings.HasPrefix(text, " "):
+			line++
+			remaining--
+		}
+	}
+	return ranges, scanner.Err()
+}
+
+// LoadDiff reads the --diff argument: a unified diff file, or otherwise a
+// git ref compared against the working tree of root with
+// "git diff --relative <ref>".
+func LoadDiff(spec, root string) (DiffRanges, error) {
+	if data, err := os.ReadFile(spec); err == nil {
+		return ParseUnifiedDiff(bytes.NewReader(data))
+	}
+	dir := root
+	if info, err := os.Stat(root); err == nil && !info.IsDir() {
+		dir = filepath.Dir(root)
+	}
+	cmd := exec.Command("git", "-C", dir, "diff", "--relative", "--unified=0", "--no-color", spec, "--")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff %s: %v: %s", spec, err, strings.TrimSpace(stderr.String()))
+	}
+	return ParseUnifiedDiff(bytes.NewReader(out))
+}
+
+// Contains reports whether line of file was added by the diff. Diff paths
+// are relative, so file matches a diff path it equals or ends with.
+func (d DiffRanges) Contains(file string, line int) bool {
+	file = filepath.ToSlash(filepath.Clean(file))
+	for path, spans := range d {
+		if file != path && !strings.HasSuffix(file, "/"+path) {
+			continue
+		}
+		for _, span := range spans {
+			if line >= span.Start && line <= span.End {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// applyDiffFilter drops violations outside the diff's added lines, counting
+// them in Summary.OutsideDiff. A nil diff keeps everything.
+func applyDiffFilter(lintResult *LintResult, diff DiffRanges) {
+	if diff == nil {
+		return
+	}
+	lintResult.Summary.OutsideDiff += dropViolations(lintResult, func(v policy.Violation) bool {
+		return !diff.Contains(v.File, v.Line)
+	})
+}
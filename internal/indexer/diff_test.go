@@ -0,0 +1,95 @@
+package indexer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+const sampleDiff = `diff --git a/rtl/top.vhd b/rtl/top.vhd
+index 1111111..2222222 100644
+--- a/rtl/top.vhd
++++ b/rtl/top.vhd
+@@ -10,3 +10,4 @@ architecture rtl of top is
+   signal a : std_logic;
+-  signal b : std_logic;
++  signal b : std_logic_vector(1 downto 0);
++  signal c : std_logic;
+   signal d : std_logic;
+@@ -40 +41 @@ begin
+-  y <= a;
++  y <= c;
+diff --git a/rtl/old.vhd b/rtl/old.vhd
+deleted file mode 100644
+--- a/rtl/old.vhd
++++ /dev/null
+@@ -1,2 +0,0 @@
+-entity old is
+-end;
+--- /dev/null
++++ new.vhd	2026-01-01 00:00:00
+@@ -0,0 +1,2 @@
++entity new is
++end;
+`
+
+func TestParseUnifiedDiff(t *testing.T) {
+	ranges, err := ParseUnifiedDiff(strings.NewReader(sampleDiff))
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff: %v", err)
+	}
+	want := DiffRanges{
+		"rtl/top.vhd": {{Start: 11, End: 12}, {Start: 41, End: 41}},
+		"new.vhd":     {{Start: 1, End: 2}},
+	}
+	if !reflect.DeepEqual(ranges, want) {
+		t.Fatalf("got %+v, want %+v", ranges, want)
+	}
+
+	cases := []struct {
+		file string
+		line int
+		want bool
+	}{
+		{"rtl/top.vhd", 11, true},
+		{"/work/proj/rtl/top.vhd", 41, true},
+		{"rtl/top.vhd", 10, false},
+		{"rtl/top.vhd", 13, false},
+		{"other/rtl/top.vhdx", 11, false},
+		{"rtl/old.vhd", 1, false},
+	}
+	for _, tc := range cases {
+		if got := ranges.Contains(tc.file, tc.line); got != tc.want {
+			t.Errorf("Contains(%q, %d) = %v, want %v", tc.file, tc.line, got, tc.want)
+		}
+	}
+}
+
+func TestApplyDiffFilter(t *testing.T) {
+	lintResult := LintResult{
+		Violations: []policy.Violation{
+			{Rule: "unused_signal", Severity: "warning", File: "rtl/top.vhd", Line: 12},
+			{Rule: "latch_inference", Severity: "error", File: "rtl/top.vhd", Line: 30},
+			{Rule: "magic_number", Severity: "info", File: "rtl/other.vhd", Line: 5},
+		},
+		Summary: ResultSummary{TotalViolations: 3, Errors: 1, Warnings: 1, Info: 1},
+		Files: []FileResult{
+			{Path: "rtl/top.vhd", Errors: 1, Warnings: 1},
+			{Path: "rtl/other.vhd", Info: 1},
+		},
+	}
+	applyDiffFilter(&lintResult, DiffRanges{"rtl/top.vhd": {{Start: 11, End: 12}}})
+
+	if len(lintResult.Violations) != 1 || lintResult.Violations[0].Rule != "unused_signal" {
+		t.Fatalf("expected only the violation on an added line, got %+v", lintResult.Violations)
+	}
+	want := ResultSummary{TotalViolations: 1, Warnings: 1, OutsideDiff: 2}
+	if !reflect.DeepEqual(lintResult.Summary, want) {
+		t.Fatalf("summary = %+v, want %+v", lintResult.Summary, want)
+	}
+	if len(lintResult.Files) != 1 || lintResult.Files[0].Path != "rtl/top.vhd" {
+		t.Fatalf("unexpected files: %+v", lintResult.Files)
+	}
+}
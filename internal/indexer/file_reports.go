@@ -0,0 +1,94 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// FileReport is one source file's --output-dir report.
+type FileReport struct {
+	File       string             `json:"file"`
+	Total      int                `json:"total_violations"`
+	Errors     int                `json:"errors"`
+	Warnings   int                `json:"warnings"`
+	Info       int                `json:"info"`
+	Violations []policy.Violation `json:"violations"`
+}
+
+// WriteFileReports writes <dir>/<relpath>.json for every analyzed file (and
+// any other file a violation names), holding only that file's violations.
+// Clean files get a report with no violations, so consumers can rely on it
+// existing. Paths are made relative to rootPath and confined to dir.
+func WriteFileReports(dir string, files []string, violations []policy.Violation, rootPath string) error {
+	root, err := sarifRoot(rootPath)
+	if err != nil {
+		return err
+	}
+	reports := make(map[string]*FileReport)
+	var order []string
+	report := func(file string) *FileReport {
+		if r, ok := reports[file]; ok {
+			return r
+		}
+		r := &FileReport{File: file, Violations: []policy.Violation{}}
+		reports[file] = r
+		order = append(order, file)
+		return r
+	}
+	for _, file := range files {
+		report(file)
+	}
+	for _, v := range violations {
+		r := report(v.File)
+		r.Violations = append(r.Violations, v)
+		r.Total++
+		switch v.Severity {
+		case "error":
+			r.Errors++
+		case "warning":
+			r.Warnings++
+		case "info":
+			r.Info++
+		}
+	}
+
+	for _, file := range order {
+		if file == "" {
+			continue
+		}
+		path := filepath.Join(dir, fileReportPath(file, root)+".json")
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		data, err := json.MarshalIndent(reports[file], "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal report for %s: %w", file, err)
+		}
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileReportPath returns file's report path relative to the output
+// directory: its path under root, or its absolute path (volume and leading
+// separator dropped) when it lies outside root. ".." elements are removed,
+// so the result never escapes the output directory.
+func fileReportPath(file, root string) string {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		abs = file
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		rel = strings.TrimPrefix(abs, filepath.VolumeName(abs))
+	}
+	sep := string(filepath.Separator)
+	return strings.TrimPrefix(filepath.Clean(sep+rel), sep)
+}
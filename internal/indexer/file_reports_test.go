@@ -0,0 +1,63 @@
+package indexer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestWriteFileReports(t *testing.T) {
+	root := t.TempDir()
+	out := filepath.Join(t.TempDir(), "reports")
+	top := filepath.Join(root, "rtl", "top.vhd")
+	clean := filepath.Join(root, "clean.vhd")
+	violations := []policy.Violation{
+		{Rule: "latch_inference", Severity: "error", File: top, Line: 30, Message: "latch"},
+		{Rule: "unused_signal", Severity: "warning", File: top, Line: 12, Message: "unused"},
+	}
+
+	if err := WriteFileReports(out, []string{top, clean}, violations, root); err != nil {
+		t.Fatalf("WriteFileReports: %v", err)
+	}
+
+	var got FileReport
+	data, err := os.ReadFile(filepath.Join(out, "rtl", "top.vhd.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.File != top || got.Total != 2 || got.Errors != 1 || got.Warnings != 1 || len(got.Violations) != 2 {
+		t.Fatalf("unexpected top report: %+v", got)
+	}
+
+	data, err = os.ReadFile(filepath.Join(out, "clean.vhd.json"))
+	if err != nil {
+		t.Fatalf("clean file has no report: %v", err)
+	}
+	var empty map[string]any
+	if err := json.Unmarshal(data, &empty); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := empty["violations"].([]any); !ok || len(v) != 0 {
+		t.Fatalf("expected an empty violations array, got %s", data)
+	}
+}
+
+func TestFileReportPathStaysInOutputDir(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "work", "proj")
+	cases := map[string]string{
+		filepath.Join(root, "rtl", "top.vhd"):                      filepath.Join("rtl", "top.vhd"),
+		filepath.Join(root, "..", "..", "etc", "evil.vhd"):         filepath.Join("etc", "evil.vhd"),
+		filepath.Join(root, "rtl", "..", "..", "..", "x", "y.vhd"): filepath.Join("x", "y.vhd"),
+	}
+	for file, want := range cases {
+		if got := fileReportPath(file, root); got != want {
+			t.Errorf("fileReportPath(%q) = %q, want %q", file, got, want)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package indexer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// checkGenericBindings reports instances that leave a generic without a
+// default unbound (or bound to open). Generics come from the component
+// declaration when the instance names a declared component (its defaults
+// apply), otherwise from the entity the SymbolTable resolves the target to.
+// Instances whose target cannot be resolved are skipped.
+func (idx *Indexer) checkGenericBindings() []policy.UnboundGeneric {
+	sorted, factsByFile, packageComponents := idx.instanceResolutionIndex()
+
+	found := []policy.UnboundGeneric{}
+	for _, facts := range sorted {
+		for _, inst := range facts.Instances {
+			unit, ok := idx.resolveInstanceUnit(facts, inst, factsByFile, packageComponents)
+			if !ok {
+				continue
+			}
+			bound := boundGenerics(inst, unit.Generics)
+			for i, g := range unit.Generics {
+				if g.Default != "" || bound[i] {
+					continue
+				}
+				found = append(found, policy.UnboundGeneric{
+					Instance: inst.Name,
+					Generic:  g.Name,
+					Unit:     unit.Name,
+					File:     facts.File,
+					Line:     inst.Line,
+					InArch:   inst.InArch,
+				})
+			}
+		}
+	}
+	return found
+}
+
+// instanceResolutionIndex returns the facts sorted by file, a lookup from
+// file to facts, and the components declared in packages (by lowercase
+// name), as needed by resolveInstanceUnit.
+func (idx *Indexer) instanceResolutionIndex() ([]extractor.FileFacts, map[string]*extractor.FileFacts, map[string]extractor.Component) {
+	sorted := make([]extractor.FileFacts, len(idx.Facts))
+	copy(sorted, idx.Facts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].File < sorted[j].File })
+
+	factsByFile := make(map[string]*extractor.FileFacts, len(sorted))
+	packageComponents := make(map[string]extractor.Component)
+	for i := range sorted {
+		factsByFile[sorted[i].File] = &sorted[i]
+		for _, comp := range sorted[i].Components {
+			if !comp.IsInstance && comp.InPackage != "" {
+				packageComponents[strings.ToLower(comp.Name)] = comp
+			}
+		}
+	}
+	return sorted, factsByFile, packageComponents
+}
+
+// instanceUnit is the entity or component declaration an instance binds to.
+type instanceUnit struct {
+	Name     string
+	Generics []extractor.GenericDecl
+	Ports    []extractor.Port
+}
+
+// resolveInstanceUnit finds the declaration whose generics and ports an
+// instance must bind.
+func (idx *Indexer) resolveInstanceUnit(facts extractor.FileFacts, inst extractor.Instance, factsByFile map[string]*extractor.FileFacts, packageComponents map[string]extractor.Component) (instanceUnit, bool) {
+	lib, name := splitInstanceTarget(inst.Target)
+	if name == "" {
+		return instanceUnit{}, false
+	}
+
+	// A plain name may be a component declared in the architecture or a package
+	if lib == "" {
+		for _, comp := range facts.Components {
+			if !comp.IsInstance && strings.EqualFold(comp.Name, name) && comp.InPackage == "" &&
+				strings.EqualFold(archScopeBase(comp.InArch), archScopeBase(inst.InArch)) {
+				return instanceUnit{Name: comp.Name, Generics: comp.Generics, Ports: comp.Ports}, true
+			}
+		}
+		if comp, ok := packageComponents[name]; ok {
+			return instanceUnit{Name: comp.Name, Generics: comp.Generics, Ports: comp.Ports}, true
+		}
+	}
+
+	// Otherwise a direct entity instantiation; "work" is the instance's own library
+	if lib == "" || lib == "work" {
+		lib = "work"
+		if info, ok := idx.FileLibraries[facts.File]; ok && info.LibraryName != "" {
+			lib = strings.ToLower(info.LibraryName)
+		}
+	}
+	sym, ok := idx.Symbols.Get(fmt.Sprintf("%s.%s", lib, name))
+	if !ok || sym.Kind != "entity" {
+		return instanceUnit{}, false
+	}
+	target, ok := factsByFile[sym.File]
+	if !ok {
+		return instanceUnit{}, false
+	}
+	for _, ent := range target.Entities {
+		if strings.EqualFold(ent.Name, name) {
+			return instanceUnit{Name: ent.Name, Generics: ent.Generics, Ports: ent.Ports}, true
+		}
+	}
+	return instanceUnit{}, false
+}
+
+// boundGenerics marks which of generics an instance's generic map binds, by
+// name or position. Binding to open leaves the generic unbound.
+func boundGenerics(inst extractor.Instance, generics []extractor.GenericDecl) []bool {
+	bound := make([]bool, len(generics))
+	byName := make(map[string]int, len(generics))
+	for i, g := range generics {
+		byName[strings.ToLower(g.Name)] = i
+	}
+
+	hasAssociations := false
+	for _, assoc := range inst.Associations {
+		if assoc.Kind != "generic" {
+			continue
+		}
+		hasAssociations = true
+		if assoc.ActualKind == "open" {
+			continue
+		}
+		if assoc.IsPositional {
+			if assoc.PositionIndex >= 0 && assoc.PositionIndex < len(bound) {
+				bound[assoc.PositionIndex] = true
+			}
+			continue
+		}
+		formal := strings.ToLower(strings.TrimSpace(assoc.Formal))
+		if paren := strings.Index(formal, "("); paren != -1 {
+			formal = strings.TrimSpace(formal[:paren])
+		}
+		if i, ok := byName[formal]; ok {
+			bound[i] = true
+		}
+	}
+	if !hasAssociations {
+		for formal, actual := range inst.GenericMap {
+			if i, ok := byName[strings.ToLower(strings.TrimSpace(formal))]; ok && !strings.EqualFold(strings.TrimSpace(actual), "open") {
+				bound[i] = true
+			}
+		}
+	}
+	return bound
+}
@@ -0,0 +1,66 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestCheckGenericBindings(t *testing.T) {
+	idx := New()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{}
+	idx.Facts = []extractor.FileFacts{
+		{
+			File: "fifo.vhd",
+			Entities: []extractor.Entity{{
+				Name: "fifo",
+				Line: 1,
+				Generics: []extractor.GenericDecl{
+					{Name: "WIDTH", Kind: "constant"},
+					{Name: "DEPTH", Kind: "constant"},
+					{Name: "FWFT", Kind: "constant", Default: "false"},
+				},
+			}},
+		},
+		{
+			File: "top.vhd",
+			Components: []extractor.Component{{
+				Name:     "fifo",
+				InArch:   "comp_arch",
+				Generics: []extractor.GenericDecl{{Name: "WIDTH", Default: "8"}, {Name: "DEPTH", Default: "16"}},
+			}},
+			Instances: []extractor.Instance{
+				// Named: DEPTH missing
+				{Name: "u_named", Target: "work.fifo", Line: 10, InArch: "rtl", Associations: []extractor.Association{
+					{Kind: "generic", Formal: "width", Actual: "8"},
+				}},
+				// Positional: both bound
+				{Name: "u_positional", Target: "entity work.fifo", Line: 20, InArch: "rtl", Associations: []extractor.Association{
+					{Kind: "generic", Actual: "8", IsPositional: true, PositionIndex: 0},
+					{Kind: "generic", Actual: "4", IsPositional: true, PositionIndex: 1},
+				}},
+				// Bound to open: WIDTH unbound
+				{Name: "u_open", Target: "work.fifo", Line: 30, InArch: "rtl", GenericMap: map[string]string{"DEPTH": "4", "WIDTH": "open"}},
+				// Component declaration supplies defaults
+				{Name: "u_comp", Target: "fifo", Line: 40, InArch: "comp_arch"},
+				// Unresolved target is skipped
+				{Name: "u_ext", Target: "work.vendor_ram", Line: 50, InArch: "rtl"},
+			},
+		},
+	}
+	for _, facts := range idx.Facts {
+		idx.registerSymbolsForFacts(facts, facts.File)
+	}
+
+	got := idx.checkGenericBindings()
+	if len(got) != 2 {
+		t.Fatalf("expected 2 unbound generics, got %+v", got)
+	}
+	if got[0].Instance != "u_named" || got[0].Generic != "DEPTH" || got[0].Unit != "fifo" || got[0].File != "top.vhd" || got[0].Line != 10 {
+		t.Errorf("unexpected first finding: %+v", got[0])
+	}
+	if got[1].Instance != "u_open" || got[1].Generic != "WIDTH" {
+		t.Errorf("unexpected second finding: %+v", got[1])
+	}
+}
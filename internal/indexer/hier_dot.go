@@ -0,0 +1,284 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+// HierarchyEdge is one instantiation in the module hierarchy.
+type HierarchyEdge struct {
+	Parent   string // Instantiating entity (lowercase)
+	Child    string // Instantiated entity (lowercase), or the raw target if unresolved
+	Instance string // Instance label
+	Resolved bool   // Child matched an entity declared in the analyzed files
+}
+
+// Hierarchy is the entity-level instantiation graph.
+type Hierarchy struct {
+	Entities map[string]string // node key -> display name
+	Edges    []HierarchyEdge
+	Roots    []string // Entities that are never instantiated (node keys, sorted)
+}
+
+// BuildHierarchy resolves every instance to the entity it instantiates and
+// connects it to the entity whose architecture contains it. Targets that do
+// not match a declared entity (vendor primitives, missing sources) are kept as
+// unresolved edges so the picture stays honest.
+//
+// libraryOf maps a file to its library (nil puts every file in work). "work."
+// in a target means the instantiating file's library, and bare component names
+// prefer an entity from that library, so same-named entities in different
+// libraries stay separate. Node keys are the lowercase entity name, qualified
+// as "lib.name" only when the name is declared in more than one library.
+func BuildHierarchy(allFacts []extractor.FileFacts, libraryOf func(file string) string) Hierarchy {
+	if libraryOf == nil {
+		libraryOf = func(string) string { return "work" }
+	}
+
+	// entity name -> library -> declared name
+	declared := make(map[string]map[string]string)
+	for _, facts := range allFacts {
+		lib := libraryOf(facts.File)
+		for _, ent := range facts.Entities {
+			name := strings.ToLower(ent.Name)
+			if declared[name] == nil {
+				declared[name] = make(map[string]string)
+			}
+			if _, ok := declared[name][lib]; !ok {
+				declared[name][lib] = ent.Name
+			}
+		}
+	}
+	nodeKey := func(lib, name string) string {
+		if len(declared[name]) > 1 {
+			return lib + "." + name
+		}
+		return name
+	}
+
+	h := Hierarchy{Entities: make(map[string]string)}
+	for name, libs := range declared {
+		for lib, display := range libs {
+			if len(libs) > 1 {
+				display = lib + "." + display
+			}
+			h.Entities[nodeKey(lib, name)] = display
+		}
+	}
+
+	// resolve finds the node key for an entity name referenced from fromLib,
+	// optionally qualified with a library
+	resolve := func(lib, name, fromLib string) (string, bool) {
+		libs, ok := declared[name]
+		if !ok {
+			return name, false
+		}
+		if lib != "" {
+			if _, ok := libs[lib]; ok {
+				return nodeKey(lib, name), true
+			}
+		}
+		if _, ok := libs[fromLib]; ok {
+			return nodeKey(fromLib, name), true
+		}
+		candidates := make([]string, 0, len(libs))
+		for l := range libs {
+			candidates = append(candidates, l)
+		}
+		sort.Strings(candidates)
+		return nodeKey(candidates[0], name), true
+	}
+
+	instantiated := make(map[string]bool)
+	for _, facts := range allFacts {
+		fileLib := libraryOf(facts.File)
+		for _, inst := range facts.Instances {
+			parent := ""
+			for _, arch := range facts.Architectures {
+				if strings.EqualFold(arch.Name, archScopeBase(inst.InArch)) {
+					parent, _ = resolve(fileLib, strings.ToLower(arch.EntityName), fileLib)
+					break
+				}
+			}
+			if parent == "" {
+				continue
+			}
+			lib, name := splitInstanceTarget(resolveWorkPrefix(inst.Target, fileLib))
+			if name == "" {
+				continue
+			}
+			child, resolved := resolve(lib, name, fileLib)
+			h.Edges = append(h.Edges, HierarchyEdge{
+				Parent:   parent,
+				Child:    child,
+				Instance: inst.Name,
+				Resolved: resolved,
+			})
+			if resolved && child != parent {
+				instantiated[child] = true
+			}
+		}
+	}
+
+	sort.Slice(h.Edges, func(i, j int) bool {
+		a, b := h.Edges[i], h.Edges[j]
+		if a.Parent != b.Parent {
+			return a.Parent < b.Parent
+		}
+		if a.Instance != b.Instance {
+			return a.Instance < b.Instance
+		}
+		return a.Child < b.Child
+	})
+	for key := range h.Entities {
+		if !instantiated[key] {
+			h.Roots = append(h.Roots, key)
+		}
+	}
+	sort.Strings(h.Roots)
+	return h
+}
+
+// splitInstanceTarget splits an instance target such as "lib.cpu",
+// "entity lib.cpu(rtl)", or "component cpu" into its lowercase library (empty
+// if unqualified) and unit name.
+func splitInstanceTarget(target string) (string, string) {
+	name := strings.ToLower(strings.TrimSpace(target))
+	name = strings.TrimSpace(strings.TrimPrefix(name, "entity "))
+	name = strings.TrimSpace(strings.TrimPrefix(name, "component "))
+	if paren := strings.Index(name, "("); paren != -1 {
+		name = strings.TrimSpace(name[:paren])
+	}
+	lib := ""
+	if dot := strings.LastIndex(name, "."); dot != -1 {
+		lib = name[:dot]
+		if prev := strings.LastIndex(lib, "."); prev != -1 {
+			lib = lib[prev+1:]
+		}
+		name = name[dot+1:]
+	}
+	return lib, name
+}
+
+// archScopeBase strips generate scopes from an architecture context ("rtl.gen_a" -> "rtl").
+func archScopeBase(scope string) string {
+	if idx := strings.Index(scope, "."); idx != -1 {
+		return scope[:idx]
+	}
+	return scope
+}
+
+// WriteHierarchyDOT writes the hierarchy as a Graphviz digraph. Entities are
+// nodes, instantiations are edges labeled with the instance name, entities
+// that are never instantiated are pinned to the top rank as roots, and
+// unresolved targets are dashed red nodes.
+func WriteHierarchyDOT(w io.Writer, h Hierarchy) error {
+	var b strings.Builder
+	b.WriteString("digraph hierarchy {\n")
+	b.WriteString("  rankdir=TB;\n")
+	b.WriteString("  node [shape=box];\n")
+
+	if len(h.Roots) > 0 {
+		b.WriteString("  { rank=min;")
+		for _, root := range h.Roots {
+			fmt.Fprintf(&b, " %s;", dotQuote(root))
+		}
+		b.WriteString(" }\n")
+	}
+
+	isRoot := make(map[string]bool, len(h.Roots))
+	for _, root := range h.Roots {
+		isRoot[root] = true
+	}
+	keys := make([]string, 0, len(h.Entities))
+	for key := range h.Entities {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		attrs := fmt.Sprintf("label=%s", dotQuote(h.Entities[key]))
+		if isRoot[key] {
+			attrs += ", style=bold"
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(key), attrs)
+	}
+
+	unresolved := make(map[string]bool)
+	for _, edge := range h.Edges {
+		if !edge.Resolved && !unresolved[edge.Child] {
+			unresolved[edge.Child] = true
+			fmt.Fprintf(&b, "  %s [label=%s, style=dashed, color=red];\n", dotQuote(edge.Child), dotQuote(edge.Child))
+		}
+	}
+	for _, edge := range h.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(edge.Parent), dotQuote(edge.Child), dotQuote(edge.Instance))
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// HierarchyNode is one entity (or unresolved target) in the JSON hierarchy.
+type HierarchyNode struct {
+	ID       string `json:"id"`
+	Label    string `json:"label"`
+	Root     bool   `json:"root"`
+	Resolved bool   `json:"resolved"`
+}
+
+// HierarchyGraphEdge is one instantiation in the JSON hierarchy.
+type HierarchyGraphEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Instance string `json:"instance"`
+	Resolved bool   `json:"resolved"`
+}
+
+// WriteHierarchyJSON writes the hierarchy as {"nodes": [...], "edges": [...]},
+// with the same node keys as WriteHierarchyDOT.
+func WriteHierarchyJSON(w io.Writer, h Hierarchy) error {
+	graph := struct {
+		Nodes []HierarchyNode      `json:"nodes"`
+		Edges []HierarchyGraphEdge `json:"edges"`
+	}{Nodes: []HierarchyNode{}, Edges: []HierarchyGraphEdge{}}
+
+	isRoot := make(map[string]bool, len(h.Roots))
+	for _, root := range h.Roots {
+		isRoot[root] = true
+	}
+	keys := make([]string, 0, len(h.Entities))
+	for key := range h.Entities {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		graph.Nodes = append(graph.Nodes, HierarchyNode{ID: key, Label: h.Entities[key], Root: isRoot[key], Resolved: true})
+	}
+	unresolved := make(map[string]bool)
+	for _, edge := range h.Edges {
+		if !edge.Resolved && !unresolved[edge.Child] {
+			unresolved[edge.Child] = true
+			graph.Nodes = append(graph.Nodes, HierarchyNode{ID: edge.Child, Label: edge.Child})
+		}
+		graph.Edges = append(graph.Edges, HierarchyGraphEdge{
+			From:     edge.Parent,
+			To:       edge.Child,
+			Instance: edge.Instance,
+			Resolved: edge.Resolved,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(graph)
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
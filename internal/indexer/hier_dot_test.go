@@ -0,0 +1,133 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestBuildHierarchyAndDOT(t *testing.T) {
+	allFacts := []extractor.FileFacts{
+		{
+			File:          "top.vhd",
+			Entities:      []extractor.Entity{{Name: "Top"}},
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "Top"}},
+			Instances: []extractor.Instance{
+				{Name: "u_cpu", Target: "work.cpu", InArch: "rtl"},
+				{Name: "u_pll", Target: "vendor.pll_prim", InArch: "rtl.gen_clk"},
+			},
+		},
+		{
+			File:          "cpu.vhd",
+			Entities:      []extractor.Entity{{Name: "cpu"}, {Name: "alu"}},
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "cpu"}},
+			Instances:     []extractor.Instance{{Name: "u_alu", Target: "entity work.ALU(rtl)", InArch: "rtl"}},
+		},
+	}
+
+	h := BuildHierarchy(allFacts, nil)
+	if len(h.Roots) != 1 || h.Roots[0] != "top" {
+		t.Fatalf("expected top as the only root, got %v", h.Roots)
+	}
+	if len(h.Edges) != 3 {
+		t.Fatalf("expected 3 edges, got %+v", h.Edges)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteHierarchyDOT(&buf, h); err != nil {
+		t.Fatalf("WriteHierarchyDOT: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`{ rank=min; "top"; }`,
+		`"top" [label="Top", style=bold];`,
+		`"top" -> "cpu" [label="u_cpu"];`,
+		`"cpu" -> "alu" [label="u_alu"];`,
+		`"pll_prim" [label="pll_prim", style=dashed, color=red];`,
+		`"top" -> "pll_prim" [label="u_pll"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected DOT output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestBuildHierarchyResolvesWorkPerLibrary(t *testing.T) {
+	libs := map[string]string{"a/top_a.vhd": "lib_a", "a/fifo.vhd": "lib_a", "b/top_b.vhd": "lib_b", "b/fifo.vhd": "lib_b"}
+	allFacts := []extractor.FileFacts{
+		{File: "a/fifo.vhd", Entities: []extractor.Entity{{Name: "fifo"}}},
+		{File: "b/fifo.vhd", Entities: []extractor.Entity{{Name: "fifo"}}},
+		{
+			File:          "a/top_a.vhd",
+			Entities:      []extractor.Entity{{Name: "top_a"}},
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top_a"}},
+			Instances:     []extractor.Instance{{Name: "u_fifo", Target: "work.fifo", InArch: "rtl"}},
+		},
+		{
+			File:          "b/top_b.vhd",
+			Entities:      []extractor.Entity{{Name: "top_b"}},
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top_b"}},
+			Instances:     []extractor.Instance{{Name: "u_fifo", Target: "fifo", InArch: "rtl"}},
+		},
+	}
+
+	h := BuildHierarchy(allFacts, func(file string) string { return libs[file] })
+	want := map[string]string{"top_a": "lib_a.fifo", "top_b": "lib_b.fifo"}
+	if len(h.Edges) != 2 {
+		t.Fatalf("expected 2 edges, got %+v", h.Edges)
+	}
+	for _, edge := range h.Edges {
+		if !edge.Resolved || want[edge.Parent] != edge.Child {
+			t.Fatalf("expected %s -> %s, got %+v", edge.Parent, want[edge.Parent], edge)
+		}
+	}
+	if h.Entities["lib_a.fifo"] != "lib_a.fifo" || h.Entities["top_a"] != "top_a" {
+		t.Fatalf("unexpected entity labels: %v", h.Entities)
+	}
+	if strings.Join(h.Roots, ",") != "top_a,top_b" {
+		t.Fatalf("expected both tops as roots, got %v", h.Roots)
+	}
+}
+
+func TestWriteHierarchyJSON(t *testing.T) {
+	allFacts := []extractor.FileFacts{
+		{File: "child.vhd", Entities: []extractor.Entity{{Name: "child"}}},
+		{
+			File:          "top.vhd",
+			Entities:      []extractor.Entity{{Name: "top"}},
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top"}},
+			Instances: []extractor.Instance{
+				{Name: "u0", Target: "work.child", InArch: "rtl"},
+				{Name: "u1", Target: "child", InArch: "rtl"},
+				{Name: "u2", Target: "missing", InArch: "rtl"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteHierarchyJSON(&buf, BuildHierarchy(allFacts, nil)); err != nil {
+		t.Fatalf("WriteHierarchyJSON: %v", err)
+	}
+	var graph struct {
+		Nodes []HierarchyNode      `json:"nodes"`
+		Edges []HierarchyGraphEdge `json:"edges"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &graph); err != nil {
+		t.Fatalf("decode: %v\n%s", err, buf.String())
+	}
+	want := []HierarchyNode{
+		{ID: "child", Label: "child", Resolved: true},
+		{ID: "top", Label: "top", Root: true, Resolved: true},
+		{ID: "missing", Label: "missing"},
+	}
+	if !reflect.DeepEqual(graph.Nodes, want) {
+		t.Fatalf("nodes = %+v, want %+v", graph.Nodes, want)
+	}
+	if len(graph.Edges) != 3 || graph.Edges[0].To != "child" || graph.Edges[1].To != "child" || graph.Edges[2].Resolved {
+		t.Fatalf("unexpected edges %+v", graph.Edges)
+	}
+}
@@ -0,0 +1,72 @@
+package indexer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// expandWildcardImports expands each "use lib.pkg.all" clause into the
+// package members registered in the SymbolTable (types, constants, functions,
+// procedures), recording one ImportedName per member visible in the file.
+// "work" resolves to the file's own library. Packages that are not in the
+// SymbolTable (standard, vendor, or unanalyzed third-party libraries) import
+// nothing.
+func (idx *Indexer) expandWildcardImports() []policy.ImportedName {
+	members := make(map[string][]Symbol) // "lib.pkg" -> members
+	for name, sym := range idx.Symbols.All() {
+		switch sym.Kind {
+		case "type", "constant", "function", "procedure":
+		default:
+			continue
+		}
+		dot := strings.LastIndex(name, ".")
+		if dot == -1 {
+			continue
+		}
+		members[name[:dot]] = append(members[name[:dot]], sym)
+	}
+	for pkg := range members {
+		sort.Slice(members[pkg], func(i, j int) bool { return members[pkg][i].Name < members[pkg][j].Name })
+	}
+
+	found := []policy.ImportedName{}
+	for _, facts := range idx.Facts {
+		fileLib := "work"
+		if libInfo, ok := idx.FileLibraries[facts.File]; ok && libInfo.LibraryName != "" {
+			fileLib = strings.ToLower(libInfo.LibraryName)
+		}
+		seen := make(map[string]bool)
+		for _, dep := range facts.Dependencies {
+			target := strings.ToLower(strings.Join(strings.Fields(dep.Target), ""))
+			if dep.Kind != "use" || !strings.HasSuffix(target, ".all") {
+				continue
+			}
+			pkgName := strings.TrimSuffix(target, ".all")
+			qualName := pkgName
+			if strings.HasPrefix(qualName, "work.") {
+				qualName = fileLib + qualName[4:]
+			}
+			if !strings.Contains(qualName, ".") {
+				qualName = fileLib + "." + qualName
+			}
+			for _, sym := range members[qualName] {
+				name := sym.Name[len(qualName)+1:]
+				key := qualName + "." + name
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				found = append(found, policy.ImportedName{
+					Name:        name,
+					Kind:        sym.Kind,
+					FromPackage: pkgName,
+					File:        facts.File,
+					Line:        dep.Line,
+				})
+			}
+		}
+	}
+	return found
+}
@@ -0,0 +1,51 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestExpandWildcardImports(t *testing.T) {
+	idx := New()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{
+		"pkg.vhd": {LibraryName: "common"},
+		"top.vhd": {LibraryName: "common"},
+	}
+	idx.Facts = []extractor.FileFacts{
+		{
+			File:          "pkg.vhd",
+			Packages:      []extractor.Package{{Name: "my_pkg", Line: 1}},
+			Types:         []extractor.TypeDeclaration{{Name: "state_t", Kind: "enum", Line: 2, InPackage: "my_pkg"}},
+			ConstantDecls: []extractor.ConstantDeclaration{{Name: "WIDTH", Line: 3, InPackage: "my_pkg"}},
+			// Architecture-local declarations are not exported
+			Functions: []extractor.FunctionDeclaration{{Name: "local_fn", Line: 9}},
+		},
+		{
+			File: "top.vhd",
+			Dependencies: []extractor.Dependency{
+				{Source: "top.vhd", Target: "ieee.std_logic_1164.all", Kind: "use", Line: 2},
+				{Source: "top.vhd", Target: "work.My_Pkg.all", Kind: "use", Line: 3},
+				// Selected import: not a wildcard
+				{Source: "top.vhd", Target: "work.my_pkg.state_t", Kind: "use", Line: 4},
+				// Third-party package that was not analyzed
+				{Source: "top.vhd", Target: "vendor.prims_pkg.all", Kind: "use", Line: 5},
+			},
+		},
+	}
+	for _, facts := range idx.Facts {
+		idx.registerSymbolsForFacts(facts, facts.File)
+	}
+
+	got := idx.expandWildcardImports()
+	want := []policy.ImportedName{
+		{Name: "state_t", Kind: "type", FromPackage: "work.my_pkg", File: "top.vhd", Line: 3},
+		{Name: "width", Kind: "constant", FromPackage: "work.my_pkg", File: "top.vhd", Line: 3},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
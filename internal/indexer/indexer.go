@@ -26,7 +26,9 @@ package indexer
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -68,11 +70,67 @@ type Indexer struct {
 	// Progress output (lightweight, streaming)
 	Progress bool
 
+	// Progress events as NDJSON on stderr, one per extracted file
+	// (--progress-json; combines with JSON output)
+	ProgressJSON bool
+
 	// Trace output (progress + per-file fact summaries)
 	Trace bool
 
 	// JSON output mode
 	JSONOutput bool
+	// Quiet text output: only violations, one "file:line: [rule] severity:
+	// message" line each, with no banners, progress, or summaries
+	Quiet bool
+
+	// SARIF 2.1.0 report path (empty = no SARIF output)
+	SARIFPath string
+	// JUnit XML report path (empty = no JUnit output)
+	JUnitPath string
+	// CSV report path (empty = no CSV output)
+	CSVPath string
+	// Directory for per-file JSON reports (empty = none)
+	OutputDir string
+	// Baseline of accepted violations to drop (empty = none)
+	BaselinePath string
+	// Write the current violations as a baseline to this path (empty = no)
+	WriteBaselinePath string
+	// Unified diff file or git ref; only violations on added lines are
+	// reported (empty = all lines)
+	DiffSpec string
+	// Rules to run (--rules; empty = all) and to skip (--skip-rules)
+	OnlyRules []string
+	SkipRules []string
+	// Content to lint in place of the file at each path (--stdin); the file
+	// is analyzed even if it does not exist on disk
+	Overlays map[string][]byte
+	// Report only violations in this file, with the rest of the project
+	// still analyzed for context (empty = all files)
+	ReportFile string
+
+	// Summary of the last Run (for exit-code thresholds)
+	Summary ResultSummary
+	// Result of the last Run
+	Result LintResult
+	// Keep the result in Result without printing it or writing reports
+	// (RunRoots merges several roots first)
+	DeferOutput bool
+
+	// Skip fact extraction for files in which ERROR and MISSING nodes are
+	// more than this percentage of the parse tree, reporting them as parse
+	// errors instead (--max-parse-errors; 0 = no limit)
+	MaxParseErrors float64
+
+	// Stop starting extractions after this long and cancel the parses in
+	// flight, then run policy on the files extracted so far and mark the
+	// result truncated (--timeout; 0 = no limit)
+	Timeout time.Duration
+
+	// Write each analyzed file's content hash and cache status to this
+	// JSON file (--manifest)
+	ManifestPath string
+	// Manifest entries of the last Run, sorted by file
+	Manifest []ManifestEntry
 
 	// Timing output (JSONL)
 	Timing     bool
@@ -85,9 +143,17 @@ type Indexer struct {
 	cacheVersionOverride *cacheVersions
 }
 
+// ResultSchemaVersion is the semantic version of the LintResult JSON shape.
+// Bump the major version when a field is removed, renamed, or changes type,
+// and the minor version when one is added.
+const ResultSchemaVersion = "1.5.0"
+
 // LintResult is the structured result of running the linter
 // This can be serialized to JSON for programmatic consumption
 type LintResult struct {
+	// Version of this JSON shape (ResultSchemaVersion)
+	SchemaVersion string `json:"schema_version"`
+
 	// Violations found by policy evaluation
 	Violations []policy.Violation `json:"violations"`
 
@@ -108,6 +174,14 @@ type LintResult struct {
 
 	// Parse errors encountered
 	ParseErrors []ParseError `json:"parse_errors,omitempty"`
+
+	// Per-root breakdown when several roots are linted in one run
+	Roots []RootResult `json:"roots,omitempty"`
+
+	// Truncated is true when --timeout stopped extraction early; policy ran
+	// on the other files, and TimedOutFiles lists the ones left out
+	Truncated     bool     `json:"truncated,omitempty"`
+	TimedOutFiles []string `json:"timed_out_files,omitempty"`
 }
 
 // ResultSummary provides aggregate violation counts
@@ -116,6 +190,92 @@ type ResultSummary struct {
 	Errors          int `json:"errors"`
 	Warnings        int `json:"warnings"`
 	Info            int `json:"info"`
+	Suppressed      int `json:"suppressed"`   // Violations silenced by inline vhdl-lint directives
+	Baselined       int `json:"baselined"`    // Violations matched by the --baseline snapshot
+	OutsideDiff     int `json:"outside_diff"` // Violations on lines the --diff does not add
+
+	// Violations per rule, and the most frequent rules first (at most
+	// topRulesLimit; ties by rule name)
+	ByRule   map[string]int `json:"by_rule,omitempty"`
+	TopRules []RuleCount    `json:"top_rules,omitempty"`
+}
+
+// RuleCount is how many violations one rule reported.
+type RuleCount struct {
+	Rule  string `json:"rule"`
+	Count int    `json:"count"`
+}
+
+// topRulesLimit caps ResultSummary.TopRules.
+const topRulesLimit = 10
+
+// FailOnLevels are the accepted --fail-on thresholds, most severe first.
+var FailOnLevels = []string{"error", "warning", "info", "none"}
+
+// CountAtOrAbove returns how many violations are at least as severe as
+// severity ("error", "warning", or "info"). "none" and unknown values count
+// nothing.
+func (s ResultSummary) CountAtOrAbove(severity string) int {
+	switch severity {
+	case "error":
+		return s.Errors
+	case "warning":
+		return s.Errors + s.Warnings
+	case "info":
+		return s.Errors + s.Warnings + s.Info
+	default:
+		return 0
+	}
+}
+
+// countSeverity adds delta to the counter for severity.
+func (s *ResultSummary) countSeverity(severity string, delta int) {
+	switch severity {
+	case "error":
+		s.Errors += delta
+	case "warning":
+		s.Warnings += delta
+	case "info":
+		s.Info += delta
+	}
+}
+
+// countRule adds delta to the counter for rule, dropping rules that reach
+// zero. Call rankRules once the counts are final.
+func (s *ResultSummary) countRule(rule string, delta int) {
+	s.ByRule = addRuleCount(s.ByRule, rule, delta)
+}
+
+// rankRules rebuilds TopRules from ByRule.
+func (s *ResultSummary) rankRules() {
+	s.TopRules = nil
+	for rule, n := range s.ByRule {
+		s.TopRules = append(s.TopRules, RuleCount{Rule: rule, Count: n})
+	}
+	sort.Slice(s.TopRules, func(i, j int) bool {
+		if s.TopRules[i].Count != s.TopRules[j].Count {
+			return s.TopRules[i].Count > s.TopRules[j].Count
+		}
+		return s.TopRules[i].Rule < s.TopRules[j].Rule
+	})
+	if len(s.TopRules) > topRulesLimit {
+		s.TopRules = s.TopRules[:topRulesLimit]
+	}
+}
+
+// addRuleCount adds delta to counts[rule], allocating counts on first use
+// and deleting rules that reach zero.
+func addRuleCount(counts map[string]int, rule string, delta int) map[string]int {
+	if counts == nil {
+		if delta <= 0 {
+			return nil
+		}
+		counts = make(map[string]int)
+	}
+	if counts[rule] += delta; counts[rule] <= 0 {
+		delete(counts, rule)
+	}
+	return counts
 }
 
 // ExtractionStats provides counts of extracted elements
@@ -137,18 +297,54 @@ type FileResult struct {
 	Errors   int    `json:"errors"`
 	Warnings int    `json:"warnings"`
 	Info     int    `json:"info"`
+	// Violations per rule in this file
+	ByRule map[string]int `json:"by_rule,omitempty"`
+}
+
+func (fr *FileResult) countSeverity(severity string, delta int) {
+	switch severity {
+	case "error":
+		fr.Errors += delta
+	case "warning":
+		fr.Warnings += delta
+	case "info":
+		fr.Info += delta
+	}
+}
+
+func (fr *FileResult) countRule(rule string, delta int) {
+	fr.ByRule = addRuleCount(fr.ByRule, rule, delta)
 }
 
 // ParseError represents a file that failed to parse
 type ParseError struct {
 	File    string `json:"file"`
 	Message string `json:"message"`
+	// ERROR and MISSING nodes in a file skipped by --max-parse-errors
+	ErrorNodes int `json:"error_nodes,omitempty"`
 }
 
 // SymbolTable holds all exported symbols across files
 type SymbolTable struct {
 	mu      sync.RWMutex
 	symbols map[string]Symbol
+	// duplicates holds every qualified name declared in more than one file
+	duplicates map[string]*DuplicateSymbol
+}
+
+// DuplicateSymbol is a qualified name declared in more than one file. The
+// symbol table keeps only the last declaration registered, so references
+// to the name may resolve to the wrong one.
+type DuplicateSymbol struct {
+	Name      string
+	Kind      string
+	Locations []SymbolLocation // Every conflicting declaration, sorted by file
+}
+
+// SymbolLocation is where a symbol is declared.
+type SymbolLocation struct {
+	File string
+	Line int
 }
 
 // Symbol represents an exported VHDL construct
@@ -195,14 +391,23 @@ func (idx *Indexer) newExtractor() FactsExtractor {
 	if idx.extractorFactory != nil {
 		return idx.extractorFactory()
 	}
-	return extractor.New()
+	ext := extractor.New()
+	ext.MaxParseErrors = idx.MaxParseErrors
+	return ext
 }
 
 func (idx *Indexer) cacheVersions(rootPath string) cacheVersions {
+	var versions cacheVersions
 	if idx.cacheVersionOverride != nil {
-		return *idx.cacheVersionOverride
+		versions = *idx.cacheVersionOverride
+	} else {
+		versions = computeCacheVersions(rootPath)
+	}
+	// Facts cached without the limit may belong to files it would skip
+	if idx.MaxParseErrors > 0 {
+		versions.extractor += fmt.Sprintf("+max-parse-errors=%g", idx.MaxParseErrors)
 	}
-	return computeCacheVersions(rootPath)
+	return versions
 }
 
 func (idx *Indexer) registerSymbolsForFacts(facts extractor.FileFacts, filePath string) {
@@ -230,6 +435,14 @@ func (idx *Indexer) registerSymbolsForFacts(facts extractor.FileFacts, filePath
 			Line: pkg.Line,
 		})
 	}
+	for _, ctx := range facts.ContextDecls {
+		idx.Symbols.Add(Symbol{
+			Name: fmt.Sprintf("%s.%s", libName, strings.ToLower(ctx.Name)),
+			Kind: "context",
+			File: filePath,
+			Line: ctx.Line,
+		})
+	}
 
 	// Register package contents in symbol table for cross-file resolution
 	// Format: library.package.item (e.g., work.my_pkg.state_t)
@@ -305,70 +518,26 @@ func (idx *Indexer) Run(rootPath string) error {
 
 	// 1. Find all VHDL files using configuration
 	stepStart := time.Now()
-	var files []string
-	var err error
-
-	// Check if config has library definitions
-	if len(idx.Config.Libraries) > 0 {
-		libs, resolveErr := idx.Config.ResolveLibraries(rootPath)
-		if resolveErr != nil {
-			return fmt.Errorf("resolve libraries: %w", resolveErr)
-		}
+	files, libs, err := idx.discoverFiles(rootPath)
+	if err != nil {
+		return err
+	}
+	files = idx.addOverlayFiles(files)
 
-		// Collect all files and track library info
-		fileSet := make(map[string]bool)
+	// Report library info (only in text mode)
+	if len(libs) > 0 && !idx.JSONOutput {
+		idx.logf("Loaded configuration with %d libraries\n", len(libs))
 		for _, lib := range libs {
-			for _, f := range lib.Files {
-				if !fileSet[f] {
-					fileSet[f] = true
-					files = append(files, f)
-
-					// Track library info for each file
-					idx.FileLibraries[f] = config.FileLibraryInfo{
-						LibraryName:  lib.Name,
-						IsThirdParty: lib.IsThirdParty,
-					}
-
-					// Track third-party files
-					if lib.IsThirdParty {
-						idx.ThirdPartyFiles[f] = true
-					}
-				}
-			}
-		}
-
-		// Report library info (only in text mode)
-		if !idx.JSONOutput {
-			fmt.Printf("Loaded configuration with %d libraries\n", len(libs))
-			for _, lib := range libs {
-				thirdParty := ""
-				if lib.IsThirdParty {
-					thirdParty = " (third-party)"
-				}
-				fmt.Printf("  %s: %d files%s\n", lib.Name, len(lib.Files), thirdParty)
+			thirdParty := ""
+			if lib.IsThirdParty {
+				thirdParty = " (third-party)"
 			}
+			idx.logf("  %s: %d files%s\n", lib.Name, len(lib.Files), thirdParty)
 		}
 	}
 
-	// Fallback to directory scan if no files from config
-	if len(files) == 0 {
-		files, err = idx.findVHDLFiles(rootPath)
-		if err != nil {
-			return fmt.Errorf("scanning files: %w", err)
-		}
-	}
-
-	// Filter out ignored files
-	var filteredFiles []string
-	for _, f := range files {
-		if !idx.Config.ShouldIgnoreFile(f) {
-			filteredFiles = append(filteredFiles, f)
-		}
-	}
-	files = filteredFiles
-
 	if !idx.JSONOutput {
-		fmt.Printf("Found %d VHDL files\n", len(files))
+		idx.logf("Found %d VHDL files\n", len(files))
 	}
 	scanDuration := time.Since(stepStart)
 	timing.RecordStage("scan", stepStart, scanDuration, "")
@@ -390,23 +559,29 @@ func (idx *Indexer) Run(rootPath string) error {
 	var wg sync.WaitGroup
 	var progressMu sync.Mutex
 	progress := 0
-	progressEnabled := (idx.Verbose || idx.Progress || idx.Trace) && !idx.JSONOutput
+	progressEvents := 0
+	progressEnabled := (idx.Verbose || idx.Progress || idx.Trace) && !idx.JSONOutput && !idx.Quiet
 	if progressEnabled {
-		fmt.Printf("\n=== Extraction Progress ===\n")
+		idx.logf("\n=== Extraction Progress ===\n")
 	}
 	factsChan := make(chan extractor.FileFacts, len(files))
 	errChan := make(chan error, len(files))
 	pipelineErrChan := make(chan error, len(files))
 	var changedMu sync.Mutex
 	changedFiles := make(map[string]bool)
+	ctx, cancel := idx.runContext()
+	defer cancel()
+	var timedOut timedOutFiles
+	var manifest manifestRecorder
 
 	for _, file := range files {
 		wg.Add(1)
 		go func(f string) {
 			defer wg.Done()
 			fileStart := time.Now()
+			overlay, overlaid := idx.overlayFor(f)
 			var contentHash string
-			if cache != nil {
+			if cache != nil && !overlaid {
 				h, err := hashFile(f)
 				if err != nil {
 					errChan <- fmt.Errorf("%s: %w", f, err)
@@ -416,18 +591,39 @@ func (idx *Indexer) Run(rootPath string) error {
 				if facts, ok, err := cache.Get(f, contentHash); err == nil && ok {
 					factsChan <- facts
 					idx.registerSymbolsForFacts(facts, f)
+					if idx.ManifestPath != "" {
+						manifest.add(facts, contentHash, "cache_hit")
+					}
 					fileDuration := time.Since(fileStart)
 					timing.RecordFile("extract", f, "cache_hit", fileStart, fileDuration)
 					if progressEnabled {
 						emitProgress(&progressMu, &progress, len(files), facts, "cache hit", idx.Trace, fileDuration)
 					}
+					if idx.ProgressJSON {
+						emitProgressJSON(os.Stderr, &progressMu, &progressEvents, len(files), f, "cache_hit", fileDuration)
+					}
 					return
 				} else if err != nil {
 					pipelineErrChan <- fmt.Errorf("cache read failed for %s: %w", f, err)
 				}
 			}
 
-			facts, err := ext.Extract(f)
+			// Past the deadline, only cached facts are still gathered
+			if ctx.Err() != nil {
+				timedOut.add(f)
+				return
+			}
+			var facts extractor.FileFacts
+			var err error
+			if overlaid {
+				facts, err = extractOverlay(ext, f, overlay)
+			} else {
+				facts, err = extractCtx(ctx, ext, f)
+			}
+			if err != nil && ctx.Err() != nil {
+				timedOut.add(f)
+				return
+			}
 			if err != nil {
 				errChan <- fmt.Errorf("%s: %w", f, err)
 				return
@@ -442,11 +638,20 @@ func (idx *Indexer) Run(rootPath string) error {
 				changedFiles[f] = true
 				changedMu.Unlock()
 			}
+			if idx.ManifestPath != "" {
+				if contentHash == "" {
+					contentHash = manifestHash(f, overlay, overlaid)
+				}
+				manifest.add(facts, contentHash, "extracted")
+			}
 			fileDuration := time.Since(fileStart)
 			timing.RecordFile("extract", f, "extracted", fileStart, fileDuration)
 			if progressEnabled {
 				emitProgress(&progressMu, &progress, len(files), facts, "extracted", idx.Trace, fileDuration)
 			}
+			if idx.ProgressJSON {
+				emitProgressJSON(os.Stderr, &progressMu, &progressEvents, len(files), f, "extracted", fileDuration)
+			}
 			factsChan <- facts
 			idx.registerSymbolsForFacts(facts, f)
 		}(file)
@@ -477,12 +682,13 @@ func (idx *Indexer) Run(rootPath string) error {
 			recordPipelineErr(fmt.Errorf("cache save failed: %w", err))
 		}
 	}
+	idx.Manifest = manifest.sorted()
 	extractDuration := time.Since(stepStart)
 	timing.RecordStage("extract", stepStart, extractDuration, "")
 
 	// Cache impact visualization (verbose/progress/trace)
 	if cache != nil && progressEnabled && len(changedFiles) > 0 {
-		fmt.Printf("\n=== Cache Impact ===\n")
+		idx.logf("\n=== Cache Impact ===\n")
 		dependents := buildDependentsGraph(factsByFile, idx.Symbols, idx.FileLibraries)
 		changedList := make([]string, 0, len(changedFiles))
 		for f := range changedFiles {
@@ -491,29 +697,34 @@ func (idx *Indexer) Run(rootPath string) error {
 		sort.Strings(changedList)
 		for _, f := range changedList {
 			report := computeImpact(f, dependents)
-			fmt.Print(formatImpactReport(report))
+			idx.logf("%s", formatImpactReport(report))
 		}
 	}
-
-	// Elaborate generate statements using constant values
-	stepStart = time.Now()
-	// Build a global constant map from all extracted constants
-	globalConstants := make(map[string]int)
-	for _, facts := range idx.Facts {
-		constMap := extractor.BuildConstantMap(facts.ConstantDecls)
-		for k, v := range constMap {
-			globalConstants[k] = v
+	// Results that include overlay content do not describe the files on
+	// disk, so the policy and fact table caches are neither read nor written
+	if len(idx.Overlays) > 0 {
+		cache = nil
+	}
+	// Neither do the results of a run cut short by its timeout
+	timedOutList := timedOut.sorted()
+	if len(timedOutList) > 0 {
+		cache = nil
+		if !idx.JSONOutput {
+			idx.logf("Timeout: %d of %d files not extracted after %s; results are partial\n", len(timedOutList), len(files), idx.Timeout)
 		}
 	}
 
-	// Elaborate generates in all files
-	elaboratedCount := 0
-	for i := range idx.Facts {
-		elaboratedCount += extractor.ElaborateGenerates(idx.Facts[i].Generates, globalConstants)
-	}
+	// Context references pull in the clauses their context declarations provide
+	idx.expandContextReferences()
+	// Case statements covering every literal of an enum selector are complete
+	idx.markCompleteEnumCases()
+
+	// Elaborate generate statements using constant values
+	stepStart = time.Now()
+	elaboratedCount, constantCount := idx.elaborateGenerates()
 	if elaboratedCount > 0 && idx.Verbose {
-		fmt.Printf("\n=== Verbose: Generate Elaboration ===\n")
-		fmt.Printf("  Elaborated %d for-generates using %d constants\n", elaboratedCount, len(globalConstants))
+		idx.logf("\n=== Verbose: Generate Elaboration ===\n")
+		idx.logf("  Elaborated %d for-generates using %d constants\n", elaboratedCount, constantCount)
 	}
 	elabDuration := time.Since(stepStart)
 	timing.RecordStage("elaborate", stepStart, elabDuration, "")
@@ -535,121 +746,123 @@ func (idx *Indexer) Run(rootPath string) error {
 
 	// Verbose output for debugging
 	if idx.Verbose {
-		fmt.Printf("\n=== Verbose: Extracted Ports ===\n")
+		idx.logf("\n=== Verbose: Extracted Ports ===\n")
 		for _, facts := range idx.Facts {
 			for _, p := range facts.Ports {
-				fmt.Printf("  %s.%s: direction=%q type=%q\n", p.InEntity, p.Name, p.Direction, p.Type)
+				idx.logf("  %s.%s: direction=%q type=%q\n", p.InEntity, p.Name, p.Direction, p.Type)
 			}
 		}
-		fmt.Printf("\n=== Verbose: Extracted Processes ===\n")
+		idx.logf("\n=== Verbose: Extracted Processes ===\n")
 		for _, facts := range idx.Facts {
 			for _, p := range facts.Processes {
 				kind := "combinational"
 				if p.IsSequential {
 					kind = "sequential"
 				}
-				fmt.Printf("  %s.%s: %s, sensitivity=%v\n", p.InArch, p.Label, kind, p.SensitivityList)
+				idx.logf("  %s.%s: %s, sensitivity=%v\n", p.InArch, p.Label, kind, p.SensitivityList)
 				if p.ClockSignal != "" {
-					fmt.Printf("    clock: %s (%s_edge)\n", p.ClockSignal, p.ClockEdge)
+					idx.logf("    clock: %s (%s_edge)\n", p.ClockSignal, p.ClockEdge)
 				}
 				if p.HasReset {
 					asyncStr := "sync"
 					if p.ResetAsync {
 						asyncStr = "async"
 					}
-					fmt.Printf("    reset: %s (%s)\n", p.ResetSignal, asyncStr)
+					idx.logf("    reset: %s (%s)\n", p.ResetSignal, asyncStr)
 				}
 				if len(p.AssignedSignals) > 0 {
-					fmt.Printf("    writes: %v\n", p.AssignedSignals)
+					idx.logf("    writes: %v\n", p.AssignedSignals)
 				}
 				if len(p.ReadSignals) > 0 {
-					fmt.Printf("    reads: %v\n", p.ReadSignals)
+					idx.logf("    reads: %v\n", p.ReadSignals)
 				}
 			}
 		}
-		fmt.Printf("\n=== Verbose: Clock Domains ===\n")
+		idx.logf("\n=== Verbose: Clock Domains ===\n")
 		for _, facts := range idx.Facts {
 			for _, cd := range facts.ClockDomains {
-				fmt.Printf("  %s (%s): drives %v\n", cd.Clock, cd.Edge, cd.Registers)
+				idx.logf("  %s (%s): drives %v\n", cd.Clock, cd.Edge, cd.Registers)
 			}
 		}
-		fmt.Printf("\n=== Verbose: Instances ===\n")
+		idx.logf("\n=== Verbose: Instances ===\n")
 		for _, facts := range idx.Facts {
 			for _, inst := range facts.Instances {
-				fmt.Printf("  %s: %s\n", inst.Name, inst.Target)
+				idx.logf("  %s: %s\n", inst.Name, inst.Target)
 				if len(inst.GenericMap) > 0 {
-					fmt.Printf("    generics: %v\n", inst.GenericMap)
+					idx.logf("    generics: %v\n", inst.GenericMap)
 				}
 				if len(inst.PortMap) > 0 {
-					fmt.Printf("    ports: %v\n", inst.PortMap)
+					idx.logf("    ports: %v\n", inst.PortMap)
 				}
 			}
 		}
-		fmt.Printf("\n=== Verbose: Case Statements ===\n")
+		idx.logf("\n=== Verbose: Case Statements ===\n")
 		for _, facts := range idx.Facts {
 			for _, cs := range facts.CaseStatements {
 				status := "INCOMPLETE (potential latch)"
 				if cs.HasOthers {
 					status = "complete (has others)"
+				} else if cs.IsComplete {
+					status = "complete (covers every enum literal)"
 				}
-				fmt.Printf("  case %s [%s] line %d\n", cs.Expression, status, cs.Line)
+				idx.logf("  case %s [%s] line %d\n", cs.Expression, status, cs.Line)
 				if cs.InProcess != "" {
-					fmt.Printf("    in process: %s\n", cs.InProcess)
+					idx.logf("    in process: %s\n", cs.InProcess)
 				}
-				fmt.Printf("    choices: %v\n", cs.Choices)
+				idx.logf("    choices: %v\n", cs.Choices)
 			}
 		}
-		fmt.Printf("\n=== Verbose: Concurrent Assignments ===\n")
+		idx.logf("\n=== Verbose: Concurrent Assignments ===\n")
 		for _, facts := range idx.Facts {
 			for _, ca := range facts.ConcurrentAssignments {
-				fmt.Printf("  %s <= [%s] (kind: %s, line %d)\n", ca.Target, strings.Join(ca.ReadSignals, ", "), ca.Kind, ca.Line)
+				idx.logf("  %s <= [%s] (kind: %s, line %d)\n", ca.Target, strings.Join(ca.ReadSignals, ", "), ca.Kind, ca.Line)
 			}
 		}
-		fmt.Printf("\n=== Verbose: Comparisons (Security Analysis) ===\n")
+		idx.logf("\n=== Verbose: Comparisons (Security Analysis) ===\n")
 		for _, facts := range idx.Facts {
 			for _, comp := range facts.Comparisons {
 				litInfo := ""
 				if comp.IsLiteral {
 					litInfo = fmt.Sprintf(" [LITERAL: %s, %d bits]", comp.LiteralValue, comp.LiteralBits)
 				}
-				fmt.Printf("  %s %s %s%s (line %d, drives: %s)\n", comp.LeftOperand, comp.Operator, comp.RightOperand, litInfo, comp.Line, comp.ResultDrives)
+				idx.logf("  %s %s %s%s (line %d, drives: %s)\n", comp.LeftOperand, comp.Operator, comp.RightOperand, litInfo, comp.Line, comp.ResultDrives)
 			}
 		}
-		fmt.Printf("\n=== Verbose: Arithmetic Ops (Power Analysis) ===\n")
+		idx.logf("\n=== Verbose: Arithmetic Ops (Power Analysis) ===\n")
 		for _, facts := range idx.Facts {
 			for _, op := range facts.ArithmeticOps {
 				guardInfo := "unguarded"
 				if op.IsGuarded {
 					guardInfo = fmt.Sprintf("guarded by %s", op.GuardSignal)
 				}
-				fmt.Printf("  %s: %s (%s, line %d)\n", op.Operator, strings.Join(op.Operands, ", "), guardInfo, op.Line)
+				idx.logf("  %s: %s (%s, line %d)\n", op.Operator, strings.Join(op.Operands, ", "), guardInfo, op.Line)
 			}
 		}
-		fmt.Printf("\n=== Verbose: Signal Dependencies (Loop Detection) ===\n")
+		idx.logf("\n=== Verbose: Signal Dependencies (Loop Detection) ===\n")
 		for _, facts := range idx.Facts {
 			for _, dep := range facts.SignalDeps {
 				seqInfo := "combinational"
 				if dep.IsSequential {
 					seqInfo = "sequential"
 				}
-				fmt.Printf("  %s -> %s (%s, line %d)\n", dep.Source, dep.Target, seqInfo, dep.Line)
+				idx.logf("  %s -> %s (%s, line %d)\n", dep.Source, dep.Target, seqInfo, dep.Line)
 			}
 		}
-		fmt.Printf("\n=== Verbose: Type Declarations ===\n")
+		idx.logf("\n=== Verbose: Type Declarations ===\n")
 		for _, facts := range idx.Facts {
 			for _, t := range facts.Types {
 				scope := t.InPackage
 				if scope == "" {
 					scope = t.InArch
 				}
-				fmt.Printf("  %s.%s: kind=%s line=%d\n", scope, t.Name, t.Kind, t.Line)
+				idx.logf("  %s.%s: kind=%s line=%d\n", scope, t.Name, t.Kind, t.Line)
 				if t.Kind == "enum" && len(t.EnumLiterals) > 0 {
-					fmt.Printf("    literals: %v\n", t.EnumLiterals)
+					idx.logf("    literals: %v\n", t.EnumLiterals)
 				}
 				if t.Kind == "record" && len(t.Fields) > 0 {
-					fmt.Printf("    fields:\n")
+					idx.logf("    fields:\n")
 					for _, f := range t.Fields {
-						fmt.Printf("      %s: %s\n", f.Name, f.Type)
+						idx.logf("      %s: %s\n", f.Name, f.Type)
 					}
 				}
 				if t.Kind == "array" {
@@ -657,11 +870,11 @@ func (idx *Indexer) Run(rootPath string) error {
 					if t.Unconstrained {
 						unc = " (unconstrained)"
 					}
-					fmt.Printf("    element: %s%s\n", t.ElementType, unc)
+					idx.logf("    element: %s%s\n", t.ElementType, unc)
 				}
 			}
 		}
-		fmt.Printf("\n=== Verbose: Subtype Declarations ===\n")
+		idx.logf("\n=== Verbose: Subtype Declarations ===\n")
 		for _, facts := range idx.Facts {
 			for _, st := range facts.Subtypes {
 				scope := st.InPackage
@@ -672,10 +885,10 @@ func (idx *Indexer) Run(rootPath string) error {
 				if st.Constraint != "" {
 					constraint = " " + st.Constraint
 				}
-				fmt.Printf("  %s.%s: %s%s\n", scope, st.Name, st.BaseType, constraint)
+				idx.logf("  %s.%s: %s%s\n", scope, st.Name, st.BaseType, constraint)
 			}
 		}
-		fmt.Printf("\n=== Verbose: Function Declarations ===\n")
+		idx.logf("\n=== Verbose: Function Declarations ===\n")
 		for _, facts := range idx.Facts {
 			for _, fn := range facts.Functions {
 				scope := fn.InPackage
@@ -690,20 +903,20 @@ func (idx *Indexer) Run(rootPath string) error {
 				if fn.HasBody {
 					hasBody = " [body]"
 				}
-				fmt.Printf("  %s.%s: %s returns %s%s\n", scope, fn.Name, purity, fn.ReturnType, hasBody)
+				idx.logf("  %s.%s: %s returns %s%s\n", scope, fn.Name, purity, fn.ReturnType, hasBody)
 				if len(fn.Parameters) > 0 {
-					fmt.Printf("    params:\n")
+					idx.logf("    params:\n")
 					for _, p := range fn.Parameters {
 						dir := p.Direction
 						if dir == "" {
 							dir = "in"
 						}
-						fmt.Printf("      %s: %s %s\n", p.Name, dir, p.Type)
+						idx.logf("      %s: %s %s\n", p.Name, dir, p.Type)
 					}
 				}
 			}
 		}
-		fmt.Printf("\n=== Verbose: Procedure Declarations ===\n")
+		idx.logf("\n=== Verbose: Procedure Declarations ===\n")
 		for _, facts := range idx.Facts {
 			for _, pr := range facts.Procedures {
 				scope := pr.InPackage
@@ -714,20 +927,20 @@ func (idx *Indexer) Run(rootPath string) error {
 				if pr.HasBody {
 					hasBody = " [body]"
 				}
-				fmt.Printf("  %s.%s%s\n", scope, pr.Name, hasBody)
+				idx.logf("  %s.%s%s\n", scope, pr.Name, hasBody)
 				if len(pr.Parameters) > 0 {
-					fmt.Printf("    params:\n")
+					idx.logf("    params:\n")
 					for _, p := range pr.Parameters {
 						dir := p.Direction
 						if dir == "" {
 							dir = "in"
 						}
-						fmt.Printf("      %s: %s %s\n", p.Name, dir, p.Type)
+						idx.logf("      %s: %s %s\n", p.Name, dir, p.Type)
 					}
 				}
 			}
 		}
-		fmt.Printf("\n=== Verbose: Constant Declarations ===\n")
+		idx.logf("\n=== Verbose: Constant Declarations ===\n")
 		for _, facts := range idx.Facts {
 			for _, c := range facts.ConstantDecls {
 				scope := c.InPackage
@@ -738,10 +951,10 @@ func (idx *Indexer) Run(rootPath string) error {
 				if c.Value != "" {
 					value = fmt.Sprintf(" := %s", c.Value)
 				}
-				fmt.Printf("  %s.%s: %s%s\n", scope, c.Name, c.Type, value)
+				idx.logf("  %s.%s: %s%s\n", scope, c.Name, c.Type, value)
 			}
 		}
-		fmt.Printf("\n=== Verbose: Generate Statements ===\n")
+		idx.logf("\n=== Verbose: Generate Statements ===\n")
 		for _, facts := range idx.Facts {
 			for _, gen := range facts.Generates {
 				switch gen.Kind {
@@ -750,24 +963,32 @@ func (idx *Indexer) Run(rootPath string) error {
 					if gen.CanElaborate {
 						elaboration = fmt.Sprintf("%d iterations", gen.IterationCount)
 					}
-					fmt.Printf("  %s: for %s in %s %s %s (%s)\n",
+					idx.logf("  %s: for %s in %s %s %s (%s)\n",
 						gen.Label, gen.LoopVar, gen.RangeLow, gen.RangeDir, gen.RangeHigh, elaboration)
 				case "if":
-					fmt.Printf("  %s: if %s\n", gen.Label, gen.Condition)
+					idx.logf("  %s: if %s\n", gen.Label, gen.Condition)
 				case "case":
-					fmt.Printf("  %s: case %s\n", gen.Label, gen.Condition)
+					idx.logf("  %s: case %s\n", gen.Label, gen.Condition)
 				default:
-					fmt.Printf("  %s: %s\n", gen.Label, gen.Kind)
+					idx.logf("  %s: %s\n", gen.Label, gen.Kind)
 				}
 				if len(gen.Signals) > 0 || len(gen.Instances) > 0 || len(gen.Processes) > 0 {
-					fmt.Printf("    contains: %d signals, %d instances, %d processes\n",
+					idx.logf("    contains: %d signals, %d instances, %d processes\n",
 						len(gen.Signals), len(gen.Instances), len(gen.Processes))
 				}
 			}
 		}
+		idx.logf("\n=== Verbose: Instance Generates ===\n")
+		for _, ig := range idx.elaborateInstanceGenerates() {
+			elaboration := "cannot elaborate"
+			if ig.IterationCount >= 0 {
+				elaboration = fmt.Sprintf("%d iterations", ig.IterationCount)
+			}
+			idx.logf("  %s (%s(%s)): %s (%s)\n", ig.Instance, ig.Entity, ig.Architecture, ig.Generate, elaboration)
+		}
 
 		// CDC crossings
-		fmt.Printf("\n=== Verbose: CDC Crossings ===\n")
+		idx.logf("\n=== Verbose: CDC Crossings ===\n")
 		for _, facts := range idx.Facts {
 			for _, cdc := range facts.CDCCrossings {
 				syncStatus := "unsynchronized"
@@ -778,7 +999,7 @@ func (idx *Indexer) Run(rootPath string) error {
 				if cdc.IsMultiBit {
 					bitWidth = "multi-bit"
 				}
-				fmt.Printf("  %s: %s -> %s (%s, %s) [%s]\n",
+				idx.logf("  %s: %s -> %s (%s, %s) [%s]\n",
 					cdc.Signal, cdc.SourceClock, cdc.DestClock, bitWidth, syncStatus,
 					fmt.Sprintf("%s writes, %s reads", cdc.SourceProc, cdc.DestProc))
 			}
@@ -832,37 +1053,40 @@ func (idx *Indexer) Run(rootPath string) error {
 	if err := validateVerificationTags(v, &policyInput); err != nil {
 		return fmt.Errorf("CRITICAL: Failed to validate verification tags: %w", err)
 	}
-	if err := v.Validate(policyInput); err != nil {
+	// Streaming mode spools the input to NDJSON and validates it table by
+	// table instead of encoding and unifying one document
+	streamPath := ""
+	if envBool("VHDL_POLICY_INPUT_NDJSON") {
+		path, err := writePolicyInputNDJSON(&policyInput, v)
+		if err != nil {
+			return fmt.Errorf("CRITICAL: Data contract violation (Go -> policy engine mismatch): %w", err)
+		}
+		streamPath = path
+		defer os.Remove(streamPath)
+	} else if err := v.Validate(policyInput); err != nil {
 		return fmt.Errorf("CRITICAL: Data contract violation (Go -> policy engine mismatch): %w", err)
 	}
 	validateDuration := time.Since(stepStart)
 	timing.RecordStage("validate", stepStart, validateDuration, "")
 
 	// 6. Run policy evaluation and build result
-	stepStart = time.Now()
-	lintResult := LintResult{
-		Violations:  []policy.Violation{},
-		ParseErrors: []ParseError{},
-		Stats: ExtractionStats{
-			Files:     len(files),
-			Symbols:   idx.Symbols.Len(),
-			Entities:  len(policyInput.Entities),
-			Packages:  len(policyInput.Packages),
-			Signals:   len(policyInput.Signals),
-			Ports:     len(policyInput.Ports),
-			Processes: len(policyInput.Processes),
-			Instances: len(policyInput.Instances),
-			Generates: len(policyInput.Generates),
-		},
-		Files: []FileResult{},
+	var baseline map[BaselineEntry]int
+	if idx.BaselinePath != "" {
+		if baseline, err = LoadBaseline(idx.BaselinePath); err != nil {
+			return fmt.Errorf("load baseline: %w", err)
+		}
 	}
-
-	// Add parse errors
-	for _, e := range errs {
-		lintResult.ParseErrors = append(lintResult.ParseErrors, ParseError{
-			File:    "",
-			Message: e.Error(),
-		})
+	var diff DiffRanges
+	if idx.DiffSpec != "" {
+		if diff, err = LoadDiff(idx.DiffSpec, rootPath); err != nil {
+			return fmt.Errorf("load diff: %w", err)
+		}
+	}
+	stepStart = time.Now()
+	lintResult := idx.newLintResult(len(files), &policyInput, errs)
+	if len(timedOutList) > 0 {
+		lintResult.Truncated = true
+		lintResult.TimedOutFiles = timedOutList
 	}
 
 	policyCached := false
@@ -876,7 +1100,7 @@ func (idx *Indexer) Run(rootPath string) error {
 		if result, usedDelta, err := runPolicyDaemon(cacheDir, cache != nil, factTables, changedFiles); err != nil {
 			recordPipelineErr(fmt.Errorf("policy daemon failed: %w", err))
 		} else {
-			applyPolicyResult(&lintResult, result)
+			applyPolicyResult(&lintResult, result, baseline, idx.Config.Lint.SeverityOverrides)
 			policyUsedDaemon = true
 			policyDelta = usedDelta
 		}
@@ -894,7 +1118,7 @@ func (idx *Indexer) Run(rootPath string) error {
 		if entry, err := loadPolicyCache(cacheDir); err != nil {
 			recordPipelineErr(fmt.Errorf("policy cache load failed: %w", err))
 		} else if ok, err := policyCacheValid(entry, policyInput, factFiles); err == nil && ok {
-			applyPolicyResult(&lintResult, &entry.Result)
+			applyPolicyResult(&lintResult, &entry.Result, baseline, idx.Config.Lint.SeverityOverrides)
 			policyCached = true
 		} else if err != nil {
 			recordPipelineErr(fmt.Errorf("policy cache disabled: %w", err))
@@ -906,11 +1130,21 @@ func (idx *Indexer) Run(rootPath string) error {
 		if err != nil {
 			return fmt.Errorf("initialize policy engine: %w", err)
 		}
-		result, err := policyEngine.Evaluate(policyInput)
+		if timing.Enabled() {
+			policyEngine.OnRuleTiming(func(rt policy.RuleTiming) {
+				timing.RecordRule(rt.Rule, rt.Duration)
+			})
+		}
+		var result *policy.Result
+		if streamPath != "" {
+			result, err = policyEngine.EvaluateNDJSON(streamPath)
+		} else {
+			result, err = policyEngine.Evaluate(policyInput)
+		}
 		if err != nil {
 			return fmt.Errorf("policy evaluation failed: %w", err)
 		}
-		applyPolicyResult(&lintResult, result)
+		applyPolicyResult(&lintResult, result, baseline, idx.Config.Lint.SeverityOverrides)
 		if cache != nil && cacheHash != "" {
 			if err := savePolicyCache(cacheDir, policyCacheEntry{
 				Version:    policyCacheVersion,
@@ -929,7 +1163,92 @@ func (idx *Indexer) Run(rootPath string) error {
 		}
 	}
 
-	// Output results
+	// Apply per-library rule overrides
+	applyLibraryRules(&lintResult, policyInput.Files)
+	// Keep only the rules selected with --rules/--skip-rules
+	applyRuleFilter(&lintResult, idx.OnlyRules, idx.SkipRules)
+	// Drop violations silenced by inline suppression comments
+	applySuppressions(&lintResult, idx.Facts)
+	// Keep only violations on lines added by --diff
+	applyDiffFilter(&lintResult, diff)
+	// Keep only violations in the file named by --filename
+	applyReportFileFilter(&lintResult, idx.ReportFile)
+
+	idx.Summary = lintResult.Summary
+	idx.Result = lintResult
+	if !idx.DeferOutput {
+		if err := idx.writeResult(&lintResult, idx.analyzedFiles(), rootPath); err != nil {
+			return err
+		}
+		if err := idx.writeManifest(idx.Manifest); err != nil {
+			return err
+		}
+	}
+	policyDuration := time.Since(stepStart)
+	policyStatus := ""
+	if policyUsedDaemon {
+		if policyDelta {
+			policyStatus = "daemon_delta"
+		} else {
+			policyStatus = "daemon_init"
+		}
+	} else if policyCached {
+		policyStatus = "cached"
+	}
+	timing.RecordStage("policy", stepStart, policyDuration, policyStatus)
+
+	if (idx.Verbose || idx.Progress || idx.Trace) && !idx.JSONOutput {
+		idx.logf("\n=== Timing Summary ===\n")
+		idx.logf("  scan:        %s\n", formatDuration(scanDuration))
+		idx.logf("  extract:     %s\n", formatDuration(extractDuration))
+		idx.logf("  elaborate:   %s\n", formatDuration(elabDuration))
+		if factsValidateDuration > 0 {
+			idx.logf("  facts:       %s\n", formatDuration(factsValidateDuration))
+		}
+		idx.logf("  resolve:     %s\n", formatDuration(resolveDuration))
+		idx.logf("  build input: %s\n", formatDuration(buildDuration))
+		idx.logf("  validate:    %s\n", formatDuration(validateDuration))
+		if policyUsedDaemon {
+			label := "daemon (init)"
+			if policyDelta {
+				label = "daemon (delta)"
+			}
+			idx.logf("  policy:      %s (%s)\n", label, formatDuration(policyDuration))
+		} else if policyCached {
+			idx.logf("  policy:      cached (%s)\n", formatDuration(policyDuration))
+		} else {
+			idx.logf("  policy:      %s\n", formatDuration(policyDuration))
+		}
+		idx.logf("  total:       %s\n", formatDuration(time.Since(runStart)))
+	}
+	timing.RecordStage("total", runStart, time.Since(runStart), "")
+
+	if len(pipelineErrs) > 0 {
+		return fmt.Errorf("pipeline errors:\n%s", formatPipelineErrors(pipelineErrs))
+	}
+	return nil
+}
+
+// logf prints diagnostic text (banners, progress, summaries, timing) to
+// stderr, keeping stdout for results. Quiet runs print none of it.
+func (idx *Indexer) logf(format string, args ...any) {
+	if idx.Quiet {
+		return
+	}
+	fmt.Fprintf(os.Stderr, format, args...)
+}
+
+// writeResult writes the requested baseline snapshot, prints lintResult as
+// JSON or text, and writes the requested SARIF, JUnit, CSV, and per-file
+// reports. analyzed lists every file linted (JUnit and the per-file reports
+// cover clean files too); rootPath anchors SARIF URIs and report paths.
+func (idx *Indexer) writeResult(lintResult *LintResult, analyzed []string, rootPath string) error {
+	if idx.WriteBaselinePath != "" {
+		if err := WriteBaselineFile(idx.WriteBaselinePath, lintResult.Violations); err != nil {
+			return fmt.Errorf("failed to write baseline: %w", err)
+		}
+	}
+
 	if idx.JSONOutput {
 		// JSON output mode
 		enc := json.NewEncoder(os.Stdout)
@@ -938,10 +1257,15 @@ func (idx *Indexer) Run(rootPath string) error {
 			return fmt.Errorf("failed to encode JSON output: %w", err)
 		}
 	} else {
-		// Text output mode (original behavior)
+		// Text output mode: violations on stdout, banners and summaries on
+		// stderr so the output can be piped
 		if len(lintResult.Violations) > 0 {
-			fmt.Printf("\n=== Policy Violations ===\n")
+			idx.logf("\n=== Policy Violations ===\n")
 			for _, v := range lintResult.Violations {
+				if idx.Quiet {
+					fmt.Printf("%s:%d: [%s] %s: %s\n", v.File, v.Line, v.Rule, v.Severity, v.Message)
+					continue
+				}
 				icon := "ℹ"
 				if v.Severity == "error" {
 					icon = "✗"
@@ -952,69 +1276,137 @@ func (idx *Indexer) Run(rootPath string) error {
 			}
 		}
 
-		fmt.Printf("\n=== Policy Summary ===\n")
-		fmt.Printf("  Errors:   %d\n", lintResult.Summary.Errors)
-		fmt.Printf("  Warnings: %d\n", lintResult.Summary.Warnings)
-		fmt.Printf("  Info:     %d\n", lintResult.Summary.Info)
+		idx.logf("\n=== Policy Summary ===\n")
+		idx.logf("  Errors:   %d\n", lintResult.Summary.Errors)
+		idx.logf("  Warnings: %d\n", lintResult.Summary.Warnings)
+		idx.logf("  Info:     %d\n", lintResult.Summary.Info)
+		if lintResult.Summary.Suppressed > 0 {
+			idx.logf("  Suppressed: %d\n", lintResult.Summary.Suppressed)
+		}
+		if lintResult.Summary.Baselined > 0 {
+			idx.logf("  Baselined: %d\n", lintResult.Summary.Baselined)
+		}
+		if lintResult.Summary.OutsideDiff > 0 {
+			idx.logf("  Outside diff: %d\n", lintResult.Summary.OutsideDiff)
+		}
+
+		idx.logf("\n=== Extraction Summary ===\n")
+		idx.logf("  Files:    %d\n", lintResult.Stats.Files)
+		idx.logf("  Symbols:  %d\n", lintResult.Stats.Symbols)
+		idx.logf("  Entities: %d\n", lintResult.Stats.Entities)
+		idx.logf("  Packages: %d\n", lintResult.Stats.Packages)
+		idx.logf("  Signals:  %d\n", lintResult.Stats.Signals)
+		idx.logf("  Ports:    %d\n", lintResult.Stats.Ports)
 
-		fmt.Printf("\n=== Extraction Summary ===\n")
-		fmt.Printf("  Files:    %d\n", lintResult.Stats.Files)
-		fmt.Printf("  Symbols:  %d\n", lintResult.Stats.Symbols)
-		fmt.Printf("  Entities: %d\n", lintResult.Stats.Entities)
-		fmt.Printf("  Packages: %d\n", lintResult.Stats.Packages)
-		fmt.Printf("  Signals:  %d\n", lintResult.Stats.Signals)
-		fmt.Printf("  Ports:    %d\n", lintResult.Stats.Ports)
+		if len(lintResult.Roots) > 0 {
+			idx.logf("\n=== Roots ===\n")
+			for _, r := range lintResult.Roots {
+				idx.logf("  %s: %d files, %d errors, %d warnings, %d info\n",
+					r.Root, r.Stats.Files, r.Summary.Errors, r.Summary.Warnings, r.Summary.Info)
+			}
+		}
 
 		if len(lintResult.ParseErrors) > 0 {
-			fmt.Printf("\n=== Parse Errors ===\n")
+			idx.logf("\n=== Parse Errors ===\n")
 			for _, e := range lintResult.ParseErrors {
-				fmt.Printf("  %s\n", e.Message)
+				idx.logf("  %s\n", e.Message)
+			}
+		}
+
+		if lintResult.Truncated {
+			idx.logf("\n=== Timed Out (not analyzed) ===\n")
+			for _, f := range lintResult.TimedOutFiles {
+				idx.logf("  %s\n", f)
 			}
 		}
 	}
-	policyDuration := time.Since(stepStart)
-	policyStatus := ""
-	if policyUsedDaemon {
-		if policyDelta {
-			policyStatus = "daemon_delta"
-		} else {
-			policyStatus = "daemon_init"
+	if idx.SARIFPath != "" {
+		if err := WriteSARIFFile(idx.SARIFPath, lintResult.Violations, rootPath); err != nil {
+			return fmt.Errorf("failed to write SARIF output: %w", err)
 		}
-	} else if policyCached {
-		policyStatus = "cached"
 	}
-	timing.RecordStage("policy", stepStart, policyDuration, policyStatus)
+	if idx.JUnitPath != "" {
+		if err := WriteJUnitFile(idx.JUnitPath, analyzed, lintResult.Violations); err != nil {
+			return fmt.Errorf("failed to write JUnit output: %w", err)
+		}
+	}
+	if idx.CSVPath != "" {
+		if err := WriteCSVFile(idx.CSVPath, lintResult.Violations); err != nil {
+			return fmt.Errorf("failed to write CSV output: %w", err)
+		}
+	}
+	if idx.OutputDir != "" {
+		if err := WriteFileReports(idx.OutputDir, analyzed, lintResult.Violations, rootPath); err != nil {
+			return fmt.Errorf("failed to write per-file reports: %w", err)
+		}
+	}
+	return nil
+}
 
-	if (idx.Verbose || idx.Progress || idx.Trace) && !idx.JSONOutput {
-		fmt.Printf("\n=== Timing Summary ===\n")
-		fmt.Printf("  scan:        %s\n", formatDuration(scanDuration))
-		fmt.Printf("  extract:     %s\n", formatDuration(extractDuration))
-		fmt.Printf("  elaborate:   %s\n", formatDuration(elabDuration))
-		if factsValidateDuration > 0 {
-			fmt.Printf("  facts:       %s\n", formatDuration(factsValidateDuration))
+// analyzedFiles lists the files of the last extraction.
+func (idx *Indexer) analyzedFiles() []string {
+	analyzed := make([]string, 0, len(idx.Facts))
+	for _, facts := range idx.Facts {
+		analyzed = append(analyzed, facts.File)
+	}
+	return analyzed
+}
+
+// discoverFiles performs the front half of Run: library resolution (or a plain
+// directory scan when no libraries are configured) followed by ignore filtering.
+// It records library attribution in idx.FileLibraries and idx.ThirdPartyFiles.
+func (idx *Indexer) discoverFiles(rootPath string) ([]string, []config.ResolvedLibrary, error) {
+	var files []string
+	var libs []config.ResolvedLibrary
+
+	// Check if config has library definitions or explicit file entries
+	if len(idx.Config.Libraries) > 0 || len(idx.Config.Files) > 0 {
+		resolved, err := idx.Config.ResolveLibraries(rootPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("resolve libraries: %w", err)
 		}
-		fmt.Printf("  resolve:     %s\n", formatDuration(resolveDuration))
-		fmt.Printf("  build input: %s\n", formatDuration(buildDuration))
-		fmt.Printf("  validate:    %s\n", formatDuration(validateDuration))
-		if policyUsedDaemon {
-			label := "daemon (init)"
-			if policyDelta {
-				label = "daemon (delta)"
+		libs = resolved
+
+		// Collect all files and track library info
+		fileSet := make(map[string]bool)
+		for _, lib := range libs {
+			for _, f := range lib.Files {
+				if !fileSet[f] {
+					fileSet[f] = true
+					files = append(files, f)
+
+					// Track library info for each file
+					idx.FileLibraries[f] = config.FileLibraryInfo{
+						LibraryName:  lib.Name,
+						IsThirdParty: lib.IsThirdParty,
+					}
+
+					// Track third-party files
+					if lib.IsThirdParty {
+						idx.ThirdPartyFiles[f] = true
+					}
+				}
 			}
-			fmt.Printf("  policy:      %s (%s)\n", label, formatDuration(policyDuration))
-		} else if policyCached {
-			fmt.Printf("  policy:      cached (%s)\n", formatDuration(policyDuration))
-		} else {
-			fmt.Printf("  policy:      %s\n", formatDuration(policyDuration))
 		}
-		fmt.Printf("  total:       %s\n", formatDuration(time.Since(runStart)))
 	}
-	timing.RecordStage("total", runStart, time.Since(runStart), "")
 
-	if len(pipelineErrs) > 0 {
-		return fmt.Errorf("pipeline errors:\n%s", formatPipelineErrors(pipelineErrs))
+	// Fallback to directory scan if no files from config
+	if len(files) == 0 {
+		scanned, err := idx.findVHDLFiles(rootPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("scanning files: %w", err)
+		}
+		files = scanned
 	}
-	return nil
+
+	// Filter out ignored files
+	var filteredFiles []string
+	for _, f := range files {
+		if !idx.Config.ShouldIgnoreFile(f) {
+			filteredFiles = append(filteredFiles, f)
+		}
+	}
+	return filteredFiles, libs, nil
 }
 
 func formatPipelineErrors(errs []error) string {
@@ -1029,7 +1421,11 @@ func formatPipelineErrors(errs []error) string {
 	return b.String()
 }
 
-func applyPolicyResult(lintResult *LintResult, result *policy.Result) {
+// applyPolicyResult copies a policy result into lintResult, rewriting the
+// severity of rules named in overrides (lint.severityOverrides; nil keeps
+// the engine's) and dropping violations whose fingerprint is in baseline
+// (nil keeps everything).
+func applyPolicyResult(lintResult *LintResult, result *policy.Result, baseline map[BaselineEntry]int, overrides map[string]string) {
 	if lintResult == nil || result == nil {
 		return
 	}
@@ -1043,8 +1439,45 @@ func applyPolicyResult(lintResult *LintResult, result *policy.Result) {
 		Info:            result.Summary.Info,
 	}
 
+	if len(overrides) > 0 {
+		// Copy first: the result may be a cached entry that is saved again
+		remapped := make([]policy.Violation, len(result.Violations))
+		for i, v := range result.Violations {
+			if sev, ok := overrides[v.Rule]; ok && sev != v.Severity {
+				lintResult.Summary.countSeverity(v.Severity, -1)
+				lintResult.Summary.countSeverity(sev, 1)
+				v.Severity = sev
+			}
+			remapped[i] = v
+		}
+		lintResult.Violations = remapped
+	}
+
+	if len(baseline) > 0 {
+		// Each entry absorbs one violation, as in diffViolations; count
+		// down a copy since the baseline may be reused
+		remaining := make(map[BaselineEntry]int, len(baseline))
+		for e, n := range baseline {
+			remaining[e] = n
+		}
+		// Overridden severities are already in lintResult.Violations
+		kept := make([]policy.Violation, 0, len(lintResult.Violations))
+		for _, v := range lintResult.Violations {
+			key := baselineEntryFor(v)
+			if remaining[key] == 0 {
+				kept = append(kept, v)
+				continue
+			}
+			remaining[key]--
+			lintResult.Summary.Baselined++
+			lintResult.Summary.TotalViolations--
+			lintResult.Summary.countSeverity(v.Severity, -1)
+		}
+		lintResult.Violations = kept
+	}
+
 	fileViolations := make(map[string]*FileResult)
-	for _, v := range result.Violations {
+	for _, v := range lintResult.Violations {
 		fr, ok := fileViolations[v.File]
 		if !ok {
 			fr = &FileResult{Path: v.File}
@@ -1058,7 +1491,10 @@ func applyPolicyResult(lintResult *LintResult, result *policy.Result) {
 		case "info":
 			fr.Info++
 		}
+		fr.countRule(v.Rule, 1)
+		lintResult.Summary.countRule(v.Rule, 1)
 	}
+	lintResult.Summary.rankRules()
 	lintResult.Files = lintResult.Files[:0]
 	for _, fr := range fileViolations {
 		lintResult.Files = append(lintResult.Files, *fr)
@@ -1108,6 +1544,81 @@ func sortedFactFiles(tables facts.Tables) []string {
 	return files
 }
 
+// elaborateGenerates resolves for-generate iteration counts in every file
+// using the global constants and each file's signal and port widths. It
+// returns the number of generates elaborated and the constants available.
+func (idx *Indexer) elaborateGenerates() (int, int) {
+	globalConstants := idx.globalConstants()
+	types := idx.buildTypeWidths(globalConstants)
+	elaborated := 0
+	for i := range idx.Facts {
+		signalWidths := fileSignalWidths(idx.Facts[i], types)
+		elaborated += extractor.ElaborateGenerates(idx.Facts[i].Generates, globalConstants, signalWidths)
+		// Process for-loop bounds resolve the same way
+		extractor.ElaborateLoops(idx.Facts[i].Loops, globalConstants, signalWidths)
+	}
+	return elaborated, len(globalConstants)
+}
+
+// fileSignalWidths maps each signal and port of a file (lowercase) to its
+// width, which resolves data'range and data'length bounds.
+func fileSignalWidths(facts extractor.FileFacts, types *typeWidths) map[string]int {
+	signalWidths := make(map[string]int)
+	for _, sig := range facts.Signals {
+		signalWidths[strings.ToLower(sig.Name)] = types.width(sig.Type)
+	}
+	for _, p := range facts.Ports {
+		signalWidths[strings.ToLower(p.Name)] = types.width(p.Type)
+	}
+	return signalWidths
+}
+
+// newLintResult starts an empty LintResult with extraction statistics and
+// the extraction errors reported as parse errors.
+func (idx *Indexer) newLintResult(fileCount int, policyInput *policy.Input, errs []error) LintResult {
+	lintResult := LintResult{
+		SchemaVersion: ResultSchemaVersion,
+		Violations:    []policy.Violation{},
+		ParseErrors:   []ParseError{},
+		Stats: ExtractionStats{
+			Files:     fileCount,
+			Symbols:   idx.Symbols.Len(),
+			Entities:  len(policyInput.Entities),
+			Packages:  len(policyInput.Packages),
+			Signals:   len(policyInput.Signals),
+			Ports:     len(policyInput.Ports),
+			Processes: len(policyInput.Processes),
+			Instances: len(policyInput.Instances),
+			Generates: len(policyInput.Generates),
+		},
+		Files: []FileResult{},
+	}
+	for _, e := range errs {
+		parseErr := ParseError{
+			File:    "",
+			Message: e.Error(),
+		}
+		var limitErr *extractor.ParseErrorLimitError
+		if errors.As(e, &limitErr) {
+			parseErr.ErrorNodes = limitErr.ErrorNodes
+		}
+		lintResult.ParseErrors = append(lintResult.ParseErrors, parseErr)
+	}
+	return lintResult
+}
+
+// globalConstants merges the integer constants of every file into one map
+// (lowercase name -> value) for generate elaboration and width calculation.
+func (idx *Indexer) globalConstants() map[string]int {
+	constants := make(map[string]int)
+	for _, facts := range idx.Facts {
+		for k, v := range extractor.BuildConstantMap(facts.ConstantDecls) {
+			constants[k] = v
+		}
+	}
+	return constants
+}
+
 // buildPolicyInput converts extracted facts to the policy engine input format
 func (idx *Indexer) buildPolicyInput() policy.Input {
 	// Initialize all slices to empty (not nil) to ensure JSON serialization
@@ -1155,9 +1666,59 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 		SignalDeps:    []policy.SignalDep{},
 		CDCCrossings:  []policy.CDCCrossing{},
 		SignalUsages:  []policy.SignalUsage{},
+		// Analysis findings
+		OverlappingChoices:          []policy.OverlappingChoice{},
+		InconsistentResetPolarities: []policy.InconsistentResetPolarity{},
+		ConstantsInSensitivityList:  []policy.ConstantInSensitivityList{},
+		MagicNumbers:                []policy.MagicNumber{},
+		PortsShadowedBySignal:       []policy.PortShadowedBySignal{},
+		ClockLevelConditions:        []policy.ClockLevelCondition{},
+		NoEffectProcesses:           []policy.NoEffectProcess{},
+		CombinationalMultiDrivers:   []policy.CombinationalMultiDriver{},
+		MixedSliceDirections:        []policy.MixedSliceDirection{},
+		UnusedComponentDeclarations: []policy.UnusedComponentDeclaration{},
+		PermanentHighZs:             []policy.PermanentHighZ{},
+		Assertions:                  []policy.Assertion{},
+		Aliases:                     []policy.AliasDeclaration{},
+		CombinationalLoops:          []policy.CombinationalLoop{},
+		MultiDrivers:                []policy.MultiDriver{},
+		UnboundGenerics:             []policy.UnboundGeneric{},
+		UnusedSignals:               []policy.UnusedSignal{},
+		WidthMismatches:             []policy.WidthMismatch{},
+		ClockMisuses:                []policy.ClockMisuse{},
+		ResetCrossings:              []policy.ResetCrossing{},
+		ConditionalLatches:          []policy.LatchInference{},
+		ImportedNames:               []policy.ImportedName{},
+		Loops:                       []policy.LoopStatement{},
+		ReadsBeforeWrite:            []policy.ReadBeforeWrite{},
+		AttributeSpecs:              []policy.AttributeSpec{},
+		TristateAssignments:         []policy.TristateAssignment{},
+		FSMIssues:                   []policy.FSMIssue{},
+		GatedClocks:                 []policy.GatedClock{},
+		UninitializedReads:          []policy.UninitRead{},
+		Blocks:                      []policy.BlockStatement{},
+		OrphanArchitectures:         []policy.OrphanArchitecture{},
+		DuplicateSymbols:            []policy.DuplicateSymbol{},
+		WidthTruncations:            []policy.WidthTruncation{},
+		CaseChoiceConflicts:         []policy.CaseChoiceConflict{},
+		Feedthroughs:                []policy.Feedthrough{},
+		ResetDefaultMismatches:      []policy.ResetDefaultMismatch{},
+		MixedSensitivityIssues:      []policy.MixedSensitivity{},
+		FSMEncodings:                []policy.FSMEncoding{},
+		ComponentMismatches:         []policy.ComponentMismatch{},
+		StandardShadowings:          []policy.StandardShadowing{},
+		SuspiciousClocks:            []policy.SuspiciousClock{},
+		UnprotectedSharedVariables:  []policy.UnprotectedSharedVariable{},
+		UnsafeArithmetic:            []policy.UnsafeArithmetic{},
+		InstanceGenerates:           []policy.InstanceGenerate{},
+		SensitivityListSmells:       []policy.SensitivityListSmell{},
+		IncompleteRecordAggregates:  []policy.IncompleteRecordAggregate{},
 		// Configuration
 		LintConfig: policy.LintRuleConfig{
-			Rules: idx.Config.Lint.Rules,
+			Rules:             idx.Config.Lint.Rules,
+			Only:              idx.OnlyRules,
+			Skip:              idx.SkipRules,
+			TrojanLiteralBits: idx.Config.Lint.TrojanLiteralBits,
 		},
 		ThirdPartyFiles: []string{},
 	}
@@ -1179,12 +1740,18 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 			lib = strings.ToLower(info.LibraryName)
 		}
 		input.Files = append(input.Files, policy.FileInfo{
-			Path:         file,
-			Library:      lib,
-			IsThirdParty: idx.ThirdPartyFiles[file],
+			Path:          file,
+			Library:       lib,
+			IsThirdParty:  idx.ThirdPartyFiles[file],
+			RuleOverrides: idx.Config.LibraryRuleOverrides(lib),
 		})
 	}
 
+	// Constants resolve parameterized widths like std_logic_vector(WIDTH-1 downto 0),
+	// and user array types and subtypes resolve by name
+	constants := idx.globalConstants()
+	types := idx.buildTypeWidths(constants)
+
 	// Aggregate facts from all files
 	for _, facts := range idx.Facts {
 		for _, e := range facts.Entities {
@@ -1200,7 +1767,8 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 						Default:   p.Default,
 						Line:      p.Line,
 						InEntity:  p.InEntity,
-						Width:     extractor.CalculateWidth(p.Type),
+						Width:     types.width(p.Type),
+						Doc:       p.Doc,
 					})
 				}
 			}
@@ -1278,7 +1846,7 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 					Type:      p.Type,
 					Line:      p.Line,
 					InEntity:  p.InEntity,
-					Width:     extractor.CalculateWidth(p.Type),
+					Width:     types.width(p.Type),
 				})
 			}
 			for _, g := range c.Generics {
@@ -1310,12 +1878,15 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 				continue
 			}
 			input.Signals = append(input.Signals, policy.Signal{
-				Name:     s.Name,
-				Type:     s.Type,
-				File:     facts.File,
-				Line:     s.Line,
-				InEntity: s.InEntity,
-				Width:    extractor.CalculateWidth(s.Type),
+				Name:             s.Name,
+				Type:             s.Type,
+				File:             facts.File,
+				Line:             s.Line,
+				InEntity:         s.InEntity,
+				Width:            types.width(s.Type),
+				InSynthOffRegion: s.InSynthOffRegion,
+				Doc:              s.Doc,
+				Default:          s.Default,
 			})
 		}
 
@@ -1326,7 +1897,8 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 				Type:      p.Type,
 				Line:      p.Line,
 				InEntity:  p.InEntity,
-				Width:     extractor.CalculateWidth(p.Type),
+				Width:     types.width(p.Type),
+				Doc:       p.Doc,
 			})
 		}
 
@@ -1391,7 +1963,8 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 			}
 			input.Instances = append(input.Instances, policy.Instance{
 				Name:         inst.Name,
-				Target:       inst.Target,
+				Target:       resolveWorkPrefix(inst.Target, idx.FileLibrary(facts.File)),
+				RawTarget:    inst.Target,
 				PortMap:      portMap,
 				GenericMap:   genericMap,
 				Associations: associations,
@@ -1436,9 +2009,10 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 			vars := []policy.VariableDecl{}
 			for _, v := range proc.Variables {
 				vars = append(vars, policy.VariableDecl{
-					Name: v.Name,
-					Type: v.Type,
-					Line: v.Line,
+					Name:    v.Name,
+					Type:    v.Type,
+					Line:    v.Line,
+					Default: v.Default,
 				})
 			}
 			procCalls := []policy.ProcedureCall{}
@@ -1484,24 +2058,27 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 				})
 			}
 			input.Processes = append(input.Processes, policy.Process{
-				Label:           proc.Label,
-				SensitivityList: sensList,
-				IsSequential:    proc.IsSequential,
-				IsCombinational: proc.IsCombinational,
-				ClockSignal:     proc.ClockSignal,
-				ClockEdge:       proc.ClockEdge,
-				HasReset:        proc.HasReset,
-				ResetSignal:     proc.ResetSignal,
-				ResetAsync:      proc.ResetAsync,
-				AssignedSignals: assigned,
-				ReadSignals:     read,
-				Variables:       vars,
-				ProcedureCalls:  procCalls,
-				FunctionCalls:   funcCalls,
-				WaitStatements:  waitStmts,
-				File:            facts.File,
-				Line:            proc.Line,
-				InArch:          proc.InArch,
+				Label:            proc.Label,
+				SensitivityList:  sensList,
+				IsSequential:     proc.IsSequential,
+				IsCombinational:  proc.IsCombinational,
+				IsStimulus:       proc.IsStimulus,
+				ClockSignal:      proc.ClockSignal,
+				ClockEdge:        proc.ClockEdge,
+				HasReset:         proc.HasReset,
+				ResetSignal:      proc.ResetSignal,
+				ResetAsync:       proc.ResetAsync,
+				ResetPolarity:    proc.ResetPolarity,
+				AssignedSignals:  assigned,
+				ReadSignals:      read,
+				Variables:        vars,
+				ProcedureCalls:   procCalls,
+				FunctionCalls:    funcCalls,
+				WaitStatements:   waitStmts,
+				File:             facts.File,
+				Line:             proc.Line,
+				InArch:           proc.InArch,
+				InSynthOffRegion: proc.InSynthOffRegion,
 			})
 		}
 
@@ -1515,13 +2092,20 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 			if readSigs == nil {
 				readSigs = []string{}
 			}
+			choices := ca.Choices
+			if choices == nil {
+				choices = []string{}
+			}
 			input.ConcurrentAssignments = append(input.ConcurrentAssignments, policy.ConcurrentAssignment{
-				Target:      ca.Target,
-				ReadSignals: readSigs,
-				File:        facts.File,
-				Line:        ca.Line,
-				InArch:      ca.InArch,
-				Kind:        ca.Kind,
+				Target:           ca.Target,
+				ReadSignals:      readSigs,
+				File:             facts.File,
+				Line:             ca.Line,
+				InArch:           ca.InArch,
+				Kind:             ca.Kind,
+				InSynthOffRegion: ca.InSynthOffRegion,
+				Choices:          choices,
+				HasOthers:        ca.HasOthers,
 			})
 		}
 
@@ -1592,10 +2176,424 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 			})
 		}
 
+		// Overlapping choices: duplicate or intersecting case choices
+		for _, oc := range facts.OverlappingChoices {
+			input.OverlappingChoices = append(input.OverlappingChoices, policy.OverlappingChoice{
+				Kind:        oc.Kind,
+				Expression:  oc.Expression,
+				First:       oc.First,
+				Second:      oc.Second,
+				IsDuplicate: oc.IsDuplicate,
+				File:        facts.File,
+				Line:        oc.Line,
+				InProcess:   oc.InProcess,
+				InArch:      oc.InArch,
+			})
+		}
+
+		// if/elsif chains: unreachable branches and parallel comparisons
+		for _, cc := range facts.CaseChoiceConflicts {
+			input.CaseChoiceConflicts = append(input.CaseChoiceConflicts, policy.CaseChoiceConflict{
+				Kind:       cc.Kind,
+				Expression: cc.Expression,
+				Choices:    cc.Choices,
+				First:      cc.First,
+				Second:     cc.Second,
+				File:       facts.File,
+				Line:       cc.Line,
+				InProcess:  cc.InProcess,
+				InArch:     cc.InArch,
+			})
+		}
+
+		// Constants/generics named in sensitivity lists
+		for _, cs := range facts.ConstantsInSensitivityList {
+			input.ConstantsInSensitivityList = append(input.ConstantsInSensitivityList, policy.ConstantInSensitivityList{
+				Name:     cs.Name,
+				Kind:     cs.Kind,
+				DeclLine: cs.DeclLine,
+				Process:  cs.Process,
+				File:     facts.File,
+				Line:     cs.Line,
+				InArch:   cs.InArch,
+			})
+		}
+
+		// Concurrent assignments gated on a clock level
+		for _, cl := range facts.ClockLevelConditions {
+			input.ClockLevelConditions = append(input.ClockLevelConditions, policy.ClockLevelCondition{
+				Target:    cl.Target,
+				Clock:     cl.Clock,
+				Level:     cl.Level,
+				Condition: cl.Condition,
+				Kind:      cl.Kind,
+				File:      facts.File,
+				Line:      cl.Line,
+				InArch:    cl.InArch,
+			})
+		}
+
+		// Processes without observable effects
+		for _, np := range facts.NoEffectProcesses {
+			reads := np.ReadSignals
+			if reads == nil {
+				reads = []string{}
+			}
+			input.NoEffectProcesses = append(input.NoEffectProcesses, policy.NoEffectProcess{
+				Process:     np.Process,
+				ReadSignals: reads,
+				File:        facts.File,
+				Line:        np.Line,
+				InArch:      np.InArch,
+			})
+		}
+
+		// Signals driven by more than one combinational process
+		for _, md := range facts.CombinationalMultiDrivers {
+			input.CombinationalMultiDrivers = append(input.CombinationalMultiDrivers, policy.CombinationalMultiDriver{
+				Signal:    md.Signal,
+				Processes: md.Processes,
+				Lines:     md.Lines,
+				File:      facts.File,
+				Line:      md.Lines[0],
+				InArch:    md.InArch,
+			})
+		}
+
+		// Signals only ever driven to high-impedance
+		for _, hz := range facts.PermanentHighZs {
+			input.PermanentHighZs = append(input.PermanentHighZs, policy.PermanentHighZ{
+				Signal:      hz.Signal,
+				Assignments: hz.Assignments,
+				File:        facts.File,
+				Line:        hz.Line,
+				InArch:      hz.InArch,
+			})
+		}
+
+		// Assert and report statements
+		for _, a := range facts.Assertions {
+			input.Assertions = append(input.Assertions, policy.Assertion{
+				Condition:     a.Condition,
+				ReportMessage: a.ReportMessage,
+				Severity:      a.Severity,
+				File:          facts.File,
+				Line:          a.Line,
+				InProcess:     a.InProcess,
+				InArch:        a.InArch,
+			})
+		}
+
+		// Alias declarations
+		for _, a := range facts.Aliases {
+			input.Aliases = append(input.Aliases, policy.AliasDeclaration{
+				Name:      a.Name,
+				Target:    a.Target,
+				BaseName:  extractor.AliasBaseSignal(a.Target),
+				Type:      a.Type,
+				File:      facts.File,
+				Line:      a.Line,
+				InArch:    a.InArch,
+				InPackage: a.InPackage,
+			})
+		}
+
+		// Combinational dependency cycles
+		for _, loop := range facts.CombinationalLoops {
+			line := 0
+			for _, l := range loop.Lines {
+				if line == 0 || (l > 0 && l < line) {
+					line = l
+				}
+			}
+			input.CombinationalLoops = append(input.CombinationalLoops, policy.CombinationalLoop{
+				Signals: loop.Signals,
+				Lines:   loop.Lines,
+				File:    facts.File,
+				Line:    line,
+				InArch:  loop.InArch,
+			})
+		}
+
+		// Unresolved signals with several drivers
+		for _, md := range facts.MultiDrivers {
+			drivers := make([]policy.DriverLocation, 0, len(md.Drivers))
+			for _, d := range md.Drivers {
+				drivers = append(drivers, policy.DriverLocation{
+					Process:    d.Process,
+					Line:       d.Line,
+					InGenerate: d.InGenerate,
+				})
+			}
+			input.MultiDrivers = append(input.MultiDrivers, policy.MultiDriver{
+				Signal:     md.Signal,
+				Drivers:    drivers,
+				InGenerate: md.InGenerate,
+				File:       facts.File,
+				Line:       md.Drivers[0].Line,
+				InArch:     md.InArch,
+			})
+		}
+
+		// Signals declared but never read
+		for _, us := range facts.UnusedSignals {
+			input.UnusedSignals = append(input.UnusedSignals, policy.UnusedSignal{
+				Name:     us.Name,
+				Kind:     us.Kind,
+				File:     facts.File,
+				Line:     us.Line,
+				InEntity: us.InEntity,
+			})
+		}
+
+		// Clocks read as data or driven combinationally
+		for _, cm := range facts.ClockMisuses {
+			input.ClockMisuses = append(input.ClockMisuses, policy.ClockMisuse{
+				Clock:   cm.Clock,
+				Kind:    cm.Kind,
+				Process: cm.Process,
+				File:    facts.File,
+				Line:    cm.Line,
+				InArch:  cm.InArch,
+			})
+		}
+
+		// Async resets and the clock domains they reach
+		for _, rc := range facts.ResetCrossings {
+			unsynchronized := rc.Unsynchronized
+			if unsynchronized == nil {
+				unsynchronized = []string{}
+			}
+			input.ResetCrossings = append(input.ResetCrossings, policy.ResetCrossing{
+				Reset:          rc.Reset,
+				Domains:        rc.Domains,
+				Unsynchronized: unsynchronized,
+				Synchronized:   rc.Synchronized,
+				File:           facts.File,
+				Line:           rc.Line,
+				InArch:         rc.InArch,
+			})
+		}
+
+		// Signals left unassigned on some branch of a combinational if/case
+		for _, li := range facts.ConditionalLatches {
+			input.ConditionalLatches = append(input.ConditionalLatches, policy.LatchInference{
+				Signal:        li.Signal,
+				Process:       li.Process,
+				MissingBranch: li.MissingBranch,
+				File:          facts.File,
+				Line:          li.Line,
+				InArch:        li.InArch,
+			})
+		}
+
+		// For loops inside processes with elaborated bounds
+		for _, loop := range facts.Loops {
+			input.Loops = append(input.Loops, policy.LoopStatement{
+				LoopVar:        loop.LoopVar,
+				RangeLow:       loop.RangeLow,
+				RangeHigh:      loop.RangeHigh,
+				RangeDir:       loop.RangeDir,
+				IterationCount: loop.IterationCount,
+				CanElaborate:   loop.CanElaborate,
+				File:           facts.File,
+				Line:           loop.Line,
+				InProcess:      loop.InProcess,
+				InArch:         loop.InArch,
+			})
+		}
+
+		// Combinational reads above the signal's only assignment
+		for _, rw := range facts.ReadsBeforeWrite {
+			input.ReadsBeforeWrite = append(input.ReadsBeforeWrite, policy.ReadBeforeWrite{
+				Signal:    rw.Signal,
+				Process:   rw.Process,
+				File:      facts.File,
+				ReadLine:  rw.ReadLine,
+				WriteLine: rw.WriteLine,
+				InArch:    rw.InArch,
+			})
+		}
+
+		// Attribute declarations and specifications
+		for _, as := range facts.AttributeSpecs {
+			input.AttributeSpecs = append(input.AttributeSpecs, policy.AttributeSpec{
+				Attribute:  as.Attribute,
+				Target:     as.Target,
+				TargetKind: as.TargetKind,
+				Value:      as.Value,
+				Type:       as.Type,
+				File:       facts.File,
+				Line:       as.Line,
+				InArch:     as.InArch,
+			})
+		}
+
+		// Assignments driving 'Z'
+		for _, ts := range facts.TristateAssignments {
+			input.TristateAssignments = append(input.TristateAssignments, policy.TristateAssignment{
+				Target:      ts.Target,
+				File:        facts.File,
+				Line:        ts.Line,
+				InProcess:   ts.InProcess,
+				InArch:      ts.InArch,
+				Conditional: ts.Conditional,
+			})
+		}
+
+		// Enum states never assigned or never decoded
+		for _, fi := range facts.FSMIssues {
+			input.FSMIssues = append(input.FSMIssues, policy.FSMIssue{
+				Signal: fi.Signal,
+				Type:   fi.Type,
+				State:  fi.State,
+				Kind:   fi.Kind,
+				File:   facts.File,
+				Line:   fi.Line,
+				InArch: fi.InArch,
+			})
+		}
+
+		// Clocks produced by logic
+		for _, gc := range facts.GatedClocks {
+			input.GatedClocks = append(input.GatedClocks, policy.GatedClock{
+				Clock:      gc.Clock,
+				Expression: gc.Expression,
+				File:       facts.File,
+				Line:       gc.Line,
+				InProcess:  gc.InProcess,
+				InArch:     gc.InArch,
+			})
+		}
+
+		// Output ports wired straight to an input port
+		for _, ft := range facts.Feedthroughs {
+			input.Feedthroughs = append(input.Feedthroughs, policy.Feedthrough{
+				Input:  ft.Input,
+				Output: ft.Output,
+				File:   facts.File,
+				Line:   ft.Line,
+				InArch: ft.InArch,
+			})
+		}
+
+		// Reset values that differ from the declared initial value
+		for _, rd := range facts.ResetDefaultMismatches {
+			input.ResetDefaultMismatches = append(input.ResetDefaultMismatches, policy.ResetDefaultMismatch{
+				Signal:    rd.Signal,
+				Default:   rd.Default,
+				Reset:     rd.Reset,
+				File:      facts.File,
+				Line:      rd.Line,
+				InProcess: rd.InProcess,
+				InArch:    rd.InArch,
+			})
+		}
+
+		// Clocked processes sensitive to data or missing their clock
+		for _, ms := range facts.MixedSensitivityIssues {
+			extra := ms.Extra
+			if extra == nil {
+				extra = []string{}
+			}
+			input.MixedSensitivityIssues = append(input.MixedSensitivityIssues, policy.MixedSensitivity{
+				Process:      ms.Process,
+				Clock:        ms.Clock,
+				Extra:        extra,
+				MissingClock: ms.MissingClock,
+				File:         facts.File,
+				Line:         ms.Line,
+				InArch:       ms.InArch,
+			})
+		}
+
+		// Encoding attributes on enumeration types
+		for _, fe := range facts.FSMEncodings {
+			input.FSMEncodings = append(input.FSMEncodings, policy.FSMEncoding{
+				TypeName:   fe.TypeName,
+				Signal:     fe.Signal,
+				Attribute:  fe.Attribute,
+				Encoding:   fe.Encoding,
+				Value:      fe.Value,
+				StateCount: fe.StateCount,
+				File:       facts.File,
+				Line:       fe.Line,
+				InArch:     fe.InArch,
+				InPackage:  fe.InPackage,
+			})
+		}
+
+		// Package members named like standard types or functions
+		for _, ss := range facts.StandardShadowings {
+			input.StandardShadowings = append(input.StandardShadowings, policy.StandardShadowing{
+				Name:    ss.Name,
+				Kind:    ss.Kind,
+				Package: ss.Package,
+				File:    facts.File,
+				Line:    ss.Line,
+			})
+		}
+
+		// Sensitivity lists repeating an entry or naming their own outputs
+		for _, sm := range facts.SensitivityListSmells {
+			duplicates, selfRefs := sm.Duplicates, sm.SelfReferences
+			if duplicates == nil {
+				duplicates = []string{}
+			}
+			if selfRefs == nil {
+				selfRefs = []string{}
+			}
+			input.SensitivityListSmells = append(input.SensitivityListSmells, policy.SensitivityListSmell{
+				Process:        sm.Process,
+				Duplicates:     duplicates,
+				SelfReferences: selfRefs,
+				File:           facts.File,
+				Line:           sm.Line,
+				InArch:         sm.InArch,
+			})
+		}
+
+		// Combinational reads of signals still holding 'U'
+		for _, ur := range facts.UninitializedReads {
+			input.UninitializedReads = append(input.UninitializedReads, policy.UninitRead{
+				Signal:    ur.Signal,
+				Type:      ur.Type,
+				File:      facts.File,
+				Line:      ur.Line,
+				InProcess: ur.InProcess,
+				InArch:    ur.InArch,
+			})
+		}
+
+		// Block statements
+		for _, blk := range facts.Blocks {
+			input.Blocks = append(input.Blocks, policy.BlockStatement{
+				Label:     blk.Label,
+				GuardExpr: blk.GuardExpr,
+				File:      facts.File,
+				Line:      blk.Line,
+				InArch:    blk.InArch,
+			})
+		}
+
+		// Integer literals used in place of named constants
+		for _, mn := range extractor.DetectMagicNumbers(&facts, magicNumberThreshold(idx.Config)) {
+			input.MagicNumbers = append(input.MagicNumbers, policy.MagicNumber{
+				Value:     mn.Value,
+				Numeric:   mn.Numeric,
+				Context:   mn.Context,
+				File:      facts.File,
+				Line:      mn.Line,
+				InProcess: mn.InProcess,
+				InArch:    mn.InArch,
+			})
+		}
+
 		// Signal usages: tracking reads, writes, and port map connections
 		for _, usage := range facts.SignalUsages {
 			input.SignalUsages = append(input.SignalUsages, policy.SignalUsage{
 				Signal:       usage.Signal,
+				FieldPath:    usage.FieldPath,
 				IsRead:       usage.IsRead,
 				IsWritten:    usage.IsWritten,
 				InProcess:    usage.InProcess,
@@ -1816,11 +2814,87 @@ func (idx *Indexer) buildPolicyInput() policy.Input {
 		})
 	}
 
+	// Cross-file analysis: reset nets compared with conflicting polarities
+	input.InconsistentResetPolarities = detectInconsistentResetPolarity(idx.Facts)
+
+	// Cross-file analysis: architecture signals shadowing entity ports
+	input.PortsShadowedBySignal = detectPortsShadowedBySignals(idx.Facts)
+
+	// Cross-file analysis: signals sliced both ascending and descending
+	input.MixedSliceDirections = detectMixedSliceDirections(idx.Facts)
+
+	// Cross-file analysis: names declared in more than one file of a library
+	for _, dup := range idx.Symbols.Duplicates() {
+		locations := make([]policy.SymbolLocation, 0, len(dup.Locations))
+		for _, loc := range dup.Locations {
+			locations = append(locations, policy.SymbolLocation{File: loc.File, Line: loc.Line})
+		}
+		input.DuplicateSymbols = append(input.DuplicateSymbols, policy.DuplicateSymbol{
+			Name:      dup.Name,
+			Kind:      dup.Kind,
+			Locations: locations,
+		})
+	}
+
+	// Cross-file analysis: architectures of entities missing from their library
+	input.OrphanArchitectures = idx.detectOrphanArchitectures()
+
+	// Cross-file analysis: component declarations never instantiated
+	input.UnusedComponentDeclarations = detectUnusedComponentDeclarations(idx.Facts)
+
+	// Cross-file analysis: component declarations whose ports differ from their entity
+	input.ComponentMismatches = idx.checkComponentMismatches()
+
+	// Cross-file analysis: clocks that are neither input ports nor known clocks
+	input.SuspiciousClocks = idx.detectSuspiciousClocks()
+
+	// Cross-file analysis: shared variables of non-protected types (VHDL-2008+)
+	input.UnprotectedSharedVariables = idx.detectUnprotectedSharedVariables()
+
+	// Cross-file analysis: std_logic_vector arithmetic with only numeric_std
+	input.UnsafeArithmetic = idx.detectUnsafeArithmetic()
+	// Cross-file analysis: for-generate iteration counts per instance
+	input.InstanceGenerates = idx.elaborateInstanceGenerates()
+	// Cross-file analysis: record aggregates that omit fields
+	input.IncompleteRecordAggregates = idx.detectIncompleteRecordAggregates()
+
+	// Cross-file analysis: instances leaving a no-default generic unbound
+	input.UnboundGenerics = idx.checkGenericBindings()
+
+	// Cross-file analysis: port associations whose actual width differs from the formal
+	input.WidthMismatches = idx.checkPortWidths()
+
+	// Cross-file analysis: whole-signal assignments of wider values
+	input.WidthTruncations = idx.detectWidthTruncations()
+
+	// Cross-file analysis: package members imported by use ... .all clauses
+	input.ImportedNames = idx.expandWildcardImports()
+
 	idx.populateScopesDefsUses(&input)
 
 	return input
 }
 
+// writePolicyInputNDJSON spools input to a temporary NDJSON file, validating
+// each table fragment against the schema as it is written.
+func writePolicyInputNDJSON(input *policy.Input, v *validator.Validator) (string, error) {
+	f, err := os.CreateTemp("", "vhdl_policy_input_*.ndjson")
+	if err != nil {
+		return "", fmt.Errorf("create policy input spool: %w", err)
+	}
+	path := f.Name()
+	writeErr := policy.WriteInputNDJSON(f, input, v.ValidateJSON)
+	closeErr := f.Close()
+	if writeErr == nil {
+		writeErr = closeErr
+	}
+	if writeErr != nil {
+		os.Remove(path)
+		return "", writeErr
+	}
+	return path, nil
+}
+
 func validateVerificationTags(v *validator.Validator, input *policy.Input) error {
 	if len(input.VerificationTags) == 0 {
 		return nil
@@ -2331,12 +3405,61 @@ func (idx *Indexer) findVHDLFiles(root string) ([]string, error) {
 
 // SymbolTable methods
 
+// Add registers sym, replacing any earlier symbol of the same name. A name
+// already declared in a different file is recorded as a duplicate;
+// re-adding the same declaration, or another declaration in the same file
+// (subprogram overloads), is not.
 func (st *SymbolTable) Add(sym Symbol) {
 	st.mu.Lock()
 	defer st.mu.Unlock()
+	if prev, ok := st.symbols[sym.Name]; ok && prev.File != sym.File {
+		if st.duplicates == nil {
+			st.duplicates = make(map[string]*DuplicateSymbol)
+		}
+		dup, ok := st.duplicates[sym.Name]
+		if !ok {
+			dup = &DuplicateSymbol{Name: sym.Name, Kind: prev.Kind}
+			dup.addLocation(prev)
+			st.duplicates[sym.Name] = dup
+		}
+		dup.addLocation(sym)
+	}
 	st.symbols[sym.Name] = sym
 }
 
+func (d *DuplicateSymbol) addLocation(sym Symbol) {
+	loc := SymbolLocation{File: sym.File, Line: sym.Line}
+	for _, l := range d.Locations {
+		if l == loc {
+			return
+		}
+	}
+	d.Locations = append(d.Locations, loc)
+	sort.Slice(d.Locations, func(i, j int) bool {
+		if d.Locations[i].File != d.Locations[j].File {
+			return d.Locations[i].File < d.Locations[j].File
+		}
+		return d.Locations[i].Line < d.Locations[j].Line
+	})
+}
+
+// Duplicates returns the names declared in more than one file, sorted by
+// name.
+func (st *SymbolTable) Duplicates() []DuplicateSymbol {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	result := make([]DuplicateSymbol, 0, len(st.duplicates))
+	for _, dup := range st.duplicates {
+		result = append(result, DuplicateSymbol{
+			Name:      dup.Name,
+			Kind:      dup.Kind,
+			Locations: append([]SymbolLocation(nil), dup.Locations...),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
 func (st *SymbolTable) Has(name string) bool {
 	st.mu.RLock()
 	defer st.mu.RUnlock()
@@ -2462,17 +3585,48 @@ func emitProgress(mu *sync.Mutex, progress *int, total int, facts extractor.File
 	mu.Lock()
 	defer mu.Unlock()
 	*progress = *progress + 1
-	fmt.Printf("  [%d/%d] %s (%s, %s)\n", *progress, total, facts.File, status, formatDuration(duration))
+	fmt.Fprintf(os.Stderr, "  [%d/%d] %s (%s, %s)\n", *progress, total, facts.File, status, formatDuration(duration))
 	if deps != "" {
-		fmt.Printf("    deps: %s\n", deps)
+		fmt.Fprintf(os.Stderr, "    deps: %s\n", deps)
 	}
 	if trace {
 		for _, line := range formatFactsSummary(facts) {
-			fmt.Printf("    %s\n", line)
+			fmt.Fprintf(os.Stderr, "    %s\n", line)
 		}
 	}
 }
 
+// ProgressEvent is one --progress-json record: a file finished extraction,
+// with how many of the run's files are done so far.
+type ProgressEvent struct {
+	Event      string `json:"event"` // "file"
+	Path       string `json:"path"`
+	Status     string `json:"status"` // "extracted" or "cache_hit"
+	DurationMs int64  `json:"duration_ms"`
+	Done       int    `json:"done"`
+	Total      int    `json:"total"`
+}
+
+// emitProgressJSON writes one progress event line to w. Extraction
+// goroutines share mu, which serializes the count and keeps lines whole.
+func emitProgressJSON(w io.Writer, mu *sync.Mutex, done *int, total int, path, status string, duration time.Duration) {
+	mu.Lock()
+	defer mu.Unlock()
+	*done = *done + 1
+	data, err := json.Marshal(ProgressEvent{
+		Event:      "file",
+		Path:       path,
+		Status:     status,
+		DurationMs: duration.Milliseconds(),
+		Done:       *done,
+		Total:      total,
+	})
+	if err != nil {
+		return
+	}
+	w.Write(append(data, '\n'))
+}
+
 func formatFactsSummary(facts extractor.FileFacts) []string {
 	lines := []string{
 		fmt.Sprintf("facts: entities=%d packages=%d arch=%d signals=%d ports=%d processes=%d instances=%d generates=%d deps=%d",
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"sync/atomic"
 	"testing"
@@ -171,6 +172,18 @@ func TestCacheInvalidationOnVersionChange(t *testing.T) {
 	}
 }
 
+func TestCacheVersionsIncludeParseErrorLimit(t *testing.T) {
+	idx := New()
+	idx.cacheVersionOverride = &cacheVersions{parser: "p1", extractor: "e1"}
+	if got := idx.cacheVersions("."); got.extractor != "e1" {
+		t.Fatalf("expected the extractor version unchanged without a limit, got %q", got.extractor)
+	}
+	idx.MaxParseErrors = 20
+	if got := idx.cacheVersions("."); got.extractor == "e1" || got.parser != "p1" {
+		t.Fatalf("expected a parse error limit to change only the extractor version, got %+v", got)
+	}
+}
+
 func TestCachedRunMatchesFresh(t *testing.T) {
 	dir := t.TempDir()
 	file1 := writeVHDL(t, dir, "pkg.vhd", "package my_pkg is constant C : integer := 1; end package;")
@@ -185,7 +198,7 @@ func TestCachedRunMatchesFresh(t *testing.T) {
 	idxCache := NewWithConfig(cfgCache)
 	cached := normalizeResult(runIndexerForTest(t, idxCache, dir))
 
-	if fresh.Summary != cached.Summary {
+	if !reflect.DeepEqual(fresh.Summary, cached.Summary) {
 		t.Fatalf("summary mismatch: fresh=%+v cached=%+v", fresh.Summary, cached.Summary)
 	}
 	if len(fresh.Violations) != len(cached.Violations) {
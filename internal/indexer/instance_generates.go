@@ -0,0 +1,131 @@
+package indexer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// elaborateInstanceGenerates resolves the for-generates of each instantiated
+// entity once per instance, with the instance's generic values in place of
+// the global constants of the same name. Generic actuals are evaluated over
+// the global constants and the generic defaults of the instantiating entity,
+// so a generic passed down one level (WIDTH => DATA_W) takes the parent's
+// default. Every architecture of the target entity is elaborated; instances
+// whose target does not resolve to an entity are skipped.
+func (idx *Indexer) elaborateInstanceGenerates() []policy.InstanceGenerate {
+	sorted, factsByFile, packageComponents := idx.instanceResolutionIndex()
+	globals := idx.globalConstants()
+	types := idx.buildTypeWidths(globals)
+
+	// Architectures by the library-qualified name of their entity
+	type archRef struct {
+		facts *extractor.FileFacts
+		arch  extractor.Architecture
+	}
+	archsOf := make(map[string][]archRef)
+	for i := range sorted {
+		lib := idx.FileLibrary(sorted[i].File)
+		for _, arch := range sorted[i].Architectures {
+			key := lib + "." + strings.ToLower(arch.EntityName)
+			archsOf[key] = append(archsOf[key], archRef{&sorted[i], arch})
+		}
+	}
+
+	found := []policy.InstanceGenerate{}
+	for _, facts := range sorted {
+		for _, inst := range facts.Instances {
+			unit, ok := idx.resolveInstanceUnit(facts, inst, factsByFile, packageComponents)
+			if !ok {
+				continue
+			}
+			lib, name := splitInstanceTarget(inst.Target)
+			if lib == "" || lib == "work" {
+				lib = idx.FileLibrary(facts.File)
+			}
+			qualName := fmt.Sprintf("%s.%s", lib, name)
+			if sym, ok := idx.Symbols.Get(qualName); !ok || sym.Kind != "entity" {
+				continue
+			}
+
+			scope := copyConstants(globals)
+			for k, v := range idx.parentGenerics(facts, inst, factsByFile, globals) {
+				scope[k] = v
+			}
+			constants := copyConstants(globals)
+			for k, v := range extractor.EvaluateGenericMap(inst, unit.Generics, scope) {
+				constants[k] = v
+			}
+
+			for _, ref := range archsOf[qualName] {
+				signalWidths := fileSignalWidths(*ref.facts, types)
+				walkForGenerates(ref.facts.Generates, func(gen extractor.GenerateStatement) {
+					if !strings.EqualFold(archScopeBase(gen.InArch), ref.arch.Name) {
+						return
+					}
+					count, _ := extractor.ForGenerateIterations(gen, constants, signalWidths)
+					found = append(found, policy.InstanceGenerate{
+						Instance:       inst.Name,
+						Entity:         unit.Name,
+						Architecture:   ref.arch.Name,
+						Generate:       gen.Label,
+						GenerateFile:   ref.facts.File,
+						GenerateLine:   gen.Line,
+						IterationCount: count,
+						File:           facts.File,
+						Line:           inst.Line,
+						InArch:         inst.InArch,
+					})
+				})
+			}
+		}
+	}
+	return found
+}
+
+// parentGenerics evaluates the generic defaults of the entity whose
+// architecture holds inst.
+func (idx *Indexer) parentGenerics(facts extractor.FileFacts, inst extractor.Instance, factsByFile map[string]*extractor.FileFacts, constants map[string]int) map[string]int {
+	arch := archScopeBase(inst.InArch)
+	for _, a := range facts.Architectures {
+		if !strings.EqualFold(a.Name, arch) {
+			continue
+		}
+		sym, ok := idx.Symbols.Get(idx.FileLibrary(facts.File) + "." + strings.ToLower(a.EntityName))
+		if !ok || sym.Kind != "entity" {
+			return nil
+		}
+		target, ok := factsByFile[sym.File]
+		if !ok {
+			return nil
+		}
+		for _, ent := range target.Entities {
+			if strings.EqualFold(ent.Name, a.EntityName) {
+				return extractor.EvaluateGenericMap(extractor.Instance{}, ent.Generics, constants)
+			}
+		}
+	}
+	return nil
+}
+
+// walkForGenerates calls fn for every for-generate in generates and the
+// generates nested inside them.
+func walkForGenerates(generates []extractor.GenerateStatement, fn func(extractor.GenerateStatement)) {
+	for _, gen := range generates {
+		if gen.Kind == "for" {
+			fn(gen)
+		}
+		walkForGenerates(gen.Generates, fn)
+	}
+}
+
+// copyConstants returns a copy of a constant map.
+func copyConstants(constants map[string]int) map[string]int {
+	out := make(map[string]int, len(constants))
+	for k, v := range constants {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,56 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestElaborateInstanceGenerates(t *testing.T) {
+	idx := New()
+	idx.Facts = []extractor.FileFacts{
+		{
+			File: "lane.vhd",
+			Entities: []extractor.Entity{{Name: "lane", Generics: []extractor.GenericDecl{
+				{Name: "WIDTH", Kind: "constant", Default: "8"},
+			}}},
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "lane"}},
+			Generates: []extractor.GenerateStatement{
+				{Label: "gen_bits", Kind: "for", Line: 12, InArch: "rtl", RangeLow: "0", RangeHigh: "WIDTH-1", RangeDir: "to",
+					Generates: []extractor.GenerateStatement{
+						{Label: "gen_copy", Kind: "for", Line: 13, InArch: "rtl.gen_bits", RangeLow: "1", RangeHigh: "COPIES", RangeDir: "to"},
+					}},
+				{Label: "gen_opt", Kind: "if", Line: 20, InArch: "rtl"},
+			},
+		},
+		{
+			File: "top.vhd",
+			Entities: []extractor.Entity{{Name: "top", Generics: []extractor.GenericDecl{
+				{Name: "DATA_W", Kind: "constant", Default: "32"},
+			}}},
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top"}},
+			ConstantDecls: []extractor.ConstantDeclaration{{Name: "COPIES", Value: "3", InPackage: "cfg_pkg"}},
+			Instances: []extractor.Instance{
+				{Name: "u_wide", Target: "work.lane", GenericMap: map[string]string{"WIDTH": "DATA_W / 2"}, Line: 30, InArch: "rtl"},
+				{Name: "u_default", Target: "work.lane", Line: 31, InArch: "rtl"},
+				{Name: "u_ip", Target: "vendor.ip", Line: 32, InArch: "rtl"},
+			},
+		},
+	}
+	for _, f := range idx.Facts {
+		idx.registerSymbolsForFacts(f, f.File)
+	}
+
+	got := idx.elaborateInstanceGenerates()
+	want := []policy.InstanceGenerate{
+		{Instance: "u_wide", Entity: "lane", Architecture: "rtl", Generate: "gen_bits", GenerateFile: "lane.vhd", GenerateLine: 12, IterationCount: 16, File: "top.vhd", Line: 30, InArch: "rtl"},
+		{Instance: "u_wide", Entity: "lane", Architecture: "rtl", Generate: "gen_copy", GenerateFile: "lane.vhd", GenerateLine: 13, IterationCount: 3, File: "top.vhd", Line: 30, InArch: "rtl"},
+		{Instance: "u_default", Entity: "lane", Architecture: "rtl", Generate: "gen_bits", GenerateFile: "lane.vhd", GenerateLine: 12, IterationCount: 8, File: "top.vhd", Line: 31, InArch: "rtl"},
+		{Instance: "u_default", Entity: "lane", Architecture: "rtl", Generate: "gen_copy", GenerateFile: "lane.vhd", GenerateLine: 13, IterationCount: 3, File: "top.vhd", Line: 31, InArch: "rtl"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v\nwant %+v", got, want)
+	}
+}
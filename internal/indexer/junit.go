@@ -0,0 +1,116 @@
+package indexer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string         `xml:"name,attr"`
+	ClassName string         `xml:"classname,attr"`
+	Failures  []junitFailure `xml:"failure"`
+	SystemOut string         `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes a JUnit XML report with one testcase per file. Error and
+// warning violations become failures of their file's testcase (so the failure
+// count equals Errors + Warnings); info violations are listed in system-out.
+// Files without violations still appear as passing testcases.
+func WriteJUnit(w io.Writer, files []string, violations []policy.Violation) error {
+	byFile := make(map[string][]policy.Violation)
+	for _, v := range violations {
+		byFile[v.File] = append(byFile[v.File], v)
+	}
+	paths := make([]string, 0, len(files))
+	seen := make(map[string]bool)
+	for _, f := range files {
+		if !seen[f] {
+			seen[f] = true
+			paths = append(paths, f)
+		}
+	}
+	for f := range byFile {
+		if !seen[f] {
+			seen[f] = true
+			paths = append(paths, f)
+		}
+	}
+	sort.Strings(paths)
+
+	suite := junitTestSuite{Name: "vhdl-lint", Tests: len(paths)}
+	for _, path := range paths {
+		tc := junitTestCase{Name: path, ClassName: "vhdl-lint"}
+		var notes []string
+		for _, v := range byFile[path] {
+			detail := fmt.Sprintf("%s:%d [%s] %s", v.File, v.Line, v.Severity, v.Message)
+			if v.Severity != "error" && v.Severity != "warning" {
+				notes = append(notes, detail)
+				continue
+			}
+			tc.Failures = append(tc.Failures, junitFailure{Message: v.Message, Type: v.Rule, Text: detail})
+		}
+		if len(notes) > 0 {
+			tc.SystemOut = strings.Join(notes, "\n")
+		}
+		suite.Failures += len(tc.Failures)
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	doc := junitTestSuites{
+		Name:     "vhdl-lint",
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Suites:   []junitTestSuite{suite},
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteJUnitFile writes the JUnit report for files and violations to path.
+func WriteJUnitFile(path string, files []string, violations []policy.Violation) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteJUnit(f, files, violations); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,41 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestWriteJUnit(t *testing.T) {
+	violations := []policy.Violation{
+		{Rule: "latch_inferred", Severity: "error", File: "rtl/top.vhd", Line: 30, Message: "latch"},
+		{Rule: "unused_signal", Severity: "warning", File: "rtl/top.vhd", Line: 12, Message: "unused & <odd>"},
+		{Rule: "magic_number", Severity: "info", File: "rtl/top.vhd", Line: 40, Message: "magic"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJUnit(&buf, []string{"rtl/top.vhd", "rtl/clean.vhd"}, violations); err != nil {
+		t.Fatalf("WriteJUnit: %v", err)
+	}
+	var doc junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid JUnit XML: %v\n%s", err, buf.String())
+	}
+	if doc.Tests != 2 || doc.Failures != 2 || len(doc.Suites) != 1 || doc.Suites[0].Failures != 2 {
+		t.Fatalf("unexpected totals: %+v", doc)
+	}
+	cases := doc.Suites[0].TestCases
+	if len(cases) != 2 || cases[0].Name != "rtl/clean.vhd" || len(cases[0].Failures) != 0 {
+		t.Fatalf("expected a passing testcase for the clean file first, got %+v", cases)
+	}
+	top := cases[1]
+	if len(top.Failures) != 2 || top.Failures[0].Type != "latch_inferred" || top.Failures[1].Message != "unused & <odd>" {
+		t.Fatalf("unexpected failures: %+v", top.Failures)
+	}
+	if !strings.Contains(top.SystemOut, "magic") {
+		t.Fatalf("expected info violation in system-out, got %q", top.SystemOut)
+	}
+}
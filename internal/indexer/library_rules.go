@@ -0,0 +1,43 @@
+package indexer
+
+import (
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// applyLibraryRules applies each file's library rule overrides
+// (libraries.<name>.rules and severityOverrides) to the violations reported
+// in it: "off" drops the rule's violations and a severity remaps them.
+func applyLibraryRules(lintResult *LintResult, files []policy.FileInfo) {
+	overrides := make(map[string]map[string]string)
+	for _, f := range files {
+		if len(f.RuleOverrides) > 0 {
+			overrides[f.Path] = f.RuleOverrides
+		}
+	}
+	if len(overrides) == 0 {
+		return
+	}
+	dropViolations(lintResult, func(v policy.Violation) bool {
+		return overrides[v.File][v.Rule] == "off"
+	})
+
+	// Copy first: the violations may be shared with a cached result
+	remapped := make([]policy.Violation, len(lintResult.Violations))
+	fileIndex := make(map[string]int, len(lintResult.Files))
+	for i, fr := range lintResult.Files {
+		fileIndex[fr.Path] = i
+	}
+	for i, v := range lintResult.Violations {
+		if sev, ok := overrides[v.File][v.Rule]; ok && sev != v.Severity {
+			lintResult.Summary.countSeverity(v.Severity, -1)
+			lintResult.Summary.countSeverity(sev, 1)
+			if j, ok := fileIndex[v.File]; ok {
+				lintResult.Files[j].countSeverity(v.Severity, -1)
+				lintResult.Files[j].countSeverity(sev, 1)
+			}
+			v.Severity = sev
+		}
+		remapped[i] = v
+	}
+	lintResult.Violations = remapped
+}
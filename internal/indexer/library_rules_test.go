@@ -0,0 +1,48 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestApplyLibraryRules(t *testing.T) {
+	shared := []policy.Violation{
+		{Rule: "magic_number", Severity: "warning", File: "legacy/a.vhd", Line: 1},
+		{Rule: "latch_inferred", Severity: "error", File: "legacy/a.vhd", Line: 2},
+		{Rule: "magic_number", Severity: "warning", File: "rtl/b.vhd", Line: 3},
+	}
+	lintResult := LintResult{
+		Violations: shared,
+		Summary:    ResultSummary{TotalViolations: 3, Errors: 1, Warnings: 2},
+		Files: []FileResult{
+			{Path: "legacy/a.vhd", Errors: 1, Warnings: 1},
+			{Path: "rtl/b.vhd", Warnings: 1},
+		},
+	}
+	files := []policy.FileInfo{
+		{Path: "legacy/a.vhd", Library: "legacy", RuleOverrides: map[string]string{"magic_number": "off", "latch_inferred": "info"}},
+		{Path: "rtl/b.vhd", Library: "work"},
+	}
+
+	applyLibraryRules(&lintResult, files)
+
+	if len(lintResult.Violations) != 2 {
+		t.Fatalf("expected the legacy magic_number to be dropped, got %+v", lintResult.Violations)
+	}
+	if v := lintResult.Violations[0]; v.Rule != "latch_inferred" || v.Severity != "info" {
+		t.Fatalf("expected latch_inferred remapped to info, got %+v", v)
+	}
+	if v := lintResult.Violations[1]; v.File != "rtl/b.vhd" || v.Severity != "warning" {
+		t.Fatalf("expected the work violation unchanged, got %+v", v)
+	}
+	if s := lintResult.Summary; s.TotalViolations != 2 || s.Errors != 0 || s.Warnings != 1 || s.Info != 1 {
+		t.Fatalf("unexpected summary: %+v", s)
+	}
+	if fr := lintResult.Files[0]; fr.Path != "legacy/a.vhd" || fr.Errors != 0 || fr.Warnings != 0 || fr.Info != 1 {
+		t.Fatalf("unexpected file counts: %+v", lintResult.Files)
+	}
+	if shared[1].Severity != "error" {
+		t.Fatalf("expected the original violations to be left unchanged")
+	}
+}
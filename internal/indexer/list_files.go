@@ -0,0 +1,72 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+)
+
+// ListedFile describes a file that Run would analyze, with its library attribution.
+type ListedFile struct {
+	Path         string `json:"path"`
+	Library      string `json:"library"`
+	IsThirdParty bool   `json:"is_third_party"`
+}
+
+// ListFiles runs only discovery, library resolution, and ignore filtering for
+// rootPath. No files are parsed, so this is cheap enough to run before a large
+// lint to confirm the file set and why a file is (or is not) third-party.
+func (idx *Indexer) ListFiles(rootPath string) ([]ListedFile, error) {
+	if idx.Config == nil {
+		cfg, err := config.Load(rootPath)
+		if err != nil {
+			return nil, fmt.Errorf("load config: %w", err)
+		}
+		idx.Config = cfg
+	}
+	idx.FileLibraries = make(map[string]config.FileLibraryInfo)
+	idx.ThirdPartyFiles = make(map[string]bool)
+
+	files, _, err := idx.discoverFiles(rootPath)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	listed := make([]ListedFile, 0, len(files))
+	for _, f := range files {
+		lib := "work"
+		if info, ok := idx.FileLibraries[f]; ok && info.LibraryName != "" {
+			lib = info.LibraryName
+		}
+		listed = append(listed, ListedFile{
+			Path:         f,
+			Library:      lib,
+			IsThirdParty: idx.ThirdPartyFiles[f],
+		})
+	}
+	return listed, nil
+}
+
+// WriteFileList prints the result of ListFiles as text or JSON.
+func WriteFileList(w io.Writer, files []ListedFile, jsonOutput bool) error {
+	if jsonOutput {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(files)
+	}
+	for _, f := range files {
+		thirdParty := ""
+		if f.IsThirdParty {
+			thirdParty = " (third-party)"
+		}
+		if _, err := fmt.Fprintf(w, "%s\t%s%s\n", f.Library, f.Path, thirdParty); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d files\n", len(files))
+	return err
+}
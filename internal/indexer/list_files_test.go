@@ -0,0 +1,51 @@
+package indexer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+)
+
+func TestListFilesAttributesLibraries(t *testing.T) {
+	root := t.TempDir()
+	for _, rel := range []string{"rtl/core.vhd", "vendor/ip.vhd", "rtl/skip_me.vhd"} {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("-- "+rel), 0o644); err != nil {
+			t.Fatalf("write %s: %v", rel, err)
+		}
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Libraries = map[string]config.LibraryConfig{
+		"work":   {Files: []string{"rtl/*.vhd"}},
+		"vendor": {Files: []string{"vendor/*.vhd"}, IsThirdParty: true},
+	}
+	cfg.Lint.IgnorePatterns = []string{"skip_*.vhd"}
+
+	idx := NewWithConfig(cfg)
+	files, err := idx.ListFiles(root)
+	if err != nil {
+		t.Fatalf("ListFiles: %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected 2 files after ignore filtering, got %+v", files)
+	}
+
+	byPath := map[string]ListedFile{}
+	for _, f := range files {
+		byPath[f.Path] = f
+	}
+	core, ok := byPath[filepath.Join(root, "rtl", "core.vhd")]
+	if !ok || core.Library != "work" || core.IsThirdParty {
+		t.Fatalf("unexpected attribution for core.vhd: %+v", core)
+	}
+	ip, ok := byPath[filepath.Join(root, "vendor", "ip.vhd")]
+	if !ok || ip.Library != "vendor" || !ip.IsThirdParty {
+		t.Fatalf("unexpected attribution for ip.vhd: %+v", ip)
+	}
+}
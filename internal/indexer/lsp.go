@@ -0,0 +1,416 @@
+package indexer
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// lspChangeDebounce is how long didChange notifications must go quiet before
+// the workspace is re-linted.
+const lspChangeDebounce = 300 * time.Millisecond
+
+// LSP diagnostic severities.
+const (
+	lspSeverityError       = 1
+	lspSeverityWarning     = 2
+	lspSeverityInformation = 3
+	lspSeverityHint        = 4
+)
+
+// JSON-RPC error codes.
+const (
+	lspParseError     = -32700
+	lspMethodNotFound = -32601
+)
+
+// lspMessage is an incoming JSON-RPC 2.0 request or notification; requests
+// carry an ID.
+type lspMessage struct {
+	ID     *json.RawMessage `json:"id"`
+	Method string           `json:"method"`
+	Params json.RawMessage  `json:"params"`
+}
+
+// lspResponse answers a request with a result (null when Result is nil).
+type lspResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id"`
+	Result  interface{}      `json:"result"`
+}
+
+// lspErrorResponse answers a request with an error instead of a result.
+type lspErrorResponse struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id"`
+	Error   lspError         `json:"error"`
+}
+
+type lspError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type lspNotification struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+type lspPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start lspPosition `json:"start"`
+	End   lspPosition `json:"end"`
+}
+
+// LSPDiagnostic is one published diagnostic, built from a policy violation.
+type LSPDiagnostic struct {
+	Range    lspRange `json:"range"`
+	Severity int      `json:"severity"`
+	Code     string   `json:"code"`
+	Source   string   `json:"source"`
+	Message  string   `json:"message"`
+}
+
+type lspPublishParams struct {
+	URI         string          `json:"uri"`
+	Diagnostics []LSPDiagnostic `json:"diagnostics"`
+}
+
+type lspInitializeParams struct {
+	RootURI          string `json:"rootUri"`
+	RootPath         string `json:"rootPath"`
+	WorkspaceFolders []struct {
+		URI string `json:"uri"`
+	} `json:"workspaceFolders"`
+}
+
+type lspDocumentParams struct {
+	TextDocument struct {
+		URI string `json:"uri"`
+	} `json:"textDocument"`
+}
+
+// LSPServer speaks the Language Server Protocol over a stream (stdio for
+// editors), publishing the violations of the whole workspace as diagnostics.
+// Documents are re-linted on open and save, and after edits go quiet for
+// lspChangeDebounce; linting goes through a resident Server, so only files
+// whose content changed are re-extracted. Diagnostics reflect the files on
+// disk, not unsaved buffers.
+type LSPServer struct {
+	srv  *Server
+	lint func(root string) (*LintResult, error) // srv.Lint; replaced in tests
+
+	in        *bufio.Reader
+	out       io.Writer
+	outMu     sync.Mutex // Serializes writes to out
+	publishMu sync.Mutex // Serializes lint-and-publish rounds
+	mu        sync.Mutex // Guards the fields below
+	root      string
+	timer     *time.Timer
+	issued    map[string]bool // URIs holding published diagnostics
+}
+
+// NewLSPServer serves the protocol read from in, writing to out.
+func NewLSPServer(idx *Indexer, in io.Reader, out io.Writer) *LSPServer {
+	srv := NewServer(idx)
+	return &LSPServer{
+		srv:    srv,
+		lint:   srv.Lint,
+		in:     bufio.NewReader(in),
+		out:    out,
+		issued: make(map[string]bool),
+	}
+}
+
+// Run answers messages until the client sends exit or closes the stream. It
+// returns an error when the client exits without a shutdown request, as the
+// protocol requires.
+func (l *LSPServer) Run() error {
+	defer l.srv.Shutdown()
+	defer l.cancelPending()
+	shutdown := false
+	for {
+		body, err := readLSPMessage(l.in)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		var msg lspMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			l.replyError(nil, lspParseError, err.Error())
+			continue
+		}
+		switch msg.Method {
+		case "initialize":
+			var params lspInitializeParams
+			_ = json.Unmarshal(msg.Params, &params)
+			l.mu.Lock()
+			l.root = initializeRoot(params)
+			l.mu.Unlock()
+			l.reply(msg.ID, map[string]interface{}{
+				"capabilities": map[string]interface{}{
+					"textDocumentSync": map[string]interface{}{
+						"openClose": true,
+						"change":    1, // Full document sync
+						"save":      map[string]bool{"includeText": false},
+					},
+				},
+				"serverInfo": map[string]string{"name": "vhdl-lint"},
+			})
+		case "initialized":
+		case "textDocument/didOpen", "textDocument/didSave":
+			l.adoptRoot(msg.Params)
+			l.cancelPending()
+			l.publish()
+		case "textDocument/didChange":
+			l.adoptRoot(msg.Params)
+			l.schedule()
+		case "shutdown":
+			shutdown = true
+			l.cancelPending()
+			l.reply(msg.ID, nil)
+		case "exit":
+			if !shutdown {
+				return errors.New("exit before shutdown")
+			}
+			return nil
+		default:
+			// Unknown notifications are ignored; unknown requests are errors
+			if msg.ID != nil {
+				l.replyError(msg.ID, lspMethodNotFound, "method not found: "+msg.Method)
+			}
+		}
+	}
+}
+
+// initializeRoot returns the workspace directory named by initialize: the
+// root URI, the first workspace folder, or the deprecated root path.
+func initializeRoot(params lspInitializeParams) string {
+	if path := uriToPath(params.RootURI); path != "" {
+		return path
+	}
+	if len(params.WorkspaceFolders) > 0 {
+		if path := uriToPath(params.WorkspaceFolders[0].URI); path != "" {
+			return path
+		}
+	}
+	if params.RootPath != "" {
+		if abs, err := filepath.Abs(params.RootPath); err == nil {
+			return abs
+		}
+	}
+	return params.RootPath
+}
+
+// adoptRoot lints the directory of the first document opened when the
+// client named no workspace root.
+func (l *LSPServer) adoptRoot(raw json.RawMessage) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.root != "" {
+		return
+	}
+	var params lspDocumentParams
+	if json.Unmarshal(raw, &params) == nil {
+		if path := uriToPath(params.TextDocument.URI); path != "" {
+			l.root = filepath.Dir(path)
+		}
+	}
+}
+
+// schedule re-lints once edits have been quiet for lspChangeDebounce.
+func (l *LSPServer) schedule() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.timer != nil {
+		l.timer.Stop()
+	}
+	l.timer = time.AfterFunc(lspChangeDebounce, l.publish)
+}
+
+func (l *LSPServer) cancelPending() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+}
+
+// publish lints the workspace and sends every file's diagnostics. Files that
+// had diagnostics before and have none now are sent an empty list, clearing
+// them in the editor. Lint failures are reported with window/showMessage.
+func (l *LSPServer) publish() {
+	l.publishMu.Lock()
+	defer l.publishMu.Unlock()
+	l.mu.Lock()
+	root := l.root
+	l.mu.Unlock()
+	if root == "" {
+		return
+	}
+	result, err := l.lint(root)
+	if err != nil {
+		l.notify("window/showMessage", map[string]interface{}{
+			"type":    1, // Error
+			"message": "vhdl-lint: " + err.Error(),
+		})
+		return
+	}
+
+	byURI := LSPDiagnostics(result.Violations, root)
+	l.mu.Lock()
+	for uri := range l.issued {
+		if _, ok := byURI[uri]; !ok {
+			byURI[uri] = []LSPDiagnostic{}
+		}
+	}
+	l.issued = make(map[string]bool)
+	for uri, diags := range byURI {
+		if len(diags) > 0 {
+			l.issued[uri] = true
+		}
+	}
+	l.mu.Unlock()
+
+	uris := make([]string, 0, len(byURI))
+	for uri := range byURI {
+		uris = append(uris, uri)
+	}
+	sort.Strings(uris)
+	for _, uri := range uris {
+		l.notify("textDocument/publishDiagnostics", lspPublishParams{URI: uri, Diagnostics: byURI[uri]})
+	}
+}
+
+// LSPDiagnostics groups violations into diagnostics by file URI. Relative
+// violation paths are resolved against root. A violation covers its whole
+// line; line numbers below 1 map to the first line.
+func LSPDiagnostics(violations []policy.Violation, root string) map[string][]LSPDiagnostic {
+	byURI := make(map[string][]LSPDiagnostic)
+	for _, v := range violations {
+		if v.File == "" {
+			continue
+		}
+		path := v.File
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(root, path)
+		}
+		line := v.Line - 1
+		if line < 0 {
+			line = 0
+		}
+		uri := pathToURI(path)
+		byURI[uri] = append(byURI[uri], LSPDiagnostic{
+			Range: lspRange{
+				Start: lspPosition{Line: line},
+				End:   lspPosition{Line: line + 1},
+			},
+			Severity: lspSeverity(v.Severity),
+			Code:     v.Rule,
+			Source:   "vhdl-lint",
+			Message:  v.Message,
+		})
+	}
+	return byURI
+}
+
+// lspSeverity maps a violation severity to an LSP diagnostic severity.
+func lspSeverity(severity string) int {
+	switch severity {
+	case "error":
+		return lspSeverityError
+	case "warning":
+		return lspSeverityWarning
+	case "info":
+		return lspSeverityInformation
+	}
+	return lspSeverityHint
+}
+
+func (l *LSPServer) reply(id *json.RawMessage, result interface{}) {
+	l.write(lspResponse{JSONRPC: "2.0", ID: id, Result: result})
+}
+
+func (l *LSPServer) replyError(id *json.RawMessage, code int, message string) {
+	l.write(lspErrorResponse{JSONRPC: "2.0", ID: id, Error: lspError{Code: code, Message: message}})
+}
+
+func (l *LSPServer) notify(method string, params interface{}) {
+	l.write(lspNotification{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+// write sends one message with its Content-Length header.
+func (l *LSPServer) write(msg interface{}) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	l.outMu.Lock()
+	defer l.outMu.Unlock()
+	fmt.Fprintf(l.out, "Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+// readLSPMessage reads one Content-Length framed message body.
+func readLSPMessage(r *bufio.Reader) ([]byte, error) {
+	length := -1
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) && line == "" && length == -1 {
+				return nil, io.EOF
+			}
+			return nil, fmt.Errorf("read header: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if ok && strings.EqualFold(strings.TrimSpace(name), "Content-Length") {
+			if length, err = strconv.Atoi(strings.TrimSpace(value)); err != nil {
+				return nil, fmt.Errorf("invalid Content-Length %q", value)
+			}
+		}
+	}
+	if length < 0 {
+		return nil, errors.New("message without Content-Length")
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+	return body, nil
+}
+
+// uriToPath returns the local path of a file URI, or "" for other URIs.
+func uriToPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return ""
+	}
+	return filepath.FromSlash(u.Path)
+}
+
+// pathToURI returns the file URI of an absolute path.
+func pathToURI(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func lspFrame(t *testing.T, msg map[string]interface{}) string {
+	t.Helper()
+	msg["jsonrpc"] = "2.0"
+	body, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+func TestLSPServerPublishesDiagnostics(t *testing.T) {
+	root := t.TempDir()
+	doc := pathToURI(root + "/top.vhd")
+	var in bytes.Buffer
+	in.WriteString(lspFrame(t, map[string]interface{}{"id": 1, "method": "initialize", "params": map[string]interface{}{"rootUri": pathToURI(root)}}))
+	in.WriteString(lspFrame(t, map[string]interface{}{"method": "initialized", "params": map[string]interface{}{}}))
+	in.WriteString(lspFrame(t, map[string]interface{}{"method": "textDocument/didOpen", "params": map[string]interface{}{"textDocument": map[string]string{"uri": doc}}}))
+	in.WriteString(lspFrame(t, map[string]interface{}{"method": "textDocument/didSave", "params": map[string]interface{}{"textDocument": map[string]string{"uri": doc}}}))
+	in.WriteString(lspFrame(t, map[string]interface{}{"id": 2, "method": "textDocument/hover", "params": map[string]interface{}{}}))
+	in.WriteString(lspFrame(t, map[string]interface{}{"id": 3, "method": "shutdown"}))
+	in.WriteString(lspFrame(t, map[string]interface{}{"method": "exit"}))
+
+	var out bytes.Buffer
+	l := NewLSPServer(New(), &in, &out)
+	lints := 0
+	l.lint = func(path string) (*LintResult, error) {
+		if path != root {
+			t.Errorf("expected lint of %s, got %s", root, path)
+		}
+		lints++
+		if lints > 1 {
+			return &LintResult{}, nil // The save fixed everything
+		}
+		return &LintResult{Violations: []policy.Violation{
+			{Rule: "unused_signal", Severity: "warning", File: "top.vhd", Line: 12, Message: "Signal 'x' is never used"},
+		}}, nil
+	}
+	if err := l.Run(); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	var msgs []map[string]json.RawMessage
+	r := bufio.NewReader(&out)
+	for {
+		body, err := readLSPMessage(r)
+		if err != nil {
+			break
+		}
+		var msg map[string]json.RawMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) != 5 {
+		t.Fatalf("expected 5 messages (initialize, 2 publishes, hover error, shutdown), got %d", len(msgs))
+	}
+	if _, ok := msgs[0]["result"]; !ok {
+		t.Errorf("expected initialize result, got %s", msgs[0])
+	}
+
+	var first, second lspPublishParams
+	if err := json.Unmarshal(msgs[1]["params"], &first); err != nil {
+		t.Fatal(err)
+	}
+	if first.URI != doc || len(first.Diagnostics) != 1 {
+		t.Fatalf("unexpected first publish: %+v", first)
+	}
+	d := first.Diagnostics[0]
+	if d.Range.Start.Line != 11 || d.Severity != lspSeverityWarning || d.Code != "unused_signal" || d.Source != "vhdl-lint" {
+		t.Errorf("unexpected diagnostic: %+v", d)
+	}
+	if err := json.Unmarshal(msgs[2]["params"], &second); err != nil {
+		t.Fatal(err)
+	}
+	if second.URI != doc || second.Diagnostics == nil || len(second.Diagnostics) != 0 {
+		t.Errorf("expected the save to clear diagnostics, got %+v", second)
+	}
+
+	var rpcErr lspError
+	if err := json.Unmarshal(msgs[3]["error"], &rpcErr); err != nil || rpcErr.Code != lspMethodNotFound {
+		t.Errorf("expected method-not-found for hover, got %s", msgs[3])
+	}
+	if string(msgs[4]["id"]) != "3" || string(msgs[4]["result"]) != "null" {
+		t.Errorf("unexpected shutdown response: %s", msgs[4])
+	}
+}
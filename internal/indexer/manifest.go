@@ -0,0 +1,93 @@
+package indexer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+// ManifestEntry is one file in the --manifest: its content hash, and whether
+// its facts came from the cache or were extracted in this run.
+type ManifestEntry struct {
+	File       string `json:"file"`
+	Hash       string `json:"hash"`        // sha256 of the content analyzed ("" if unreadable)
+	Status     string `json:"status"`      // "cache_hit" or "extracted"
+	FactsCount int    `json:"facts_count"` // Design units, declarations, processes, instances, generates, and dependencies
+}
+
+// Manifest is the --manifest file.
+type Manifest struct {
+	Files []ManifestEntry `json:"files"`
+}
+
+// manifestRecorder collects manifest entries from the extraction goroutines.
+type manifestRecorder struct {
+	mu      sync.Mutex
+	entries []ManifestEntry
+}
+
+func (m *manifestRecorder) add(facts extractor.FileFacts, hash, status string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = append(m.entries, ManifestEntry{
+		File:       facts.File,
+		Hash:       hash,
+		Status:     status,
+		FactsCount: factsCount(facts),
+	})
+}
+
+// sorted returns the entries ordered by file.
+func (m *manifestRecorder) sorted() []ManifestEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := append([]ManifestEntry(nil), m.entries...)
+	sort.Slice(out, func(i, j int) bool { return out[i].File < out[j].File })
+	return out
+}
+
+// factsCount counts the facts summarized for a file in --trace output.
+func factsCount(facts extractor.FileFacts) int {
+	return len(facts.Entities) + len(facts.Packages) + len(facts.Architectures) +
+		len(facts.Signals) + len(facts.Ports) + len(facts.Processes) +
+		len(facts.Instances) + len(facts.Generates) + len(facts.Dependencies)
+}
+
+// manifestHash returns the hash of the content a file was analyzed from: the
+// overlay when one stands in for it, otherwise the file on disk. Files that
+// cannot be read hash to "".
+func manifestHash(path string, overlay []byte, overlaid bool) string {
+	if overlaid {
+		sum := sha256.Sum256(overlay)
+		return hex.EncodeToString(sum[:])
+	}
+	h, err := hashFile(path)
+	if err != nil {
+		return ""
+	}
+	return h
+}
+
+// writeManifest writes entries to ManifestPath as indented JSON, sorted by
+// file. It does nothing when no --manifest was requested.
+func (idx *Indexer) writeManifest(entries []ManifestEntry) error {
+	if idx.ManifestPath == "" {
+		return nil
+	}
+	sorted := append([]ManifestEntry{}, entries...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].File < sorted[j].File })
+	data, err := json.MarshalIndent(Manifest{Files: sorted}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(idx.ManifestPath, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
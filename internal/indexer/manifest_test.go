@@ -0,0 +1,85 @@
+package indexer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestRunWritesManifest(t *testing.T) {
+	dir := t.TempDir()
+	a := writeVHDL(t, dir, "a.vhd", "entity a is end entity; architecture rtl of a is begin end architecture;")
+	b := writeVHDL(t, dir, "b.vhd", "entity b is end entity;")
+	cacheDir := filepath.Join(dir, ".cache")
+	manifestPath := filepath.Join(dir, "manifest.json")
+
+	readManifest := func(cacheEnabled bool) []ManifestEntry {
+		t.Helper()
+		idx := NewWithConfig(defaultTestConfig([]string{a, b}, cacheDir, cacheEnabled))
+		idx.ManifestPath = manifestPath
+		runIndexerForTest(t, idx, dir)
+		data, err := os.ReadFile(manifestPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var m Manifest
+		if err := json.Unmarshal(data, &m); err != nil {
+			t.Fatal(err)
+		}
+		return m.Files
+	}
+
+	// Without the cache every file is extracted and still hashed
+	uncached := readManifest(false)
+	if len(uncached) != 2 || uncached[0].File != a || uncached[1].File != b {
+		t.Fatalf("expected both files in path order, got %+v", uncached)
+	}
+	for _, e := range uncached {
+		if e.Status != "extracted" || e.Hash == "" || e.FactsCount == 0 {
+			t.Fatalf("unexpected uncached entry %+v", e)
+		}
+	}
+
+	readManifest(true)
+	cached := readManifest(true)
+	for i, e := range cached {
+		if e.Status != "cache_hit" || e.Hash != uncached[i].Hash || e.FactsCount != uncached[i].FactsCount {
+			t.Fatalf("entry %d = %+v, want a cache hit matching %+v", i, e, uncached[i])
+		}
+	}
+}
+
+func TestWriteManifestSortsEntries(t *testing.T) {
+	var rec manifestRecorder
+	rec.add(extractor.FileFacts{File: "b.vhd", Entities: []extractor.Entity{{Name: "b"}}}, "bb", "extracted")
+	rec.add(extractor.FileFacts{File: "a.vhd", Signals: []extractor.Signal{{Name: "x"}, {Name: "y"}}}, "aa", "cache_hit")
+
+	idx := New()
+	idx.ManifestPath = filepath.Join(t.TempDir(), "manifest.json")
+	if err := idx.writeManifest(rec.sorted()); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(idx.ManifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	want := []ManifestEntry{
+		{File: "a.vhd", Hash: "aa", Status: "cache_hit", FactsCount: 2},
+		{File: "b.vhd", Hash: "bb", Status: "extracted", FactsCount: 1},
+	}
+	if !reflect.DeepEqual(m.Files, want) {
+		t.Fatalf("got %+v, want %+v", m.Files, want)
+	}
+
+	if got := manifestHash("unused.vhd", []byte("abc"), true); got != "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad" {
+		t.Fatalf("overlay hash = %s", got)
+	}
+}
@@ -0,0 +1,177 @@
+package indexer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// RootResult is one root's share of a multi-root run.
+type RootResult struct {
+	Root        string          `json:"root"`
+	Summary     ResultSummary   `json:"summary"`
+	Stats       ExtractionStats `json:"stats"`
+	ParseErrors int             `json:"parse_errors"`
+	Error       string          `json:"error,omitempty"` // Why the root could not be linted
+}
+
+// MergeLintResults combines the results of linting each root into one
+// LintResult: violations, files, parse errors, and structured tasks are
+// concatenated in root order, summaries (per-rule counts included) and stats
+// are summed, the merge is truncated when any root is, and Roots keeps each
+// root's own counts.
+func MergeLintResults(roots []string, results []LintResult) LintResult {
+	merged := LintResult{
+		SchemaVersion: ResultSchemaVersion,
+		Violations:    []policy.Violation{},
+		ParseErrors:   []ParseError{},
+		Files:         []FileResult{},
+	}
+	for i, r := range results {
+		merged.Violations = append(merged.Violations, r.Violations...)
+		merged.MissingChecks = append(merged.MissingChecks, r.MissingChecks...)
+		merged.AmbiguousConstructs = append(merged.AmbiguousConstructs, r.AmbiguousConstructs...)
+		merged.Files = append(merged.Files, r.Files...)
+		merged.ParseErrors = append(merged.ParseErrors, r.ParseErrors...)
+		merged.Truncated = merged.Truncated || r.Truncated
+		merged.TimedOutFiles = append(merged.TimedOutFiles, r.TimedOutFiles...)
+
+		merged.Summary.TotalViolations += r.Summary.TotalViolations
+		merged.Summary.Errors += r.Summary.Errors
+		merged.Summary.Warnings += r.Summary.Warnings
+		merged.Summary.Info += r.Summary.Info
+		merged.Summary.Suppressed += r.Summary.Suppressed
+		merged.Summary.Baselined += r.Summary.Baselined
+		merged.Summary.OutsideDiff += r.Summary.OutsideDiff
+		for rule, n := range r.Summary.ByRule {
+			merged.Summary.countRule(rule, n)
+		}
+
+		merged.Stats.Files += r.Stats.Files
+		merged.Stats.Symbols += r.Stats.Symbols
+		merged.Stats.Entities += r.Stats.Entities
+		merged.Stats.Packages += r.Stats.Packages
+		merged.Stats.Signals += r.Stats.Signals
+		merged.Stats.Ports += r.Stats.Ports
+		merged.Stats.Processes += r.Stats.Processes
+		merged.Stats.Instances += r.Stats.Instances
+		merged.Stats.Generates += r.Stats.Generates
+
+		merged.Roots = append(merged.Roots, RootResult{
+			Root:        roots[i],
+			Summary:     r.Summary,
+			Stats:       r.Stats,
+			ParseErrors: len(r.ParseErrors),
+		})
+	}
+	merged.Summary.rankRules()
+	return merged
+}
+
+// RunRoots lints each root with its own Indexer (and so its own discovered
+// config) and writes one merged result using the first root's output
+// settings. A root that fails to load or lint is reported on stderr, left
+// out of the merged counts, and listed with its error in the per-root
+// breakdown; RunRoots then returns an error once the result is written, so
+// one broken root fails the run like a single failing root does.
+func RunRoots(roots []string, newIndexer func(root string) (*Indexer, error)) (ResultSummary, error) {
+	var (
+		out      *Indexer
+		linted   []string
+		results  []LintResult
+		analyzed []string
+		manifest []ManifestEntry
+		lastErr  error
+		failed   = make(map[int]error)
+	)
+	for i, root := range roots {
+		idx, err := newIndexer(root)
+		if err == nil {
+			idx.DeferOutput = true
+			err = idx.Run(root)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s: %v\n", root, err)
+			lastErr = err
+			failed[i] = err
+			continue
+		}
+		if out == nil {
+			out = idx
+		}
+		linted = append(linted, root)
+		results = append(results, idx.Result)
+		analyzed = append(analyzed, idx.analyzedFiles()...)
+		manifest = append(manifest, idx.Manifest...)
+	}
+	if out == nil {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no roots to lint")
+		}
+		return ResultSummary{}, lastErr
+	}
+
+	merged := MergeLintResults(linted, results)
+	merged.Roots = insertFailedRoots(roots, merged.Roots, failed)
+	if err := out.writeResult(&merged, analyzed, commonRoot(linted)); err != nil {
+		return merged.Summary, err
+	}
+	if err := out.writeManifest(manifest); err != nil {
+		return merged.Summary, err
+	}
+	if len(failed) > 0 {
+		return merged.Summary, fmt.Errorf("%d of %d roots failed to lint", len(failed), len(roots))
+	}
+	return merged.Summary, nil
+}
+
+// insertFailedRoots returns the breakdown of every root in roots order: the
+// linted roots' results with an entry carrying the error for each failed
+// root (keyed by its index in roots).
+func insertFailedRoots(roots []string, linted []RootResult, failed map[int]error) []RootResult {
+	if len(failed) == 0 {
+		return linted
+	}
+	all := make([]RootResult, 0, len(roots))
+	for i, root := range roots {
+		if err, ok := failed[i]; ok {
+			all = append(all, RootResult{Root: root, Error: err.Error()})
+			continue
+		}
+		all = append(all, linted[0])
+		linted = linted[1:]
+	}
+	return all
+}
+
+// commonRoot returns the deepest directory containing every root, which
+// anchors SARIF URIs for a multi-root run.
+func commonRoot(roots []string) string {
+	var common []string
+	for i, root := range roots {
+		abs, err := filepath.Abs(root)
+		if err != nil {
+			abs = root
+		}
+		if info, err := os.Stat(abs); err == nil && !info.IsDir() {
+			abs = filepath.Dir(abs)
+		}
+		parts := strings.Split(filepath.Clean(abs), string(filepath.Separator))
+		if i == 0 {
+			common = parts
+			continue
+		}
+		n := 0
+		for n < len(common) && n < len(parts) && common[n] == parts[n] {
+			n++
+		}
+		common = common[:n]
+	}
+	if len(common) == 0 || (len(common) == 1 && common[0] == "") {
+		return string(filepath.Separator)
+	}
+	return strings.Join(common, string(filepath.Separator))
+}
@@ -0,0 +1,92 @@
+package indexer
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestMergeLintResults(t *testing.T) {
+	a := LintResult{
+		Violations:  []policy.Violation{{Rule: "unused_signal", File: "a/x.vhd", Line: 3, Severity: "warning"}},
+		Summary:     ResultSummary{TotalViolations: 1, Warnings: 1, Suppressed: 2, ByRule: map[string]int{"unused_signal": 1}},
+		Stats:       ExtractionStats{Files: 2, Signals: 5},
+		Files:       []FileResult{{Path: "a/x.vhd", Warnings: 1}},
+		ParseErrors: []ParseError{{File: "a/bad.vhd", Message: "syntax error"}},
+	}
+	b := LintResult{
+		Violations:    []policy.Violation{{Rule: "latch_inference", File: "b/y.vhd", Line: 9, Severity: "error"}},
+		Summary:       ResultSummary{TotalViolations: 1, Errors: 1, ByRule: map[string]int{"latch_inference": 1}},
+		Stats:         ExtractionStats{Files: 1, Signals: 2},
+		Files:         []FileResult{{Path: "b/y.vhd", Errors: 1}},
+		Truncated:     true,
+		TimedOutFiles: []string{"b/huge.vhd"},
+	}
+
+	merged := MergeLintResults([]string{"a", "b"}, []LintResult{a, b})
+	if merged.SchemaVersion != ResultSchemaVersion {
+		t.Fatalf("expected schema version %s, got %q", ResultSchemaVersion, merged.SchemaVersion)
+	}
+	if len(merged.Violations) != 2 || merged.Violations[0].Rule != "unused_signal" || merged.Violations[1].Rule != "latch_inference" {
+		t.Fatalf("expected violations in root order, got %+v", merged.Violations)
+	}
+	want := ResultSummary{
+		TotalViolations: 2, Errors: 1, Warnings: 1, Suppressed: 2,
+		ByRule:   map[string]int{"unused_signal": 1, "latch_inference": 1},
+		TopRules: []RuleCount{{Rule: "latch_inference", Count: 1}, {Rule: "unused_signal", Count: 1}},
+	}
+	if !reflect.DeepEqual(merged.Summary, want) {
+		t.Fatalf("summary = %+v, want %+v", merged.Summary, want)
+	}
+	if merged.Stats.Files != 3 || merged.Stats.Signals != 7 {
+		t.Fatalf("expected summed stats, got %+v", merged.Stats)
+	}
+	if len(merged.Files) != 2 || len(merged.ParseErrors) != 1 {
+		t.Fatalf("expected concatenated files and parse errors, got %+v / %+v", merged.Files, merged.ParseErrors)
+	}
+	if !merged.Truncated || len(merged.TimedOutFiles) != 1 {
+		t.Fatalf("expected the merge truncated by root b, got %v / %v", merged.Truncated, merged.TimedOutFiles)
+	}
+	if len(merged.Roots) != 2 {
+		t.Fatalf("expected one breakdown per root, got %+v", merged.Roots)
+	}
+	if r := merged.Roots[0]; r.Root != "a" || r.Summary.Warnings != 1 || r.Stats.Files != 2 || r.ParseErrors != 1 {
+		t.Fatalf("unexpected breakdown for root a: %+v", r)
+	}
+	if r := merged.Roots[1]; r.Root != "b" || r.Summary.Errors != 1 || r.ParseErrors != 0 {
+		t.Fatalf("unexpected breakdown for root b: %+v", r)
+	}
+}
+
+func TestCommonRoot(t *testing.T) {
+	dir := t.TempDir()
+	got := commonRoot([]string{filepath.Join(dir, "rtl", "core"), filepath.Join(dir, "rtl", "periph"), filepath.Join(dir, "tb")})
+	if got != dir {
+		t.Fatalf("commonRoot = %q, want %q", got, dir)
+	}
+}
+
+func TestInsertFailedRoots(t *testing.T) {
+	linted := []RootResult{{Root: "a", Stats: ExtractionStats{Files: 2}}, {Root: "c", Stats: ExtractionStats{Files: 1}}}
+	got := insertFailedRoots([]string{"a", "b", "c"}, linted, map[int]error{1: errors.New("load config: bad json")})
+	want := []RootResult{
+		{Root: "a", Stats: ExtractionStats{Files: 2}},
+		{Root: "b", Error: "load config: bad json"},
+		{Root: "c", Stats: ExtractionStats{Files: 1}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestRunRootsFailsWhenEveryRootFails(t *testing.T) {
+	_, err := RunRoots([]string{"a", "b"}, func(root string) (*Indexer, error) {
+		return nil, errors.New("no config")
+	})
+	if err == nil {
+		t.Fatalf("expected an error when no root can be linted")
+	}
+}
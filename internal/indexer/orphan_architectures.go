@@ -0,0 +1,46 @@
+package indexer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// detectOrphanArchitectures reports architectures whose entity is not an
+// entity symbol of the architecture's own library. An architecture and its
+// entity always share a library, so "work.fifo" and a bare "fifo" both
+// resolve in the library the architecture's file is compiled into; an
+// entity of the same name in another library does not count.
+func (idx *Indexer) detectOrphanArchitectures() []policy.OrphanArchitecture {
+	found := []policy.OrphanArchitecture{}
+	for _, facts := range idx.Facts {
+		fileLib := idx.FileLibrary(facts.File)
+		for _, arch := range facts.Architectures {
+			if arch.EntityName == "" {
+				continue
+			}
+			qualName := resolveWorkPrefix(strings.ToLower(strings.TrimSpace(arch.EntityName)), fileLib)
+			if !strings.Contains(qualName, ".") {
+				qualName = fileLib + "." + qualName
+			}
+			if sym, ok := idx.Symbols.Get(qualName); ok && sym.Kind == "entity" {
+				continue
+			}
+			found = append(found, policy.OrphanArchitecture{
+				Architecture: arch.Name,
+				Entity:       arch.EntityName,
+				Library:      fileLib,
+				File:         facts.File,
+				Line:         arch.Line,
+			})
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].File != found[j].File {
+			return found[i].File < found[j].File
+		}
+		return found[i].Line < found[j].Line
+	})
+	return found
+}
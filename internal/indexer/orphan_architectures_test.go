@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestDetectOrphanArchitectures(t *testing.T) {
+	idx := New()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{
+		"core/fifo.vhd":     {LibraryName: "core"},
+		"core/fifo_rtl.vhd": {LibraryName: "core"},
+		"app/top.vhd":       {LibraryName: "app"},
+	}
+	idx.Facts = []extractor.FileFacts{
+		{File: "core/fifo.vhd", Entities: []extractor.Entity{{Name: "FIFO", Line: 1}}},
+		{
+			File: "core/fifo_rtl.vhd",
+			Architectures: []extractor.Architecture{
+				{Name: "rtl", EntityName: "fifo", Line: 3},
+				{Name: "alt", EntityName: "work.fifo", Line: 9},
+				{Name: "typo", EntityName: "fifoo", Line: 15},
+			},
+		},
+		{
+			// fifo exists, but in core rather than app
+			File:          "app/top.vhd",
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "fifo", Line: 2}},
+		},
+	}
+	for _, facts := range idx.Facts {
+		idx.registerSymbolsForFacts(facts, facts.File)
+	}
+
+	got := idx.detectOrphanArchitectures()
+	want := []policy.OrphanArchitecture{
+		{Architecture: "rtl", Entity: "fifo", Library: "app", File: "app/top.vhd", Line: 2},
+		{Architecture: "typo", Entity: "fifoo", Library: "core", File: "core/fifo_rtl.vhd", Line: 15},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
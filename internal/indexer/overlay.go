@@ -0,0 +1,83 @@
+package indexer
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// BytesExtractor is a FactsExtractor that can also extract facts from
+// content held in memory, as needed for Indexer.Overlays.
+type BytesExtractor interface {
+	ExtractBytes(path string, content []byte) (extractor.FileFacts, error)
+}
+
+// overlayFor returns the overlay content for path, if any. Paths are
+// compared in absolute form, so a discovered relative path matches an
+// overlay keyed by its absolute path.
+func (idx *Indexer) overlayFor(path string) ([]byte, bool) {
+	if len(idx.Overlays) == 0 {
+		return nil, false
+	}
+	if content, ok := idx.Overlays[path]; ok {
+		return content, true
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, false
+	}
+	for overlay, content := range idx.Overlays {
+		if overlayAbs, err := filepath.Abs(overlay); err == nil && overlayAbs == abs {
+			return content, true
+		}
+	}
+	return nil, false
+}
+
+// addOverlayFiles appends the overlays missing from files (a buffer not yet
+// saved to disk), unless the configuration ignores them.
+func (idx *Indexer) addOverlayFiles(files []string) []string {
+	present := make(map[string]bool, len(files))
+	for _, f := range files {
+		if abs, err := filepath.Abs(f); err == nil {
+			present[abs] = true
+		}
+	}
+	for overlay := range idx.Overlays {
+		abs, err := filepath.Abs(overlay)
+		if err != nil || present[abs] || idx.Config.ShouldIgnoreFile(overlay) {
+			continue
+		}
+		present[abs] = true
+		files = append(files, overlay)
+	}
+	return files
+}
+
+// extractOverlay extracts facts for path from its overlay content.
+func extractOverlay(ext FactsExtractor, path string, content []byte) (extractor.FileFacts, error) {
+	be, ok := ext.(BytesExtractor)
+	if !ok {
+		return extractor.FileFacts{File: path}, fmt.Errorf("extractor cannot read overlay content")
+	}
+	return be.ExtractBytes(path, content)
+}
+
+// applyReportFileFilter keeps only the violations in file, after the rest of
+// the project has been analyzed for cross-file context. No-op when file is
+// empty.
+func applyReportFileFilter(lintResult *LintResult, file string) {
+	if file == "" {
+		return
+	}
+	want, err := filepath.Abs(file)
+	if err != nil {
+		return
+	}
+	dropViolations(lintResult, func(v policy.Violation) bool {
+		abs, err := filepath.Abs(v.File)
+		return err != nil || abs != want
+	})
+}
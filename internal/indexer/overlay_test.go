@@ -0,0 +1,81 @@
+package indexer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// bytesStubExtractor records the content it was asked to extract.
+type bytesStubExtractor struct {
+	stubExtractor
+	content *string
+}
+
+func (s bytesStubExtractor) ExtractBytes(path string, content []byte) (extractor.FileFacts, error) {
+	*s.content = string(content)
+	return extractor.FileFacts{File: path}, nil
+}
+
+func TestOverlayFiles(t *testing.T) {
+	dir := t.TempDir()
+	onDisk := writeVHDL(t, dir, "a.vhd", "entity a is end;")
+	unsaved := filepath.Join(dir, "new.vhd")
+
+	idx := NewWithConfig(config.DefaultConfig())
+	idx.Overlays = map[string][]byte{
+		onDisk:  []byte("entity a is end entity;"),
+		unsaved: []byte("entity n is end;"),
+	}
+
+	files := idx.addOverlayFiles([]string{onDisk})
+	if len(files) != 2 || files[1] != unsaved {
+		t.Fatalf("expected the unsaved overlay to be added once, got %v", files)
+	}
+
+	unclean := dir + string(filepath.Separator) + "." + string(filepath.Separator) + "a.vhd"
+	content, ok := idx.overlayFor(unclean)
+	if !ok || string(content) != "entity a is end entity;" {
+		t.Fatalf("expected %s to match the overlay, got %q %v", unclean, content, ok)
+	}
+	if _, ok := idx.overlayFor(filepath.Join(dir, "b.vhd")); ok {
+		t.Fatalf("expected no overlay for other files")
+	}
+
+	var count int32
+	var seen string
+	ext := bytesStubExtractor{stubExtractor{count: &count}, &seen}
+	facts, err := extractOverlay(ext, unsaved, idx.Overlays[unsaved])
+	if err != nil || facts.File != unsaved || seen != "entity n is end;" {
+		t.Fatalf("expected overlay content extracted, got %+v %q %v", facts, seen, err)
+	}
+	if count != 0 {
+		t.Fatalf("expected the file on disk not to be read")
+	}
+	if _, err := extractOverlay(stubExtractor{count: &count}, unsaved, nil); err == nil {
+		t.Fatalf("expected an error from an extractor without ExtractBytes")
+	}
+}
+
+func TestApplyReportFileFilter(t *testing.T) {
+	dir := t.TempDir()
+	top := filepath.Join(dir, "top.vhd")
+	lintResult := LintResult{
+		Violations: []policy.Violation{
+			{Rule: "unused_signal", Severity: "warning", File: top, Line: 12},
+			{Rule: "magic_number", Severity: "info", File: filepath.Join(dir, "other.vhd"), Line: 5},
+		},
+		Summary: ResultSummary{TotalViolations: 2, Warnings: 1, Info: 1},
+	}
+
+	applyReportFileFilter(&lintResult, top)
+	if len(lintResult.Violations) != 1 || lintResult.Violations[0].File != top {
+		t.Fatalf("expected only top.vhd violations, got %+v", lintResult.Violations)
+	}
+	if lintResult.Summary.TotalViolations != 1 || lintResult.Summary.Info != 0 {
+		t.Fatalf("expected summary to drop the filtered violation, got %+v", lintResult.Summary)
+	}
+}
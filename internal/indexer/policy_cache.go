@@ -82,16 +82,38 @@ func policyConfigHash(input policy.Input) (string, error) {
 	}
 	thirdParty := append([]string{}, input.ThirdPartyFiles...)
 	sort.Strings(thirdParty)
+	magicNumbers := append([]policy.MagicNumber{}, input.MagicNumbers...)
+	sort.Slice(magicNumbers, func(i, j int) bool {
+		a, b := magicNumbers[i], magicNumbers[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Value != b.Value {
+			return a.Value < b.Value
+		}
+		return a.Context < b.Context
+	})
 	payload := struct {
 		Standard        string                `json:"standard"`
 		LintConfig      policy.LintRuleConfig `json:"lint_config"`
 		ThirdPartyFiles []string              `json:"third_party_files"`
 		PolicyVersion   string                `json:"policy_version"`
+		// Magic numbers depend on lint.magicNumberThreshold, which the
+		// cached facts do not capture
+		MagicNumbers []policy.MagicNumber `json:"magic_numbers"`
+		// Likewise suspicious clocks depend on lint.clockPatterns; they are
+		// detected in file order, so no sort is needed
+		SuspiciousClocks []policy.SuspiciousClock `json:"suspicious_clocks"`
 	}{
-		Standard:        input.Standard,
-		LintConfig:      input.LintConfig,
-		ThirdPartyFiles: thirdParty,
-		PolicyVersion:   policyVersion,
+		Standard:         input.Standard,
+		LintConfig:       input.LintConfig,
+		ThirdPartyFiles:  thirdParty,
+		PolicyVersion:    policyVersion,
+		MagicNumbers:     magicNumbers,
+		SuspiciousClocks: input.SuspiciousClocks,
 	}
 	data, err := json.Marshal(payload)
 	if err != nil {
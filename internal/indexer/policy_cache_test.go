@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
 	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
 )
 
@@ -75,6 +76,54 @@ func TestPolicyCacheRoundTripAndValidity(t *testing.T) {
 	}
 }
 
+func TestPolicyConfigHashIgnoresMagicNumberOrder(t *testing.T) {
+	first := policy.MagicNumber{Value: "100", Numeric: 100, Context: "comparison", File: "a.vhd", Line: 10}
+	second := policy.MagicNumber{Value: "3", Numeric: 3, Context: "arithmetic", File: "b.vhd", Line: 4}
+
+	hash, err := policyConfigHash(policy.Input{MagicNumbers: []policy.MagicNumber{first, second}})
+	if err != nil {
+		t.Fatalf("policyConfigHash error: %v", err)
+	}
+	reordered, err := policyConfigHash(policy.Input{MagicNumbers: []policy.MagicNumber{second, first}})
+	if err != nil {
+		t.Fatalf("policyConfigHash error: %v", err)
+	}
+	if hash != reordered {
+		t.Fatalf("expected the hash not to depend on magic number order")
+	}
+
+	fewer, err := policyConfigHash(policy.Input{MagicNumbers: []policy.MagicNumber{first}})
+	if err != nil {
+		t.Fatalf("policyConfigHash error: %v", err)
+	}
+	if hash == fewer {
+		t.Fatalf("expected the hash to change when a magic number is no longer reported")
+	}
+}
+
+func TestPolicyConfigHashTracksClockPatterns(t *testing.T) {
+	idx := New()
+	idx.Facts = []extractor.FileFacts{{
+		File:          "core_rtl.vhd",
+		Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "core"}},
+		Signals:       []extractor.Signal{{Name: "clk_pll_100", InEntity: "rtl"}},
+		Processes:     []extractor.Process{{Label: "p_pll", ClockSignal: "clk_pll_100", Line: 10, InArch: "rtl"}},
+	}}
+
+	hash, err := policyConfigHash(policy.Input{SuspiciousClocks: idx.detectSuspiciousClocks()})
+	if err != nil {
+		t.Fatalf("policyConfigHash error: %v", err)
+	}
+	idx.Config.Lint.ClockPatterns = []string{"clk_pll_.*"}
+	allowed, err := policyConfigHash(policy.Input{SuspiciousClocks: idx.detectSuspiciousClocks()})
+	if err != nil {
+		t.Fatalf("policyConfigHash error: %v", err)
+	}
+	if hash == allowed {
+		t.Fatalf("expected the hash to change with lint.clockPatterns")
+	}
+}
+
 func TestClearPolicyCache(t *testing.T) {
 	dir := t.TempDir()
 	entry := policyCacheEntry{
@@ -99,3 +148,22 @@ func TestClearPolicyCache(t *testing.T) {
 		t.Fatalf("expected cache file to be removed, got err: %v", err)
 	}
 }
+
+func TestCheckCacheDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "shared", "cache")
+	if err := CheckCacheDir(dir); err != nil {
+		t.Fatalf("CheckCacheDir error: %v", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("expected an empty created directory, got %v (%v)", entries, err)
+	}
+
+	file := filepath.Join(t.TempDir(), "not_a_dir")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckCacheDir(filepath.Join(file, "cache")); err == nil {
+		t.Fatalf("expected an error for a directory that cannot be created")
+	}
+}
@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+type portDecl struct {
+	name string
+	file string
+	line int
+}
+
+// detectPortsShadowedBySignals reports architecture signals that reuse the
+// name of a port on the architecture's entity. The declaration is legal, but
+// every reference inside the architecture then resolves to the signal and the
+// port is silently left unconnected. Entities and architectures may live in
+// different files, so this runs over all facts.
+func detectPortsShadowedBySignals(allFacts []extractor.FileFacts) []policy.PortShadowedBySignal {
+	sorted := make([]extractor.FileFacts, len(allFacts))
+	copy(sorted, allFacts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].File < sorted[j].File })
+
+	// entity (lowercase) -> port name (lowercase) -> declaration; first file wins
+	portsByEntity := make(map[string]map[string]portDecl)
+	for _, facts := range sorted {
+		declaredHere := make(map[string]bool)
+		for _, ent := range facts.Entities {
+			key := strings.ToLower(ent.Name)
+			if _, seen := portsByEntity[key]; !seen {
+				portsByEntity[key] = make(map[string]portDecl)
+				declaredHere[key] = true
+			}
+		}
+		for _, port := range facts.Ports {
+			key := strings.ToLower(port.InEntity)
+			if !declaredHere[key] {
+				continue
+			}
+			name := strings.ToLower(port.Name)
+			if _, dup := portsByEntity[key][name]; !dup {
+				portsByEntity[key][name] = portDecl{name: port.Name, file: facts.File, line: port.Line}
+			}
+		}
+	}
+
+	found := []policy.PortShadowedBySignal{}
+	for _, facts := range sorted {
+		for _, sig := range facts.Signals {
+			arch, ok := architectureForSignal(facts.Architectures, sig)
+			if !ok {
+				continue
+			}
+			port, ok := portsByEntity[strings.ToLower(arch.EntityName)][strings.ToLower(sig.Name)]
+			if !ok {
+				continue
+			}
+			found = append(found, policy.PortShadowedBySignal{
+				Signal:       sig.Name,
+				Port:         port.name,
+				Entity:       arch.EntityName,
+				Architecture: arch.Name,
+				File:         facts.File,
+				Line:         sig.Line,
+				PortFile:     port.file,
+				PortLine:     port.line,
+			})
+		}
+	}
+	return found
+}
+
+// architectureForSignal finds the architecture a signal is declared in. Signals
+// record only the architecture name (plus any generate scope), so when a file
+// holds several same-named architectures the closest one above the signal wins.
+func architectureForSignal(archs []extractor.Architecture, sig extractor.Signal) (extractor.Architecture, bool) {
+	return architectureAt(archs, sig.InEntity, sig.Line)
+}
+
+// architectureAt finds the architecture named by scope (ignoring any generate
+// suffix) that is closest above line.
+func architectureAt(archs []extractor.Architecture, scope string, line int) (extractor.Architecture, bool) {
+	scope = archScopeBase(scope)
+	var best extractor.Architecture
+	found := false
+	for _, arch := range archs {
+		if !strings.EqualFold(arch.Name, scope) || arch.Line > line {
+			continue
+		}
+		if !found || arch.Line > best.Line {
+			best = arch
+			found = true
+		}
+	}
+	return best, found
+}
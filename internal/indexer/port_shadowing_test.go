@@ -0,0 +1,44 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestDetectPortsShadowedBySignals(t *testing.T) {
+	allFacts := []extractor.FileFacts{
+		{
+			File:     "core_ent.vhd",
+			Entities: []extractor.Entity{{Name: "core", Line: 1}},
+			Ports: []extractor.Port{
+				{Name: "valid_o", Direction: "out", Line: 4, InEntity: "core"},
+				{Name: "data_i", Direction: "in", Line: 3, InEntity: "core"},
+			},
+		},
+		{
+			File: "core_rtl.vhd",
+			Architectures: []extractor.Architecture{
+				{Name: "rtl", EntityName: "core", Line: 1},
+				{Name: "rtl", EntityName: "other", Line: 30},
+			},
+			Signals: []extractor.Signal{
+				{Name: "VALID_O", Line: 2, InEntity: "rtl"},
+				{Name: "busy", Line: 3, InEntity: "rtl"},
+				{Name: "data_i", Line: 31, InEntity: "rtl"},
+			},
+		},
+	}
+
+	found := detectPortsShadowedBySignals(allFacts)
+	if len(found) != 1 {
+		t.Fatalf("expected 1 shadowed port, got %d: %+v", len(found), found)
+	}
+	got := found[0]
+	if got.Signal != "VALID_O" || got.Port != "valid_o" || got.Entity != "core" {
+		t.Fatalf("unexpected finding %+v", got)
+	}
+	if got.File != "core_rtl.vhd" || got.Line != 2 || got.PortFile != "core_ent.vhd" || got.PortLine != 4 {
+		t.Fatalf("unexpected locations %+v", got)
+	}
+}
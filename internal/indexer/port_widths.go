@@ -0,0 +1,146 @@
+package indexer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// portActualRe matches a whole or sliced name used as a port actual:
+// "data" or "data(7 downto 0)".
+var portActualRe = regexp.MustCompile(`^([A-Za-z]\w*)\s*(?:\((.*)\))?$`)
+
+// checkPortWidths compares, for each port association of each instance, the
+// width of the formal port on the resolved entity or component against the
+// width of the actual signal or port declared in the instantiating
+// architecture. A sliced actual takes the width of its index range. Widths
+// that cannot be computed (unresolved bounds, records, indexed elements,
+// expressions) are skipped.
+func (idx *Indexer) checkPortWidths() []policy.WidthMismatch {
+	sorted, factsByFile, packageComponents := idx.instanceResolutionIndex()
+	constants := idx.globalConstants()
+	types := idx.buildTypeWidths(constants)
+
+	found := []policy.WidthMismatch{}
+	for _, facts := range sorted {
+		locals := localWidths(facts, types)
+		for _, inst := range facts.Instances {
+			unit, ok := idx.resolveInstanceUnit(facts, inst, factsByFile, packageComponents)
+			if !ok || len(unit.Ports) == 0 {
+				continue
+			}
+			scope := strings.ToLower(archScopeBase(inst.InArch))
+			for _, assoc := range portAssociations(inst) {
+				if assoc.ActualKind != "" && assoc.ActualKind != "name" {
+					continue
+				}
+				port, ok := formalPort(unit.Ports, assoc)
+				if !ok {
+					continue
+				}
+				formalWidth := types.width(port.Type)
+				actualWidth := actualPortWidth(assoc.Actual, scope, locals, constants)
+				if formalWidth <= 0 || actualWidth <= 0 || formalWidth == actualWidth {
+					continue
+				}
+				line := assoc.Line
+				if line == 0 {
+					line = inst.Line
+				}
+				found = append(found, policy.WidthMismatch{
+					Instance:    inst.Name,
+					Formal:      port.Name,
+					Actual:      strings.TrimSpace(assoc.Actual),
+					FormalWidth: formalWidth,
+					ActualWidth: actualWidth,
+					File:        facts.File,
+					Line:        line,
+					InArch:      inst.InArch,
+				})
+			}
+		}
+	}
+	return found
+}
+
+// portAssociations returns an instance's port associations, falling back to
+// its named port map when no association elements were extracted.
+func portAssociations(inst extractor.Instance) []extractor.Association {
+	var assocs []extractor.Association
+	for _, assoc := range inst.Associations {
+		if assoc.Kind == "port" {
+			assocs = append(assocs, assoc)
+		}
+	}
+	if len(assocs) > 0 {
+		return assocs
+	}
+	for formal, actual := range inst.PortMap {
+		assocs = append(assocs, extractor.Association{Kind: "port", Formal: formal, Actual: actual})
+	}
+	return assocs
+}
+
+// formalPort finds the port an association binds, by name or position.
+// Partial formals such as "data(3 downto 0) => x" are not matched.
+func formalPort(ports []extractor.Port, assoc extractor.Association) (extractor.Port, bool) {
+	if assoc.IsPositional {
+		if assoc.PositionIndex >= 0 && assoc.PositionIndex < len(ports) {
+			return ports[assoc.PositionIndex], true
+		}
+		return extractor.Port{}, false
+	}
+	formal := strings.TrimSpace(assoc.Formal)
+	for _, p := range ports {
+		if strings.EqualFold(p.Name, formal) {
+			return p, true
+		}
+	}
+	return extractor.Port{}, false
+}
+
+// scopedName is a lowercase name declared in a lowercase architecture.
+type scopedName struct {
+	scope string
+	name  string
+}
+
+// localWidths maps (lowercase architecture, lowercase name) to the width of
+// the signals an architecture declares and the ports of its entity.
+func localWidths(facts extractor.FileFacts, types *typeWidths) map[scopedName]int {
+	widths := make(map[scopedName]int)
+	for _, sig := range facts.Signals {
+		widths[scopedName{strings.ToLower(archScopeBase(sig.InEntity)), strings.ToLower(sig.Name)}] =
+			types.width(sig.Type)
+	}
+	for _, arch := range facts.Architectures {
+		for _, p := range facts.Ports {
+			if strings.EqualFold(p.InEntity, arch.EntityName) {
+				widths[scopedName{strings.ToLower(arch.Name), strings.ToLower(p.Name)}] =
+					types.width(p.Type)
+			}
+		}
+	}
+	return widths
+}
+
+// actualPortWidth returns the width of a port actual in the architecture
+// scope, or 0 when it is not a declared name or a range slice of one.
+func actualPortWidth(actual, scope string, locals map[scopedName]int, constants map[string]int) int {
+	match := portActualRe.FindStringSubmatch(strings.TrimSpace(actual))
+	if match == nil {
+		return 0
+	}
+	width, ok := locals[scopedName{scope, strings.ToLower(match[1])}]
+	if !ok || width <= 0 {
+		return 0
+	}
+	if match[2] == "" {
+		return width
+	}
+	// An index range narrows the width; a single index selects an element
+	// whose width is unknown
+	return extractor.RangeWidth(match[2], constants)
+}
@@ -0,0 +1,65 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestCheckPortWidths(t *testing.T) {
+	idx := New()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{}
+	idx.Facts = []extractor.FileFacts{
+		{
+			File: "cpu.vhd",
+			Entities: []extractor.Entity{{
+				Name: "cpu",
+				Line: 1,
+				Ports: []extractor.Port{
+					{Name: "addr_i", Direction: "in", Type: "std_logic_vector(15 downto 0)"},
+					{Name: "data_o", Direction: "out", Type: "std_logic_vector(7 downto 0)"},
+					{Name: "irq_i", Direction: "in", Type: "std_logic"},
+				},
+			}},
+		},
+		{
+			File:          "top.vhd",
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top"}},
+			Ports:         []extractor.Port{{Name: "irq", Direction: "in", Type: "std_logic_vector(1 downto 0)", InEntity: "top"}},
+			Signals: []extractor.Signal{
+				{Name: "addr", Type: "std_logic_vector(7 downto 0)", InEntity: "rtl"},
+				{Name: "bus_s", Type: "std_logic_vector(31 downto 0)", InEntity: "rtl"},
+			},
+			Instances: []extractor.Instance{
+				{Name: "u_cpu", Target: "work.cpu", Line: 10, InArch: "rtl", Associations: []extractor.Association{
+					{Kind: "port", Formal: "addr_i", Actual: "addr", ActualKind: "name", Line: 11},
+					{Kind: "port", Formal: "data_o", Actual: "bus_s(7 downto 0)", ActualKind: "name", Line: 12},
+					{Kind: "port", Formal: "irq_i", Actual: "irq", ActualKind: "name", Line: 13},
+				}},
+				{Name: "u_cpu2", Target: "work.cpu", Line: 20, InArch: "rtl", Associations: []extractor.Association{
+					{Kind: "port", Actual: "bus_s(15 downto 0)", ActualKind: "name", IsPositional: true, PositionIndex: 0, Line: 20},
+					{Kind: "port", Actual: "bus_s(3 downto 0)", ActualKind: "name", IsPositional: true, PositionIndex: 1, Line: 20},
+					{Kind: "port", Actual: "irq(0)", ActualKind: "name", IsPositional: true, PositionIndex: 2, Line: 20},
+				}},
+			},
+		},
+	}
+	for _, facts := range idx.Facts {
+		idx.registerSymbolsForFacts(facts, facts.File)
+	}
+
+	got := idx.checkPortWidths()
+	if len(got) != 3 {
+		t.Fatalf("expected 3 width mismatches, got %+v", got)
+	}
+	if m := got[0]; m.Instance != "u_cpu" || m.Formal != "addr_i" || m.Actual != "addr" || m.FormalWidth != 16 || m.ActualWidth != 8 || m.Line != 11 {
+		t.Errorf("unexpected addr mismatch: %+v", m)
+	}
+	if m := got[1]; m.Formal != "irq_i" || m.FormalWidth != 1 || m.ActualWidth != 2 {
+		t.Errorf("unexpected port actual mismatch: %+v", m)
+	}
+	if m := got[2]; m.Instance != "u_cpu2" || m.Formal != "data_o" || m.ActualWidth != 4 {
+		t.Errorf("unexpected positional slice mismatch: %+v", m)
+	}
+}
@@ -0,0 +1,53 @@
+package indexer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEmitProgressJSONConcurrent(t *testing.T) {
+	var buf bytes.Buffer
+	var mu sync.Mutex
+	done := 0
+	const total = 50
+
+	var wg sync.WaitGroup
+	for i := 0; i < total; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			status := "extracted"
+			if i%2 == 0 {
+				status = "cache_hit"
+			}
+			emitProgressJSON(&buf, &mu, &done, total, fmt.Sprintf("f%d.vhd", i), status, 1500*time.Microsecond)
+		}(i)
+	}
+	wg.Wait()
+
+	seen := make(map[int]bool)
+	scanner := bufio.NewScanner(&buf)
+	lines := 0
+	for scanner.Scan() {
+		lines++
+		var ev ProgressEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			t.Fatalf("line %d is not a JSON event: %v (%q)", lines, err, scanner.Text())
+		}
+		if ev.Event != "file" || ev.Total != total || ev.DurationMs != 1 {
+			t.Fatalf("unexpected event %+v", ev)
+		}
+		if ev.Status != "extracted" && ev.Status != "cache_hit" {
+			t.Fatalf("unexpected status %q", ev.Status)
+		}
+		seen[ev.Done] = true
+	}
+	if lines != total || len(seen) != total || !seen[1] || !seen[total] {
+		t.Fatalf("expected %d events counting 1..%d, got %d lines and counts %v", total, total, lines, seen)
+	}
+}
@@ -0,0 +1,202 @@
+package indexer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// detectIncompleteRecordAggregates reports aggregates assigned to a whole
+// record-typed signal or port that leave fields out and have no others
+// choice. Positional elements cover the leading fields in declaration
+// order and named elements cover the fields they choose. The target's type
+// is looked up like a case selector's; record types come from the file's
+// own declarations first, then from every analyzed package, and a record
+// name declared with different fields in several places is skipped.
+func (idx *Indexer) detectIncompleteRecordAggregates() []policy.IncompleteRecordAggregate {
+	sorted := make([]extractor.FileFacts, len(idx.Facts))
+	copy(sorted, idx.Facts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].File < sorted[j].File })
+
+	global := make(map[string][]string)
+	conflicting := make(map[string]bool)
+	for _, facts := range sorted {
+		for _, td := range facts.Types {
+			if td.Kind != "record" {
+				continue
+			}
+			name := strings.ToLower(td.Name)
+			fields := recordFieldNames(td)
+			if prev, ok := global[name]; ok && !sameLiterals(prev, fields) {
+				conflicting[name] = true
+			}
+			global[name] = fields
+		}
+	}
+	for name := range conflicting {
+		delete(global, name)
+	}
+
+	found := []policy.IncompleteRecordAggregate{}
+	for _, facts := range sorted {
+		for _, drive := range facts.AssignmentDrives {
+			if !drive.Whole {
+				continue
+			}
+			typ := objectType(facts, drive.Target, drive.InProcess, drive.InArch)
+			recordType, fields := recordFieldsOf(facts, typ, global)
+			if len(fields) == 0 {
+				continue
+			}
+			for _, value := range drive.Values {
+				elements, ok := parseAggregate(value)
+				if !ok {
+					continue
+				}
+				missing := missingRecordFields(elements, fields)
+				if len(missing) == 0 {
+					continue
+				}
+				found = append(found, policy.IncompleteRecordAggregate{
+					Target:    drive.Target,
+					Type:      recordType,
+					Missing:   missing,
+					File:      facts.File,
+					Line:      drive.Line,
+					InProcess: drive.InProcess,
+					InArch:    drive.InArch,
+				})
+			}
+		}
+	}
+	return found
+}
+
+// recordFieldNames returns the lowercase field names of a record type in
+// declaration order.
+func recordFieldNames(td extractor.TypeDeclaration) []string {
+	names := make([]string, 0, len(td.Fields))
+	for _, f := range td.Fields {
+		names = append(names, strings.ToLower(f.Name))
+	}
+	return names
+}
+
+// recordFieldsOf returns the name and lowercase field names of the record
+// type a subtype indication names, following unconstrained subtypes of it,
+// or nil fields when it is not a known record.
+func recordFieldsOf(facts extractor.FileFacts, typ string, global map[string][]string) (string, []string) {
+	name := typeMark(typ)
+	for depth := 0; name != "" && depth < 8; depth++ {
+		for _, td := range facts.Types {
+			if td.Kind == "record" && strings.EqualFold(td.Name, name) {
+				return td.Name, recordFieldNames(td)
+			}
+		}
+		if fields, ok := global[name]; ok {
+			return name, fields
+		}
+		next := ""
+		for _, st := range facts.Subtypes {
+			if strings.EqualFold(st.Name, name) && st.Constraint == "" {
+				next = typeMark(st.BaseType)
+			}
+		}
+		name = next
+	}
+	return "", nil
+}
+
+// aggregateElement is one element of an aggregate: its lowercase choices,
+// or none for a positional element.
+type aggregateElement struct {
+	Choices []string
+}
+
+// parseAggregate splits an expression that is a single parenthesized
+// aggregate into its elements. A parenthesized expression with one
+// positional element is not an aggregate.
+func parseAggregate(expr string) ([]aggregateElement, bool) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "(") || !strings.HasSuffix(expr, ")") {
+		return nil, false
+	}
+	inner := expr[1 : len(expr)-1]
+	// The first parenthesis must close at the end: "(a) + (b)" is not an aggregate
+	if parts := splitAggregateText(inner, ')'); len(parts) > 1 {
+		return nil, false
+	}
+
+	var elements []aggregateElement
+	for _, part := range splitAggregateText(inner, ',') {
+		var el aggregateElement
+		// The first "=" outside parentheses starts the arrow of a named element
+		if sides := splitAggregateText(part, '='); len(sides) > 1 && strings.HasPrefix(sides[1], ">") {
+			for _, choice := range strings.Split(sides[0], "|") {
+				el.Choices = append(el.Choices, strings.ToLower(strings.TrimSpace(choice)))
+			}
+		}
+		elements = append(elements, el)
+	}
+	if len(elements) == 1 && len(elements[0].Choices) == 0 {
+		return nil, false
+	}
+	return elements, true
+}
+
+// splitAggregateText splits s at sep outside parentheses, string literals,
+// and character literals. Splitting at ')' finds a closing parenthesis
+// with no matching opener.
+func splitAggregateText(s string, sep byte) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"':
+			if j := strings.IndexByte(s[i+1:], '"'); j >= 0 {
+				i += j + 1
+			}
+		case c == '\'' && i+2 < len(s) && s[i+2] == '\'':
+			i += 2
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(parts, strings.TrimSpace(s[start:]))
+}
+
+// missingRecordFields returns the fields an aggregate leaves out, in
+// declaration order, or nil when it has an others choice.
+func missingRecordFields(elements []aggregateElement, fields []string) []string {
+	covered := make(map[string]bool)
+	positional := 0
+	for _, el := range elements {
+		if len(el.Choices) == 0 {
+			if positional < len(fields) {
+				covered[fields[positional]] = true
+			}
+			positional++
+			continue
+		}
+		for _, choice := range el.Choices {
+			if choice == "others" {
+				return nil
+			}
+			covered[choice] = true
+		}
+	}
+	var missing []string
+	for _, f := range fields {
+		if !covered[f] {
+			missing = append(missing, f)
+		}
+	}
+	return missing
+}
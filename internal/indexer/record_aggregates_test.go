@@ -0,0 +1,77 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestDetectIncompleteRecordAggregates(t *testing.T) {
+	idx := New()
+	idx.Facts = []extractor.FileFacts{
+		{
+			File: "bus_pkg.vhd",
+			Types: []extractor.TypeDeclaration{{
+				Name:      "req_t",
+				Kind:      "record",
+				InPackage: "bus_pkg",
+				Fields: []extractor.RecordField{
+					{Name: "valid", Type: "std_logic"},
+					{Name: "addr", Type: "std_logic_vector(7 downto 0)"},
+					{Name: "data", Type: "std_logic_vector(7 downto 0)"},
+				},
+			}},
+		},
+		{
+			File:          "master.vhd",
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "master"}},
+			Ports:         []extractor.Port{{Name: "req_o", Type: "work.bus_pkg.req_t", InEntity: "master"}},
+			Signals: []extractor.Signal{
+				{Name: "req_s", Type: "req_t", InEntity: "rtl"},
+				{Name: "cmd_s", Type: "cmd_t", InEntity: "rtl"},
+			},
+			Subtypes: []extractor.SubtypeDeclaration{{Name: "cmd_t", BaseType: "req_t"}},
+			AssignmentDrives: []extractor.AssignmentDrive{
+				{Target: "req_s", Whole: true, Values: []string{"(valid => '1', addr => x\"00\")"}, Line: 10, InArch: "rtl"},
+				{Target: "req_s", Whole: true, Values: []string{"('1', x\"00\", x\"ff\")"}, Line: 11, InArch: "rtl"},
+				{Target: "req_s", Whole: true, Values: []string{"(valid => '1', others => (others => '0'))"}, Line: 12, InArch: "rtl"},
+				{Target: "req_o", Whole: true, Values: []string{"('1', (others => '0'), data => x\"ff\")", "(valid | data => '0', addr => x\"01\")", "req_s"}, Line: 13, InArch: "rtl"},
+				{Target: "req_o", Whole: true, Values: []string{"('0', data => (x\"0\" & ','))"}, Line: 14, InArch: "rtl"},
+				{Target: "req_s", Whole: false, Values: []string{"('1')"}, Line: 15, InArch: "rtl"},
+				{Target: "cmd_s", Whole: true, Values: []string{"(addr => x\"00\", data => x\"00\")"}, Line: 16, InProcess: "p", InArch: "rtl"},
+			},
+		},
+	}
+
+	want := []policy.IncompleteRecordAggregate{
+		{Target: "req_s", Type: "req_t", Missing: []string{"data"}, File: "master.vhd", Line: 10, InArch: "rtl"},
+		{Target: "req_o", Type: "req_t", Missing: []string{"addr"}, File: "master.vhd", Line: 14, InArch: "rtl"},
+		{Target: "cmd_s", Type: "req_t", Missing: []string{"valid"}, File: "master.vhd", Line: 16, InProcess: "p", InArch: "rtl"},
+	}
+	if got := idx.detectIncompleteRecordAggregates(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestParseAggregate(t *testing.T) {
+	for expr, want := range map[string]int{
+		"(a => '1', b => '0')":    2,
+		"('(', ')')":              2,
+		"(others => '0')":         1,
+		"(a)":                     -1,
+		"(a) + (b)":               -1,
+		"a & (b, c)":              -1,
+		"(f(x, y), \"a,b\", 'c')": 3,
+	} {
+		elements, ok := parseAggregate(expr)
+		got := len(elements)
+		if !ok {
+			got = -1
+		}
+		if got != want {
+			t.Errorf("parseAggregate(%q) = %d elements, want %d", expr, got, want)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package indexer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// detectInconsistentResetPolarity groups reset usages by signal name across all
+// files and reports reset nets that are compared with more than one polarity.
+// A mismatch usually means two modules disagree on whether rst_n is active-low,
+// which only shows up once the design is integrated. Polarities are the ones
+// the extractor derives from each reset condition; usages whose condition it
+// could not classify (empty polarity) are left out rather than guessed.
+func detectInconsistentResetPolarity(allFacts []extractor.FileFacts) []policy.InconsistentResetPolarity {
+	usagesBySignal := make(map[string][]policy.ResetPolarityUsage)
+	for _, facts := range allFacts {
+		for _, ri := range facts.ResetInfos {
+			name := strings.ToLower(strings.TrimSpace(ri.Signal))
+			if name == "" || ri.Polarity == "" {
+				continue
+			}
+			usagesBySignal[name] = append(usagesBySignal[name], policy.ResetPolarityUsage{
+				Process:  ri.Process,
+				Polarity: ri.Polarity,
+				File:     facts.File,
+				Line:     ri.Line,
+			})
+		}
+	}
+
+	signals := make([]string, 0, len(usagesBySignal))
+	for name := range usagesBySignal {
+		signals = append(signals, name)
+	}
+	sort.Strings(signals)
+
+	conflicts := []policy.InconsistentResetPolarity{}
+	for _, name := range signals {
+		usages := usagesBySignal[name]
+		sort.Slice(usages, func(i, j int) bool {
+			if usages[i].File != usages[j].File {
+				return usages[i].File < usages[j].File
+			}
+			return usages[i].Line < usages[j].Line
+		})
+
+		counts := make(map[string]int)
+		var polarities []string
+		for _, u := range usages {
+			if counts[u.Polarity] == 0 {
+				polarities = append(polarities, u.Polarity)
+			}
+			counts[u.Polarity]++
+		}
+		if len(polarities) < 2 {
+			continue
+		}
+
+		// The majority polarity is treated as the design's intent; the first
+		// usage that disagrees with it is where the warning is anchored.
+		expected := polarities[0]
+		for _, p := range polarities[1:] {
+			if counts[p] > counts[expected] {
+				expected = p
+			}
+		}
+		anchor := usages[0]
+		for _, u := range usages {
+			if u.Polarity != expected {
+				anchor = u
+				break
+			}
+		}
+
+		sort.Strings(polarities)
+		conflicts = append(conflicts, policy.InconsistentResetPolarity{
+			Signal:           name,
+			ExpectedPolarity: expected,
+			Polarities:       polarities,
+			Usages:           usages,
+			File:             anchor.File,
+			Line:             anchor.Line,
+		})
+	}
+	return conflicts
+}
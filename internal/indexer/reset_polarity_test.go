@@ -0,0 +1,129 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestDetectInconsistentResetPolarity(t *testing.T) {
+	allFacts := []extractor.FileFacts{
+		{
+			File: "a.vhd",
+			ResetInfos: []extractor.ResetInfo{
+				{Signal: "rst_n", Polarity: "active_low", Process: "p_a", Line: 10},
+				{Signal: "sys_rst", Polarity: "active_high", Process: "p_b", Line: 20},
+			},
+		},
+		{
+			File: "b.vhd",
+			ResetInfos: []extractor.ResetInfo{
+				{Signal: "RST_N", Polarity: "active_low", Process: "p_c", Line: 5},
+				{Signal: "rst_n", Polarity: "active_high", Process: "p_d", Line: 15},
+				{Signal: "sys_rst", Polarity: "active_high", Process: "p_e", Line: 25},
+			},
+		},
+	}
+
+	conflicts := detectInconsistentResetPolarity(allFacts)
+	if len(conflicts) != 1 {
+		t.Fatalf("expected 1 conflict, got %d: %+v", len(conflicts), conflicts)
+	}
+	got := conflicts[0]
+	if got.Signal != "rst_n" {
+		t.Fatalf("expected conflict on rst_n, got %q", got.Signal)
+	}
+	if got.ExpectedPolarity != "active_low" {
+		t.Fatalf("expected majority polarity active_low, got %q", got.ExpectedPolarity)
+	}
+	if got.File != "b.vhd" || got.Line != 15 {
+		t.Fatalf("expected anchor at b.vhd:15, got %s:%d", got.File, got.Line)
+	}
+	if len(got.Usages) != 3 {
+		t.Fatalf("expected 3 usages, got %d", len(got.Usages))
+	}
+}
+
+func TestDetectInconsistentResetPolaritySkipsUnknownPolarity(t *testing.T) {
+	allFacts := []extractor.FileFacts{{
+		File: "a.vhd",
+		ResetInfos: []extractor.ResetInfo{
+			{Signal: "rst", Polarity: "active_high", Process: "p_a", Line: 10},
+			{Signal: "rst", Process: "p_b", Line: 20},
+		},
+	}}
+	if conflicts := detectInconsistentResetPolarity(allFacts); len(conflicts) != 0 {
+		t.Fatalf("expected an unclassified reset condition not to conflict, got %+v", conflicts)
+	}
+}
+
+func TestDetectInconsistentResetPolarityFromExtractedFacts(t *testing.T) {
+	dir := t.TempDir()
+	files := []string{
+		writeVHDL(t, dir, "a.vhd", `library ieee;
+use ieee.std_logic_1164.all;
+entity a is
+  port (clk, rst_n, d : in std_logic; q : out std_logic_vector(1 downto 0));
+end entity;
+architecture rtl of a is
+begin
+  p_low : process(clk, rst_n)
+  begin
+    if rst_n = '0' then
+      q(0) <= '0';
+    elsif rising_edge(clk) then
+      q(0) <= d;
+    end if;
+  end process;
+
+  p_inverted : process(clk, rst_n)
+  begin
+    if rst_n /= '1' then
+      q(1) <= '0';
+    elsif rising_edge(clk) then
+      q(1) <= d;
+    end if;
+  end process;
+end architecture;
+`),
+		writeVHDL(t, dir, "b.vhd", `library ieee;
+use ieee.std_logic_1164.all;
+entity b is
+  port (clk, rst_n, d : in std_logic; q : out std_logic);
+end entity;
+architecture rtl of b is
+begin
+  p_high : process(clk, rst_n)
+  begin
+    if rst_n = '1' then
+      q <= '0';
+    elsif rising_edge(clk) then
+      q <= d;
+    end if;
+  end process;
+end architecture;
+`),
+	}
+
+	ext := extractor.New()
+	var allFacts []extractor.FileFacts
+	for _, file := range files {
+		facts, err := ext.Extract(file)
+		if err != nil {
+			t.Fatalf("extract %s: %v", file, err)
+		}
+		allFacts = append(allFacts, facts)
+	}
+
+	conflicts := detectInconsistentResetPolarity(allFacts)
+	if len(conflicts) != 1 {
+		t.Fatalf("expected 1 conflict, got %d: %+v", len(conflicts), conflicts)
+	}
+	got := conflicts[0]
+	if got.Signal != "rst_n" || got.ExpectedPolarity != "active_low" {
+		t.Fatalf("expected rst_n to be active_low by majority, got %+v", got)
+	}
+	if got.File != files[1] {
+		t.Fatalf("expected the active-high usage in b.vhd as anchor, got %s:%d", got.File, got.Line)
+	}
+}
@@ -0,0 +1,162 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestResultSummaryCountAtOrAbove(t *testing.T) {
+	summary := ResultSummary{TotalViolations: 6, Errors: 1, Warnings: 2, Info: 3}
+	cases := map[string]int{
+		"error":   1,
+		"warning": 3,
+		"info":    6,
+		"none":    0,
+		"bogus":   0,
+	}
+	for level, want := range cases {
+		if got := summary.CountAtOrAbove(level); got != want {
+			t.Errorf("CountAtOrAbove(%q) = %d, want %d", level, got, want)
+		}
+	}
+	if got := (ResultSummary{Info: 4}).CountAtOrAbove("warning"); got != 0 {
+		t.Errorf("info-only summary should not reach warning, got %d", got)
+	}
+}
+
+func TestResultSummaryByRule(t *testing.T) {
+	var violations []policy.Violation
+	for i := 0; i < 12; i++ {
+		rule := fmt.Sprintf("rule_%02d", i)
+		for n := 0; n <= i%3; n++ {
+			violations = append(violations, policy.Violation{Rule: rule, Severity: "warning", File: fmt.Sprintf("f%d.vhd", n), Line: 1})
+		}
+	}
+	lintResult := LintResult{}
+	applyPolicyResult(&lintResult, &policy.Result{
+		Violations: violations,
+		Summary:    policy.Summary{TotalViolations: len(violations), Warnings: len(violations)},
+	}, nil, nil)
+	dropViolations(&lintResult, func(v policy.Violation) bool { return v.Rule == "rule_11" && v.File == "f2.vhd" })
+
+	total := 0
+	for _, n := range lintResult.Summary.ByRule {
+		total += n
+	}
+	if total != lintResult.Summary.TotalViolations || total != len(lintResult.Violations) {
+		t.Fatalf("by_rule sums to %d, want %d", total, lintResult.Summary.TotalViolations)
+	}
+	fileTotal := 0
+	for _, fr := range lintResult.Files {
+		for _, n := range fr.ByRule {
+			fileTotal += n
+		}
+	}
+	if fileTotal != total {
+		t.Fatalf("per-file by_rule sums to %d, want %d", fileTotal, total)
+	}
+
+	top := lintResult.Summary.TopRules
+	if len(top) != topRulesLimit {
+		t.Fatalf("expected %d top rules, got %+v", topRulesLimit, top)
+	}
+	want := []RuleCount{{Rule: "rule_02", Count: 3}, {Rule: "rule_05", Count: 3}, {Rule: "rule_08", Count: 3}, {Rule: "rule_01", Count: 2}}
+	for i, rc := range want {
+		if top[i] != rc {
+			t.Fatalf("top rule %d = %+v, want %+v (all: %+v)", i, top[i], rc, top)
+		}
+	}
+}
+
+func TestLintResultCarriesSchemaVersion(t *testing.T) {
+	idx := New()
+	result := idx.newLintResult(0, &policy.Input{}, nil)
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), `{"schema_version":"`+ResultSchemaVersion+`"`) {
+		t.Fatalf("expected schema_version first in the JSON, got %s", data)
+	}
+}
+
+func TestLintResultParseErrorNodes(t *testing.T) {
+	idx := New()
+	limitErr := &extractor.ParseErrorLimitError{ErrorNodes: 120, TotalNodes: 400, Limit: 20}
+	errs := []error{fmt.Errorf("a.vhd: %w", limitErr), fmt.Errorf("b.vhd: read failed")}
+	result := idx.newLintResult(2, &policy.Input{}, errs)
+	if len(result.ParseErrors) != 2 {
+		t.Fatalf("expected 2 parse errors, got %+v", result.ParseErrors)
+	}
+	if got := result.ParseErrors[0]; got.ErrorNodes != 120 || !strings.Contains(got.Message, "30.0% of 400") {
+		t.Fatalf("expected the error-node count and ratio, got %+v", got)
+	}
+	if got := result.ParseErrors[1]; got.ErrorNodes != 0 {
+		t.Fatalf("expected no error-node count for other errors, got %+v", got)
+	}
+}
+
+// captureOutput runs fn with stdout and stderr redirected to pipes and
+// returns what each received.
+func captureOutput(t *testing.T, fn func()) (string, string) {
+	t.Helper()
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	errR, errW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldStdout, oldStderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = outW, errW
+	fn()
+	os.Stdout, os.Stderr = oldStdout, oldStderr
+	_ = outW.Close()
+	_ = errW.Close()
+	stdout, _ := io.ReadAll(outR)
+	stderr, _ := io.ReadAll(errR)
+	return string(stdout), string(stderr)
+}
+
+func TestWriteResultSeparatesResultsFromBanners(t *testing.T) {
+	result := LintResult{
+		Violations: []policy.Violation{
+			{Rule: "latch_inference", Severity: "error", File: "rtl/top.vhd", Line: 30, Message: "latch on q"},
+		},
+		Summary: ResultSummary{TotalViolations: 1, Errors: 1},
+	}
+
+	idx := New()
+	stdout, stderr := captureOutput(t, func() {
+		if err := idx.writeResult(&result, nil, "."); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if strings.Contains(stdout, "===") || !strings.Contains(stdout, "rtl/top.vhd:30") {
+		t.Fatalf("stdout should hold only violations, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "=== Policy Summary ===") {
+		t.Fatalf("expected the summary on stderr, got %q", stderr)
+	}
+
+	idx.Quiet = true
+	stdout, stderr = captureOutput(t, func() {
+		if err := idx.writeResult(&result, nil, "."); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if want := "rtl/top.vhd:30: [latch_inference] error: latch on q\n"; stdout != want {
+		t.Fatalf("quiet stdout = %q, want %q", stdout, want)
+	}
+	if stderr != "" {
+		t.Fatalf("quiet run wrote to stderr: %q", stderr)
+	}
+}
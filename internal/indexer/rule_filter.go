@@ -0,0 +1,51 @@
+package indexer
+
+import (
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// ParseRuleList splits a comma-separated --rules/--skip-rules value into
+// lowercase rule names, dropping empty entries.
+func ParseRuleList(value string) []string {
+	var rules []string
+	for _, rule := range strings.Split(value, ",") {
+		if rule = strings.ToLower(strings.TrimSpace(rule)); rule != "" {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// ruleSelected reports whether rule passes the --rules allowlist (empty
+// allows every rule) and is not in the --skip-rules denylist, which is
+// applied after the allowlist.
+func ruleSelected(rule string, only, skip []string) bool {
+	if len(only) > 0 && !containsRule(only, rule) {
+		return false
+	}
+	return !containsRule(skip, rule)
+}
+
+func containsRule(rules []string, rule string) bool {
+	for _, r := range rules {
+		if strings.EqualFold(r, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRuleFilter drops violations of rules outside the --rules/--skip-rules
+// selection. The policy engine already filters on LintConfig.Only/Skip; this
+// guards the summary counts against results that did not (the policy daemon
+// and cached results).
+func applyRuleFilter(lintResult *LintResult, only, skip []string) {
+	if len(only) == 0 && len(skip) == 0 {
+		return
+	}
+	dropViolations(lintResult, func(v policy.Violation) bool {
+		return !ruleSelected(v.Rule, only, skip)
+	})
+}
@@ -0,0 +1,43 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestParseRuleList(t *testing.T) {
+	got := ParseRuleList(" Latch_Inference, ,cdc_unsync,")
+	want := []string{"latch_inference", "cdc_unsync"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseRuleList = %v, want %v", got, want)
+	}
+}
+
+func TestApplyRuleFilter(t *testing.T) {
+	lintResult := LintResult{
+		Violations: []policy.Violation{
+			{Rule: "latch_inference", Severity: "error", File: "a.vhd", Line: 1},
+			{Rule: "cdc_unsync", Severity: "warning", File: "a.vhd", Line: 2},
+			{Rule: "unused_signal", Severity: "warning", File: "b.vhd", Line: 3},
+		},
+		Summary: ResultSummary{TotalViolations: 3, Errors: 1, Warnings: 2},
+		Files: []FileResult{
+			{Path: "a.vhd", Errors: 1, Warnings: 1},
+			{Path: "b.vhd", Warnings: 1},
+		},
+	}
+
+	applyRuleFilter(&lintResult, []string{"latch_inference", "cdc_unsync"}, []string{"cdc_unsync"})
+
+	if len(lintResult.Violations) != 1 || lintResult.Violations[0].Rule != "latch_inference" {
+		t.Fatalf("expected only latch_inference, got %+v", lintResult.Violations)
+	}
+	if s := lintResult.Summary; s.TotalViolations != 1 || s.Errors != 1 || s.Warnings != 0 || s.Suppressed != 0 {
+		t.Fatalf("unexpected summary: %+v", s)
+	}
+	if len(lintResult.Files) != 1 || lintResult.Files[0].Path != "a.vhd" || lintResult.Files[0].Warnings != 0 {
+		t.Fatalf("unexpected files: %+v", lintResult.Files)
+	}
+}
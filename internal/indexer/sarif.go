@@ -0,0 +1,201 @@
+package indexer
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	// sarifRootBase names the scanned root in originalUriBaseIds; result
+	// locations are relative to it so uploaders can map them onto the checkout.
+	sarifRootBase = "%SRCROOT%"
+)
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool               sarifTool                   `json:"tool"`
+	OriginalURIBaseIDs map[string]sarifArtifactLoc `json:"originalUriBaseIds,omitempty"`
+	Results            []sarifResult               `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLoc `json:"artifactLocation"`
+	Region           *sarifRegion     `json:"region,omitempty"`
+}
+
+type sarifArtifactLoc struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// sarifLevel maps a violation severity to a SARIF result level.
+func sarifLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "error":
+		return "error"
+	case "warning":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// WriteSARIF writes violations as a SARIF 2.1.0 log for code-scanning
+// uploaders. Files under rootPath are emitted relative to it (uriBaseId
+// %SRCROOT%, whose file:// URI is recorded in originalUriBaseIds); files
+// outside it keep an absolute file:// URI.
+func WriteSARIF(w io.Writer, violations []policy.Violation, rootPath string) error {
+	root, err := sarifRoot(rootPath)
+	if err != nil {
+		return err
+	}
+
+	ruleIDs := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, v := range violations {
+		if !seen[v.Rule] {
+			seen[v.Rule] = true
+			ruleIDs = append(ruleIDs, v.Rule)
+		}
+	}
+	sort.Strings(ruleIDs)
+	ruleIndex := make(map[string]int, len(ruleIDs))
+	rules := make([]sarifRule, 0, len(ruleIDs))
+	for i, id := range ruleIDs {
+		ruleIndex[id] = i
+		rules = append(rules, sarifRule{ID: id})
+	}
+
+	results := make([]sarifResult, 0, len(violations))
+	for _, v := range violations {
+		loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifact(v.File, root)}
+		if v.Line > 0 {
+			loc.Region = &sarifRegion{StartLine: v.Line}
+		}
+		results = append(results, sarifResult{
+			RuleID:    v.Rule,
+			RuleIndex: ruleIndex[v.Rule],
+			Level:     sarifLevel(v.Severity),
+			Message:   sarifMessage{Text: v.Message},
+			Locations: []sarifLocation{{PhysicalLocation: loc}},
+		})
+	}
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "vhdl-lint",
+				InformationURI: "https://github.com/robert-at-pretension-io/learn_vhdl",
+				Rules:          rules,
+			}},
+			OriginalURIBaseIDs: map[string]sarifArtifactLoc{
+				sarifRootBase: {URI: strings.TrimSuffix(fileURI(root), "/") + "/"},
+			},
+			Results: results,
+		}},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+// WriteSARIFFile writes the SARIF log for violations to path.
+func WriteSARIFFile(path string, violations []policy.Violation, rootPath string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteSARIF(f, violations, rootPath); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// sarifRoot returns the absolute directory results are made relative to. A
+// file root uses its containing directory.
+func sarifRoot(rootPath string) (string, error) {
+	root, err := filepath.Abs(rootPath)
+	if err != nil {
+		return "", err
+	}
+	if info, err := os.Stat(root); err == nil && !info.IsDir() {
+		root = filepath.Dir(root)
+	}
+	return root, nil
+}
+
+// sarifArtifact locates file relative to root when it lies inside it.
+func sarifArtifact(file, root string) sarifArtifactLoc {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return sarifArtifactLoc{URI: filepath.ToSlash(file)}
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return sarifArtifactLoc{URI: fileURI(abs)}
+	}
+	u := url.URL{Path: filepath.ToSlash(rel)}
+	return sarifArtifactLoc{URI: u.EscapedPath(), URIBaseID: sarifRootBase}
+}
+
+// fileURI converts an absolute path to a file:// URI.
+func fileURI(path string) string {
+	slashed := filepath.ToSlash(path)
+	if !strings.HasPrefix(slashed, "/") {
+		slashed = "/" + slashed // Windows drive letters
+	}
+	u := url.URL{Scheme: "file", Path: slashed}
+	return u.String()
+}
@@ -0,0 +1,88 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestWriteSARIF(t *testing.T) {
+	root := t.TempDir()
+	outside := filepath.Join(t.TempDir(), "vendor.vhd")
+	violations := []policy.Violation{
+		{Rule: "unused_signal", Severity: "warning", File: filepath.Join(root, "rtl", "top.vhd"), Line: 12, Message: "unused"},
+		{Rule: "latch_inferred", Severity: "error", File: filepath.Join(root, "rtl", "top.vhd"), Line: 30, Message: "latch"},
+		{Rule: "unused_signal", Severity: "info", File: outside, Line: 0, Message: "elsewhere"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteSARIF(&buf, violations, root); err != nil {
+		t.Fatalf("WriteSARIF: %v", err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("invalid SARIF JSON: %v\n%s", err, buf.String())
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("unexpected SARIF header: %+v", log)
+	}
+	run := log.Runs[0]
+
+	if len(run.Tool.Driver.Rules) != 2 || run.Tool.Driver.Rules[0].ID != "latch_inferred" || run.Tool.Driver.Rules[1].ID != "unused_signal" {
+		t.Fatalf("expected distinct sorted rules, got %+v", run.Tool.Driver.Rules)
+	}
+	if base := run.OriginalURIBaseIDs[sarifRootBase].URI; base != fileURI(root)+"/" {
+		t.Fatalf("unexpected root base URI %q", base)
+	}
+	if len(run.Results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(run.Results))
+	}
+
+	first := run.Results[0]
+	if first.RuleID != "unused_signal" || first.RuleIndex != 1 || first.Level != "warning" {
+		t.Fatalf("unexpected first result: %+v", first)
+	}
+	loc := first.Locations[0].PhysicalLocation
+	if loc.ArtifactLocation.URI != "rtl/top.vhd" || loc.ArtifactLocation.URIBaseID != sarifRootBase {
+		t.Fatalf("expected root-relative location, got %+v", loc.ArtifactLocation)
+	}
+	if loc.Region == nil || loc.Region.StartLine != 12 {
+		t.Fatalf("unexpected region: %+v", loc.Region)
+	}
+	if run.Results[1].Level != "error" {
+		t.Fatalf("expected error level, got %q", run.Results[1].Level)
+	}
+
+	last := run.Results[2]
+	if last.Level != "note" {
+		t.Fatalf("expected info to map to note, got %q", last.Level)
+	}
+	lastLoc := last.Locations[0].PhysicalLocation
+	if lastLoc.ArtifactLocation.URI != fileURI(outside) || lastLoc.ArtifactLocation.URIBaseID != "" || lastLoc.Region != nil {
+		t.Fatalf("expected absolute file URI without region, got %+v", lastLoc)
+	}
+}
+
+func TestWriteSARIFFileRootIsFile(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "top.vhd")
+	if err := os.WriteFile(file, []byte("entity top is end;"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "out.sarif")
+	violations := []policy.Violation{{Rule: "r", Severity: "warning", File: file, Line: 1}}
+	if err := WriteSARIFFile(out, violations, file); err != nil {
+		t.Fatalf("WriteSARIFFile: %v", err)
+	}
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte(`"uri": "top.vhd"`)) {
+		t.Fatalf("expected location relative to the file's directory:\n%s", data)
+	}
+}
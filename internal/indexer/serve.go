@@ -0,0 +1,363 @@
+package indexer
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/facts"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/validator"
+)
+
+// ServeRequest is one newline-delimited JSON command read from the serve
+// socket.
+type ServeRequest struct {
+	Command string `json:"command"` // "lint" or "shutdown"
+	Path    string `json:"path,omitempty"`
+}
+
+// ServeResponse answers one ServeRequest on the same connection.
+type ServeResponse struct {
+	OK     bool        `json:"ok"`
+	Error  string      `json:"error,omitempty"`
+	Result *LintResult `json:"result,omitempty"`
+}
+
+// servedFile is the last extraction of a file and the content hash it was
+// extracted from.
+type servedFile struct {
+	hash  string
+	facts extractor.FileFacts
+}
+
+// Server keeps an Indexer, its facts cache, and (with VHDL_POLICY_DAEMON) the
+// policy daemon resident between lint requests so editor integrations linting on every save avoid
+// cold starts. Only files whose content hash changed are re-extracted.
+type Server struct {
+	idx *Indexer
+
+	mu        sync.Mutex // Serializes lint requests
+	files     map[string]servedFile
+	cache     *factsCache // On-disk facts cache (nil when disabled)
+	cacheDir  string
+	daemon    *policy.Daemon
+	tables    *facts.Tables // Snapshot last loaded into the daemon
+	validator *validator.Validator
+
+	listener  net.Listener
+	socket    string
+	closing   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewServer wraps idx for serving. The Indexer's Config is reloaded from each
+// request's path.
+func NewServer(idx *Indexer) *Server {
+	idx.JSONOutput = true
+	return &Server{
+		idx:     idx,
+		files:   make(map[string]servedFile),
+		closing: make(chan struct{}),
+	}
+}
+
+// Serve listens on the Unix socket at socketPath until Shutdown is called or a
+// client sends a shutdown command. A stale socket left by a previous server is
+// replaced; any other existing file is an error.
+func (s *Server) Serve(socketPath string) error {
+	if info, err := os.Lstat(socketPath); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return fmt.Errorf("socket path %s exists and is not a socket", socketPath)
+		}
+		if err := os.Remove(socketPath); err != nil {
+			return fmt.Errorf("remove stale socket: %w", err)
+		}
+	}
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", socketPath, err)
+	}
+	s.listener = listener
+	s.socket = socketPath
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			select {
+			case <-s.closing:
+				return nil
+			default:
+				return fmt.Errorf("accept: %w", err)
+			}
+		}
+		go s.handleConn(conn)
+	}
+}
+
+// Shutdown stops accepting connections, stops the policy daemon, saves the
+// facts cache, and removes the socket. It is safe to call more than once.
+func (s *Server) Shutdown() {
+	s.closeOnce.Do(func() {
+		close(s.closing)
+		if s.listener != nil {
+			_ = s.listener.Close()
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.stopDaemon()
+		if s.cache != nil {
+			_ = s.cache.Save()
+		}
+		if s.socket != "" {
+			_ = os.Remove(s.socket)
+		}
+	})
+}
+
+// handleConn answers requests on one connection until the client closes it.
+func (s *Server) handleConn(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	enc := json.NewEncoder(conn)
+	for scanner.Scan() {
+		var req ServeRequest
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			_ = enc.Encode(ServeResponse{Error: fmt.Sprintf("invalid request: %v", err)})
+			continue
+		}
+		switch req.Command {
+		case "lint":
+			result, err := s.Lint(req.Path)
+			if err != nil {
+				_ = enc.Encode(ServeResponse{Error: err.Error()})
+				continue
+			}
+			_ = enc.Encode(ServeResponse{OK: true, Result: result})
+		case "shutdown":
+			_ = enc.Encode(ServeResponse{OK: true})
+			go s.Shutdown()
+			return
+		default:
+			_ = enc.Encode(ServeResponse{Error: fmt.Sprintf("unknown command %q", req.Command)})
+		}
+	}
+}
+
+// Lint runs the pipeline for rootPath against the resident state and returns
+// the result Run would print with --json.
+func (s *Server) Lint(rootPath string) (*LintResult, error) {
+	if rootPath == "" {
+		return nil, errors.New("lint requires a path")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := s.idx
+	cfg, err := config.Load(rootPath)
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	idx.Config = cfg
+	idx.Symbols = &SymbolTable{symbols: make(map[string]Symbol)}
+	idx.Facts = nil
+	idx.FileLibraries = make(map[string]config.FileLibraryInfo)
+	idx.ThirdPartyFiles = make(map[string]bool)
+
+	files, _, err := idx.discoverFiles(rootPath)
+	if err != nil {
+		return nil, err
+	}
+	s.useCache(rootPath)
+	errs := s.refreshFacts(files)
+	for _, facts := range idx.Facts {
+		idx.registerSymbolsForFacts(facts, facts.File)
+	}
+	idx.expandContextReferences()
+	idx.markCompleteEnumCases()
+	idx.elaborateGenerates()
+
+	policyInput := idx.buildPolicyInput()
+	if s.validator == nil {
+		if s.validator, err = validator.New(); err != nil {
+			return nil, fmt.Errorf("CRITICAL: Failed to initialize CUE validator: %w", err)
+		}
+	}
+	if err := validateVerificationTags(s.validator, &policyInput); err != nil {
+		return nil, fmt.Errorf("CRITICAL: Failed to validate verification tags: %w", err)
+	}
+	if err := s.validator.Validate(policyInput); err != nil {
+		return nil, fmt.Errorf("CRITICAL: Data contract violation (Go -> policy engine mismatch): %w", err)
+	}
+
+	var baseline map[BaselineEntry]int
+	if idx.BaselinePath != "" {
+		if baseline, err = LoadBaseline(idx.BaselinePath); err != nil {
+			return nil, fmt.Errorf("load baseline: %w", err)
+		}
+	}
+	result, err := s.evaluate(policyInput)
+	if err != nil {
+		return nil, err
+	}
+	lintResult := idx.newLintResult(len(files), &policyInput, errs)
+	applyPolicyResult(&lintResult, result, baseline, idx.Config.Lint.SeverityOverrides)
+	applyLibraryRules(&lintResult, policyInput.Files)
+	applyRuleFilter(&lintResult, idx.OnlyRules, idx.SkipRules)
+	applySuppressions(&lintResult, idx.Facts)
+	idx.Summary = lintResult.Summary
+	return &lintResult, nil
+}
+
+// useCache opens the on-disk facts cache for rootPath when caching is
+// enabled, reusing the open cache while the directory is unchanged.
+func (s *Server) useCache(rootPath string) {
+	if !cacheEnabled(s.idx.Config) {
+		s.cache, s.cacheDir = nil, ""
+		return
+	}
+	dir := resolveCacheDir(rootPath, s.idx.Config)
+	if s.cache != nil && s.cacheDir == dir {
+		return
+	}
+	if s.cache != nil {
+		_ = s.cache.Save()
+	}
+	versions := s.idx.cacheVersions(rootPath)
+	cache := newFactsCache(dir, versions.parser, versions.extractor)
+	if err := cache.Load(); err != nil {
+		s.cache, s.cacheDir = nil, ""
+		return
+	}
+	s.cache, s.cacheDir = cache, dir
+}
+
+// refreshFacts fills idx.Facts for files, re-extracting only files whose
+// content hash differs from the resident copy and the facts cache. Files
+// that no longer exist in the set are dropped. Extraction errors are returned
+// per file.
+func (s *Server) refreshFacts(files []string) []error {
+	ext := s.idx.newExtractor()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	next := make(map[string]servedFile, len(files))
+	for _, file := range files {
+		wg.Add(1)
+		go func(f string) {
+			defer wg.Done()
+			hash, err := hashFile(f)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", f, err))
+				mu.Unlock()
+				return
+			}
+			mu.Lock()
+			prev, ok := s.files[f]
+			mu.Unlock()
+			if ok && prev.hash == hash {
+				mu.Lock()
+				next[f] = prev
+				mu.Unlock()
+				return
+			}
+
+			var facts extractor.FileFacts
+			cached := false
+			if s.cache != nil {
+				facts, cached, _ = s.cache.Get(f, hash)
+			}
+			if !cached {
+				if facts, err = ext.Extract(f); err != nil {
+					mu.Lock()
+					errs = append(errs, fmt.Errorf("%s: %w", f, err))
+					mu.Unlock()
+					return
+				}
+				if s.cache != nil {
+					_ = s.cache.Put(f, hash, facts)
+				}
+			}
+			mu.Lock()
+			next[f] = servedFile{hash: hash, facts: facts}
+			mu.Unlock()
+		}(file)
+	}
+	wg.Wait()
+	if s.cache != nil {
+		_ = s.cache.Save()
+	}
+
+	s.files = next
+	s.idx.Facts = make([]extractor.FileFacts, 0, len(next))
+	for _, served := range next {
+		s.idx.Facts = append(s.idx.Facts, served.facts)
+	}
+	sort.Slice(s.idx.Facts, func(i, j int) bool { return s.idx.Facts[i].File < s.idx.Facts[j].File })
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errs
+}
+
+// evaluate runs the one-shot policy engine on the input. Like Run, it uses
+// the resident daemon only when VHDL_POLICY_DAEMON is set, since the daemon
+// evaluates a subset of the rules; it then sends only the fact-table delta
+// since the previous request. If the daemon cannot be started or fails, it
+// is dropped and the one-shot engine evaluates the input.
+func (s *Server) evaluate(policyInput policy.Input) (*policy.Result, error) {
+	if !envBool("VHDL_POLICY_DAEMON") {
+		s.stopDaemon()
+		return evaluatePolicy(policyInput)
+	}
+	tables := facts.BuildTables(s.idx.Facts, s.idx.FileLibraries, s.idx.ThirdPartyFiles, s.idx.buildSymbolRows())
+	if s.daemon == nil {
+		if daemon, err := policy.NewDaemon("."); err == nil {
+			s.daemon = daemon
+		}
+	}
+	if s.daemon != nil {
+		var result *policy.Result
+		var err error
+		if s.tables == nil {
+			result, err = s.daemon.Init(tables)
+		} else {
+			result, err = s.daemon.Delta(facts.ComputeDelta(*s.tables, tables))
+		}
+		if err == nil {
+			s.tables = &tables
+			return result, nil
+		}
+		s.stopDaemon()
+	}
+	return evaluatePolicy(policyInput)
+}
+
+// evaluatePolicy evaluates the input with the one-shot policy engine.
+func evaluatePolicy(policyInput policy.Input) (*policy.Result, error) {
+	policyEngine, err := policy.New(".")
+	if err != nil {
+		return nil, fmt.Errorf("initialize policy engine: %w", err)
+	}
+	result, err := policyEngine.Evaluate(policyInput)
+	if err != nil {
+		return nil, fmt.Errorf("policy evaluation failed: %w", err)
+	}
+	return result, nil
+}
+
+// stopDaemon terminates the resident policy daemon, if any.
+func (s *Server) stopDaemon() {
+	if s.daemon != nil {
+		_ = s.daemon.Close()
+	}
+	s.daemon = nil
+	s.tables = nil
+}
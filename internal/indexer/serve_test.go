@@ -0,0 +1,114 @@
+package indexer
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+type stubExtractor struct {
+	count *int32
+}
+
+func (s stubExtractor) Extract(path string) (extractor.FileFacts, error) {
+	atomic.AddInt32(s.count, 1)
+	return extractor.FileFacts{File: path}, nil
+}
+
+func TestServerRefreshFactsReextractsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	a := writeVHDL(t, dir, "a.vhd", "entity a is end;")
+	b := writeVHDL(t, dir, "b.vhd", "entity b is end;")
+
+	var count int32
+	idx := New()
+	idx.extractorFactory = func() FactsExtractor { return stubExtractor{count: &count} }
+	srv := NewServer(idx)
+
+	if errs := srv.refreshFacts([]string{a, b}); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if count != 2 || len(idx.Facts) != 2 {
+		t.Fatalf("first refresh: expected 2 extractions and 2 facts, got %d and %d", count, len(idx.Facts))
+	}
+
+	writeVHDL(t, dir, "b.vhd", "entity b is end entity;")
+	srv.refreshFacts([]string{a, b})
+	if count != 3 {
+		t.Fatalf("expected only the changed file to be re-extracted, got %d extractions", count)
+	}
+
+	srv.refreshFacts([]string{a})
+	if len(idx.Facts) != 1 || idx.Facts[0].File != a {
+		t.Fatalf("expected removed file to be dropped, got %+v", idx.Facts)
+	}
+	if count != 3 {
+		t.Fatalf("expected no re-extraction for unchanged files, got %d extractions", count)
+	}
+}
+
+func TestServerShutdownCommand(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "lint.sock")
+	srv := NewServer(New())
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(socket) }()
+
+	var conn net.Conn
+	var err error
+	for i := 0; i < 100; i++ {
+		if conn, err = net.Dial("unix", socket); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	send := func(req ServeRequest) ServeResponse {
+		t.Helper()
+		if err := json.NewEncoder(conn).Encode(req); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		var resp ServeResponse
+		if err := json.Unmarshal(line, &resp); err != nil {
+			t.Fatalf("parse response %q: %v", line, err)
+		}
+		return resp
+	}
+
+	if resp := send(ServeRequest{Command: "bogus"}); resp.OK || resp.Error == "" {
+		t.Fatalf("expected error for unknown command, got %+v", resp)
+	}
+	if resp := send(ServeRequest{Command: "lint"}); resp.OK || resp.Error == "" {
+		t.Fatalf("expected error for lint without path, got %+v", resp)
+	}
+	if resp := send(ServeRequest{Command: "shutdown"}); !resp.OK {
+		t.Fatalf("expected shutdown to succeed, got %+v", resp)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("serve returned %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not stop after shutdown")
+	}
+	if _, err := os.Stat(socket); !os.IsNotExist(err) {
+		t.Fatalf("expected socket to be removed, stat err = %v", err)
+	}
+}
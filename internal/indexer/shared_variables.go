@@ -0,0 +1,68 @@
+package indexer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// detectUnprotectedSharedVariables reports shared variables whose type is not
+// a protected type, which VHDL-2008 and later reject. Nothing is reported for
+// earlier standards. Protected types are collected from every file, so a
+// variable may use one declared in another file's package. Types that are
+// declared nowhere in the design and are not standard types (an unanalyzed
+// vendor package) are given the benefit of the doubt.
+func (idx *Indexer) detectUnprotectedSharedVariables() []policy.UnprotectedSharedVariable {
+	found := []policy.UnprotectedSharedVariable{}
+	if idx.Config.Standard == "1993" || idx.Config.Standard == "2002" {
+		return found
+	}
+
+	sorted := make([]extractor.FileFacts, len(idx.Facts))
+	copy(sorted, idx.Facts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].File < sorted[j].File })
+
+	protected := make(map[string]bool)
+	declared := make(map[string]bool)
+	for _, facts := range sorted {
+		for _, t := range facts.Types {
+			name := strings.ToLower(t.Name)
+			declared[name] = true
+			if t.Kind == "protected" {
+				protected[name] = true
+			}
+		}
+		for _, st := range facts.Subtypes {
+			declared[strings.ToLower(st.Name)] = true
+		}
+	}
+
+	for _, facts := range sorted {
+		for _, sv := range facts.SharedVariableDecls {
+			typeName := strings.ToLower(strings.TrimSpace(sv.Type))
+			if i := strings.IndexAny(typeName, " ("); i >= 0 {
+				typeName = typeName[:i]
+			}
+			if i := strings.LastIndex(typeName, "."); i >= 0 {
+				typeName = typeName[i+1:]
+			}
+			if typeName == "" || protected[typeName] {
+				continue
+			}
+			if !declared[typeName] && !extractor.IsStandardTypeName(typeName) {
+				continue
+			}
+			found = append(found, policy.UnprotectedSharedVariable{
+				Name:      sv.Name,
+				Type:      sv.Type,
+				File:      facts.File,
+				Line:      sv.Line,
+				InPackage: sv.InPackage,
+				InArch:    sv.InArch,
+			})
+		}
+	}
+	return found
+}
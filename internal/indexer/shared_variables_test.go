@@ -0,0 +1,44 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestDetectUnprotectedSharedVariables(t *testing.T) {
+	idx := New()
+	idx.Facts = []extractor.FileFacts{
+		{
+			File: "pkg.vhd",
+			Types: []extractor.TypeDeclaration{
+				{Name: "counter_t", Kind: "protected", InPackage: "pkg"},
+				{Name: "state_t", Kind: "enum", InPackage: "pkg"},
+			},
+		},
+		{
+			File: "top.vhd",
+			SharedVariableDecls: []extractor.SharedVariableDeclaration{
+				{Name: "hits", Type: "work.pkg.counter_t", Line: 5, InArch: "rtl"},
+				{Name: "count", Type: "integer", Line: 6, InArch: "rtl"},
+				{Name: "state", Type: "state_t", Line: 7, InArch: "rtl"},
+				{Name: "rv", Type: "RandomPType", Line: 8, InArch: "rtl"},
+			},
+		},
+	}
+
+	want := []policy.UnprotectedSharedVariable{
+		{Name: "count", Type: "integer", File: "top.vhd", Line: 6, InArch: "rtl"},
+		{Name: "state", Type: "state_t", File: "top.vhd", Line: 7, InArch: "rtl"},
+	}
+	if got := idx.detectUnprotectedSharedVariables(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v\nwant %+v", got, want)
+	}
+
+	idx.Config.Standard = "1993"
+	if got := idx.detectUnprotectedSharedVariables(); len(got) != 0 {
+		t.Fatalf("expected no findings for VHDL-93, got %+v", got)
+	}
+}
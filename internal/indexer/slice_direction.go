@@ -0,0 +1,107 @@
+package indexer
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// detectMixedSliceDirections reports signals and ports that one architecture
+// slices both ascending (v(0 to 3)) and descending (v(3 downto 0)). Only names
+// declared as a signal of the architecture or a port of its entity count, so
+// type marks and function calls that look like slices are ignored. Ports may
+// be declared in another file, so this runs over all facts.
+func detectMixedSliceDirections(allFacts []extractor.FileFacts) []policy.MixedSliceDirection {
+	sorted := make([]extractor.FileFacts, len(allFacts))
+	copy(sorted, allFacts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].File < sorted[j].File })
+
+	// entity (lowercase) -> port names (lowercase)
+	portsByEntity := make(map[string]map[string]bool)
+	for _, facts := range sorted {
+		for _, port := range facts.Ports {
+			key := strings.ToLower(port.InEntity)
+			if portsByEntity[key] == nil {
+				portsByEntity[key] = make(map[string]bool)
+			}
+			portsByEntity[key][strings.ToLower(port.Name)] = true
+		}
+	}
+
+	type sliceGroup struct {
+		arch   extractor.Architecture
+		first  map[string]extractor.SliceAccess // direction -> first slice
+		signal string
+	}
+
+	found := []policy.MixedSliceDirection{}
+	for _, facts := range sorted {
+		// architecture line -> signal names (lowercase) declared in it
+		signalsByArch := make(map[int]map[string]bool)
+		for _, sig := range facts.Signals {
+			arch, ok := architectureForSignal(facts.Architectures, sig)
+			if !ok {
+				continue
+			}
+			if signalsByArch[arch.Line] == nil {
+				signalsByArch[arch.Line] = make(map[string]bool)
+			}
+			signalsByArch[arch.Line][strings.ToLower(sig.Name)] = true
+		}
+
+		groups := make(map[string]*sliceGroup)
+		var order []string
+		for _, sa := range facts.SliceAccesses {
+			arch, ok := architectureAt(facts.Architectures, sa.InArch, sa.Line)
+			if !ok {
+				continue
+			}
+			name := strings.ToLower(sa.Signal)
+			base := name
+			if dot := strings.Index(base, "."); dot != -1 {
+				base = base[:dot]
+			}
+			if !signalsByArch[arch.Line][base] && !portsByEntity[strings.ToLower(arch.EntityName)][base] {
+				continue
+			}
+			key := strconv.Itoa(arch.Line) + "|" + name
+			group, ok := groups[key]
+			if !ok {
+				group = &sliceGroup{arch: arch, first: make(map[string]extractor.SliceAccess), signal: sa.Signal}
+				groups[key] = group
+				order = append(order, key)
+			}
+			if _, seen := group.first[sa.Direction]; !seen {
+				group.first[sa.Direction] = sa
+			}
+		}
+
+		for _, key := range order {
+			group := groups[key]
+			to, hasTo := group.first["to"]
+			downto, hasDownto := group.first["downto"]
+			if !hasTo || !hasDownto {
+				continue
+			}
+			// Report at whichever direction appeared second
+			line := to.Line
+			if downto.Line > line {
+				line = downto.Line
+			}
+			found = append(found, policy.MixedSliceDirection{
+				Signal:       group.signal,
+				Architecture: group.arch.Name,
+				File:         facts.File,
+				Line:         line,
+				ToExpr:       to.Expr,
+				ToLine:       to.Line,
+				DowntoExpr:   downto.Expr,
+				DowntoLine:   downto.Line,
+			})
+		}
+	}
+	return found
+}
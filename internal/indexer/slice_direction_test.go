@@ -0,0 +1,42 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestDetectMixedSliceDirections(t *testing.T) {
+	allFacts := []extractor.FileFacts{
+		{
+			File:  "core_ent.vhd",
+			Ports: []extractor.Port{{Name: "dout", InEntity: "core"}},
+		},
+		{
+			File:          "core_rtl.vhd",
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "core", Line: 1}},
+			Signals:       []extractor.Signal{{Name: "lo", InEntity: "rtl", Line: 2}},
+			SliceAccesses: []extractor.SliceAccess{
+				{Signal: "dout", Expr: "dout(7 downto 4)", Direction: "downto", Line: 5, InArch: "rtl"},
+				{Signal: "dout", Expr: "dout(0 to 3)", Direction: "to", Line: 6, InArch: "rtl"},
+				{Signal: "lo", Expr: "lo(3 downto 0)", Direction: "downto", Line: 7, InArch: "rtl"},
+				{Signal: "lo", Expr: "lo(1 downto 0)", Direction: "downto", Line: 8, InArch: "rtl"},
+				// Type marks are not signals
+				{Signal: "std_logic_vector", Expr: "std_logic_vector(0 to 3)", Direction: "to", Line: 3, InArch: "rtl"},
+				{Signal: "std_logic_vector", Expr: "std_logic_vector(3 downto 0)", Direction: "downto", Line: 4, InArch: "rtl"},
+			},
+		},
+	}
+
+	found := detectMixedSliceDirections(allFacts)
+	if len(found) != 1 {
+		t.Fatalf("expected 1 mixed slice direction, got %+v", found)
+	}
+	ms := found[0]
+	if ms.Signal != "dout" || ms.File != "core_rtl.vhd" || ms.Line != 6 {
+		t.Fatalf("unexpected finding: %+v", ms)
+	}
+	if ms.ToExpr != "dout(0 to 3)" || ms.DowntoExpr != "dout(7 downto 4)" || ms.DowntoLine != 5 {
+		t.Fatalf("unexpected slices: %+v", ms)
+	}
+}
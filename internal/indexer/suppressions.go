@@ -0,0 +1,98 @@
+package indexer
+
+import (
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// applySuppressions drops violations silenced by inline "-- vhdl-lint:
+// disable" directives, adjusting the summary and per-file counts and
+// recording how many were dropped in Summary.Suppressed.
+func applySuppressions(lintResult *LintResult, facts []extractor.FileFacts) {
+	byFile := make(map[string][]extractor.Suppression)
+	for _, f := range facts {
+		if len(f.Suppressions) > 0 {
+			byFile[f.File] = f.Suppressions
+		}
+	}
+	if len(byFile) == 0 {
+		return
+	}
+	lintResult.Summary.Suppressed += dropViolations(lintResult, func(v policy.Violation) bool {
+		return isSuppressed(byFile[v.File], v)
+	})
+}
+
+// dropViolations removes the violations matching drop, adjusting the summary
+// and per-file counts, and returns how many were removed.
+func dropViolations(lintResult *LintResult, drop func(policy.Violation) bool) int {
+	kept := make([]policy.Violation, 0, len(lintResult.Violations))
+	dropped := make(map[string]map[string]int)      // file -> severity -> count
+	droppedRules := make(map[string]map[string]int) // file -> rule -> count
+	count := 0
+	for _, v := range lintResult.Violations {
+		if !drop(v) {
+			kept = append(kept, v)
+			continue
+		}
+		if dropped[v.File] == nil {
+			dropped[v.File] = make(map[string]int)
+		}
+		dropped[v.File][v.Severity]++
+		if droppedRules[v.File] == nil {
+			droppedRules[v.File] = make(map[string]int)
+		}
+		droppedRules[v.File][v.Rule]++
+		count++
+		lintResult.Summary.countRule(v.Rule, -1)
+		lintResult.Summary.TotalViolations--
+		switch v.Severity {
+		case "error":
+			lintResult.Summary.Errors--
+		case "warning":
+			lintResult.Summary.Warnings--
+		case "info":
+			lintResult.Summary.Info--
+		}
+	}
+	lintResult.Violations = kept
+	if count > 0 {
+		lintResult.Summary.rankRules()
+	}
+
+	files := lintResult.Files[:0]
+	for _, fr := range lintResult.Files {
+		if counts, ok := dropped[fr.Path]; ok {
+			fr.Errors -= counts["error"]
+			fr.Warnings -= counts["warning"]
+			fr.Info -= counts["info"]
+			if len(fr.ByRule) > 0 {
+				// Copy first: the map may be shared with a cached result
+				byRule := make(map[string]int, len(fr.ByRule))
+				for rule, n := range fr.ByRule {
+					byRule[rule] = n
+				}
+				for rule, n := range droppedRules[fr.Path] {
+					byRule = addRuleCount(byRule, rule, -n)
+				}
+				fr.ByRule = byRule
+			}
+			if fr.Errors+fr.Warnings+fr.Info == 0 {
+				continue
+			}
+		}
+		files = append(files, fr)
+	}
+	lintResult.Files = files
+	return count
+}
+
+// isSuppressed reports whether any suppression silences the violation.
+func isSuppressed(suppressions []extractor.Suppression, v policy.Violation) bool {
+	for _, s := range suppressions {
+		if s.Suppresses(v.Rule, v.Line) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestApplySuppressions(t *testing.T) {
+	lintResult := LintResult{}
+	applyPolicyResult(&lintResult, &policy.Result{
+		Violations: []policy.Violation{
+			{Rule: "latch_inference", Severity: "warning", File: "a.vhd", Line: 10},
+			{Rule: "cdc_unsync", Severity: "error", File: "a.vhd", Line: 21},
+			{Rule: "magic_number", Severity: "info", File: "a.vhd", Line: 30},
+			{Rule: "latch_inference", Severity: "warning", File: "b.vhd", Line: 10},
+		},
+		Summary: policy.Summary{TotalViolations: 4, Errors: 1, Warnings: 2, Info: 1},
+	}, nil, nil)
+	facts := []extractor.FileFacts{{
+		File: "a.vhd",
+		Suppressions: []extractor.Suppression{
+			{Rule: "latch_inference", Line: 10, Scope: "line"},
+			{Line: 20, Scope: "next-line"},
+		},
+	}}
+
+	applySuppressions(&lintResult, facts)
+
+	if len(lintResult.Violations) != 2 {
+		t.Fatalf("expected 2 violations left, got %+v", lintResult.Violations)
+	}
+	for _, v := range lintResult.Violations {
+		if v.File == "a.vhd" && v.Rule != "magic_number" {
+			t.Fatalf("violation should have been suppressed: %+v", v)
+		}
+	}
+	want := ResultSummary{
+		TotalViolations: 2, Warnings: 1, Info: 1, Suppressed: 2,
+		ByRule:   map[string]int{"latch_inference": 1, "magic_number": 1},
+		TopRules: []RuleCount{{Rule: "latch_inference", Count: 1}, {Rule: "magic_number", Count: 1}},
+	}
+	if !reflect.DeepEqual(lintResult.Summary, want) {
+		t.Fatalf("summary = %+v, want %+v", lintResult.Summary, want)
+	}
+	for _, fr := range lintResult.Files {
+		if fr.Path == "a.vhd" && (fr.Errors != 0 || fr.Warnings != 0 || fr.Info != 1 || !reflect.DeepEqual(fr.ByRule, map[string]int{"magic_number": 1})) {
+			t.Fatalf("a.vhd counts not adjusted: %+v", fr)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package indexer
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// detectSuspiciousClocks reports clocked processes whose clock is neither an
+// input port of their entity, a signal whose doc comment calls it a clock,
+// nor a name matching lint.clockPatterns. Such a clock is usually a data
+// register passed to rising_edge by mistake. Only clocks declared as a
+// signal or a non-input port of the architecture are judged; names declared
+// elsewhere (packages, generate scopes of other files) are skipped. The
+// entity's ports may live in another file.
+func (idx *Indexer) detectSuspiciousClocks() []policy.SuspiciousClock {
+	var allowed []*regexp.Regexp
+	for _, pattern := range idx.Config.Lint.ClockPatterns {
+		// Invalid patterns are reported by config validation
+		if re, err := regexp.Compile("(?i)^(?:" + pattern + ")$"); err == nil {
+			allowed = append(allowed, re)
+		}
+	}
+
+	sorted := make([]extractor.FileFacts, len(idx.Facts))
+	copy(sorted, idx.Facts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].File < sorted[j].File })
+
+	entityPorts := make(map[scopedName]extractor.Port)
+	for _, facts := range sorted {
+		for _, p := range facts.Ports {
+			entityPorts[scopedName{strings.ToLower(p.InEntity), strings.ToLower(p.Name)}] = p
+		}
+	}
+
+	found := []policy.SuspiciousClock{}
+	for _, facts := range sorted {
+		entityOf := make(map[string]string)
+		for _, arch := range facts.Architectures {
+			entityOf[strings.ToLower(arch.Name)] = strings.ToLower(arch.EntityName)
+		}
+		signals := make(map[scopedName]extractor.Signal)
+		for _, sig := range facts.Signals {
+			signals[scopedName{strings.ToLower(archScopeBase(sig.InEntity)), strings.ToLower(sig.Name)}] = sig
+		}
+
+		for _, proc := range facts.Processes {
+			if proc.ClockSignal == "" {
+				continue
+			}
+			clock := strings.ToLower(proc.ClockSignal)
+			if i := strings.IndexAny(clock, "(."); i >= 0 {
+				clock = strings.TrimSpace(clock[:i])
+			}
+			if matchesAny(allowed, clock) {
+				continue
+			}
+			arch := strings.ToLower(archScopeBase(proc.InArch))
+			kind := ""
+			if port, ok := entityPorts[scopedName{entityOf[arch], clock}]; ok {
+				if strings.EqualFold(port.Direction, "in") {
+					continue
+				}
+				kind = "port"
+			} else if sig, ok := signals[scopedName{arch, clock}]; ok {
+				doc := strings.ToLower(sig.Doc)
+				if strings.Contains(doc, "clock") || strings.Contains(doc, "clk") {
+					continue
+				}
+				kind = "signal"
+			} else {
+				continue
+			}
+			found = append(found, policy.SuspiciousClock{
+				Clock:   proc.ClockSignal,
+				Kind:    kind,
+				Process: proc.Label,
+				File:    facts.File,
+				Line:    proc.Line,
+				InArch:  proc.InArch,
+			})
+		}
+	}
+	return found
+}
+
+// matchesAny reports whether name matches one of patterns.
+func matchesAny(patterns []*regexp.Regexp, name string) bool {
+	for _, re := range patterns {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestDetectSuspiciousClocks(t *testing.T) {
+	idx := New()
+	idx.Config.Lint.ClockPatterns = []string{"clk_pll_.*", "clk_(div"}
+	idx.Facts = []extractor.FileFacts{
+		{
+			File: "core.vhd",
+			Ports: []extractor.Port{
+				{Name: "clk_i", Direction: "in", InEntity: "core"},
+				{Name: "strobe_o", Direction: "out", InEntity: "core"},
+			},
+		},
+		{
+			File:          "core_rtl.vhd",
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "core"}},
+			Signals: []extractor.Signal{
+				{Name: "data_r", InEntity: "rtl"},
+				{Name: "sys_ck", InEntity: "rtl", Doc: "Clock from the MMCM"},
+				{Name: "CLK_PLL_100", InEntity: "rtl"},
+			},
+			Processes: []extractor.Process{
+				{Label: "p_in", ClockSignal: "CLK_I", Line: 10, InArch: "rtl"},
+				{Label: "p_data", ClockSignal: "data_r", Line: 20, InArch: "rtl"},
+				{ClockSignal: "strobe_o", Line: 30, InArch: "rtl.gen_a"},
+				{Label: "p_doc", ClockSignal: "sys_ck", Line: 40, InArch: "rtl"},
+				{Label: "p_pll", ClockSignal: "clk_pll_100", Line: 50, InArch: "rtl"},
+				{Label: "p_pkg", ClockSignal: "pkg_clk", Line: 60, InArch: "rtl"},
+				{Label: "p_comb", Line: 70, InArch: "rtl"},
+			},
+		},
+	}
+
+	want := []policy.SuspiciousClock{
+		{Clock: "data_r", Kind: "signal", Process: "p_data", File: "core_rtl.vhd", Line: 20, InArch: "rtl"},
+		{Clock: "strobe_o", Kind: "port", File: "core_rtl.vhd", Line: 30, InArch: "rtl.gen_a"},
+	}
+	if got := idx.detectSuspiciousClocks(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v\nwant %+v", got, want)
+	}
+}
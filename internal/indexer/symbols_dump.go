@@ -0,0 +1,44 @@
+package indexer
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+)
+
+// SymbolEntry is the JSON form of a symbol table entry.
+type SymbolEntry struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// ListSymbols returns the symbol table sorted by name. A non-empty kinds list
+// keeps only symbols of those kinds (entity, package, type, ...).
+func ListSymbols(symbols map[string]Symbol, kinds []string) []SymbolEntry {
+	keep := make(map[string]bool, len(kinds))
+	for _, k := range kinds {
+		if k = strings.ToLower(strings.TrimSpace(k)); k != "" {
+			keep[k] = true
+		}
+	}
+
+	entries := make([]SymbolEntry, 0, len(symbols))
+	for _, sym := range symbols {
+		if len(keep) > 0 && !keep[sym.Kind] {
+			continue
+		}
+		entries = append(entries, SymbolEntry{Name: sym.Name, Kind: sym.Kind, File: sym.File, Line: sym.Line})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries
+}
+
+// WriteSymbols prints symbols as an indented JSON array.
+func WriteSymbols(w io.Writer, symbols []SymbolEntry) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(symbols)
+}
@@ -0,0 +1,65 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestListSymbols(t *testing.T) {
+	symbols := map[string]Symbol{
+		"work.cpu":          {Name: "work.cpu", Kind: "entity", File: "cpu.vhd", Line: 4},
+		"work.alu_pkg":      {Name: "work.alu_pkg", Kind: "package", File: "alu_pkg.vhd", Line: 1},
+		"work.alu_pkg.op_t": {Name: "work.alu_pkg.op_t", Kind: "type", File: "alu_pkg.vhd", Line: 3},
+	}
+
+	all := ListSymbols(symbols, nil)
+	if len(all) != 3 || all[0].Name != "work.alu_pkg" || all[1].Name != "work.alu_pkg.op_t" || all[2].Name != "work.cpu" {
+		t.Fatalf("expected all symbols sorted by name, got %+v", all)
+	}
+
+	filtered := ListSymbols(symbols, []string{"entity", " Package"})
+	if len(filtered) != 2 || filtered[0].Kind != "package" || filtered[1].Kind != "entity" {
+		t.Fatalf("expected entity and package symbols, got %+v", filtered)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteSymbols(&buf, filtered); err != nil {
+		t.Fatalf("write symbols: %v", err)
+	}
+	var decoded []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if decoded[1]["name"] != "work.cpu" || decoded[1]["file"] != "cpu.vhd" || decoded[1]["line"] != float64(4) {
+		t.Fatalf("unexpected JSON entry: %v", decoded[1])
+	}
+}
+
+func TestSymbolTableRecordsDuplicates(t *testing.T) {
+	st := &SymbolTable{symbols: make(map[string]Symbol)}
+	st.Add(Symbol{Name: "work.uart", Kind: "entity", File: "b/uart.vhd", Line: 3})
+	st.Add(Symbol{Name: "work.uart", Kind: "entity", File: "b/uart.vhd", Line: 3}) // Re-registered (cache reload)
+	st.Add(Symbol{Name: "work.pkg.f", Kind: "function", File: "pkg.vhd", Line: 4})
+	st.Add(Symbol{Name: "work.pkg.f", Kind: "function", File: "pkg.vhd", Line: 9}) // Overload in the same file
+	if dups := st.Duplicates(); len(dups) != 0 {
+		t.Fatalf("expected no duplicates, got %+v", dups)
+	}
+
+	st.Add(Symbol{Name: "work.uart", Kind: "entity", File: "a/uart.vhd", Line: 1})
+	st.Add(Symbol{Name: "work.uart", Kind: "entity", File: "a/uart.vhd", Line: 1})
+	st.Add(Symbol{Name: "work.uart", Kind: "entity", File: "c/uart.vhd", Line: 7})
+	want := []DuplicateSymbol{{
+		Name: "work.uart",
+		Kind: "entity",
+		Locations: []SymbolLocation{
+			{File: "a/uart.vhd", Line: 1},
+			{File: "b/uart.vhd", Line: 3},
+			{File: "c/uart.vhd", Line: 7},
+		},
+	}}
+	if got := st.Duplicates(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,54 @@
+package indexer
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+// ContextExtractor is a FactsExtractor whose extractions a context can
+// cancel, as needed for Indexer.Timeout.
+type ContextExtractor interface {
+	ExtractCtx(ctx context.Context, path string) (extractor.FileFacts, error)
+}
+
+// extractCtx extracts path with ext, cancellable by ctx when ext supports it.
+func extractCtx(ctx context.Context, ext FactsExtractor, path string) (extractor.FileFacts, error) {
+	if ce, ok := ext.(ContextExtractor); ok {
+		return ce.ExtractCtx(ctx, path)
+	}
+	return ext.Extract(path)
+}
+
+// runContext returns the context bounding a run's extraction: cancelled
+// after Timeout, or never when Timeout is zero.
+func (idx *Indexer) runContext() (context.Context, context.CancelFunc) {
+	if idx.Timeout > 0 {
+		return context.WithTimeout(context.Background(), idx.Timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// timedOutFiles collects the files a run's timeout left unextracted, either
+// never started or cancelled mid-parse.
+type timedOutFiles struct {
+	mu    sync.Mutex
+	files []string
+}
+
+func (t *timedOutFiles) add(file string) {
+	t.mu.Lock()
+	t.files = append(t.files, file)
+	t.mu.Unlock()
+}
+
+// sorted returns the collected files sorted by path.
+func (t *timedOutFiles) sorted() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	files := append([]string(nil), t.files...)
+	sort.Strings(files)
+	return files
+}
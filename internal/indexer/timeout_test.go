@@ -0,0 +1,78 @@
+package indexer
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+// stallingExtractor extracts every file instantly except stall, whose parse
+// only ends when its context is cancelled.
+type stallingExtractor struct {
+	inner FactsExtractor
+	stall string
+}
+
+func (s *stallingExtractor) Extract(path string) (extractor.FileFacts, error) {
+	return s.ExtractCtx(context.Background(), path)
+}
+
+func (s *stallingExtractor) ExtractCtx(ctx context.Context, path string) (extractor.FileFacts, error) {
+	if path == s.stall {
+		<-ctx.Done()
+		return extractor.FileFacts{File: path}, ctx.Err()
+	}
+	return s.inner.Extract(path)
+}
+
+func TestRunTimeoutReturnsPartialResult(t *testing.T) {
+	dir := t.TempDir()
+	fast := writeVHDL(t, dir, "fast.vhd", "entity fast is end entity; architecture rtl of fast is begin end architecture;")
+	slow := writeVHDL(t, dir, "slow.vhd", "entity slow is end entity; architecture rtl of slow is begin end architecture;")
+	cfg := defaultTestConfig([]string{fast, slow}, filepath.Join(dir, ".cache"), false)
+
+	idx := NewWithConfig(cfg)
+	idx.Timeout = 50 * time.Millisecond
+	idx.extractorFactory = func() FactsExtractor {
+		return &stallingExtractor{inner: extractor.New(), stall: slow}
+	}
+	result := runIndexerForTest(t, idx, dir)
+	if !result.Truncated {
+		t.Fatalf("expected a truncated result")
+	}
+	if want := []string{slow}; !reflect.DeepEqual(result.TimedOutFiles, want) {
+		t.Fatalf("timed out files = %v, want %v", result.TimedOutFiles, want)
+	}
+	if len(result.ParseErrors) != 0 {
+		t.Fatalf("a timed-out file is not a parse error, got %+v", result.ParseErrors)
+	}
+	if result.Stats.Entities != 1 {
+		t.Fatalf("expected the fast file's entity to be linted, got %+v", result.Stats)
+	}
+}
+
+func TestExtractCtxCancelsContextExtractors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ext := &stallingExtractor{stall: "slow.vhd"}
+	if _, err := extractCtx(ctx, ext, "slow.vhd"); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	idx := New()
+	runCtx, runCancel := idx.runContext()
+	defer runCancel()
+	if _, ok := runCtx.Deadline(); ok {
+		t.Fatalf("expected no deadline without a timeout")
+	}
+	idx.Timeout = time.Minute
+	runCtx, runCancel = idx.runContext()
+	defer runCancel()
+	if _, ok := runCtx.Deadline(); !ok {
+		t.Fatalf("expected a deadline with a timeout")
+	}
+}
@@ -9,6 +9,8 @@ import (
 )
 
 type timingEvent struct {
+	Event      string  `json:"event,omitempty"` // "rule_timing" for per-rule policy events
+	Rule       string  `json:"rule,omitempty"`
 	Phase      string  `json:"phase"`
 	Kind       string  `json:"kind"`
 	File       string  `json:"file,omitempty"`
@@ -68,7 +70,7 @@ func (tr *timingRecorder) record(phase, kind, file, status string, start time.Ti
 	}
 	startMS := durationToMS(start.Sub(tr.start))
 	durationMS := durationToMS(duration)
-	event := timingEvent{
+	tr.write(timingEvent{
 		Phase:      phase,
 		Kind:       kind,
 		File:       file,
@@ -76,7 +78,10 @@ func (tr *timingRecorder) record(phase, kind, file, status string, start time.Ti
 		StartMS:    startMS,
 		DurationMS: durationMS,
 		EndMS:      startMS + durationMS,
-	}
+	})
+}
+
+func (tr *timingRecorder) write(event timingEvent) {
 	tr.mu.Lock()
 	tr.events = append(tr.events, event)
 	if tr.enc != nil {
@@ -93,6 +98,26 @@ func (tr *timingRecorder) RecordFile(phase, file, status string, start time.Time
 	tr.record(phase, "file", file, status, start, duration)
 }
 
+// RecordRule records one policy rule group's evaluation time as a
+// "rule_timing" event. The engine reports when a rule finishes, so the start
+// is taken as that moment minus the duration.
+func (tr *timingRecorder) RecordRule(rule string, duration time.Duration) {
+	if tr == nil || !tr.enabled {
+		return
+	}
+	endMS := durationToMS(time.Since(tr.start))
+	durationMS := durationToMS(duration)
+	tr.write(timingEvent{
+		Event:      "rule_timing",
+		Rule:       rule,
+		Phase:      "policy",
+		Kind:       "rule",
+		StartMS:    endMS - durationMS,
+		DurationMS: durationMS,
+		EndMS:      endMS,
+	})
+}
+
 func durationToMS(d time.Duration) float64 {
 	return float64(d.Nanoseconds()) / 1_000_000.0
 }
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
 )
@@ -53,3 +54,22 @@ func TestTimingJSONLWritten(t *testing.T) {
 		t.Fatalf("expected scan and total timing events")
 	}
 }
+
+func TestTimingRecordsRuleEvents(t *testing.T) {
+	timingPath := filepath.Join(t.TempDir(), "timing.jsonl")
+	tr := newTimingRecorder(time.Now(), timingPath)
+	tr.RecordRule("cdc", 1500*time.Microsecond)
+	tr.Close()
+
+	raw, err := os.ReadFile(timingPath)
+	if err != nil {
+		t.Fatalf("read timing file: %v", err)
+	}
+	var ev map[string]any
+	if err := json.Unmarshal(bytes.TrimSpace(raw), &ev); err != nil {
+		t.Fatalf("parse timing event: %v", err)
+	}
+	if ev["event"] != "rule_timing" || ev["rule"] != "cdc" || ev["duration_ms"] != 1.5 {
+		t.Fatalf("unexpected rule timing event %v", ev)
+	}
+}
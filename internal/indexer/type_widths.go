@@ -0,0 +1,144 @@
+package indexer
+
+import (
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+// typeWidths resolves the bit width of user-defined array types and subtypes
+// declared in any analyzed file, on top of the built-in vector types
+// CalculateWidthWithConstants understands.
+type typeWidths struct {
+	constants map[string]int
+	widths    map[string]int // Lowercase constrained type or subtype name -> total bits
+	elements  map[string]int // Lowercase unconstrained array type name -> bits per element
+}
+
+// buildTypeWidths collects the constrained array types ("array(7 downto 0) of
+// std_logic"), unconstrained array types ("array(natural range <>) of
+// word_t"), and subtypes of every file. An array's width is its element
+// count times its element width, so arrays of user types resolve once their
+// element type does. Types whose bounds or element cannot be evaluated are
+// left out.
+func (idx *Indexer) buildTypeWidths(constants map[string]int) *typeWidths {
+	tw := &typeWidths{
+		constants: constants,
+		widths:    make(map[string]int),
+		elements:  make(map[string]int),
+	}
+	var arrays []extractor.TypeDeclaration
+	var subtypes []extractor.SubtypeDeclaration
+	for _, facts := range idx.Facts {
+		for _, t := range facts.Types {
+			if t.Kind == "array" {
+				arrays = append(arrays, t)
+			}
+		}
+		subtypes = append(subtypes, facts.Subtypes...)
+	}
+
+	// Each pass resolves types whose element type resolved in an earlier one
+	for resolved := true; resolved; {
+		resolved = false
+		for _, t := range arrays {
+			name := strings.ToLower(t.Name)
+			if tw.widths[name] > 0 || tw.elements[name] > 0 {
+				continue
+			}
+			elem := tw.width(t.ElementType)
+			if elem <= 0 {
+				continue
+			}
+			if t.Unconstrained {
+				tw.elements[name] = elem
+				resolved = true
+				continue
+			}
+			if count := indexCount(t.IndexTypes, constants); count > 0 {
+				tw.widths[name] = count * elem
+				resolved = true
+			}
+		}
+		for _, st := range subtypes {
+			name := strings.ToLower(st.Name)
+			if tw.widths[name] > 0 {
+				continue
+			}
+			if width := tw.width(st.BaseType + " " + st.Constraint); width > 0 {
+				tw.widths[name] = width
+				resolved = true
+			}
+		}
+	}
+	return tw
+}
+
+// width returns the bit width of a type indication, or 0 when unknown.
+// Built-in types come first; otherwise the type mark (without library or
+// package prefix) is looked up among the user types, and an unconstrained
+// array takes its width from the index constraint that follows the mark.
+func (tw *typeWidths) width(typeStr string) int {
+	if width := extractor.CalculateWidthWithConstants(typeStr, tw.constants); width > 0 {
+		return width
+	}
+	mark, constraint := strings.TrimSpace(typeStr), ""
+	if open := strings.Index(mark, "("); open != -1 && strings.HasSuffix(mark, ")") {
+		mark, constraint = strings.TrimSpace(mark[:open]), mark[open+1:len(mark)-1]
+	}
+	mark = strings.ToLower(mark)
+	if dot := strings.LastIndex(mark, "."); dot != -1 {
+		mark = mark[dot+1:]
+	}
+	if constraint == "" {
+		return tw.widths[mark]
+	}
+	if elem := tw.elements[mark]; elem > 0 {
+		if count := indexCount(splitTopLevel(constraint), tw.constants); count > 0 {
+			return count * elem
+		}
+	}
+	return 0
+}
+
+// indexCount returns the number of elements spanned by an array's index
+// ranges ("7 downto 0", "integer range 0 to 3"), or 0 if any range is a type
+// name or cannot be evaluated.
+func indexCount(indexes []string, constants map[string]int) int {
+	if len(indexes) == 0 {
+		return 0
+	}
+	count := 1
+	for _, index := range indexes {
+		lower := strings.ToLower(index)
+		if pos := strings.Index(lower, " range "); pos != -1 {
+			index = index[pos+len(" range "):]
+		}
+		n := extractor.RangeWidth(index, constants)
+		if n <= 0 {
+			return 0
+		}
+		count *= n
+	}
+	return count
+}
+
+// splitTopLevel splits s on commas outside parentheses.
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, strings.TrimSpace(s[start:]))
+}
@@ -0,0 +1,47 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestTypeWidths(t *testing.T) {
+	idx := New()
+	idx.Facts = []extractor.FileFacts{
+		{
+			File: "types_pkg.vhd",
+			Types: []extractor.TypeDeclaration{
+				{Name: "byte_t", Kind: "array", IndexTypes: []string{"7 downto 0"}, ElementType: "std_logic"},
+				// Element type declared after its use still resolves
+				{Name: "word_array", Kind: "array", IndexTypes: []string{"0 to DEPTH-1"}, ElementType: "word_t"},
+				{Name: "mem_t", Kind: "array", IndexTypes: []string{"natural range <>"}, ElementType: "byte_t", Unconstrained: true},
+				{Name: "grid_t", Kind: "array", IndexTypes: []string{"0 to 1", "integer range 0 to 2"}, ElementType: "std_logic"},
+				{Name: "state_t", Kind: "enum"},
+			},
+			Subtypes: []extractor.SubtypeDeclaration{
+				{Name: "word_t", BaseType: "std_logic_vector", Constraint: "(WIDTH-1 downto 0)"},
+			},
+		},
+	}
+	constants := map[string]int{"width": 16, "depth": 4}
+	types := idx.buildTypeWidths(constants)
+
+	cases := map[string]int{
+		"std_logic_vector(MAX downto MIN)": 0, // Unknown constants
+		"bit_vector(0 to 15)":              16,
+		"byte_t":                           8,
+		"work.types_pkg.byte_t":            8,
+		"word_t":                           16,
+		"word_array":                       64,
+		"mem_t(0 to 3)":                    32,
+		"mem_t":                            0, // Unconstrained without a constraint
+		"grid_t":                           6,
+		"state_t":                          0,
+	}
+	for typeStr, want := range cases {
+		if got := types.width(typeStr); got != want {
+			t.Errorf("width(%q) = %d, want %d", typeStr, got, want)
+		}
+	}
+}
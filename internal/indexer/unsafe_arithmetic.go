@@ -0,0 +1,122 @@
+package indexer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// vectorArithmeticPackages are the packages that overload "+" and "-" for
+// std_logic_vector itself.
+var vectorArithmeticPackages = map[string]bool{
+	"std_logic_unsigned": true, "std_logic_signed": true, "numeric_std_unsigned": true,
+}
+
+// detectUnsafeArithmetic reports "+" and "-" in processes applied to a
+// std_logic_vector operand in files that use ieee.numeric_std but none of
+// the packages defining vector arithmetic (std_logic_unsigned,
+// std_logic_signed, numeric_std_unsigned). numeric_std only defines the
+// operators for signed and unsigned, so such an operation does not analyze
+// and the usual fix is a non-standard package rather than a conversion.
+// Operands are looked up among the process's variables, the architecture's
+// signals, and its entity's ports, which may be declared in another file.
+func (idx *Indexer) detectUnsafeArithmetic() []policy.UnsafeArithmetic {
+	sorted := make([]extractor.FileFacts, len(idx.Facts))
+	copy(sorted, idx.Facts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].File < sorted[j].File })
+
+	portTypes := make(map[scopedName]string)
+	for _, facts := range sorted {
+		for _, p := range facts.Ports {
+			portTypes[scopedName{strings.ToLower(p.InEntity), strings.ToLower(p.Name)}] = p.Type
+		}
+	}
+
+	found := []policy.UnsafeArithmetic{}
+	for _, facts := range sorted {
+		if !usesOnlyNumericStd(facts) {
+			continue
+		}
+		entityOf := make(map[string]string)
+		for _, arch := range facts.Architectures {
+			entityOf[strings.ToLower(arch.Name)] = strings.ToLower(arch.EntityName)
+		}
+		signalTypes := make(map[scopedName]string)
+		for _, sig := range facts.Signals {
+			signalTypes[scopedName{strings.ToLower(archScopeBase(sig.InEntity)), strings.ToLower(sig.Name)}] = sig.Type
+		}
+		variableTypes := make(map[scopedName]string)
+		for _, proc := range facts.Processes {
+			for _, v := range proc.Variables {
+				variableTypes[scopedName{strings.ToLower(proc.Label), strings.ToLower(v.Name)}] = v.Type
+			}
+		}
+
+		for _, op := range facts.ArithmeticOps {
+			if op.Operator != "+" && op.Operator != "-" {
+				continue
+			}
+			arch := strings.ToLower(archScopeBase(op.InArch))
+			for _, operand := range op.Operands {
+				name := strings.ToLower(operand)
+				typ, ok := variableTypes[scopedName{strings.ToLower(op.InProcess), name}]
+				if !ok {
+					if typ, ok = signalTypes[scopedName{arch, name}]; !ok {
+						typ = portTypes[scopedName{entityOf[arch], name}]
+					}
+				}
+				if !isLogicVectorType(typ) {
+					continue
+				}
+				found = append(found, policy.UnsafeArithmetic{
+					Operator:    op.Operator,
+					Operand:     operand,
+					OperandType: typ,
+					File:        facts.File,
+					Line:        op.Line,
+					InProcess:   op.InProcess,
+					InArch:      op.InArch,
+				})
+				break
+			}
+		}
+	}
+	return found
+}
+
+// usesOnlyNumericStd reports whether a file uses ieee.numeric_std and no
+// package defining arithmetic on std_logic_vector.
+func usesOnlyNumericStd(facts extractor.FileFacts) bool {
+	numericStd := false
+	for _, uc := range facts.UseClauses {
+		for _, item := range uc.Items {
+			parts := strings.Split(strings.ToLower(item), ".")
+			if len(parts) < 2 {
+				continue
+			}
+			pkg := parts[1]
+			if vectorArithmeticPackages[pkg] {
+				return false
+			}
+			if pkg == "numeric_std" {
+				numericStd = true
+			}
+		}
+	}
+	return numericStd
+}
+
+// isLogicVectorType reports whether a subtype indication names
+// std_logic_vector or std_ulogic_vector.
+func isLogicVectorType(typ string) bool {
+	name := strings.ToLower(strings.TrimSpace(typ))
+	if i := strings.IndexAny(name, " ("); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name == "std_logic_vector" || name == "std_ulogic_vector"
+}
@@ -0,0 +1,57 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestDetectUnsafeArithmetic(t *testing.T) {
+	numericStd := []extractor.UseClause{{Items: []string{"ieee.std_logic_1164.all", "ieee.numeric_std.all"}}}
+	idx := New()
+	idx.Facts = []extractor.FileFacts{
+		{
+			File:  "counter_ent.vhd",
+			Ports: []extractor.Port{{Name: "step_i", Type: "std_logic_vector(7 downto 0)", InEntity: "counter"}},
+		},
+		{
+			File:          "counter_rtl.vhd",
+			UseClauses:    numericStd,
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "counter"}},
+			Signals: []extractor.Signal{
+				{Name: "count_q", Type: "std_logic_vector(7 downto 0)", InEntity: "rtl"},
+				{Name: "total_q", Type: "unsigned(7 downto 0)", InEntity: "rtl"},
+			},
+			Processes: []extractor.Process{{
+				Label:     "p",
+				InArch:    "rtl",
+				Variables: []extractor.VariableDecl{{Name: "tmp", Type: "ieee.std_logic_1164.std_ulogic_vector(3 downto 0)"}},
+			}},
+			ArithmeticOps: []extractor.ArithmeticOp{
+				{Operator: "+", Operands: []string{"total_q", "step_i"}, Line: 10, InProcess: "p", InArch: "rtl"},
+				{Operator: "+", Operands: []string{"total_q", "1"}, Line: 11, InProcess: "p", InArch: "rtl"},
+				{Operator: "-", Operands: []string{"tmp", "1"}, Line: 12, InProcess: "p", InArch: "rtl"},
+				{Operator: "*", Operands: []string{"count_q", "count_q"}, Line: 13, InProcess: "p", InArch: "rtl"},
+			},
+		},
+		{
+			File: "legacy.vhd",
+			UseClauses: []extractor.UseClause{
+				{Items: []string{"ieee.numeric_std.all"}},
+				{Items: []string{"ieee.std_logic_unsigned.all"}},
+			},
+			Signals:       []extractor.Signal{{Name: "count_q", Type: "std_logic_vector(7 downto 0)", InEntity: "rtl"}},
+			ArithmeticOps: []extractor.ArithmeticOp{{Operator: "+", Operands: []string{"count_q", "1"}, Line: 5, InArch: "rtl"}},
+		},
+	}
+
+	want := []policy.UnsafeArithmetic{
+		{Operator: "+", Operand: "step_i", OperandType: "std_logic_vector(7 downto 0)", File: "counter_rtl.vhd", Line: 10, InProcess: "p", InArch: "rtl"},
+		{Operator: "-", Operand: "tmp", OperandType: "ieee.std_logic_1164.std_ulogic_vector(3 downto 0)", File: "counter_rtl.vhd", Line: 12, InProcess: "p", InArch: "rtl"},
+	}
+	if got := idx.detectUnsafeArithmetic(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v\nwant %+v", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+package indexer
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// detectUnusedComponentDeclarations reports component declarations that are
+// never instantiated where they are visible. A component declared in an
+// architecture (or a block/generate inside it) must be instantiated within that
+// scope; one declared in a package may be instantiated by any design unit, so
+// instances in every file count. Names match case-insensitively.
+func detectUnusedComponentDeclarations(allFacts []extractor.FileFacts) []policy.UnusedComponentDeclaration {
+	sorted := make([]extractor.FileFacts, len(allFacts))
+	copy(sorted, allFacts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].File < sorted[j].File })
+
+	// Instantiated component names anywhere, for package-level declarations
+	usedAnywhere := make(map[string]bool)
+	for _, facts := range sorted {
+		for _, inst := range facts.Instances {
+			if name := instantiatedUnitName(inst.Target); name != "" {
+				usedAnywhere[name] = true
+			}
+		}
+	}
+
+	found := []policy.UnusedComponentDeclaration{}
+	for _, facts := range sorted {
+		for _, comp := range facts.Components {
+			if comp.IsInstance || comp.Name == "" {
+				continue
+			}
+			name := strings.ToLower(comp.Name)
+			scope, kind := comp.InArch, "architecture"
+			if comp.InPackage != "" {
+				scope, kind = comp.InPackage, "package"
+				if usedAnywhere[name] {
+					continue
+				}
+			} else if componentUsedInScope(facts.Instances, name, comp.InArch) {
+				continue
+			}
+			found = append(found, policy.UnusedComponentDeclaration{
+				Component: comp.Name,
+				Scope:     scope,
+				ScopeKind: kind,
+				File:      facts.File,
+				Line:      comp.Line,
+			})
+		}
+	}
+	return found
+}
+
+// componentUsedInScope reports whether an instance inside scope (or a nested
+// generate/block scope) instantiates the named component.
+func componentUsedInScope(instances []extractor.Instance, name, scope string) bool {
+	prefix := strings.ToLower(scope) + "."
+	for _, inst := range instances {
+		instScope := strings.ToLower(inst.InArch)
+		if scope != "" && instScope != strings.ToLower(scope) && !strings.HasPrefix(instScope, prefix) {
+			continue
+		}
+		if instantiatedUnitName(inst.Target) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// instantiatedUnitName reduces an instance target ("component foo",
+// "work.foo", "entity lib.foo(rtl)") to the lowercase unit name.
+func instantiatedUnitName(target string) string {
+	_, name := splitInstanceTarget(target)
+	return name
+}
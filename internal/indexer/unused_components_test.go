@@ -0,0 +1,49 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestDetectUnusedComponentDeclarations(t *testing.T) {
+	allFacts := []extractor.FileFacts{
+		{
+			File: "comps_pkg.vhd",
+			Components: []extractor.Component{
+				{Name: "fifo", Line: 3, InPackage: "comps_pkg"},
+				{Name: "old_ram", Line: 9, InPackage: "comps_pkg"},
+			},
+		},
+		{
+			File: "top.vhd",
+			Components: []extractor.Component{
+				{Name: "Adder", Line: 5, InArch: "rtl"},
+				{Name: "mux", Line: 10, InArch: "rtl"},
+				{Name: "stale", Line: 15, InArch: "rtl"},
+				{Name: "u_add", EntityRef: "adder", Line: 30, IsInstance: true},
+			},
+			Instances: []extractor.Instance{
+				{Name: "u_add", Target: "ADDER", InArch: "rtl"},
+				{Name: "u_mux", Target: "component mux", InArch: "rtl.gen_lanes"},
+				{Name: "u_fifo", Target: "fifo", InArch: "rtl"},
+			},
+		},
+		{
+			// Same component name used only in another architecture
+			File:      "other.vhd",
+			Instances: []extractor.Instance{{Name: "u_stale", Target: "stale", InArch: "behav"}},
+		},
+	}
+
+	found := detectUnusedComponentDeclarations(allFacts)
+	if len(found) != 2 {
+		t.Fatalf("expected 2 unused components, got %+v", found)
+	}
+	if found[0].Component != "old_ram" || found[0].ScopeKind != "package" || found[0].Scope != "comps_pkg" {
+		t.Fatalf("unexpected package finding: %+v", found[0])
+	}
+	if found[1].Component != "stale" || found[1].ScopeKind != "architecture" || found[1].File != "top.vhd" || found[1].Line != 15 {
+		t.Fatalf("unexpected architecture finding: %+v", found[1])
+	}
+}
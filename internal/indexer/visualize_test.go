@@ -78,19 +78,20 @@ func TestProgressOutputIncludesDeps(t *testing.T) {
 	idx := NewWithConfig(cfg)
 	idx.Progress = true
 
+	// Progress is diagnostic output and goes to stderr
 	reader, writer, err := os.Pipe()
 	if err != nil {
-		t.Fatalf("pipe stdout: %v", err)
+		t.Fatalf("pipe stderr: %v", err)
 	}
-	oldStdout := os.Stdout
-	os.Stdout = writer
+	oldStderr := os.Stderr
+	os.Stderr = writer
 	if err := idx.Run(dir); err != nil {
 		_ = writer.Close()
-		os.Stdout = oldStdout
+		os.Stderr = oldStderr
 		t.Fatalf("run: %v", err)
 	}
 	_ = writer.Close()
-	os.Stdout = oldStdout
+	os.Stderr = oldStderr
 
 	output, _ := io.ReadAll(reader)
 	_ = reader.Close()
@@ -0,0 +1,297 @@
+package indexer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+// Default watch timings: successive writes within DefaultWatchDebounce are
+// linted once, and the polling fallback rescans every DefaultWatchPollInterval.
+const (
+	DefaultWatchDebounce     = 200 * time.Millisecond
+	DefaultWatchPollInterval = time.Second
+)
+
+// fileNotifier delivers the paths of files created, written, moved, or
+// removed under a watched directory.
+type fileNotifier interface {
+	Events() <-chan string
+	Close() error
+}
+
+// Watcher re-lints a directory whenever its VHDL files change. Extraction
+// state stays resident in a Server, so only files whose content changed are
+// re-extracted; violations are refreshed for the changed files and every
+// file that depends on them, and each run prints the delta (new vs resolved)
+// since the previous one.
+type Watcher struct {
+	Debounce     time.Duration
+	PollInterval time.Duration
+
+	srv        *Server
+	root       string
+	out        io.Writer
+	last       map[string][]policy.Violation // Violations by file as of the last run
+	dependents dependentsGraph               // Dependents graph as of the last run
+}
+
+// NewWatcher wraps idx for watching root, writing deltas to out.
+func NewWatcher(idx *Indexer, root string, out io.Writer) *Watcher {
+	return &Watcher{
+		Debounce:     DefaultWatchDebounce,
+		PollInterval: DefaultWatchPollInterval,
+		srv:          NewServer(idx),
+		root:         root,
+		out:          out,
+		last:         make(map[string][]policy.Violation),
+	}
+}
+
+// Run lints root once, then re-lints on every debounced batch of changes
+// until stop is closed. Filesystem notifications are used when available;
+// otherwise the directory is polled.
+func (w *Watcher) Run(stop <-chan struct{}) error {
+	defer w.srv.Shutdown()
+	result, err := w.srv.Lint(w.root)
+	if err != nil {
+		return err
+	}
+	w.last = violationsByFile(result.Violations)
+	w.dependents = w.currentDependents()
+	fmt.Fprintf(w.out, "watch: %d files, %d violations (%d errors, %d warnings, %d info)\n",
+		result.Stats.Files, len(result.Violations), result.Summary.Errors, result.Summary.Warnings, result.Summary.Info)
+
+	notifier, err := newFileNotifier(w.root)
+	if err != nil {
+		fmt.Fprintf(w.out, "watch: file notifications unavailable (%v); polling every %s\n", err, w.PollInterval)
+		notifier = newPollNotifier(w.root, w.PollInterval)
+	}
+	defer notifier.Close()
+
+	pending := make(map[string]bool)
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-stop:
+			return nil
+		case path, ok := <-notifier.Events():
+			if !ok {
+				return nil
+			}
+			if !isVHDLPath(path) {
+				continue
+			}
+			pending[filepath.Clean(path)] = true
+			debounce = time.After(w.Debounce)
+		case <-debounce:
+			changed := make([]string, 0, len(pending))
+			for path := range pending {
+				changed = append(changed, path)
+			}
+			sort.Strings(changed)
+			pending = make(map[string]bool)
+			debounce = nil
+			if err := w.relint(changed); err != nil {
+				fmt.Fprintf(w.out, "watch: %v\n", err)
+			}
+		}
+	}
+}
+
+// relint lints root again and prints the violation delta for the changed
+// files and their dependents. Violations in other files keep their previous
+// state.
+func (w *Watcher) relint(changed []string) error {
+	result, err := w.srv.Lint(w.root)
+	if err != nil {
+		return err
+	}
+	current := violationsByFile(result.Violations)
+	next := w.currentDependents()
+	affected := affectedFiles(changed, w.dependents, next)
+	w.dependents = next
+
+	var added, resolved []policy.Violation
+	for _, file := range affected {
+		a, r := diffViolations(w.last[file], current[file])
+		added = append(added, a...)
+		resolved = append(resolved, r...)
+		if len(current[file]) > 0 {
+			w.last[file] = current[file]
+		} else {
+			delete(w.last, file)
+		}
+	}
+	w.printDelta(changed, affected, added, resolved)
+	return nil
+}
+
+// currentDependents builds the dependents graph from the resident facts.
+func (w *Watcher) currentDependents() dependentsGraph {
+	idx := w.srv.idx
+	factsByFile := make(map[string]extractor.FileFacts, len(idx.Facts))
+	for _, facts := range idx.Facts {
+		factsByFile[facts.File] = facts
+	}
+	return buildDependentsGraph(factsByFile, idx.Symbols, idx.FileLibraries)
+}
+
+// affectedFiles returns the changed files plus everything that depends on
+// them, transitively, in either the previous or the current graph (so the
+// dependents of a deleted file are still re-linted).
+func affectedFiles(changed []string, graphs ...dependentsGraph) []string {
+	seen := make(map[string]bool)
+	for _, file := range changed {
+		seen[file] = true
+		for _, graph := range graphs {
+			for _, level := range computeImpact(file, graph).Levels {
+				for _, dep := range level {
+					seen[dep] = true
+				}
+			}
+		}
+	}
+	files := make([]string, 0, len(seen))
+	for file := range seen {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// violationsByFile groups violations by cleaned file path.
+func violationsByFile(violations []policy.Violation) map[string][]policy.Violation {
+	byFile := make(map[string][]policy.Violation)
+	for _, v := range violations {
+		file := filepath.Clean(v.File)
+		byFile[file] = append(byFile[file], v)
+	}
+	return byFile
+}
+
+// diffViolations returns the violations in current but not prev (added) and
+// in prev but not current (resolved). Violations are matched like baseline
+// entries, by rule and normalized message, so a violation that only moved
+// lines is neither.
+func diffViolations(prev, current []policy.Violation) (added, resolved []policy.Violation) {
+	remaining := make(map[BaselineEntry]int)
+	for _, v := range prev {
+		remaining[baselineEntryFor(v)]++
+	}
+	for _, v := range current {
+		key := baselineEntryFor(v)
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+		added = append(added, v)
+	}
+	for i := len(prev) - 1; i >= 0; i-- {
+		key := baselineEntryFor(prev[i])
+		if remaining[key] > 0 {
+			remaining[key]--
+			resolved = append([]policy.Violation{prev[i]}, resolved...)
+		}
+	}
+	return added, resolved
+}
+
+// printDelta writes one summary line and one line per new ("+") or
+// resolved ("-") violation.
+func (w *Watcher) printDelta(changed, affected []string, added, resolved []policy.Violation) {
+	fmt.Fprintf(w.out, "watch: %d changed, %d affected: %d new, %d resolved\n",
+		len(changed), len(affected), len(added), len(resolved))
+	for _, v := range added {
+		fmt.Fprintf(w.out, "  + [%s] %s:%d - %s\n", v.Rule, v.File, v.Line, v.Message)
+	}
+	for _, v := range resolved {
+		fmt.Fprintf(w.out, "  - [%s] %s:%d - %s\n", v.Rule, v.File, v.Line, v.Message)
+	}
+}
+
+func isVHDLPath(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".vhd" || ext == ".vhdl"
+}
+
+// pollNotifier reports VHDL files whose size or modification time changed,
+// or that appeared or disappeared, between rescans of a directory.
+type pollNotifier struct {
+	events chan string
+	done   chan struct{}
+}
+
+type pollStamp struct {
+	size    int64
+	modTime time.Time
+}
+
+func newPollNotifier(root string, interval time.Duration) *pollNotifier {
+	p := &pollNotifier{events: make(chan string), done: make(chan struct{})}
+	go p.run(root, pollSnapshot(root), interval)
+	return p
+}
+
+func (p *pollNotifier) Events() <-chan string { return p.events }
+
+func (p *pollNotifier) Close() error {
+	close(p.done)
+	return nil
+}
+
+func (p *pollNotifier) run(root string, prev map[string]pollStamp, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+		}
+		next := pollSnapshot(root)
+		var changed []string
+		for path, stamp := range next {
+			if old, ok := prev[path]; !ok || old != stamp {
+				changed = append(changed, path)
+			}
+		}
+		for path := range prev {
+			if _, ok := next[path]; !ok {
+				changed = append(changed, path)
+			}
+		}
+		sort.Strings(changed)
+		for _, path := range changed {
+			select {
+			case p.events <- path:
+			case <-p.done:
+				return
+			}
+		}
+		prev = next
+	}
+}
+
+// pollSnapshot stamps every VHDL file under root. Unreadable entries are
+// skipped.
+func pollSnapshot(root string) map[string]pollStamp {
+	stamps := make(map[string]pollStamp)
+	_ = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !info.IsDir() && isVHDLPath(path) {
+			stamps[path] = pollStamp{size: info.Size(), modTime: info.ModTime()}
+		}
+		return nil
+	})
+	return stamps
+}
@@ -0,0 +1,109 @@
+package indexer
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+const inotifyMask = syscall.IN_CLOSE_WRITE | syscall.IN_CREATE | syscall.IN_DELETE |
+	syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO
+
+// inotifyNotifier watches every directory under a root with inotify. The
+// descriptor is non-blocking and wrapped in an *os.File, so reads park in the
+// runtime poller and Close unblocks them.
+type inotifyNotifier struct {
+	file   *os.File
+	fd     int
+	events chan string
+	done   chan struct{}
+
+	mu   sync.Mutex
+	dirs map[int32]string // Watch descriptor -> directory
+}
+
+func newFileNotifier(root string) (fileNotifier, error) {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("inotify: %w", err)
+	}
+	n := &inotifyNotifier{
+		file:   os.NewFile(uintptr(fd), "inotify"),
+		fd:     fd,
+		events: make(chan string),
+		done:   make(chan struct{}),
+		dirs:   make(map[int32]string),
+	}
+	if err := n.addTree(root); err != nil {
+		n.file.Close()
+		return nil, err
+	}
+	go n.run()
+	return n, nil
+}
+
+func (n *inotifyNotifier) Events() <-chan string { return n.events }
+
+func (n *inotifyNotifier) Close() error {
+	close(n.done)
+	return n.file.Close()
+}
+
+// addTree watches dir and every directory below it.
+func (n *inotifyNotifier) addTree(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+		wd, err := syscall.InotifyAddWatch(n.fd, path, inotifyMask)
+		if err != nil {
+			return fmt.Errorf("inotify watch %s: %w", path, err)
+		}
+		n.mu.Lock()
+		n.dirs[int32(wd)] = path
+		n.mu.Unlock()
+		return nil
+	})
+}
+
+// run decodes events until the descriptor is closed, then closes Events.
+func (n *inotifyNotifier) run() {
+	defer close(n.events)
+	buf := make([]byte, 64*1024)
+	for {
+		count, err := n.file.Read(buf)
+		if err != nil {
+			return
+		}
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= count; {
+			event := (*syscall.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameStart := offset + syscall.SizeofInotifyEvent
+			name := string(bytes.TrimRight(buf[nameStart:nameStart+int(event.Len)], "\x00"))
+			offset = nameStart + int(event.Len)
+
+			n.mu.Lock()
+			dir, ok := n.dirs[event.Wd]
+			n.mu.Unlock()
+			if !ok || name == "" {
+				continue
+			}
+			path := filepath.Join(dir, name)
+			if event.Mask&syscall.IN_ISDIR != 0 {
+				// New directories are watched as they appear
+				if event.Mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 {
+					_ = n.addTree(path)
+				}
+				continue
+			}
+			select {
+			case n.events <- path:
+			case <-n.done:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+
+package indexer
+
+import "errors"
+
+// newFileNotifier has no backend outside Linux; Watcher falls back to
+// polling.
+func newFileNotifier(root string) (fileNotifier, error) {
+	return nil, errors.New("no file notification backend on this platform")
+}
@@ -0,0 +1,118 @@
+package indexer
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+func TestDiffViolations(t *testing.T) {
+	prev := []policy.Violation{
+		{Rule: "unused_signal", File: "a.vhd", Line: 3, Message: "Signal 'x' is declared but never used"},
+		{Rule: "latch_inference", File: "a.vhd", Line: 10, Message: "Latch on 'q'"},
+	}
+	current := []policy.Violation{
+		// Moved down two lines: neither new nor resolved
+		{Rule: "unused_signal", File: "a.vhd", Line: 5, Message: "Signal 'x' is declared but never used"},
+		{Rule: "undriven_signal", File: "a.vhd", Line: 7, Message: "Signal 'y' is read but never driven"},
+	}
+
+	added, resolved := diffViolations(prev, current)
+	if len(added) != 1 || added[0].Rule != "undriven_signal" {
+		t.Fatalf("expected undriven_signal to be new, got %+v", added)
+	}
+	if len(resolved) != 1 || resolved[0].Rule != "latch_inference" {
+		t.Fatalf("expected latch_inference to be resolved, got %+v", resolved)
+	}
+}
+
+func TestAffectedFilesIncludesDependents(t *testing.T) {
+	symbols := &SymbolTable{symbols: make(map[string]Symbol)}
+	symbols.Add(Symbol{Name: "work.pkg", Kind: "package", File: "pkg.vhd", Line: 1})
+	symbols.Add(Symbol{Name: "work.mid", Kind: "entity", File: "mid.vhd", Line: 1})
+	factsByFile := map[string]extractor.FileFacts{
+		"pkg.vhd":   {File: "pkg.vhd"},
+		"mid.vhd":   {File: "mid.vhd", Dependencies: []extractor.Dependency{{Target: "work.pkg.all", Kind: "use", Line: 1}}},
+		"top.vhd":   {File: "top.vhd", Dependencies: []extractor.Dependency{{Target: "work.mid", Kind: "instantiation", Line: 5}}},
+		"other.vhd": {File: "other.vhd"},
+	}
+	graph := buildDependentsGraph(factsByFile, symbols, map[string]config.FileLibraryInfo{})
+
+	got := affectedFiles([]string{"pkg.vhd"}, graph)
+	want := []string{"mid.vhd", "pkg.vhd", "top.vhd"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	// A deleted package has no dependents in the new graph; the previous
+	// graph still supplies them
+	got = affectedFiles([]string{"pkg.vhd"}, graph, dependentsGraph{})
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("with empty current graph: got %v, want %v", got, want)
+	}
+}
+
+func TestPollNotifierReportsChanges(t *testing.T) {
+	dir := t.TempDir()
+	a := writeVHDL(t, dir, "a.vhd", "entity a is end;")
+	writeVHDL(t, dir, "notes.txt", "ignored")
+
+	p := newPollNotifier(dir, 10*time.Millisecond)
+	defer p.Close()
+
+	// Same size, later modification time
+	if err := os.WriteFile(a, []byte("entity b is end;"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(a, future, future); err != nil {
+		t.Fatal(err)
+	}
+	b := writeVHDL(t, dir, "b.vhdl", "entity b is end;")
+
+	seen := make(map[string]bool)
+	timeout := time.After(2 * time.Second)
+	for len(seen) < 2 {
+		select {
+		case path := <-p.Events():
+			seen[filepath.Clean(path)] = true
+		case <-timeout:
+			t.Fatalf("timed out waiting for changes, saw %v", seen)
+		}
+	}
+	if !seen[a] || !seen[b] {
+		t.Fatalf("expected %s and %s, saw %v", a, b, seen)
+	}
+}
+
+func TestFileNotifierReportsWrites(t *testing.T) {
+	dir := t.TempDir()
+	sub := filepath.Join(dir, "rtl")
+	if err := os.Mkdir(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	n, err := newFileNotifier(dir)
+	if err != nil {
+		t.Skipf("no notification backend: %v", err)
+	}
+	defer n.Close()
+
+	path := writeVHDL(t, sub, "a.vhd", "entity a is end;")
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case got := <-n.Events():
+			if got == path {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for %s", path)
+		}
+	}
+}
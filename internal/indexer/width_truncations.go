@@ -0,0 +1,338 @@
+package indexer
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
+)
+
+var (
+	// bitStringRe matches a bit string literal: "0101", x"FF", 12x"FF".
+	bitStringRe = regexp.MustCompile(`(?i)^(\d*)([box]?)"([^"]*)"$`)
+	// conversionCallRe matches a type conversion or numeric_std call and
+	// captures its name and argument list.
+	conversionCallRe = regexp.MustCompile(`(?i)^(std_logic_vector|std_ulogic_vector|unsigned|signed|resize|to_unsigned|to_signed)\s*\((.*)\)$`)
+	logicalOpRe      = regexp.MustCompile(`(?i)\b(?:and|or|xor|nand|nor|xnor)\b`)
+	// widthBlockerRe matches operators whose result width is not the width
+	// of an operand: relations, division, exponentiation, and shifts.
+	widthBlockerRe = regexp.MustCompile(`(?i)[=<>/]|\*\*|\b(?:mod|rem|abs|sll|srl|sla|sra|rol|ror)\b`)
+	integerLitRe   = regexp.MustCompile(`^\d[\d_]*$`)
+)
+
+// scalarTypes are types whose bit width is not the width of their values on
+// assignment, so truncation between them is a range check instead.
+var scalarTypes = map[string]bool{
+	"integer":   true,
+	"natural":   true,
+	"positive":  true,
+	"boolean":   true,
+	"real":      true,
+	"time":      true,
+	"character": true,
+	"string":    true,
+}
+
+// detectWidthTruncations flags signal assignments whose target is a whole
+// signal or port of known width and whose driven value is wider. A value's
+// width comes from its operands: names and slices take their declared or
+// index-range width, bit string literals their digit count, a concatenation
+// the sum of its operands, and "+", "-", and the logical operators the width
+// of their widest operand. Values whose width cannot be computed are
+// skipped.
+func (idx *Indexer) detectWidthTruncations() []policy.WidthTruncation {
+	constants := idx.globalConstants()
+	types := idx.buildTypeWidths(constants)
+
+	found := []policy.WidthTruncation{}
+	for _, facts := range idx.Facts {
+		widths := vectorWidths(facts, types)
+		for _, drive := range facts.AssignmentDrives {
+			if !drive.Whole || drive.HighZ {
+				continue
+			}
+			scope := strings.ToLower(archScopeBase(drive.InArch))
+			targetWidth := widths[scopedName{scope, strings.ToLower(drive.Target)}]
+			if targetWidth <= 0 {
+				continue
+			}
+			source, sourceWidth := "", 0
+			for _, value := range drive.Values {
+				if w := expressionWidth(value, scope, widths, constants); w > sourceWidth {
+					source, sourceWidth = value, w
+				}
+			}
+			if sourceWidth <= targetWidth {
+				continue
+			}
+			found = append(found, policy.WidthTruncation{
+				Target:      drive.Target,
+				TargetWidth: targetWidth,
+				Source:      source,
+				SourceWidth: sourceWidth,
+				File:        facts.File,
+				Line:        drive.Line,
+				InProcess:   drive.InProcess,
+				InArch:      drive.InArch,
+			})
+		}
+	}
+	return found
+}
+
+// vectorWidths is localWidths without scalar types such as integers, whose
+// computed width is a range size rather than a bit count.
+func vectorWidths(facts extractor.FileFacts, types *typeWidths) map[scopedName]int {
+	widths := make(map[scopedName]int)
+	for _, sig := range facts.Signals {
+		if !isScalarType(sig.Type) {
+			widths[scopedName{strings.ToLower(archScopeBase(sig.InEntity)), strings.ToLower(sig.Name)}] =
+				types.width(sig.Type)
+		}
+	}
+	for _, arch := range facts.Architectures {
+		for _, p := range facts.Ports {
+			if strings.EqualFold(p.InEntity, arch.EntityName) && !isScalarType(p.Type) {
+				widths[scopedName{strings.ToLower(arch.Name), strings.ToLower(p.Name)}] =
+					types.width(p.Type)
+			}
+		}
+	}
+	return widths
+}
+
+// isScalarType reports whether a subtype indication names a scalar type.
+func isScalarType(typ string) bool {
+	fields := strings.Fields(strings.ToLower(typ))
+	return len(fields) > 0 && scalarTypes[strings.TrimSuffix(fields[0], ";")]
+}
+
+// expressionWidth returns the bit width of an assigned value in the
+// architecture scope, or 0 when it cannot be computed.
+func expressionWidth(expr, scope string, widths map[scopedName]int, constants map[string]int) int {
+	expr = stripEnclosingParens(strings.TrimSpace(expr))
+	if expr == "" || strings.Contains(expr, "=>") {
+		return 0 // Aggregates take the target's width
+	}
+	masked := maskNested(expr)
+	if widthBlockerRe.MatchString(masked) {
+		return 0
+	}
+
+	// Logical operators bind loosest and require equal widths
+	if parts := splitAt(expr, logicalOpRe.FindAllStringIndex(masked, -1)); len(parts) > 1 {
+		return widestOperand(parts, scope, widths, constants)
+	}
+
+	// "&", "+", and "-" share a precedence level; mixing them is skipped
+	concat := opIndexes(masked, "&")
+	adding := addingOpIndexes(masked)
+	switch {
+	case len(concat) > 0 && len(adding) > 0:
+		return 0
+	case len(concat) > 0:
+		total := 0
+		for _, part := range splitAt(expr, concat) {
+			w := expressionWidth(part, scope, widths, constants)
+			if w <= 0 {
+				return 0
+			}
+			total += w
+		}
+		return total
+	case len(adding) > 0:
+		return widestOperand(splitAt(expr, adding), scope, widths, constants)
+	}
+
+	if mul := opIndexes(masked, "*"); len(mul) > 0 {
+		// A numeric_std product is as wide as its operands together
+		total := 0
+		for _, part := range splitAt(expr, mul) {
+			w := expressionWidth(part, scope, widths, constants)
+			if w <= 0 {
+				return 0
+			}
+			total += w
+		}
+		return total
+	}
+	return operandWidth(expr, scope, widths, constants)
+}
+
+// widestOperand returns the width of the widest operand. Integer literals
+// adapt to the other operand and are ignored; any other operand of unknown
+// width makes the result unknown.
+func widestOperand(parts []string, scope string, widths map[scopedName]int, constants map[string]int) int {
+	widest := 0
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if integerLitRe.MatchString(part) {
+			continue
+		}
+		w := expressionWidth(part, scope, widths, constants)
+		if w <= 0 {
+			return 0
+		}
+		if w > widest {
+			widest = w
+		}
+	}
+	return widest
+}
+
+// operandWidth returns the width of a single operand: a character or bit
+// string literal, a conversion call, a negation, or a whole or sliced name.
+func operandWidth(expr, scope string, widths map[scopedName]int, constants map[string]int) int {
+	lower := strings.ToLower(expr)
+	if len(expr) == 3 && expr[0] == '\'' && expr[2] == '\'' {
+		return 1
+	}
+	if m := bitStringRe.FindStringSubmatch(expr); m != nil {
+		if m[1] != "" {
+			n, _ := strconv.Atoi(m[1])
+			return n
+		}
+		digits := len(strings.ReplaceAll(m[3], "_", ""))
+		switch strings.ToLower(m[2]) {
+		case "o":
+			return 3 * digits
+		case "x":
+			return 4 * digits
+		}
+		return digits
+	}
+	if strings.HasPrefix(lower, "not ") || strings.HasPrefix(lower, "not(") {
+		return expressionWidth(expr[3:], scope, widths, constants)
+	}
+	if m := conversionCallRe.FindStringSubmatch(expr); m != nil {
+		args := splitAt(m[2], opIndexes(maskNested(m[2]), ","))
+		switch strings.ToLower(m[1]) {
+		case "resize", "to_unsigned", "to_signed":
+			if len(args) != 2 {
+				return 0
+			}
+			return constantValue(args[1], constants)
+		}
+		if len(args) != 1 {
+			return 0
+		}
+		return expressionWidth(args[0], scope, widths, constants)
+	}
+	return actualPortWidth(expr, scope, widths, constants)
+}
+
+// constantValue evaluates an integer literal or a named constant, returning
+// 0 when it is neither.
+func constantValue(expr string, constants map[string]int) int {
+	expr = strings.TrimSpace(expr)
+	if n, err := strconv.Atoi(strings.ReplaceAll(expr, "_", "")); err == nil {
+		return n
+	}
+	return constants[strings.ToLower(expr)]
+}
+
+// stripEnclosingParens removes parentheses that wrap the whole expression.
+func stripEnclosingParens(expr string) string {
+	for len(expr) >= 2 && expr[0] == '(' && expr[len(expr)-1] == ')' {
+		masked := maskNested(expr[1 : len(expr)-1])
+		// "(a) & (b)" is not enclosed: its inner text has unbalanced parens
+		if strings.Count(masked, "(") != strings.Count(masked, ")") || strings.Index(masked, ")") < strings.Index(masked, "(") {
+			break
+		}
+		expr = strings.TrimSpace(expr[1 : len(expr)-1])
+	}
+	return expr
+}
+
+// maskNested returns expr with the text inside parentheses, string
+// literals, and character literals replaced by '#', so operators found in
+// the result are top-level. The mask has the same length as expr.
+func maskNested(expr string) string {
+	b := []byte(expr)
+	depth := 0
+	inString := false
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case inString:
+			if c == '"' {
+				inString = false
+			} else {
+				b[i] = '#'
+			}
+		case c == '"':
+			inString = true
+		case c == '\'' && i+2 < len(b) && b[i+2] == '\'' && (i == 0 || !isWordByte(b[i-1])):
+			b[i+1] = '#'
+			i += 2
+		case c == '(':
+			if depth > 0 {
+				b[i] = '#'
+			}
+			depth++
+		case c == ')':
+			depth--
+			if depth > 0 {
+				b[i] = '#'
+			}
+		default:
+			if depth > 0 {
+				b[i] = '#'
+			}
+		}
+	}
+	return string(b)
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c == ')' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// opIndexes returns the [start, end) spans of op in the masked expression.
+func opIndexes(masked, op string) [][]int {
+	var spans [][]int
+	for i := 0; i+len(op) <= len(masked); {
+		j := strings.Index(masked[i:], op)
+		if j == -1 {
+			break
+		}
+		spans = append(spans, []int{i + j, i + j + len(op)})
+		i += j + len(op)
+	}
+	return spans
+}
+
+// addingOpIndexes returns the spans of binary "+" and "-" in the masked
+// expression; a sign at the start or after another operator is unary.
+func addingOpIndexes(masked string) [][]int {
+	var spans [][]int
+	for i := 0; i < len(masked); i++ {
+		if masked[i] != '+' && masked[i] != '-' {
+			continue
+		}
+		prev := strings.TrimRight(masked[:i], " \t")
+		if prev == "" {
+			continue
+		}
+		if last := prev[len(prev)-1]; isWordByte(last) || last == '"' || last == '\'' || last == '#' {
+			spans = append(spans, []int{i, i + 1})
+		}
+	}
+	return spans
+}
+
+// splitAt splits expr around the operator spans found in its mask.
+func splitAt(expr string, spans [][]int) []string {
+	if len(spans) == 0 {
+		return []string{expr}
+	}
+	var parts []string
+	start := 0
+	for _, span := range spans {
+		parts = append(parts, strings.TrimSpace(expr[start:span[0]]))
+		start = span[1]
+	}
+	return append(parts, strings.TrimSpace(expr[start:]))
+}
@@ -0,0 +1,61 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestDetectWidthTruncations(t *testing.T) {
+	idx := New()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{}
+	drive := func(target string, whole bool, line int, values ...string) extractor.AssignmentDrive {
+		return extractor.AssignmentDrive{Target: target, Whole: whole, Values: values, Line: line, InArch: "rtl"}
+	}
+	idx.Facts = []extractor.FileFacts{{
+		File:          "top.vhd",
+		Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top"}},
+		Ports: []extractor.Port{
+			{Name: "byte_o", Direction: "out", Type: "std_logic_vector(7 downto 0)", InEntity: "top"},
+		},
+		ConstantDecls: []extractor.ConstantDeclaration{{Name: "W", Value: "12"}},
+		Signals: []extractor.Signal{
+			{Name: "nib", Type: "std_logic_vector(3 downto 0)", InEntity: "rtl"},
+			{Name: "word", Type: "std_logic_vector(15 downto 0)", InEntity: "rtl"},
+			{Name: "cnt", Type: "unsigned(7 downto 0)", InEntity: "rtl"},
+			{Name: "big", Type: "unsigned(11 downto 0)", InEntity: "rtl"},
+			{Name: "idx", Type: "integer range 0 to 255", InEntity: "rtl"},
+			{Name: "small", Type: "integer range 0 to 3", InEntity: "rtl"},
+		},
+		AssignmentDrives: []extractor.AssignmentDrive{
+			drive("byte_o", true, 10, "word"),
+			drive("byte_o", true, 11, "word(7 downto 0)"),
+			drive("byte_o", true, 12, "nib & nib & '0'"),
+			drive("nib", true, 13, `x"F"`),
+			drive("cnt", true, 14, "cnt + 1"),
+			drive("cnt", true, 15, "resize(big, W)", "cnt"),
+			drive("cnt", true, 16, "(others => '0')"),
+			drive("byte_o", false, 17, "word"),
+			drive("small", true, 18, "idx"),
+			drive("byte_o", true, 19, "std_logic_vector(cnt + big)"),
+			drive("nib", true, 20, "some_func(word)"),
+		},
+	}}
+
+	got := idx.detectWidthTruncations()
+	want := []struct {
+		line, target, source int
+	}{{10, 8, 16}, {12, 8, 9}, {15, 8, 12}, {19, 8, 12}}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d truncations, got %+v", len(want), got)
+	}
+	for i, w := range want {
+		if got[i].Line != w.line || got[i].TargetWidth != w.target || got[i].SourceWidth != w.source {
+			t.Errorf("truncation %d: got %+v, want line %d %d <- %d bits", i, got[i], w.line, w.target, w.source)
+		}
+	}
+	if got[2].Source != "resize(big, W)" {
+		t.Errorf("expected the widest value as source, got %q", got[2].Source)
+	}
+}
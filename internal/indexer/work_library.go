@@ -0,0 +1,23 @@
+package indexer
+
+import "strings"
+
+// FileLibrary returns the lowercase library a file is compiled into, "work"
+// when no library is configured for it.
+func (idx *Indexer) FileLibrary(file string) string {
+	if info, ok := idx.FileLibraries[file]; ok && info.LibraryName != "" {
+		return strings.ToLower(info.LibraryName)
+	}
+	return "work"
+}
+
+// resolveWorkPrefix rewrites a "work." prefix to lib, the library of the
+// file that contains the reference. In VHDL "work" always means the current
+// design unit's own library, so "work.fifo" in a file compiled into lib_a is
+// lib_a.fifo. Targets without a work prefix are returned unchanged.
+func resolveWorkPrefix(target, lib string) string {
+	if len(target) < 5 || !strings.EqualFold(target[:5], "work.") || lib == "" {
+		return target
+	}
+	return lib + target[4:]
+}
@@ -0,0 +1,63 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
+)
+
+func TestBuildPolicyInputResolvesWorkInInstanceTargets(t *testing.T) {
+	idx := New()
+	idx.Config = config.DefaultConfig()
+	idx.FileLibraries = map[string]config.FileLibraryInfo{
+		"a/fifo.vhd":  {LibraryName: "lib_a"},
+		"a/top_a.vhd": {LibraryName: "lib_a"},
+		"b/fifo.vhd":  {LibraryName: "Lib_B"},
+		"b/top_b.vhd": {LibraryName: "Lib_B"},
+	}
+	idx.ThirdPartyFiles = map[string]bool{}
+	idx.Facts = []extractor.FileFacts{
+		{File: "a/fifo.vhd", Entities: []extractor.Entity{{Name: "fifo", Line: 1}}},
+		{File: "b/fifo.vhd", Entities: []extractor.Entity{{Name: "fifo", Line: 1}}},
+		{
+			File:          "a/top_a.vhd",
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top_a", Line: 1}},
+			Instances:     []extractor.Instance{{Name: "u_fifo", Target: "work.fifo", Line: 4, InArch: "rtl"}},
+		},
+		{
+			File:          "b/top_b.vhd",
+			Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "top_b", Line: 1}},
+			Instances:     []extractor.Instance{{Name: "u_fifo", Target: "work.fifo", Line: 4, InArch: "rtl"}},
+		},
+	}
+	for _, facts := range idx.Facts {
+		idx.registerSymbolsForFacts(facts, facts.File)
+	}
+
+	input := idx.buildPolicyInput()
+	want := map[string]string{"a/top_a.vhd": "lib_a.fifo", "b/top_b.vhd": "lib_b.fifo"}
+	if len(input.Instances) != 2 {
+		t.Fatalf("expected 2 instances, got %+v", input.Instances)
+	}
+	for _, inst := range input.Instances {
+		if inst.Target != want[inst.File] || inst.RawTarget != "work.fifo" {
+			t.Fatalf("%s: expected target %q (raw work.fifo), got %q (raw %q)", inst.File, want[inst.File], inst.Target, inst.RawTarget)
+		}
+	}
+}
+
+func TestResolveWorkPrefix(t *testing.T) {
+	cases := map[string]string{
+		"work.fifo":  "lib_a.fifo",
+		"WORK.Fifo":  "lib_a.Fifo",
+		"ieee.fifo":  "ieee.fifo",
+		"fifo":       "fifo",
+		"workshop.x": "workshop.x",
+	}
+	for in, want := range cases {
+		if got := resolveWorkPrefix(in, "lib_a"); got != want {
+			t.Errorf("resolveWorkPrefix(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
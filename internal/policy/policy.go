@@ -14,7 +14,8 @@ import (
 
 // Engine evaluates Rust policy rules against VHDL facts
 type Engine struct {
-	binaryPath string
+	binaryPath   string
+	onRuleTiming func(RuleTiming)
 }
 
 // Violation represents a policy violation
@@ -109,10 +110,57 @@ type Input struct {
 	SharedVariables []string `json:"shared_variables"` // Shared variable names (not signals)
 	// Advanced analysis for security/power/correctness
 	Comparisons   []Comparison   `json:"comparisons"`    // Comparisons for trojan/trigger detection
-	ArithmeticOps []ArithmeticOp `json:"arithmetic_ops"` // Expensive operations for power analysis
+	ArithmeticOps []ArithmeticOp `json:"arithmetic_ops"` // Arithmetic in processes (power analysis, operand types)
 	SignalDeps    []SignalDep    `json:"signal_deps"`    // Signal dependencies for loop detection
 	CDCCrossings  []CDCCrossing  `json:"cdc_crossings"`  // Clock domain crossings
 	SignalUsages  []SignalUsage  `json:"signal_usages"`  // Signal read/write/port-map tracking
+	// Analysis findings (extractor Detect* passes and cross-file checks)
+	OverlappingChoices          []OverlappingChoice          `json:"overlapping_choices"`           // Duplicate/overlapping case choices
+	InconsistentResetPolarities []InconsistentResetPolarity  `json:"inconsistent_reset_polarities"` // Reset nets used with conflicting polarity
+	ConstantsInSensitivityList  []ConstantInSensitivityList  `json:"constants_in_sensitivity_list"` // Constants/generics named in sensitivity lists
+	MagicNumbers                []MagicNumber                `json:"magic_numbers"`                 // Integer literals used in place of named constants
+	PortsShadowedBySignal       []PortShadowedBySignal       `json:"ports_shadowed_by_signal"`      // Architecture signals reusing a port name of their entity
+	ClockLevelConditions        []ClockLevelCondition        `json:"clock_level_conditions"`        // Concurrent assignments gated on a clock level
+	NoEffectProcesses           []NoEffectProcess            `json:"no_effect_processes"`           // Processes with no signal writes, calls, or assertions
+	CombinationalMultiDrivers   []CombinationalMultiDriver   `json:"combinational_multi_drivers"`   // Signals written by several combinational processes
+	MixedSliceDirections        []MixedSliceDirection        `json:"mixed_slice_directions"`        // Signals sliced both ascending and descending in one architecture
+	UnusedComponentDeclarations []UnusedComponentDeclaration `json:"unused_component_declarations"` // Component declarations never instantiated where visible
+	PermanentHighZs             []PermanentHighZ             `json:"permanent_high_z"`              // Signals only ever assigned 'Z'
+	Assertions                  []Assertion                  `json:"assertions"`                    // Assert and report statements
+	Aliases                     []AliasDeclaration           `json:"aliases"`                       // Alias declarations
+	CombinationalLoops          []CombinationalLoop          `json:"combinational_loops"`           // Cycles of combinational signal dependencies
+	MultiDrivers                []MultiDriver                `json:"multi_drivers"`                 // Unresolved signals with several drivers
+	UnboundGenerics             []UnboundGeneric             `json:"unbound_generics"`              // Instances leaving a no-default generic unbound
+	UnusedSignals               []UnusedSignal               `json:"unused_signals"`                // Signals never read (write-only or dead)
+	WidthMismatches             []WidthMismatch              `json:"width_mismatches"`              // Port associations whose actual width differs from the formal
+	ClockMisuses                []ClockMisuse                `json:"clock_misuses"`                 // Clocks read as data or driven combinationally
+	ResetCrossings              []ResetCrossing              `json:"reset_crossings"`               // Async resets and the clock domains they reach
+	ConditionalLatches          []LatchInference             `json:"conditional_latches"`           // Signals a combinational process leaves unassigned on some if/case branch
+	ImportedNames               []ImportedName               `json:"imported_names"`                // Package members made visible by use ... .all clauses
+	Loops                       []LoopStatement              `json:"loops"`                         // For loops inside processes with elaborated bounds
+	ReadsBeforeWrite            []ReadBeforeWrite            `json:"reads_before_write"`            // Combinational reads above the signal's only assignment in the same process
+	AttributeSpecs              []AttributeSpec              `json:"attribute_specs"`               // Attribute declarations and specifications (keep, dont_touch, ...)
+	TristateAssignments         []TristateAssignment         `json:"tristate_assignments"`          // Assignments driving 'Z' (tri-state buffers)
+	FSMIssues                   []FSMIssue                   `json:"fsm_issues"`                    // Enum states never assigned (unreachable) or never decoded (dead)
+	GatedClocks                 []GatedClock                 `json:"gated_clocks"`                  // Clocks driven by an assignment instead of an input port
+	UninitializedReads          []UninitRead                 `json:"uninitialized_reads"`           // Signals without a default read combinationally before any assignment
+	Blocks                      []BlockStatement             `json:"blocks"`                        // Block statements (guard condition and scope)
+	OrphanArchitectures         []OrphanArchitecture         `json:"orphan_architectures"`          // Architectures whose entity is not in their library
+	DuplicateSymbols            []DuplicateSymbol            `json:"duplicate_symbols"`             // Qualified names declared in more than one file
+	WidthTruncations            []WidthTruncation            `json:"width_truncations"`             // Whole-signal assignments whose value is wider than the target
+	CaseChoiceConflicts         []CaseChoiceConflict         `json:"case_choice_conflicts"`         // Unreachable or parallel if/elsif chains
+	Feedthroughs                []Feedthrough                `json:"feedthroughs"`                  // Output ports assigned straight from an input port
+	ResetDefaultMismatches      []ResetDefaultMismatch       `json:"reset_default_mismatches"`      // Reset values that differ from the declared initial value
+	MixedSensitivityIssues      []MixedSensitivity           `json:"mixed_sensitivity_issues"`      // Clocked processes sensitive to data or missing their clock
+	FSMEncodings                []FSMEncoding                `json:"fsm_encodings"`                 // Encoding attributes applied to enumeration types
+	ComponentMismatches         []ComponentMismatch          `json:"component_mismatches"`          // Component declarations whose ports differ from their entity
+	StandardShadowings          []StandardShadowing          `json:"standard_shadowings"`           // Package members named like standard types or functions
+	SuspiciousClocks            []SuspiciousClock            `json:"suspicious_clocks"`             // Clocked processes whose clock is not an input port or known clock
+	UnprotectedSharedVariables  []UnprotectedSharedVariable  `json:"unprotected_shared_variables"`  // Shared variables of non-protected types (VHDL-2008+)
+	UnsafeArithmetic            []UnsafeArithmetic           `json:"unsafe_arithmetic"`             // Additions and subtractions on std_logic_vector with only numeric_std
+	InstanceGenerates           []InstanceGenerate           `json:"instance_generates"`            // For-generate iteration counts per instance, under its generic values
+	SensitivityListSmells       []SensitivityListSmell       `json:"sensitivity_list_smells"`       // Sensitivity lists repeating an entry or naming the process's own outputs
+	IncompleteRecordAggregates  []IncompleteRecordAggregate  `json:"incomplete_record_aggregates"`  // Record aggregates assigned without every field or others
 	// Configuration for lint rules
 	LintConfig LintRuleConfig `json:"lint_config"` // Rule severities and enabled/disabled
 	// Third-party file tracking
@@ -121,29 +169,37 @@ type Input struct {
 
 // LintRuleConfig contains rule configuration passed to the Rust policy engine
 type LintRuleConfig struct {
-	Rules map[string]string `json:"rules"` // rule name -> "off", "warning", "error"
+	Rules map[string]string `json:"rules"`          // rule name -> "off", "warning", "error"
+	Only  []string          `json:"only,omitempty"` // --rules allowlist (empty = every enabled rule)
+	Skip  []string          `json:"skip,omitempty"` // --skip-rules denylist, applied after Only
+	// Literal width from which the large-literal trojan rules report a
+	// comparison (0 = engine default of 32)
+	TrojanLiteralBits int `json:"trojan_literal_bits,omitempty"`
 }
 
 // Process represents a VHDL process for policy analysis
 type Process struct {
-	Label           string          `json:"label"`
-	SensitivityList []string        `json:"sensitivity_list"`
-	IsSequential    bool            `json:"is_sequential"`
-	IsCombinational bool            `json:"is_combinational"`
-	ClockSignal     string          `json:"clock_signal"`
-	ClockEdge       string          `json:"clock_edge"`
-	HasReset        bool            `json:"has_reset"`
-	ResetSignal     string          `json:"reset_signal"`
-	ResetAsync      bool            `json:"reset_async"`
-	AssignedSignals []string        `json:"assigned_signals"`
-	ReadSignals     []string        `json:"read_signals"`
-	Variables       []VariableDecl  `json:"variables"`
-	ProcedureCalls  []ProcedureCall `json:"procedure_calls"`
-	FunctionCalls   []FunctionCall  `json:"function_calls"`
-	WaitStatements  []WaitStatement `json:"wait_statements"`
-	File            string          `json:"file"`
-	Line            int             `json:"line"`
-	InArch          string          `json:"in_arch"`
+	Label            string          `json:"label"`
+	SensitivityList  []string        `json:"sensitivity_list"`
+	IsSequential     bool            `json:"is_sequential"`
+	IsCombinational  bool            `json:"is_combinational"`
+	IsStimulus       bool            `json:"is_stimulus"` // Only "wait for" delays (testbench stimulus)
+	ClockSignal      string          `json:"clock_signal"`
+	ClockEdge        string          `json:"clock_edge"`
+	HasReset         bool            `json:"has_reset"`
+	ResetSignal      string          `json:"reset_signal"`
+	ResetAsync       bool            `json:"reset_async"`
+	ResetPolarity    string          `json:"reset_polarity"` // "active_high", "active_low", or "" if unknown
+	AssignedSignals  []string        `json:"assigned_signals"`
+	ReadSignals      []string        `json:"read_signals"`
+	Variables        []VariableDecl  `json:"variables"`
+	ProcedureCalls   []ProcedureCall `json:"procedure_calls"`
+	FunctionCalls    []FunctionCall  `json:"function_calls"`
+	WaitStatements   []WaitStatement `json:"wait_statements"`
+	File             string          `json:"file"`
+	Line             int             `json:"line"`
+	InArch           string          `json:"in_arch"`
+	InSynthOffRegion bool            `json:"in_synth_off_region"` // Inside a "-- synthesis translate_off" region
 }
 
 // Simplified types for policy input (mirrors extractor types)
@@ -179,12 +235,15 @@ type Component struct {
 }
 
 type Signal struct {
-	Name     string `json:"name"`
-	Type     string `json:"type"`
-	File     string `json:"file"`
-	Line     int    `json:"line"`
-	InEntity string `json:"in_entity"`
-	Width    int    `json:"width"` // Estimated bit width (0 if unknown)
+	Name             string `json:"name"`
+	Type             string `json:"type"`
+	File             string `json:"file"`
+	Line             int    `json:"line"`
+	InEntity         string `json:"in_entity"`
+	Width            int    `json:"width"`               // Estimated bit width (0 if unknown)
+	InSynthOffRegion bool   `json:"in_synth_off_region"` // Inside a "-- synthesis translate_off" region
+	Doc              string `json:"doc"`                 // Documenting comment ("" if none)
+	Default          string `json:"default"`             // Initial value after ":=" ("" if none)
 }
 
 type Port struct {
@@ -195,6 +254,7 @@ type Port struct {
 	Line      int    `json:"line"`
 	InEntity  string `json:"in_entity"`
 	Width     int    `json:"width"` // Estimated bit width (0 if unknown)
+	Doc       string `json:"doc"`   // Documenting comment ("" if none)
 }
 
 type GenericDecl struct {
@@ -239,9 +299,10 @@ type Association struct {
 }
 
 type VariableDecl struct {
-	Name string `json:"name"`
-	Type string `json:"type"`
-	Line int    `json:"line"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Line    int    `json:"line"`
+	Default string `json:"default"` // Initial value after ":=" ("" if none)
 }
 
 type ProcedureCall struct {
@@ -288,6 +349,9 @@ type FileInfo struct {
 	Path         string `json:"path"`
 	Library      string `json:"library"`
 	IsThirdParty bool   `json:"is_third_party"`
+	// RuleOverrides is the file's library's rule -> severity ("off" drops
+	// the rule) map from libraries.<name>.rules and severityOverrides
+	RuleOverrides map[string]string `json:"rule_overrides,omitempty"`
 }
 
 // Scope represents a lexical or generate scope for name resolution.
@@ -352,7 +416,8 @@ type VerificationTagError struct {
 // Enables system-level analysis (cross-module signal tracing, clock mismatch detection)
 type Instance struct {
 	Name         string            `json:"name"`        // Instance label (e.g., "u_cpu")
-	Target       string            `json:"target"`      // Target entity/component (e.g., "work.cpu")
+	Target       string            `json:"target"`      // Target with "work." resolved to the file's library (e.g., "lib_a.cpu")
+	RawTarget    string            `json:"raw_target"`  // Target as written (e.g., "work.cpu")
 	PortMap      map[string]string `json:"port_map"`    // Formal port -> actual signal
 	GenericMap   map[string]string `json:"generic_map"` // Formal generic -> actual value
 	Associations []Association     `json:"associations"`
@@ -377,14 +442,17 @@ type CaseStatement struct {
 // ConcurrentAssignment represents a concurrent signal assignment (outside processes)
 // Enables detection of undriven/multi-driven signals that were previously missed
 type ConcurrentAssignment struct {
-	Target        string   `json:"target"`       // Signal being assigned (LHS)
-	ReadSignals   []string `json:"read_signals"` // Signals being read (RHS)
-	File          string   `json:"file"`
-	Line          int      `json:"line"`
-	InArch        string   `json:"in_arch"`        // Which architecture contains this assignment
-	Kind          string   `json:"kind"`           // "simple", "conditional", "selected"
-	InGenerate    bool     `json:"in_generate"`    // True if inside a generate block
-	GenerateLabel string   `json:"generate_label"` // Label of containing generate block
+	Target           string   `json:"target"`       // Signal being assigned (LHS)
+	ReadSignals      []string `json:"read_signals"` // Signals being read (RHS)
+	File             string   `json:"file"`
+	Line             int      `json:"line"`
+	InArch           string   `json:"in_arch"`             // Which architecture contains this assignment
+	Kind             string   `json:"kind"`                // "simple", "conditional", "selected"
+	InGenerate       bool     `json:"in_generate"`         // True if inside a generate block
+	GenerateLabel    string   `json:"generate_label"`      // Label of containing generate block
+	InSynthOffRegion bool     `json:"in_synth_off_region"` // Inside a "-- synthesis translate_off" region
+	Choices          []string `json:"choices"`             // Explicit choices of a selected assignment
+	HasOthers        bool     `json:"has_others"`          // Selected assignment has "when others"
 }
 
 // Comparison represents a comparison operation for trojan/trigger detection
@@ -405,7 +473,7 @@ type Comparison struct {
 
 // ArithmeticOp represents an expensive arithmetic operation for power analysis
 type ArithmeticOp struct {
-	Operator    string   `json:"operator"`     // *, /, mod, rem, **
+	Operator    string   `json:"operator"`     // +, -, *, /, mod, rem, **
 	Operands    []string `json:"operands"`     // Input signals/expressions
 	Result      string   `json:"result"`       // Output signal
 	IsGuarded   bool     `json:"is_guarded"`   // True if inputs are gated by enable
@@ -431,6 +499,7 @@ type SignalDep struct {
 // Used to track where signals are used for accurate dead code detection
 type SignalUsage struct {
 	Signal       string `json:"signal"`
+	FieldPath    string `json:"field_path"` // Record field accessed ("trap.cause"); empty for the whole signal
 	IsRead       bool   `json:"is_read"`
 	IsWritten    bool   `json:"is_written"`
 	InProcess    string `json:"in_process"`
@@ -456,6 +525,540 @@ type CDCCrossing struct {
 	InArch         string `json:"in_arch"`
 }
 
+// OverlappingChoice reports two choices of one case/selected statement that overlap
+type OverlappingChoice struct {
+	Kind        string `json:"kind"`         // "case" or "selected"
+	Expression  string `json:"expression"`   // Case expression / selector
+	First       string `json:"first"`        // Earlier choice text
+	Second      string `json:"second"`       // Later choice text that overlaps First
+	IsDuplicate bool   `json:"is_duplicate"` // True if both choices cover exactly the same values
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	InProcess   string `json:"in_process"`
+	InArch      string `json:"in_arch"`
+}
+
+// ConstantInSensitivityList reports a sensitivity list entry naming a constant or generic
+type ConstantInSensitivityList struct {
+	Name     string `json:"name"`      // Sensitivity list entry as written
+	Kind     string `json:"kind"`      // "constant" or "generic"
+	DeclLine int    `json:"decl_line"` // Line of the constant/generic declaration
+	Process  string `json:"process"`
+	File     string `json:"file"`
+	Line     int    `json:"line"` // Line of the process
+	InArch   string `json:"in_arch"`
+}
+
+// MagicNumber reports an integer literal used directly in an expression instead of a named constant
+type MagicNumber struct {
+	Value     string `json:"value"`   // Literal as written
+	Numeric   int    `json:"numeric"` // Parsed integer value
+	Context   string `json:"context"` // "comparison", "arithmetic", or "assignment"
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	InProcess string `json:"in_process"`
+	InArch    string `json:"in_arch"`
+}
+
+// PortShadowedBySignal reports an architecture signal with the same name as a port of its entity
+type PortShadowedBySignal struct {
+	Signal       string `json:"signal"` // Signal name as declared
+	Port         string `json:"port"`   // Port name as declared
+	Entity       string `json:"entity"`
+	Architecture string `json:"architecture"`
+	File         string `json:"file"` // File of the signal declaration
+	Line         int    `json:"line"` // Line of the signal declaration
+	PortFile     string `json:"port_file"`
+	PortLine     int    `json:"port_line"`
+}
+
+// ClockLevelCondition reports a concurrent assignment whose condition tests a clock level (a latch)
+type ClockLevelCondition struct {
+	Target    string `json:"target"`
+	Clock     string `json:"clock"`
+	Level     string `json:"level"`     // "'1'" or "'0'", empty when the clock is the selector
+	Condition string `json:"condition"` // Condition (or selector) expression as written
+	Kind      string `json:"kind"`      // "conditional" or "selected"
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	InArch    string `json:"in_arch"`
+}
+
+// NoEffectProcess reports a process with no signal writes, procedure calls, or assertions
+type NoEffectProcess struct {
+	Process     string   `json:"process"`
+	ReadSignals []string `json:"read_signals"` // Signals read and then discarded
+	File        string   `json:"file"`
+	Line        int      `json:"line"`
+	InArch      string   `json:"in_arch"`
+}
+
+// CombinationalMultiDriver reports a signal written by more than one combinational process
+type CombinationalMultiDriver struct {
+	Signal    string   `json:"signal"`
+	Processes []string `json:"processes"` // Driving process labels ("" for unlabeled)
+	Lines     []int    `json:"lines"`     // Line of each driving process
+	File      string   `json:"file"`
+	Line      int      `json:"line"` // Line of the first driving process
+	InArch    string   `json:"in_arch"`
+}
+
+// MixedSliceDirection reports a signal sliced both with "to" and "downto" in one architecture
+type MixedSliceDirection struct {
+	Signal       string `json:"signal"`
+	Architecture string `json:"architecture"`
+	File         string `json:"file"`
+	Line         int    `json:"line"`    // Line of the later of the two first slices
+	ToExpr       string `json:"to_expr"` // First ascending slice as written
+	ToLine       int    `json:"to_line"`
+	DowntoExpr   string `json:"downto_expr"` // First descending slice as written
+	DowntoLine   int    `json:"downto_line"`
+}
+
+// UnusedComponentDeclaration reports a component declaration that is never instantiated
+type UnusedComponentDeclaration struct {
+	Component string `json:"component"`
+	Scope     string `json:"scope"`      // Declaring architecture or package
+	ScopeKind string `json:"scope_kind"` // "architecture" or "package"
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+}
+
+// PermanentHighZ reports a signal whose every assignment drives 'Z'
+type PermanentHighZ struct {
+	Signal      string `json:"signal"`
+	Assignments int    `json:"assignments"` // Number of assignments, all high-impedance
+	File        string `json:"file"`
+	Line        int    `json:"line"` // First assignment
+	InArch      string `json:"in_arch"`
+}
+
+// Assertion represents an assert or report statement (report statements have no condition)
+type Assertion struct {
+	Condition     string `json:"condition"`      // Asserted expression ("" for report statements)
+	ReportMessage string `json:"report_message"` // Report expression as written
+	Severity      string `json:"severity"`       // note, warning, error, failure (defaults applied)
+	File          string `json:"file"`
+	Line          int    `json:"line"`
+	InProcess     string `json:"in_process"` // Empty for concurrent assertions
+	InArch        string `json:"in_arch"`
+}
+
+// AliasDeclaration represents an alias declaration and the base signal it resolves to
+type AliasDeclaration struct {
+	Name      string `json:"name"`
+	Target    string `json:"target"`    // Aliased name as written
+	BaseName  string `json:"base_name"` // Base signal of the target ("" if not a plain name)
+	Type      string `json:"type"`      // Optional subtype indication
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	InArch    string `json:"in_arch"`
+	InPackage string `json:"in_package"`
+}
+
+// CombinationalLoop is a cycle of combinational signal dependencies
+type CombinationalLoop struct {
+	Signals []string `json:"signals"` // Cycle path; the last signal feeds the first
+	Lines   []int    `json:"lines"`   // Line of each dependency along the path
+	File    string   `json:"file"`
+	Line    int      `json:"line"` // First line of the cycle
+	InArch  string   `json:"in_arch"`
+}
+
+// MultiDriver reports an unresolved signal written by more than one driver
+type MultiDriver struct {
+	Signal     string           `json:"signal"`
+	Drivers    []DriverLocation `json:"drivers"`
+	InGenerate bool             `json:"in_generate"` // Some driver is inside a generate (legal if mutually exclusive)
+	File       string           `json:"file"`
+	Line       int              `json:"line"` // First driver
+	InArch     string           `json:"in_arch"`
+}
+
+// DriverLocation is one process or concurrent assignment writing a signal
+type DriverLocation struct {
+	Process    string `json:"process"` // "" for concurrent assignments
+	Line       int    `json:"line"`
+	InGenerate bool   `json:"in_generate"`
+}
+
+// UnboundGeneric reports an instance that leaves a generic without a default unbound
+type UnboundGeneric struct {
+	Instance string `json:"instance"`
+	Generic  string `json:"generic"`
+	Unit     string `json:"unit"` // Entity or component declaring the generic
+	File     string `json:"file"`
+	Line     int    `json:"line"` // Line of the instance
+	InArch   string `json:"in_arch"`
+}
+
+// UnusedSignal reports a declared signal that is never read
+type UnusedSignal struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"` // "write-only" or "dead"
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	InEntity string `json:"in_entity"`
+}
+
+// WidthMismatch reports a port association whose actual and formal widths differ
+type WidthMismatch struct {
+	Instance    string `json:"instance"`
+	Formal      string `json:"formal"`
+	Actual      string `json:"actual"` // As written, including any slice
+	FormalWidth int    `json:"formal_width"`
+	ActualWidth int    `json:"actual_width"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	InArch      string `json:"in_arch"`
+}
+
+// ClockMisuse reports a clock read as data or driven combinationally
+type ClockMisuse struct {
+	Clock   string `json:"clock"`
+	Kind    string `json:"kind"`    // "data_read" or "combinational_assignment"
+	Process string `json:"process"` // Empty for a concurrent assignment
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	InArch  string `json:"in_arch"`
+}
+
+// ResetCrossing reports an async reset and the clock domains it reaches
+type ResetCrossing struct {
+	Reset          string   `json:"reset"`
+	Domains        []string `json:"domains"`        // Clocks of processes using the reset asynchronously
+	Unsynchronized []string `json:"unsynchronized"` // Domains with no reset synchronizer
+	Synchronized   bool     `json:"synchronized"`   // Every domain has a reset synchronizer
+	File           string   `json:"file"`
+	Line           int      `json:"line"`
+	InArch         string   `json:"in_arch"`
+}
+
+// LatchInference reports a signal a combinational process leaves unassigned on
+// some branch of an if or case statement
+type LatchInference struct {
+	Signal        string `json:"signal"`
+	Process       string `json:"process"`
+	MissingBranch string `json:"missing_branch"` // First branch that does not assign the signal
+	File          string `json:"file"`
+	Line          int    `json:"line"` // Line of the if/case statement
+	InArch        string `json:"in_arch"`
+}
+
+// ImportedName is a package member made visible in a file by a
+// "use lib.pkg.all" clause
+type ImportedName struct {
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`         // type, constant, function, procedure
+	FromPackage string `json:"from_package"` // Package as written in the use clause, e.g. work.my_pkg
+	File        string `json:"file"`
+	Line        int    `json:"line"` // Line of the use clause
+}
+
+// LoopStatement represents a for loop inside a process
+type LoopStatement struct {
+	LoopVar        string `json:"loop_var"`
+	RangeLow       string `json:"range_low"`       // Range low bound (or the whole attribute range)
+	RangeHigh      string `json:"range_high"`      // Range high bound
+	RangeDir       string `json:"range_dir"`       // "to", "downto", or empty for attribute ranges
+	IterationCount int    `json:"iteration_count"` // Number of iterations (-1 if cannot evaluate)
+	CanElaborate   bool   `json:"can_elaborate"`   // True if range was successfully evaluated
+	File           string `json:"file"`
+	Line           int    `json:"line"`
+	InProcess      string `json:"in_process"`
+	InArch         string `json:"in_arch"`
+}
+
+// ReadBeforeWrite reports a combinational process that reads a signal above
+// its only assignment in the same process
+type ReadBeforeWrite struct {
+	Signal    string `json:"signal"`
+	Process   string `json:"process"`
+	File      string `json:"file"`
+	ReadLine  int    `json:"read_line"`  // First read of the signal
+	WriteLine int    `json:"write_line"` // The signal's only assignment
+	InArch    string `json:"in_arch"`
+}
+
+// AttributeSpec is an attribute declaration or one target of an attribute
+// specification (synthesis directives such as keep and dont_touch)
+type AttributeSpec struct {
+	Attribute  string `json:"attribute"`   // Well-known vendor attributes are lowercase
+	Target     string `json:"target"`      // Named entity, "others", or "all" ("" for declarations)
+	TargetKind string `json:"target_kind"` // Entity class ("signal", "entity", ...; "" for declarations)
+	Value      string `json:"value"`       // Value expression as written ("" for declarations)
+	Type       string `json:"type"`        // Declared attribute type (declarations only)
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	InArch     string `json:"in_arch"`
+}
+
+// TristateAssignment is a signal assignment that drives 'Z' in at least one
+// waveform
+type TristateAssignment struct {
+	Target      string `json:"target"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	InProcess   string `json:"in_process"` // Empty for concurrent assignments
+	InArch      string `json:"in_arch"`
+	Conditional bool   `json:"conditional"` // Guarded by a condition (output-enable pattern)
+}
+
+// FSMIssue is an enum state a state machine never assigns or never decodes
+type FSMIssue struct {
+	Signal string `json:"signal"`
+	Type   string `json:"type"`
+	State  string `json:"state"`
+	Kind   string `json:"kind"` // "unreachable" or "dead"
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	InArch string `json:"in_arch"`
+}
+
+// GatedClock is a clock driven by an assignment instead of an input port
+type GatedClock struct {
+	Clock      string `json:"clock"`
+	Expression string `json:"expression"` // Driven value(s) as written
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	InProcess  string `json:"in_process"` // Empty for a concurrent assignment
+	InArch     string `json:"in_arch"`
+}
+
+// UninitRead is a signal without a default read combinationally before any assignment
+type UninitRead struct {
+	Signal    string `json:"signal"`
+	Type      string `json:"type"`
+	File      string `json:"file"`
+	Line      int    `json:"line"`       // First combinational read
+	InProcess string `json:"in_process"` // Empty for a concurrent assignment
+	InArch    string `json:"in_arch"`
+}
+
+// BlockStatement is a block statement; its contents are scoped "arch.label"
+type BlockStatement struct {
+	Label     string `json:"label"`
+	GuardExpr string `json:"guard_expr"` // Empty unless the block is guarded
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	InArch    string `json:"in_arch"`
+}
+
+// OrphanArchitecture is an architecture whose entity does not resolve in its library
+type OrphanArchitecture struct {
+	Architecture string `json:"architecture"`
+	Entity       string `json:"entity"`  // Entity name as written
+	Library      string `json:"library"` // Library the architecture is compiled into
+	File         string `json:"file"`
+	Line         int    `json:"line"`
+}
+
+// DuplicateSymbol is a qualified name (library.unit) declared in more than one file
+type DuplicateSymbol struct {
+	Name      string           `json:"name"`
+	Kind      string           `json:"kind"` // entity, package, context, type, ...
+	Locations []SymbolLocation `json:"locations"`
+}
+
+// SymbolLocation is one conflicting declaration of a duplicate symbol
+type SymbolLocation struct {
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// WidthTruncation reports a signal assignment whose value is wider than its
+// whole-signal target, silently dropping the upper bits
+type WidthTruncation struct {
+	Target      string `json:"target"`
+	TargetWidth int    `json:"target_width"`
+	Source      string `json:"source"` // Widest driven value, as written
+	SourceWidth int    `json:"source_width"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	InProcess   string `json:"in_process"` // Empty for a concurrent assignment
+	InArch      string `json:"in_arch"`
+}
+
+// CaseChoiceConflict reports an if/elsif chain comparing one signal whose
+// structure contradicts its intent: a branch that repeats an earlier value
+// ("unreachable"), or distinct constant comparisons that need no priority
+// ("parallel")
+type CaseChoiceConflict struct {
+	Kind       string   `json:"kind"` // "unreachable" or "parallel"
+	Expression string   `json:"expression"`
+	Choices    []string `json:"choices"`
+	First      string   `json:"first"`  // unreachable: the earlier value
+	Second     string   `json:"second"` // unreachable: the repeated value
+	File       string   `json:"file"`
+	Line       int      `json:"line"`
+	InProcess  string   `json:"in_process"`
+	InArch     string   `json:"in_arch"`
+}
+
+// Feedthrough is an output port driven straight from an input port by a
+// concurrent assignment with no logic in between.
+type Feedthrough struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	InArch string `json:"in_arch"`
+}
+
+// ResetDefaultMismatch is a reset branch assigning a signal a value other
+// than the initial value of its declaration.
+type ResetDefaultMismatch struct {
+	Signal    string `json:"signal"`
+	Default   string `json:"default"`
+	Reset     string `json:"reset"`
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	InProcess string `json:"in_process"`
+	InArch    string `json:"in_arch"`
+}
+
+// MixedSensitivity is a clocked process whose sensitivity list holds data
+// signals besides its clock and reset, or omits the clock.
+type MixedSensitivity struct {
+	Process      string   `json:"process"`
+	Clock        string   `json:"clock"`
+	Extra        []string `json:"extra"`         // Entries that are neither the clock nor a reset
+	MissingClock bool     `json:"missing_clock"` // The clock is not in the sensitivity list
+	File         string   `json:"file"`
+	Line         int      `json:"line"`
+	InArch       string   `json:"in_arch"`
+}
+
+// FSMEncoding is a synthesis encoding attribute applied to an enumeration
+// type, directly or through a signal of that type.
+type FSMEncoding struct {
+	TypeName   string `json:"type_name"`
+	Signal     string `json:"signal"`      // Signal the attribute targets ("" for the type itself)
+	Attribute  string `json:"attribute"`   // enum_encoding, fsm_encoding, or syn_encoding
+	Encoding   string `json:"encoding"`    // one-hot, gray, binary, johnson, auto, user, or as written
+	Value      string `json:"value"`       // Attribute value as written
+	StateCount int    `json:"state_count"` // Number of enumeration literals
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	InArch     string `json:"in_arch"`
+	InPackage  string `json:"in_package"`
+}
+
+// ComponentMismatch reports a port on which a component declaration and the
+// entity of the same name disagree
+type ComponentMismatch struct {
+	Component      string `json:"component"`
+	Entity         string `json:"entity"`
+	Port           string `json:"port"`
+	Kind           string `json:"kind"`            // "missing_in_entity", "missing_in_component", "direction", or "width"
+	ComponentValue string `json:"component_value"` // Direction or width on the component ("" if absent)
+	EntityValue    string `json:"entity_value"`    // Direction or width on the entity ("" if absent)
+	File           string `json:"file"`            // Component declaration
+	Line           int    `json:"line"`
+	EntityFile     string `json:"entity_file"`
+	EntityLine     int    `json:"entity_line"`
+}
+
+// StandardShadowing is a package member named like a standard type or function
+type StandardShadowing struct {
+	Name    string `json:"name"`
+	Kind    string `json:"kind"` // "type", "subtype", "function", or "constant"
+	Package string `json:"package"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+}
+
+// SuspiciousClock is a clocked process whose clock is a data signal or a
+// non-input port rather than an input port or a known clock
+type SuspiciousClock struct {
+	Clock   string `json:"clock"`
+	Kind    string `json:"kind"` // "signal" or "port"
+	Process string `json:"process"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	InArch  string `json:"in_arch"`
+}
+
+// UnprotectedSharedVariable is a shared variable whose type is not a
+// protected type, illegal from VHDL-2008 on
+type UnprotectedSharedVariable struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	InPackage string `json:"in_package"`
+	InArch    string `json:"in_arch"`
+}
+
+// UnsafeArithmetic is a "+" or "-" on a std_logic_vector operand in a file
+// whose only arithmetic package is numeric_std
+type UnsafeArithmetic struct {
+	Operator    string `json:"operator"`
+	Operand     string `json:"operand"`
+	OperandType string `json:"operand_type"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	InProcess   string `json:"in_process"`
+	InArch      string `json:"in_arch"`
+}
+
+// InstanceGenerate is a for-generate's iteration count in one instance of
+// its entity, with the instance's generic values in place of the defaults
+type InstanceGenerate struct {
+	Instance       string `json:"instance"`
+	Entity         string `json:"entity"`
+	Architecture   string `json:"architecture"`
+	Generate       string `json:"generate"` // Generate label
+	GenerateFile   string `json:"generate_file"`
+	GenerateLine   int    `json:"generate_line"`
+	IterationCount int    `json:"iteration_count"` // Number of iterations (-1 if cannot evaluate)
+	File           string `json:"file"`            // Where the instance is
+	Line           int    `json:"line"`
+	InArch         string `json:"in_arch"`
+}
+
+// SensitivityListSmell is a sensitivity list that repeats an entry or names
+// a signal its process assigns
+type SensitivityListSmell struct {
+	Process        string   `json:"process"`
+	Duplicates     []string `json:"duplicates"`      // Entries listed more than once
+	SelfReferences []string `json:"self_references"` // Entries the process also assigns
+	File           string   `json:"file"`
+	Line           int      `json:"line"`
+	InArch         string   `json:"in_arch"`
+}
+
+// IncompleteRecordAggregate is an aggregate assigned to a record-typed signal
+// that names neither every field nor others
+type IncompleteRecordAggregate struct {
+	Target    string   `json:"target"`
+	Type      string   `json:"type"`    // Record type of the target
+	Missing   []string `json:"missing"` // Fields the aggregate leaves out
+	File      string   `json:"file"`
+	Line      int      `json:"line"`
+	InProcess string   `json:"in_process"` // Empty for concurrent assignments
+	InArch    string   `json:"in_arch"`
+}
+
+// ResetPolarityUsage records one process comparing a reset net against a polarity
+type ResetPolarityUsage struct {
+	Process  string `json:"process"`
+	Polarity string `json:"polarity"` // "active_high" or "active_low"
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// InconsistentResetPolarity groups usages of a reset net that disagree on polarity
+// File/Line point at the first usage that departs from the majority polarity
+type InconsistentResetPolarity struct {
+	Signal           string               `json:"signal"`            // Reset signal name (lowercased)
+	ExpectedPolarity string               `json:"expected_polarity"` // Majority polarity across the design
+	Polarities       []string             `json:"polarities"`        // All distinct polarities seen
+	Usages           []ResetPolarityUsage `json:"usages"`
+	File             string               `json:"file"`
+	Line             int                  `json:"line"`
+}
+
 // GenerateStatement represents a VHDL generate statement
 // Generate statements create conditional or iterative scopes with their own declarations
 type GenerateStatement struct {
@@ -584,24 +1187,44 @@ func New(policyDir string) (*Engine, error) {
 	return &Engine{binaryPath: binaryPath}, nil
 }
 
+// OnRuleTiming registers hook to receive each rule group's timing while the
+// engine runs with VHDL_POLICY_TRACE_TIMING set. The human-readable stream
+// still reaches stderr.
+func (e *Engine) OnRuleTiming(hook func(RuleTiming)) {
+	e.onRuleTiming = hook
+}
+
 // Evaluate runs the policies against the input data
 func (e *Engine) Evaluate(input Input) (*Result, error) {
-	ctx := context.Background()
 	payload, err := json.Marshal(input)
 	if err != nil {
 		return nil, fmt.Errorf("marshal input: %w", err)
 	}
+	return e.run(bytes.NewReader(payload))
+}
+
+// EvaluateNDJSON runs the policies against an input written by
+// WriteInputNDJSON. The engine reads the file itself, so the payload never
+// passes through memory as a single document.
+func (e *Engine) EvaluateNDJSON(path string) (*Result, error) {
+	return e.run(nil, "--ndjson", path)
+}
 
-	cmd := exec.CommandContext(ctx, e.binaryPath)
-	cmd.Stdin = bytes.NewReader(payload)
+func (e *Engine) run(stdin io.Reader, args ...string) (*Result, error) {
+	ctx := context.Background()
+	cmd := exec.CommandContext(ctx, e.binaryPath, args...)
+	cmd.Stdin = stdin
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
 	cmd.Stdout = &stdout
+	stderrWriters := []io.Writer{&stderr}
 	if policyTimingEnabled() || policyStreamEnabled() {
-		cmd.Stderr = io.MultiWriter(&stderr, os.Stderr)
-	} else {
-		cmd.Stderr = &stderr
+		stderrWriters = append(stderrWriters, os.Stderr)
+	}
+	if policyTimingEnabled() && e.onRuleTiming != nil {
+		stderrWriters = append(stderrWriters, &ruleTimingWriter{hook: e.onRuleTiming})
 	}
+	cmd.Stderr = io.MultiWriter(stderrWriters...)
 
 	if err := cmd.Run(); err != nil {
 		return nil, fmt.Errorf("rust policy engine failed: %w (%s)", err, stderr.String())
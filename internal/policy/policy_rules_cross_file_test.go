@@ -20,6 +20,7 @@ func TestCrossFileDuplicatePrimaryUnits(t *testing.T) {
 	rules := map[string]string{
 		"duplicate_entity_in_library":  "error",
 		"duplicate_package_in_library": "error",
+		"duplicate_symbol":             "error",
 	}
 
 	cfg := config.DefaultConfig()
@@ -40,6 +41,9 @@ func TestCrossFileDuplicatePrimaryUnits(t *testing.T) {
 	if !hasRule(result, "duplicate_package_in_library") {
 		t.Fatalf("expected duplicate_package_in_library, got rules: %v", collectRules(result))
 	}
+	if !hasRule(result, "duplicate_symbol") {
+		t.Fatalf("expected duplicate_symbol for the duplicated context, got rules: %v", collectRules(result))
+	}
 }
 
 func TestCrossFileDuplicatePrimaryUnitsDifferentLibraries(t *testing.T) {
@@ -51,6 +55,7 @@ func TestCrossFileDuplicatePrimaryUnitsDifferentLibraries(t *testing.T) {
 	rules := map[string]string{
 		"duplicate_entity_in_library":  "error",
 		"duplicate_package_in_library": "error",
+		"duplicate_symbol":             "error",
 	}
 
 	cfg := config.DefaultConfig()
@@ -75,6 +80,9 @@ func TestCrossFileDuplicatePrimaryUnitsDifferentLibraries(t *testing.T) {
 	if hasRule(result, "duplicate_package_in_library") {
 		t.Fatalf("did not expect duplicate_package_in_library, got rules: %v", collectRules(result))
 	}
+	if hasRule(result, "duplicate_symbol") {
+		t.Fatalf("did not expect duplicate_symbol, got rules: %v", collectRules(result))
+	}
 }
 
 func lintWithConfig(t *testing.T, repoRoot string, cfg *config.Config) indexer.LintResult {
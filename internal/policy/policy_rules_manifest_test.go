@@ -7,6 +7,8 @@ import (
 	"regexp"
 	"sort"
 	"testing"
+
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/policy"
 )
 
 func TestPolicyRuleManifestsCoverRustRules(t *testing.T) {
@@ -112,3 +114,36 @@ func loadRuleList(t *testing.T, path string) map[string]struct{} {
 	}
 	return out
 }
+
+func TestRuleMetadataCoversRustRules(t *testing.T) {
+	rustRules := collectRustPolicyRules(t, findRepoRoot(t))
+
+	var missing []string
+	for rule := range rustRules {
+		if _, ok := policy.RuleInfo(rule); !ok {
+			missing = append(missing, rule)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		t.Fatalf("rules missing from internal/policy/rules.json: %v", missing)
+	}
+
+	var extra []string
+	for _, meta := range policy.Rules() {
+		if _, ok := rustRules[meta.ID]; !ok {
+			extra = append(extra, meta.ID)
+		}
+		if meta.Title == "" || meta.Rationale == "" || meta.Category == "" {
+			t.Errorf("rule %s has incomplete metadata: %+v", meta.ID, meta)
+		}
+		switch meta.Severity {
+		case "error", "warning", "info":
+		default:
+			t.Errorf("rule %s has invalid default severity %q", meta.ID, meta.Severity)
+		}
+	}
+	if len(extra) > 0 {
+		t.Fatalf("rules.json describes rules not found in Rust policy sources: %v", extra)
+	}
+}
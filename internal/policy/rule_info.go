@@ -0,0 +1,55 @@
+package policy
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// RuleMeta describes one rule the engine can report, for `vhdl-lint explain`.
+type RuleMeta struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Category  string `json:"category"` // Engine rule group (cdc, fsm, latch, ...)
+	Severity  string `json:"severity"` // Default severity before vhdl_lint.json overrides
+	Optional  bool   `json:"optional"` // Off unless enabled in vhdl_lint.json or named in --rules
+	Rationale string `json:"rationale"`
+	Example   string `json:"example,omitempty"` // VHDL that triggers the rule
+}
+
+// rulesJSON is the rule metadata table. The engine binary has no
+// introspection mode, so the table is bundled here and kept in sync with
+// src/policy by the policy rule manifests.
+//
+//go:embed rules.json
+var rulesJSON []byte
+
+var (
+	rulesOnce sync.Once
+	rules     []RuleMeta
+	rulesByID map[string]RuleMeta
+)
+
+func loadRules() {
+	if err := json.Unmarshal(rulesJSON, &rules); err != nil {
+		panic(fmt.Sprintf("policy: invalid rules.json: %v", err))
+	}
+	rulesByID = make(map[string]RuleMeta, len(rules))
+	for _, r := range rules {
+		rulesByID[r.ID] = r
+	}
+}
+
+// Rules returns the metadata of every rule, sorted by id.
+func Rules() []RuleMeta {
+	rulesOnce.Do(loadRules)
+	return append([]RuleMeta(nil), rules...)
+}
+
+// RuleInfo returns the metadata of one rule.
+func RuleInfo(id string) (RuleMeta, bool) {
+	rulesOnce.Do(loadRules)
+	r, ok := rulesByID[id]
+	return r, ok
+}
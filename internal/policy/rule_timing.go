@@ -0,0 +1,63 @@
+package policy
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// RuleTiming is the evaluation time of one rule group, parsed from the
+// "[done ]" lines the engine streams to stderr when VHDL_POLICY_TRACE_TIMING
+// is set.
+type RuleTiming struct {
+	Rule       string
+	Violations int
+	Duration   time.Duration
+}
+
+// ruleTimingRe matches "  [done ] cdc                           3 1.25ms".
+var ruleTimingRe = regexp.MustCompile(`^\s*\[done \]\s+(\S+)\s+(\d+)\s+([0-9.]+)(ms|us)\s*$`)
+
+// ParseRuleTiming parses one line of the engine's live timing stream.
+func ParseRuleTiming(line string) (RuleTiming, bool) {
+	m := ruleTimingRe.FindStringSubmatch(line)
+	if m == nil {
+		return RuleTiming{}, false
+	}
+	count, err := strconv.Atoi(m[2])
+	if err != nil {
+		return RuleTiming{}, false
+	}
+	value, err := strconv.ParseFloat(m[3], 64)
+	if err != nil {
+		return RuleTiming{}, false
+	}
+	unit := time.Microsecond
+	if m[4] == "ms" {
+		unit = time.Millisecond
+	}
+	return RuleTiming{Rule: m[1], Violations: count, Duration: time.Duration(value * float64(unit))}, true
+}
+
+// ruleTimingWriter splits the engine's stderr into lines and passes each
+// parsed rule timing to hook.
+type ruleTimingWriter struct {
+	hook    func(RuleTiming)
+	pending []byte
+}
+
+func (w *ruleTimingWriter) Write(p []byte) (int, error) {
+	w.pending = append(w.pending, p...)
+	for {
+		nl := bytes.IndexByte(w.pending, '\n')
+		if nl == -1 {
+			break
+		}
+		if timing, ok := ParseRuleTiming(string(w.pending[:nl])); ok {
+			w.hook(timing)
+		}
+		w.pending = w.pending[nl+1:]
+	}
+	return len(p), nil
+}
@@ -0,0 +1,37 @@
+package policy
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseRuleTiming(t *testing.T) {
+	timing, ok := ParseRuleTiming("  [done ] combinational                 12 3.25ms")
+	if !ok || timing.Rule != "combinational" || timing.Violations != 12 || timing.Duration != 3250*time.Microsecond {
+		t.Fatalf("unexpected timing %+v (ok=%v)", timing, ok)
+	}
+	timing, ok = ParseRuleTiming("  [done ] core                           0 840us")
+	if !ok || timing.Duration != 840*time.Microsecond {
+		t.Fatalf("unexpected timing %+v (ok=%v)", timing, ok)
+	}
+	for _, line := range []string{"=== Policy Timing (live) ===", "  [start] core", "  core     0 840us"} {
+		if _, ok := ParseRuleTiming(line); ok {
+			t.Fatalf("expected %q not to parse", line)
+		}
+	}
+}
+
+func TestRuleTimingWriterSplitsLines(t *testing.T) {
+	var rules []string
+	w := &ruleTimingWriter{hook: func(rt RuleTiming) { rules = append(rules, rt.Rule) }}
+	chunks := []string{"  [start] core\n  [done ] co", "re    1 1.00ms\n  [done ] cdc  0 5us", "\n"}
+	for _, chunk := range chunks {
+		if _, err := fmt.Fprint(w, chunk); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	if len(rules) != 2 || rules[0] != "core" || rules[1] != "cdc" {
+		t.Fatalf("expected core and cdc timings, got %v", rules)
+	}
+}
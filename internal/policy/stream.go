@@ -0,0 +1,104 @@
+package policy
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// inputStreamBatch is the number of rows validated together when streaming.
+// It bounds the size of each JSON fragment handed to the validator.
+const inputStreamBatch = 1000
+
+// tableRecord is one NDJSON line of a streamed policy input. Slice fields of
+// Input are written one row per line; other fields are written once as a value.
+type tableRecord struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// WriteInputNDJSON writes input to w table by table as newline-delimited
+// records ({"table":"signals","row":{...}}) instead of one JSON document, so
+// neither side has to hold the whole encoded payload at once. Each table is
+// also passed to check in fragments of at most inputStreamBatch rows, shaped
+// like a partial Input ({"signals":[...]}), for incremental validation.
+// Empty tables are omitted; the engine defaults them to empty.
+func WriteInputNDJSON(w io.Writer, input *Input, check func(fragment []byte) error) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	val := reflect.ValueOf(input).Elem()
+	typ := val.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		table := strings.Split(typ.Field(i).Tag.Get("json"), ",")[0]
+		if table == "" || table == "-" {
+			continue
+		}
+		field := val.Field(i)
+
+		if field.Kind() != reflect.Slice {
+			raw, err := json.Marshal(field.Interface())
+			if err != nil {
+				return fmt.Errorf("marshal %s: %w", table, err)
+			}
+			if err := enc.Encode(tableRecord{Table: table, Value: raw}); err != nil {
+				return err
+			}
+			if check != nil {
+				if err := check(tableFragment(table, raw)); err != nil {
+					return fmt.Errorf("table %s: %w", table, err)
+				}
+			}
+			continue
+		}
+
+		batch := make([]json.RawMessage, 0, inputStreamBatch)
+		flush := func() error {
+			if check == nil || len(batch) == 0 {
+				return nil
+			}
+			rows, err := json.Marshal(batch)
+			if err != nil {
+				return err
+			}
+			batch = batch[:0]
+			if err := check(tableFragment(table, rows)); err != nil {
+				return fmt.Errorf("table %s: %w", table, err)
+			}
+			return nil
+		}
+		for j := 0; j < field.Len(); j++ {
+			raw, err := json.Marshal(field.Index(j).Interface())
+			if err != nil {
+				return fmt.Errorf("marshal %s row %d: %w", table, j, err)
+			}
+			if err := enc.Encode(tableRecord{Table: table, Row: raw}); err != nil {
+				return err
+			}
+			batch = append(batch, raw)
+			if len(batch) == inputStreamBatch {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// tableFragment wraps one table's JSON as a partial Input object.
+func tableFragment(table string, raw []byte) []byte {
+	fragment := make([]byte, 0, len(table)+len(raw)+5)
+	fragment = append(fragment, `{"`...)
+	fragment = append(fragment, table...)
+	fragment = append(fragment, `":`...)
+	fragment = append(fragment, raw...)
+	return append(fragment, '}')
+}
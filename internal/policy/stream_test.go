@@ -0,0 +1,59 @@
+package policy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestWriteInputNDJSON(t *testing.T) {
+	input := Input{
+		Standard: "2008",
+		Signals:  make([]Signal, inputStreamBatch+1),
+		Ports:    []Port{{Name: "clk", Direction: "in", Type: "std_logic", Line: 3}},
+	}
+	for i := range input.Signals {
+		input.Signals[i] = Signal{Name: "s", Type: "std_logic", File: "top.vhd", Line: i + 1}
+	}
+
+	var fragments []string
+	var buf bytes.Buffer
+	err := WriteInputNDJSON(&buf, &input, func(fragment []byte) error {
+		fragments = append(fragments, string(fragment))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WriteInputNDJSON: %v", err)
+	}
+
+	counts := make(map[string]int)
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var rec tableRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("invalid record %q: %v", scanner.Text(), err)
+		}
+		if (rec.Row == nil) == (rec.Value == nil) {
+			t.Fatalf("record must have exactly one of row/value: %q", scanner.Text())
+		}
+		counts[rec.Table]++
+	}
+	if counts["signals"] != inputStreamBatch+1 || counts["ports"] != 1 || counts["standard"] != 1 {
+		t.Fatalf("unexpected table counts: %v", counts)
+	}
+	if _, ok := counts["processes"]; ok {
+		t.Fatalf("expected empty tables to be omitted, got %v", counts)
+	}
+
+	signalFragments := 0
+	for _, f := range fragments {
+		if strings.HasPrefix(f, `{"signals":[`) {
+			signalFragments++
+		}
+	}
+	if signalFragments != 2 {
+		t.Fatalf("expected signals validated in 2 batches, got %d", signalFragments)
+	}
+}
@@ -7,6 +7,7 @@ package daemon_schema
 #QualifiedIdentifier: string & =~"^(?:[a-zA-Z_][a-zA-Z0-9_]*|\\\\.+\\\\)(?:\\.(?:[a-zA-Z_][a-zA-Z0-9_]*|\\\\.+\\\\))*$"
 
 #FactTables: {
+    schema_version?: string  // Version of this shape; absent in delta tables
     files:           [...#FileRow]
     entities:        [...#EntityRow]
     architectures:   [...#ArchitectureRow]
@@ -60,6 +61,7 @@ package daemon_schema
     type:      string & !=""
     file:      string & =~".+\\.(vhd|vhdl)$"
     line:      int & >=1
+    doc?:      string  // Declaration comment
 }
 
 #SignalRow: {
@@ -68,6 +70,7 @@ package daemon_schema
     file:  string & =~".+\\.(vhd|vhdl)$"
     line:  int & >=1
     scope: string
+    doc?:  string  // Declaration comment
 }
 
 #InstanceRow: {
@@ -3,6 +3,8 @@ package validator
 import (
 	"testing"
 
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/config"
+	"github.com/robert-at-pretension-io/vhdl-lint/internal/extractor"
 	"github.com/robert-at-pretension-io/vhdl-lint/internal/facts"
 )
 
@@ -70,3 +72,31 @@ func TestFactsValidatorRejectsInvalidTables(t *testing.T) {
 		t.Fatalf("expected validation error, got nil")
 	}
 }
+
+func TestFactsValidatorAcceptsBuiltTables(t *testing.T) {
+	v, err := NewFactsValidator()
+	if err != nil {
+		t.Fatalf("new facts validator: %v", err)
+	}
+
+	fileFacts := []extractor.FileFacts{{
+		File:          "rtl/counter.vhd",
+		Entities:      []extractor.Entity{{Name: "counter", Line: 4}},
+		Architectures: []extractor.Architecture{{Name: "rtl", EntityName: "counter", Line: 11}},
+		Ports: []extractor.Port{
+			{Name: "clk", Direction: "in", Type: "std_logic", Line: 6, InEntity: "counter", Doc: "System clock"},
+			{Name: "count_o", Direction: "out", Type: "unsigned(7 downto 0)", Line: 7, InEntity: "counter"},
+		},
+		Signals: []extractor.Signal{{Name: "count_q", Type: "unsigned(7 downto 0)", Line: 12, InEntity: "rtl", Doc: "Registered count"}},
+	}}
+	tables := facts.BuildTables(fileFacts, map[string]config.FileLibraryInfo{
+		"rtl/counter.vhd": {LibraryName: "work"},
+	}, nil, nil)
+	if tables.SchemaVersion == "" {
+		t.Fatalf("expected BuildTables to set schema_version")
+	}
+
+	if err := v.Validate(tables); err != nil {
+		t.Fatalf("expected built tables to satisfy the contract, got error: %v", err)
+	}
+}
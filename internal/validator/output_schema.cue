@@ -11,6 +11,7 @@ package schema
 
 // LintOutput is the root structure of JSON output
 #LintOutput: {
+    schema_version: string & =~"^[0-9]+\\.[0-9]+\\.[0-9]+$"  // Version of this shape
     violations:   [...#Violation]
     missing_checks: [...#MissingCheck] | *[]
     ambiguous_constructs: [...#AmbiguousConstruct] | *[]
@@ -18,6 +19,9 @@ package schema
     stats:        #Stats
     files:        [...#FileResult]
     parse_errors: [...#ParseError] | *[]
+    roots?:       [...#RootResult]  // Per-root breakdown of a multi-root run
+    truncated?:       bool        // --timeout stopped extraction early
+    timed_out_files?: [...string] // Files left out of a truncated run
 }
 
 // Violation represents a policy violation found by the linter
@@ -35,6 +39,17 @@ package schema
     errors:           int & >=0
     warnings:         int & >=0
     info:             int & >=0
+    suppressed?:      int & >=0              // Silenced by inline vhdl-lint directives
+    baselined?:       int & >=0              // Matched by the --baseline snapshot
+    outside_diff?:    int & >=0              // On lines the --diff does not add
+    by_rule?:         {[string]: int & >=1}  // Violations per rule
+    top_rules?:       [...#RuleCount]        // Most frequent rules first
+}
+
+// RuleCount is how many violations one rule reported
+#RuleCount: {
+    rule:  string & =~"^[a-z_]+$"
+    count: int & >=1
 }
 
 // Stats provides extraction statistics
@@ -56,12 +71,23 @@ package schema
     errors:   int & >=0
     warnings: int & >=0
     info:     int & >=0
+    by_rule?: {[string]: int & >=1}  // Violations per rule in this file
+}
+
+// RootResult is one root's summary in a multi-root run
+#RootResult: {
+    root:         string & !=""
+    summary:      #Summary
+    stats:        #Stats
+    parse_errors: int & >=0
+    error?:       string  // Set when the root could not be linted
 }
 
 // ParseError represents a parsing failure
 #ParseError: {
-    file:    string
-    message: string & !=""
+    file:         string
+    message:      string & !=""
+    error_nodes?: int & >=1  // ERROR/MISSING nodes in a file skipped by --max-parse-errors
 }
 
 // MissingCheckTask is a structured task for the verification agent.
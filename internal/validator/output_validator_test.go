@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// lintResultJSON is a result as the indexer writes it, summary counters included.
+const lintResultJSON = `{
+  "schema_version": "1.5.0",
+  "violations": [
+    {"rule": "unused_signal", "severity": "warning", "file": "rtl/top.vhd", "line": 12, "message": "Signal 'dbg' is never read"}
+  ],
+  "summary": {
+    "total_violations": 1,
+    "errors": 0,
+    "warnings": 1,
+    "info": 0,
+    "suppressed": 2,
+    "baselined": 3,
+    "outside_diff": 4,
+    "by_rule": {"unused_signal": 1},
+    "top_rules": [{"rule": "unused_signal", "count": 1}]
+  },
+  "stats": {"files": 1, "symbols": 2, "entities": 1, "packages": 0, "signals": 1, "ports": 0, "processes": 0, "instances": 0, "generates": 0},
+  "files": [{"path": "rtl/top.vhd", "errors": 0, "warnings": 1, "info": 0, "by_rule": {"unused_signal": 1}}],
+  "roots": [
+    {
+      "root": "rtl",
+      "summary": {"total_violations": 1, "errors": 0, "warnings": 1, "info": 0, "suppressed": 0, "baselined": 0, "outside_diff": 0},
+      "stats": {"files": 1, "symbols": 2, "entities": 1, "packages": 0, "signals": 1, "ports": 0, "processes": 0, "instances": 0, "generates": 0},
+      "parse_errors": 0
+    },
+    {
+      "root": "ip",
+      "summary": {"total_violations": 0, "errors": 0, "warnings": 0, "info": 0, "suppressed": 0, "baselined": 0, "outside_diff": 0},
+      "stats": {"files": 0, "symbols": 0, "entities": 0, "packages": 0, "signals": 0, "ports": 0, "processes": 0, "instances": 0, "generates": 0},
+      "parse_errors": 0,
+      "error": "load config: invalid config file"
+    }
+  ]
+}`
+
+func TestOutputValidatorAcceptsLintResult(t *testing.T) {
+	v, err := NewOutputValidator()
+	if err != nil {
+		t.Fatalf("new output validator: %v", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(lintResultJSON), &result); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Validate(result); err != nil {
+		t.Fatalf("expected valid lint result, got error: %v", err)
+	}
+
+	result["summary"].(map[string]interface{})["unknown_counter"] = 1
+	if err := v.Validate(result); err == nil {
+		t.Fatalf("expected an unknown summary field to be rejected")
+	}
+}
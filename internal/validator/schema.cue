@@ -54,6 +54,53 @@ package schema
     arithmetic_ops:         [...#ArithmeticOp]
     signal_deps:            [...#SignalDep]
     cdc_crossings:          [...#CDCCrossing]
+    // Analysis findings (extractor Detect* passes and cross-file checks)
+    overlapping_choices:    [...#OverlappingChoice]
+    inconsistent_reset_polarities: [...#InconsistentResetPolarity]
+    constants_in_sensitivity_list: [...#ConstantInSensitivityList]
+    magic_numbers:          [...#MagicNumber]
+    ports_shadowed_by_signal: [...#PortShadowedBySignal]
+    clock_level_conditions: [...#ClockLevelCondition]
+    no_effect_processes:    [...#NoEffectProcess]
+    combinational_multi_drivers: [...#CombinationalMultiDriver]
+    mixed_slice_directions: [...#MixedSliceDirection]
+    unused_component_declarations: [...#UnusedComponentDeclaration]
+    permanent_high_z:       [...#PermanentHighZ]
+    assertions:             [...#Assertion]
+    aliases:                [...#AliasDeclaration]
+    combinational_loops:    [...#CombinationalLoop]
+    multi_drivers:          [...#MultiDriver]
+    unbound_generics:       [...#UnboundGeneric]
+    unused_signals:         [...#UnusedSignal]
+    width_mismatches:       [...#WidthMismatch]
+    clock_misuses:          [...#ClockMisuse]
+    reset_crossings:        [...#ResetCrossing]
+    conditional_latches:    [...#LatchInference]
+    imported_names:         [...#ImportedName]
+    loops:                  [...#LoopStatement]
+    reads_before_write:     [...#ReadBeforeWrite]
+    attribute_specs:        [...#AttributeSpec]
+    tristate_assignments:   [...#TristateAssignment]
+    fsm_issues:             [...#FSMIssue]
+    gated_clocks:           [...#GatedClock]
+    uninitialized_reads:    [...#UninitRead]
+    blocks:                 [...#BlockStatement]
+    orphan_architectures:   [...#OrphanArchitecture]
+    duplicate_symbols:      [...#DuplicateSymbol]
+    width_truncations:      [...#WidthTruncation]
+    case_choice_conflicts:  [...#CaseChoiceConflict]
+    feedthroughs:           [...#Feedthrough]
+    reset_default_mismatches: [...#ResetDefaultMismatch]
+    mixed_sensitivity_issues: [...#MixedSensitivity]
+    fsm_encodings:          [...#FSMEncoding]
+    component_mismatches:   [...#ComponentMismatch]
+    standard_shadowings:    [...#StandardShadowing]
+    suspicious_clocks:      [...#SuspiciousClock]
+    unprotected_shared_variables: [...#UnprotectedSharedVariable]
+    unsafe_arithmetic:      [...#UnsafeArithmetic]
+    instance_generates:     [...#InstanceGenerate]
+    sensitivity_list_smells: [...#SensitivityListSmell]
+    incomplete_record_aggregates: [...#IncompleteRecordAggregate]
     // Configuration
     lint_config:            #LintConfig  // Rule severities from vhdl_lint.json
     third_party_files:      [...string]  // Files from third-party libraries (suppress warnings)
@@ -70,6 +117,9 @@ package schema
 // LintConfig contains rule configuration passed to the policy engine
 #LintConfig: {
     rules: {[string]: "off" | "info" | "warning" | "error"}  // rule name -> severity
+    only?: [...string]                                        // --rules allowlist (absent = every enabled rule)
+    skip?: [...string]                                        // --skip-rules denylist, applied after only
+    trojan_literal_bits?: int & >=1                           // Literal width the large-literal trojan rules report from
 }
 
 // Entity declaration
@@ -115,6 +165,9 @@ package schema
     line:      int & >=1
     in_entity: string  // Which entity/architecture this signal belongs to
     width:     int & >=0  // Estimated bit width (0 if unknown)
+    in_synth_off_region: bool  // Inside a "-- synthesis translate_off" region
+    doc:       string  // Documenting comment ("" if none)
+    default:   string  // Initial value after ":=" ("" if none)
 }
 
 // Port declaration
@@ -127,6 +180,7 @@ package schema
     line:      int & >=1
     in_entity: string  // Which entity this port belongs to
     width:     int & >=0  // Estimated bit width (0 if unknown)
+    doc:       string  // Documenting comment ("" if none)
 }
 
 #GenericDecl: {
@@ -171,9 +225,10 @@ package schema
 }
 
 #VariableDecl: {
-    name: #Identifier
-    type: string
-    line: int & >=1
+    name:    #Identifier
+    type:    string
+    line:    int & >=1
+    default: string  // Initial value after ":=" ("" if none)
 }
 
 #ProcedureCall: {
@@ -222,6 +277,7 @@ package schema
     path:          string
     library:       string
     is_third_party: bool
+    rule_overrides?: {[string]: "off" | "info" | "warning" | "error"}  // From the file's library config
 }
 
 // Scope represents a lexical or generate scope for name resolution.
@@ -283,7 +339,8 @@ package schema
 // Enables system-level analysis (cross-module signal tracing)
 #Instance: {
     name:        #Identifier  // Instance label
-    target:      string & !=""                          // Target entity/component
+    target:      string & !=""                          // Target with "work." resolved to the file's library
+    raw_target:  string & !=""                          // Target as written
     port_map:    {[string]: string}                     // Formal -> actual signal
     generic_map: {[string]: string}                     // Formal -> actual value
     associations: [...#Association]
@@ -311,11 +368,13 @@ package schema
     sensitivity_list: [...string]                       // Signals in sensitivity list
     is_sequential:    bool                              // Has clock edge
     is_combinational: bool                              // No clock edge
+    is_stimulus:      bool                              // Only "wait for" delays (testbench stimulus)
     clock_signal:     string                            // Clock signal if sequential
     clock_edge:       string                            // "rising" or "falling" if sequential
     has_reset:        bool                              // Has reset logic
     reset_signal:     string                            // Reset signal name
     reset_async:      bool                              // Async reset if checked before clock
+    reset_polarity:   "active_high" | "active_low" | "" // Level that asserts the reset ("" if unknown)
     assigned_signals: [...string]                       // Signals written
     read_signals:     [...string]                       // Signals read
     variables:        [...#VariableDecl]
@@ -325,6 +384,7 @@ package schema
     file:             string & =~".+\\.(vhd|vhdl)$"
     line:             int & >=1
     in_arch:          string                            // Containing architecture
+    in_synth_off_region: bool                           // Inside a "-- synthesis translate_off" region
 }
 
 // ConcurrentAssignment represents a concurrent signal assignment (outside processes)
@@ -338,6 +398,9 @@ package schema
     kind:           "simple" | "conditional" | "selected"   // Assignment type
     in_generate:    bool                                    // True if inside generate block
     generate_label: string                                  // Label of containing generate
+    in_synth_off_region: bool                               // Inside a "-- synthesis translate_off" region
+    choices:        [...string]                             // Explicit choices of a selected assignment
+    has_others:     bool                                    // Selected assignment has "when others"
 }
 
 // Comparison represents a comparison operation for trojan/trigger detection
@@ -358,7 +421,7 @@ package schema
 
 // ArithmeticOp represents an expensive arithmetic operation for power analysis
 #ArithmeticOp: {
-    operator:     string                                // *, /, mod, rem, **
+    operator:     string                                // +, -, *, /, mod, rem, **
     operands:     [...string]                           // Input signals/expressions
     result:       string                                // Output signal
     is_guarded:   bool                                  // True if gated by enable
@@ -397,6 +460,531 @@ package schema
     in_arch:         string                             // Which architecture
 }
 
+// OverlappingChoice reports two choices of one case/selected statement that overlap
+#OverlappingChoice: {
+    kind:         "case" | "selected"
+    expression:   string                              // Case expression / selector
+    first:        string & !=""                       // Earlier choice text
+    second:       string & !=""                       // Later choice text that overlaps first
+    is_duplicate: bool                                // Both choices cover exactly the same values
+    file:         string & =~".+\\.(vhd|vhdl)$"
+    line:         int & >=1
+    in_process:   string
+    in_arch:      string
+}
+
+// ConstantInSensitivityList reports a sensitivity list entry naming a constant or generic
+#ConstantInSensitivityList: {
+    name:      string & !=""                        // Sensitivity list entry as written
+    kind:      "constant" | "generic"
+    decl_line: int & >=1                            // Line of the constant/generic declaration
+    process:   string
+    file:      string & =~".+\\.(vhd|vhdl)$"
+    line:      int & >=1                            // Line of the process
+    in_arch:   string
+}
+
+// MagicNumber reports an integer literal used directly in an expression instead of a named constant
+#MagicNumber: {
+    value:      string & !=""                       // Literal as written
+    numeric:    int
+    context:    "comparison" | "arithmetic" | "assignment"
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    line:       int & >=1
+    in_process: string
+    in_arch:    string
+}
+
+// PortShadowedBySignal reports an architecture signal with the same name as a port of its entity
+#PortShadowedBySignal: {
+    signal:       string & !=""
+    port:         string & !=""
+    entity:       string & !=""
+    architecture: string & !=""
+    file:         string & =~".+\\.(vhd|vhdl)$"   // File of the signal declaration
+    line:         int & >=1                         // Line of the signal declaration
+    port_file:    string & =~".+\\.(vhd|vhdl)$"
+    port_line:    int & >=1
+}
+
+// ClockLevelCondition reports a concurrent assignment whose condition tests a clock level (a latch)
+#ClockLevelCondition: {
+    target:    string
+    clock:     string & !=""
+    level:     "'1'" | "'0'" | ""                 // Empty when the clock is the selector
+    condition: string & !=""                      // Condition (or selector) expression as written
+    kind:      "conditional" | "selected"
+    file:      string & =~".+\\.(vhd|vhdl)$"
+    line:      int & >=1
+    in_arch:   string
+}
+
+// NoEffectProcess reports a process with no signal writes, procedure calls, or assertions
+#NoEffectProcess: {
+    process:      string
+    read_signals: [...string]                       // Signals read and then discarded
+    file:         string & =~".+\\.(vhd|vhdl)$"
+    line:         int & >=1
+    in_arch:      string
+}
+
+// CombinationalMultiDriver reports a signal written by more than one combinational process
+#CombinationalMultiDriver: {
+    signal:    string
+    processes: [...string]                       // Driving process labels ("" for unlabeled)
+    lines:     [...int]                          // Line of each driving process
+    file:      string & =~".+\\.(vhd|vhdl)$"
+    line:      int & >=1                         // Line of the first driving process
+    in_arch:   string
+}
+
+// MixedSliceDirection reports a signal sliced both with "to" and "downto" in one architecture
+#MixedSliceDirection: {
+    signal:       string
+    architecture: string
+    file:         string & =~".+\\.(vhd|vhdl)$"
+    line:         int & >=1                         // Line of the later of the two first slices
+    to_expr:      string                            // First ascending slice as written
+    to_line:      int & >=1
+    downto_expr:  string                            // First descending slice as written
+    downto_line:  int & >=1
+}
+
+// UnusedComponentDeclaration reports a component declaration that is never instantiated
+#UnusedComponentDeclaration: {
+    component:  string
+    scope:      string                            // Declaring architecture or package
+    scope_kind: "architecture" | "package"
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    line:       int & >=1
+}
+
+// PermanentHighZ reports a signal whose every assignment drives 'Z'
+#PermanentHighZ: {
+    signal:      string
+    assignments: int & >=1                      // Number of assignments, all high-impedance
+    file:        string & =~".+\\.(vhd|vhdl)$"
+    line:        int & >=1                      // First assignment
+    in_arch:     string
+}
+
+// Assertion represents an assert or report statement (report statements have no condition)
+#Assertion: {
+    condition:      string                          // Asserted expression ("" for report statements)
+    report_message: string                          // Report expression as written
+    severity:       string & !=""                   // note, warning, error, failure (defaults applied)
+    file:           string & =~".+\\.(vhd|vhdl)$"
+    line:           int & >=1
+    in_process:     string                          // Empty for concurrent assertions
+    in_arch:        string
+}
+
+// AliasDeclaration represents an alias declaration and the base signal it resolves to
+#AliasDeclaration: {
+    name:       string & !=""
+    target:     string                          // Aliased name as written
+    base_name:  string                          // Base signal of the target ("" if not a plain name)
+    type:       string                          // Optional subtype indication
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    line:       int & >=1
+    in_arch:    string
+    in_package: string
+}
+
+// CombinationalLoop is a cycle of combinational signal dependencies
+#CombinationalLoop: {
+    signals: [string, ...string]                // Cycle path; the last signal feeds the first
+    lines:   [...int]                           // Line of each dependency along the path
+    file:    string & =~".+\\.(vhd|vhdl)$"
+    line:    int & >=0                          // First line of the cycle
+    in_arch: string
+}
+
+// MultiDriver reports an unresolved signal written by more than one driver
+#MultiDriver: {
+    signal:      string & !=""
+    drivers:     [#DriverLocation, #DriverLocation, ...#DriverLocation]
+    in_generate: bool                           // Some driver is inside a generate (legal if mutually exclusive)
+    file:        string & =~".+\\.(vhd|vhdl)$"
+    line:        int & >=1                      // First driver
+    in_arch:     string
+}
+
+// DriverLocation is one process or concurrent assignment writing a signal
+#DriverLocation: {
+    process:     string                         // "" for concurrent assignments
+    line:        int & >=1
+    in_generate: bool
+}
+
+// UnboundGeneric reports an instance that leaves a generic without a default unbound
+#UnboundGeneric: {
+    instance: string
+    generic:  string & !=""
+    unit:     string & !=""                     // Entity or component declaring the generic
+    file:     string & =~".+\\.(vhd|vhdl)$"
+    line:     int & >=1                         // Line of the instance
+    in_arch:  string
+}
+
+// UnusedSignal reports a declared signal that is never read
+#UnusedSignal: {
+    name:      string & !=""
+    kind:      "write-only" | "dead"
+    file:      string & =~".+\\.(vhd|vhdl)$"
+    line:      int & >=1
+    in_entity: string
+}
+
+// WidthMismatch reports a port association whose actual and formal widths differ
+#WidthMismatch: {
+    instance:     string
+    formal:       string & !=""
+    actual:       string & !=""                 // As written, including any slice
+    formal_width: int & >=1
+    actual_width: int & >=1
+    file:         string & =~".+\\.(vhd|vhdl)$"
+    line:         int & >=1
+    in_arch:      string
+}
+
+// ClockMisuse reports a clock read as data or driven combinationally
+#ClockMisuse: {
+    clock:   string & !=""
+    kind:    "data_read" | "combinational_assignment"
+    process: string                               // Empty for a concurrent assignment
+    file:    string & =~".+\\.(vhd|vhdl)$"
+    line:    int & >=1
+    in_arch: string
+}
+
+// ResetCrossing reports an async reset and the clock domains it reaches
+#ResetCrossing: {
+    reset:          string & !=""
+    domains:        [...string]                       // Clocks of processes using the reset asynchronously
+    unsynchronized: [...string]                       // Domains with no reset synchronizer
+    synchronized:   bool                              // Every domain has a reset synchronizer
+    file:           string & =~".+\\.(vhd|vhdl)$"
+    line:           int & >=1
+    in_arch:        string
+}
+
+// LatchInference reports a signal a combinational process leaves unassigned on
+// some branch of an if or case statement
+#LatchInference: {
+    signal:         string & !=""
+    process:        string
+    missing_branch: string & !=""                     // First branch that does not assign the signal
+    file:           string & =~".+\\.(vhd|vhdl)$"
+    line:           int & >=1                         // Line of the if/case statement
+    in_arch:        string
+}
+
+// ImportedName is a package member made visible in a file by a
+// "use lib.pkg.all" clause
+#ImportedName: {
+    name:         string & !=""
+    kind:         "type" | "constant" | "function" | "procedure"
+    from_package: string & !=""                       // Package as written in the use clause
+    file:         string & =~".+\\.(vhd|vhdl)$"
+    line:         int & >=1                           // Line of the use clause
+}
+
+// LoopStatement represents a for loop inside a process
+#LoopStatement: {
+    loop_var:        string & !=""
+    range_low:       string                           // Range low bound (or the whole attribute range)
+    range_high:      string                           // Range high bound
+    range_dir:       "to" | "downto" | ""             // Empty for attribute ranges
+    iteration_count: int & >=-1                       // Number of iterations (-1 if cannot evaluate)
+    can_elaborate:   bool                             // True if range was successfully evaluated
+    file:            string & =~".+\\.(vhd|vhdl)$"
+    line:            int & >=1
+    in_process:      string
+    in_arch:         string
+}
+
+// ReadBeforeWrite reports a combinational process that reads a signal above
+// its only assignment in the same process
+#ReadBeforeWrite: {
+    signal:     string & !=""
+    process:    string
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    read_line:  int & >=1                         // First read of the signal
+    write_line: int & >=1                         // The signal's only assignment
+    in_arch:    string
+}
+
+// AttributeSpec is an attribute declaration or one target of an attribute
+// specification (synthesis directives such as keep and dont_touch)
+#AttributeSpec: {
+    attribute:   string & !=""                     // Well-known vendor attributes are lowercase
+    target:      string                            // Named entity, "others", or "all" ("" for declarations)
+    target_kind: string                            // Entity class ("" for declarations)
+    value:       string                            // Value expression as written ("" for declarations)
+    type:        string                            // Declared attribute type (declarations only)
+    file:        string & =~".+\\.(vhd|vhdl)$"
+    line:        int & >=1
+    in_arch:     string
+}
+
+// TristateAssignment is a signal assignment that drives 'Z' in at least one
+// waveform
+#TristateAssignment: {
+    target:      string & !=""
+    file:        string & =~".+\\.(vhd|vhdl)$"
+    line:        int & >=1
+    in_process:  string                            // Empty for concurrent assignments
+    in_arch:     string
+    conditional: bool                              // Guarded by a condition (output-enable pattern)
+}
+
+// FSMIssue is an enum state a state machine never assigns or never decodes
+#FSMIssue: {
+    signal:  string & !=""
+    type:    string & !=""
+    state:   string & !=""
+    kind:    "unreachable" | "dead"
+    file:    string & =~".+\\.(vhd|vhdl)$"
+    line:    int & >=1
+    in_arch: string
+}
+
+// GatedClock is a clock driven by an assignment instead of an input port
+#GatedClock: {
+    clock:      string & !=""
+    expression: string                      // Driven value(s) as written
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    line:       int & >=1
+    in_process: string                      // Empty for a concurrent assignment
+    in_arch:    string
+}
+
+// UninitRead is a signal without a default read combinationally before any assignment
+#UninitRead: {
+    signal:     string & !=""
+    type:       string
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    line:       int & >=1                 // First combinational read
+    in_process: string                    // Empty for a concurrent assignment
+    in_arch:    string
+}
+
+// BlockStatement is a block statement; its contents are scoped "arch.label"
+#BlockStatement: {
+    label:      string & !=""
+    guard_expr: string                    // Empty unless the block is guarded
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    line:       int & >=1
+    in_arch:    string
+}
+
+// OrphanArchitecture is an architecture whose entity does not resolve in its library
+#OrphanArchitecture: {
+    architecture: string & !=""
+    entity:       string & !=""           // Entity name as written
+    library:      string & !=""           // Library the architecture is compiled into
+    file:         string & =~".+\\.(vhd|vhdl)$"
+    line:         int & >=1
+}
+
+// DuplicateSymbol is a qualified name (library.unit) declared in more than one file
+#DuplicateSymbol: {
+    name:      string & !=""
+    kind:      string & !=""              // entity, package, context, type, ...
+    locations: [#SymbolLocation, #SymbolLocation, ...#SymbolLocation]
+}
+
+// SymbolLocation is one conflicting declaration of a duplicate symbol
+#SymbolLocation: {
+    file: string & =~".+\\.(vhd|vhdl)$"
+    line: int & >=1
+}
+
+// WidthTruncation reports a signal assignment whose value is wider than its
+// whole-signal target, silently dropping the upper bits
+#WidthTruncation: {
+    target:       string & !=""
+    target_width: int & >=1
+    source:       string & !=""                 // Widest driven value, as written
+    source_width: int & >=2
+    file:         string & =~"\\.(vhd|vhdl)$"
+    line:         int & >=1
+    in_process:   string                        // Empty for a concurrent assignment
+    in_arch:      string
+}
+
+// CaseChoiceConflict reports an if/elsif chain comparing one signal whose
+// structure contradicts its intent: a branch that repeats an earlier value
+// ("unreachable"), or distinct constant comparisons that need no priority
+// ("parallel")
+#CaseChoiceConflict: {
+    kind:       "unreachable" | "parallel"
+    expression: string & !=""
+    choices:    [string, string, ...string]
+    first:      string                       // unreachable: the earlier value
+    second:     string                       // unreachable: the repeated value
+    file:       string & =~"\\.(vhd|vhdl)$"
+    line:       int & >=1
+    in_process: string
+    in_arch:    string
+}
+
+// Feedthrough is an output port assigned straight from an input port
+#Feedthrough: {
+    input:   #Identifier
+    output:  #Identifier
+    file:    string & =~".+\\.(vhd|vhdl)$"
+    line:    int & >=1
+    in_arch: string
+}
+
+// ResetDefaultMismatch is a reset value differing from the declared initial value
+#ResetDefaultMismatch: {
+    signal:     #Identifier
+    default:    string & !=""
+    reset:      string & !=""
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    line:       int & >=1
+    in_process: string
+    in_arch:    string
+}
+
+// MixedSensitivity is a clocked process sensitive to data or missing its clock
+#MixedSensitivity: {
+    process:       string
+    clock:         string & !=""
+    extra:         [...string]  // Entries that are neither the clock nor a reset
+    missing_clock: bool         // The clock is not in the sensitivity list
+    file:          string & =~".+\\.(vhd|vhdl)$"
+    line:          int & >=1
+    in_arch:       string
+}
+
+// FSMEncoding is an encoding attribute applied to an enumeration type
+#FSMEncoding: {
+    type_name:   string & !=""
+    signal:      string  // Signal the attribute targets ("" for the type itself)
+    attribute:   "enum_encoding" | "fsm_encoding" | "syn_encoding"
+    encoding:    string  // one-hot, gray, binary, johnson, auto, user, or as written
+    value:       string
+    state_count: int & >=0
+    file:        string & =~".+\\.(vhd|vhdl)$"
+    line:        int & >=1
+    in_arch:     string
+    in_package:  string
+}
+
+// ComponentMismatch reports a port on which a component and its entity disagree
+#ComponentMismatch: {
+    component:       string & !=""
+    entity:          string & !=""
+    port:            string & !=""
+    kind:            "missing_in_entity" | "missing_in_component" | "direction" | "width"
+    component_value: string  // Direction or width on the component ("" if absent)
+    entity_value:    string  // Direction or width on the entity ("" if absent)
+    file:            string & =~".+\\.(vhd|vhdl)$"
+    line:            int & >=1
+    entity_file:     string & =~".+\\.(vhd|vhdl)$"
+    entity_line:     int & >=1
+}
+
+// StandardShadowing is a package member named like a standard type or function
+#StandardShadowing: {
+    name:    string & !=""
+    kind:    "type" | "subtype" | "function" | "constant"
+    package: string & !=""
+    file:    string & =~".+\\.(vhd|vhdl)$"
+    line:    int & >=1
+}
+
+// SuspiciousClock is a clocked process whose clock is not an input port or known clock
+#SuspiciousClock: {
+    clock:   string & !=""
+    kind:    "signal" | "port"
+    process: string  // Process label ("" if unlabeled)
+    file:    string & =~".+\\.(vhd|vhdl)$"
+    line:    int & >=1
+    in_arch: string
+}
+
+// UnprotectedSharedVariable is a shared variable of a non-protected type (VHDL-2008+)
+#UnprotectedSharedVariable: {
+    name:       string & !=""
+    type:       string & !=""
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    line:       int & >=1
+    in_package: string
+    in_arch:    string
+}
+
+// UnsafeArithmetic is "+" or "-" on a std_logic_vector where only numeric_std is used
+#UnsafeArithmetic: {
+    operator:     "+" | "-"
+    operand:      string & !=""
+    operand_type: string & !=""
+    file:         string & =~".+\\.(vhd|vhdl)$"
+    line:         int & >=1
+    in_process:   string
+    in_arch:      string
+}
+
+// InstanceGenerate is a for-generate's iteration count in one instance of
+// its entity, with the instance's generic values in place of the defaults
+#InstanceGenerate: {
+    instance:        string & !=""
+    entity:          string & !=""
+    architecture:    string & !=""
+    generate:        string
+    generate_file:   string & =~".+\\.(vhd|vhdl)$"
+    generate_line:   int & >=1
+    iteration_count: int & >=-1
+    file:            string & =~".+\\.(vhd|vhdl)$"
+    line:            int & >=1
+    in_arch:         string
+}
+
+// SensitivityListSmell is a sensitivity list that repeats an entry or names
+// a signal its process assigns
+#SensitivityListSmell: {
+    process:         string
+    duplicates:      [...string]  // Entries listed more than once
+    self_references: [...string]  // Entries the process also assigns
+    file:            string & =~".+\\.(vhd|vhdl)$"
+    line:            int & >=1
+    in_arch:         string
+}
+
+// IncompleteRecordAggregate is an aggregate assigned to a record-typed signal
+// that names neither every field nor others
+#IncompleteRecordAggregate: {
+    target:     string & !=""
+    type:       string & !=""
+    missing:    [...string]  // Fields the aggregate leaves out
+    file:       string & =~".+\\.(vhd|vhdl)$"
+    line:       int & >=1
+    in_process: string  // Empty for concurrent assignments
+    in_arch:    string
+}
+
+// ResetPolarityUsage records one process comparing a reset net against a polarity
+#ResetPolarityUsage: {
+    process:  string
+    polarity: "active_high" | "active_low"
+    file:     string & =~".+\\.(vhd|vhdl)$"
+    line:     int & >=1
+}
+
+// InconsistentResetPolarity groups usages of a reset net that disagree on polarity
+#InconsistentResetPolarity: {
+    signal:            string & !=""                     // Reset signal name (lowercased)
+    expected_polarity: "active_high" | "active_low"      // Majority polarity across the design
+    polarities:        [...("active_high" | "active_low")]
+    usages:            [...#ResetPolarityUsage]
+    file:              string & =~".+\\.(vhd|vhdl)$"   // First usage departing from the majority
+    line:              int & >=1
+}
+
 // GenerateStatement represents a VHDL generate statement (for/if/case generate)
 // Generate statements create conditional or iterative scopes with their own declarations
 #GenerateStatement: {
@@ -425,6 +1013,7 @@ package schema
 // Enables accurate detection of undriven signals (driven by component outputs)
 #SignalUsage: {
     signal:        string                               // Signal name
+    field_path:    string                               // Record field accessed ("trap.cause"); empty for the whole signal
     is_read:       bool                                 // Signal is read
     is_written:    bool                                 // Signal is written
     in_process:    string                               // Process where usage occurs (empty if concurrent)
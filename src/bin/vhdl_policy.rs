@@ -1,13 +1,17 @@
 use std::error::Error;
 use std::fs::File;
-use std::io::{self, Read};
+use std::io::{self, BufRead, BufReader, Read};
 
+use serde::Deserialize;
+use serde_json::{Map, Value};
 use vhdl_compiler::policy::engine;
 use vhdl_compiler::policy::input::Input;
 
 fn main() -> Result<(), Box<dyn Error>> {
     let args: Vec<String> = std::env::args().collect();
-    let input = if args.len() > 1 {
+    let input = if args.len() > 2 && args[1] == "--ndjson" {
+        read_input_ndjson(&args[2])?
+    } else if args.len() > 1 {
         read_input_file(&args[1])?
     } else {
         read_input_stdin()?
@@ -30,3 +34,61 @@ fn read_input_stdin() -> Result<Input, Box<dyn Error>> {
     let input: Input = serde_json::from_str(&buf)?;
     Ok(input)
 }
+
+/// One line of a streamed input: a row of a table, or a whole non-table value.
+#[derive(Deserialize)]
+struct TableRecord {
+    table: String,
+    #[serde(default)]
+    row: Option<Value>,
+    #[serde(default)]
+    value: Option<Value>,
+}
+
+/// Reads an input written table by table (see policy.WriteInputNDJSON).
+/// Tables missing from the stream default to empty like any absent field.
+fn read_input_ndjson(path: &str) -> Result<Input, Box<dyn Error>> {
+    let reader = BufReader::new(File::open(path)?);
+    let mut tables = Map::new();
+    for (idx, line) in reader.lines().enumerate() {
+        let line = line?;
+        if line.trim().is_empty() {
+            continue;
+        }
+        let record: TableRecord = serde_json::from_str(&line)
+            .map_err(|err| format!("{}:{}: invalid record: {}", path, idx + 1, err))?;
+        match (record.row, record.value) {
+            (Some(row), _) => {
+                let rows = tables
+                    .entry(record.table.clone())
+                    .or_insert_with(|| Value::Array(Vec::new()));
+                match rows {
+                    Value::Array(rows) => rows.push(row),
+                    _ => {
+                        return Err(format!(
+                            "{}:{}: table '{}' mixes rows and values",
+                            path,
+                            idx + 1,
+                            record.table
+                        )
+                        .into())
+                    }
+                }
+            }
+            (None, Some(value)) => {
+                tables.insert(record.table, value);
+            }
+            (None, None) => {
+                return Err(format!(
+                    "{}:{}: record for '{}' has neither row nor value",
+                    path,
+                    idx + 1,
+                    record.table
+                )
+                .into())
+            }
+        }
+    }
+    let input: Input = serde_json::from_value(Value::Object(tables))?;
+    Ok(input)
+}
@@ -1,4 +1,4 @@
-use crate::policy::helpers::{is_clock_name, is_reset_name, is_single_bit_type};
+use crate::policy::helpers::{file_in_testbench, is_clock_name, is_reset_name, is_single_bit_type};
 use crate::policy::input::{Input, Port};
 use crate::policy::result::Violation;
 
@@ -7,6 +7,9 @@ pub fn violations(input: &Input) -> Vec<Violation> {
     out.extend(clock_not_std_logic(input));
     out.extend(reset_not_std_logic(input));
     out.extend(multiple_clocks_in_process(input));
+    out.extend(inconsistent_reset_polarity(input));
+    out.extend(clock_level_condition(input));
+    out.extend(clock_used_as_data(input));
     out
 }
 
@@ -14,6 +17,9 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     out.extend(async_reset_active_high(input));
     out.extend(missing_reset(input));
+    out.extend(gated_clock(input));
+    out.extend(reset_default_mismatch(input));
+    out.extend(suspicious_clock(input));
     out
 }
 
@@ -131,6 +137,166 @@ fn async_reset_active_high(input: &Input) -> Vec<Violation> {
         .collect()
 }
 
+fn inconsistent_reset_polarity(input: &Input) -> Vec<Violation> {
+    input
+        .inconsistent_reset_polarities
+        .iter()
+        .map(|conflict| {
+            let sites: Vec<String> = conflict
+                .usages
+                .iter()
+                .map(|u| format!("{}:{} {}", u.file, u.line, u.polarity))
+                .collect();
+            Violation {
+                rule: "inconsistent_reset_polarity".to_string(),
+                severity: "warning".to_string(),
+                file: conflict.file.clone(),
+                line: conflict.line,
+                message: format!(
+                    "Reset '{}' is used with inconsistent polarity (expected {}): {}",
+                    conflict.signal,
+                    conflict.expected_polarity,
+                    sites.join(", ")
+                ),
+            }
+        })
+        .collect()
+}
+
+fn clock_level_condition(input: &Input) -> Vec<Violation> {
+    input
+        .clock_level_conditions
+        .iter()
+        .map(|cond| {
+            let test = if cond.level.is_empty() {
+                format!("selects on clock '{}'", cond.clock)
+            } else {
+                format!("tests clock '{}' = {}", cond.clock, cond.level)
+            };
+            Violation {
+                rule: "clock_level_condition".to_string(),
+                severity: "warning".to_string(),
+                file: cond.file.clone(),
+                line: cond.line,
+                message: format!(
+                    "Assignment to '{}' {} - this infers a level-sensitive latch, not a register (use a clocked process)",
+                    cond.target, test
+                ),
+            }
+        })
+        .collect()
+}
+
+fn clock_used_as_data(input: &Input) -> Vec<Violation> {
+    input
+        .clock_misuses
+        .iter()
+        .map(|misuse| {
+            let site = if misuse.process.is_empty() {
+                "a concurrent assignment".to_string()
+            } else {
+                format!("process '{}'", misuse.process)
+            };
+            let message = if misuse.kind == "combinational_assignment" {
+                format!(
+                    "Clock '{}' is driven combinationally by {} - derived clocks glitch and skew (use a clock enable or a dedicated clock resource)",
+                    misuse.clock, site
+                )
+            } else {
+                format!(
+                    "Clock '{}' is read as data in {} - clocks should only reach edge detection (register the value or use a clock enable)",
+                    misuse.clock, site
+                )
+            };
+            Violation {
+                rule: "clock_used_as_data".to_string(),
+                severity: "warning".to_string(),
+                file: misuse.file.clone(),
+                line: misuse.line,
+                message,
+            }
+        })
+        .collect()
+}
+
+fn gated_clock(input: &Input) -> Vec<Violation> {
+    input
+        .gated_clocks
+        .iter()
+        .map(|gc| {
+            let site = if gc.in_process.is_empty() {
+                "a concurrent assignment".to_string()
+            } else {
+                format!("process '{}'", gc.in_process)
+            };
+            Violation {
+                rule: "gated_clock".to_string(),
+                severity: "warning".to_string(),
+                file: gc.file.clone(),
+                line: gc.line,
+                message: format!(
+                    "Clock '{}' is produced by logic ('{}') in {} rather than an input port - gated clocks add skew and glitches (use a clock enable or a clock buffer)",
+                    gc.clock, gc.expression, site
+                ),
+            }
+        })
+        .collect()
+}
+
+fn reset_default_mismatch(input: &Input) -> Vec<Violation> {
+    input
+        .reset_default_mismatches
+        .iter()
+        .map(|rd| Violation {
+            rule: "reset_default_mismatch".to_string(),
+            severity: "info".to_string(),
+            file: rd.file.clone(),
+            line: rd.line,
+            message: format!(
+                "Signal '{}' is reset to {} in process '{}' but declared with initial value {} - simulation before the first reset will not match hardware",
+                rd.signal, rd.reset, rd.in_process, rd.default
+            ),
+        })
+        .collect()
+}
+
+fn suspicious_clock(input: &Input) -> Vec<Violation> {
+    input
+        .suspicious_clocks
+        .iter()
+        .filter(|sc| !file_in_testbench(input, &sc.file))
+        // gated_clock already reports clocks produced by logic
+        .filter(|sc| {
+            !input
+                .gated_clocks
+                .iter()
+                .any(|gc| gc.file == sc.file && gc.clock.eq_ignore_ascii_case(&sc.clock))
+        })
+        .map(|sc| {
+            let process = if sc.process.is_empty() {
+                "a process".to_string()
+            } else {
+                format!("process '{}'", sc.process)
+            };
+            let source = if sc.kind == "port" {
+                "an output port"
+            } else {
+                "a signal not documented as a clock"
+            };
+            Violation {
+                rule: "suspicious_clock".to_string(),
+                severity: "warning".to_string(),
+                file: sc.file.clone(),
+                line: sc.line,
+                message: format!(
+                    "{} is clocked by '{}', {} rather than an input port - check for an edge on a data signal (add the name to lint.clockPatterns if it is a derived clock)",
+                    process, sc.clock, source
+                ),
+            }
+        })
+        .collect()
+}
+
 fn entity_file(input: &Input, port: &Port) -> Option<String> {
     input
         .entities
@@ -142,7 +308,11 @@ fn entity_file(input: &Input, port: &Port) -> Option<String> {
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{Architecture, Entity, Input, Process};
+    use crate::policy::input::{
+        Architecture, ClockLevelCondition, ClockMisuse, Entity, GatedClock,
+        InconsistentResetPolarity, Input, Process, ResetDefaultMismatch, ResetPolarityUsage,
+        SuspiciousClock,
+    };
 
     fn add_entity_arch(input: &mut Input, name: &str) {
         input.entities.push(Entity {
@@ -208,4 +378,161 @@ mod tests {
         assert_eq!(violations.len(), 1);
         assert_eq!(violations[0].rule, "missing_reset");
     }
+
+    #[test]
+    fn inconsistent_reset_polarity_flags() {
+        let mut input = Input::default();
+        input
+            .inconsistent_reset_polarities
+            .push(InconsistentResetPolarity {
+                signal: "rst_n".to_string(),
+                expected_polarity: "active_low".to_string(),
+                polarities: vec!["active_high".to_string(), "active_low".to_string()],
+                usages: vec![
+                    ResetPolarityUsage {
+                        process: "p1".to_string(),
+                        polarity: "active_low".to_string(),
+                        file: "a.vhd".to_string(),
+                        line: 4,
+                    },
+                    ResetPolarityUsage {
+                        process: "p2".to_string(),
+                        polarity: "active_high".to_string(),
+                        file: "b.vhd".to_string(),
+                        line: 9,
+                    },
+                ],
+                file: "b.vhd".to_string(),
+                line: 9,
+            });
+        let violations = inconsistent_reset_polarity(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "inconsistent_reset_polarity");
+        assert_eq!(violations[0].file, "b.vhd");
+    }
+
+    #[test]
+    fn clock_level_condition_flags() {
+        let mut input = Input::default();
+        input.clock_level_conditions.push(ClockLevelCondition {
+            target: "q".to_string(),
+            clock: "clk".to_string(),
+            level: "'1'".to_string(),
+            condition: "clk = '1'".to_string(),
+            kind: "conditional".to_string(),
+            file: "a.vhd".to_string(),
+            line: 12,
+            ..Default::default()
+        });
+        let violations = clock_level_condition(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "clock_level_condition");
+        assert_eq!(violations[0].severity, "warning");
+    }
+
+    #[test]
+    fn clock_used_as_data_names_site() {
+        let mut input = Input::default();
+        input.clock_misuses.push(ClockMisuse {
+            clock: "clk".to_string(),
+            kind: "data_read".to_string(),
+            process: "p_comb".to_string(),
+            file: "a.vhd".to_string(),
+            line: 7,
+            ..Default::default()
+        });
+        input.clock_misuses.push(ClockMisuse {
+            clock: "clk_div".to_string(),
+            kind: "combinational_assignment".to_string(),
+            file: "a.vhd".to_string(),
+            line: 20,
+            ..Default::default()
+        });
+        let violations = clock_used_as_data(&input);
+        assert_eq!(violations.len(), 2);
+        assert_eq!(violations[0].rule, "clock_used_as_data");
+        assert!(violations[0].message.contains("process 'p_comb'"));
+        assert!(violations[1].message.contains("driven combinationally"));
+    }
+
+    #[test]
+    fn gated_clock_names_driver() {
+        let mut input = Input::default();
+        input.gated_clocks.push(GatedClock {
+            clock: "gclk".to_string(),
+            expression: "clk and en".to_string(),
+            file: "a.vhd".to_string(),
+            line: 9,
+            ..Default::default()
+        });
+        let violations = gated_clock(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "gated_clock");
+        assert!(violations[0].message.contains("'clk and en'"));
+        assert!(violations[0].message.contains("a concurrent assignment"));
+    }
+
+    #[test]
+    fn reset_default_mismatch_reports_info() {
+        let mut input = Input::default();
+        input.reset_default_mismatches.push(ResetDefaultMismatch {
+            signal: "cnt".to_string(),
+            default: "(others => '1')".to_string(),
+            reset: "(others => '0')".to_string(),
+            file: "a.vhd".to_string(),
+            line: 12,
+            in_process: "p_cnt".to_string(),
+            in_arch: "rtl".to_string(),
+        });
+        let violations = reset_default_mismatch(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "reset_default_mismatch");
+        assert_eq!(violations[0].severity, "info");
+        assert!(violations[0].message.contains("'cnt'"));
+    }
+
+    #[test]
+    fn suspicious_clock_skips_gated_and_testbench() {
+        let mut input = Input::default();
+        add_entity_arch(&mut input, "core");
+        input.suspicious_clocks.push(SuspiciousClock {
+            clock: "data_reg".to_string(),
+            kind: "signal".to_string(),
+            process: "p_bad".to_string(),
+            file: "a.vhd".to_string(),
+            line: 8,
+            in_arch: "rtl".to_string(),
+        });
+        input.suspicious_clocks.push(SuspiciousClock {
+            clock: "clk_g".to_string(),
+            kind: "signal".to_string(),
+            file: "a.vhd".to_string(),
+            line: 14,
+            in_arch: "rtl".to_string(),
+            ..Default::default()
+        });
+        input.gated_clocks.push(GatedClock {
+            clock: "clk_g".to_string(),
+            file: "a.vhd".to_string(),
+            line: 10,
+            ..Default::default()
+        });
+        input.suspicious_clocks.push(SuspiciousClock {
+            clock: "clk".to_string(),
+            kind: "signal".to_string(),
+            file: "tb.vhd".to_string(),
+            line: 20,
+            ..Default::default()
+        });
+        input.entities.push(Entity {
+            name: "core_tb".to_string(),
+            file: "tb.vhd".to_string(),
+            line: 1,
+            ..Default::default()
+        });
+        let violations = suspicious_clock(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "suspicious_clock");
+        assert!(violations[0].message.contains("process 'p_bad'"));
+    }
 }
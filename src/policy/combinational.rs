@@ -11,6 +11,7 @@ pub fn violations(input: &Input) -> Vec<Violation> {
     out.extend(two_stage_loop(input));
     out.extend(three_stage_loop(input));
     out.extend(cross_process_loop(input));
+    out.extend(unreachable_elsif_branch(input));
     out
 }
 
@@ -20,6 +21,7 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     out.extend(vhdl2008_sensitivity_all(input));
     out.extend(long_sensitivity_list(input));
     out.extend(potential_comb_loop(input));
+    out.extend(parallel_if_chain(input));
     out
 }
 
@@ -410,10 +412,48 @@ fn filtered_combinational_deps(input: &Input) -> Vec<&SignalDep> {
         .collect()
 }
 
+fn unreachable_elsif_branch(input: &Input) -> Vec<Violation> {
+    input
+        .case_choice_conflicts
+        .iter()
+        .filter(|cc| cc.kind == "unreachable")
+        .map(|cc| Violation {
+            rule: "unreachable_elsif_branch".to_string(),
+            severity: "warning".to_string(),
+            file: cc.file.clone(),
+            line: cc.line,
+            message: format!(
+                "Branch testing {} = {} can never be taken: an earlier branch already tests {} = {}",
+                cc.expression, cc.second, cc.expression, cc.first
+            ),
+        })
+        .collect()
+}
+
+fn parallel_if_chain(input: &Input) -> Vec<Violation> {
+    input
+        .case_choice_conflicts
+        .iter()
+        .filter(|cc| cc.kind == "parallel")
+        .map(|cc| Violation {
+            rule: "parallel_if_chain".to_string(),
+            severity: "info".to_string(),
+            file: cc.file.clone(),
+            line: cc.line,
+            message: format!(
+                "if/elsif chain compares '{}' against {} distinct constants ({}) - the branches are mutually exclusive, so a case statement avoids the priority logic",
+                cc.expression,
+                cc.choices.len(),
+                cc.choices.join(", ")
+            ),
+        })
+        .collect()
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{Entity, Input, Process, Signal, SignalDep};
+    use crate::policy::input::{CaseChoiceConflict, Entity, Input, Process, Signal, SignalDep};
 
     #[test]
     fn combinational_feedback_flags() {
@@ -503,4 +543,38 @@ mod tests {
         let v = direct_combinational_loop(&input);
         assert!(v.is_empty());
     }
+
+    #[test]
+    fn case_choice_conflicts_split_by_kind() {
+        let mut input = Input::default();
+        input.case_choice_conflicts.push(CaseChoiceConflict {
+            kind: "unreachable".to_string(),
+            expression: "sel".to_string(),
+            choices: vec![
+                "\"00\"".to_string(),
+                "\"01\"".to_string(),
+                "\"00\"".to_string(),
+            ],
+            first: "\"00\"".to_string(),
+            second: "\"00\"".to_string(),
+            file: "a.vhd".to_string(),
+            line: 5,
+            ..Default::default()
+        });
+        input.case_choice_conflicts.push(CaseChoiceConflict {
+            kind: "parallel".to_string(),
+            expression: "op".to_string(),
+            choices: vec!["0".to_string(), "1".to_string(), "2".to_string()],
+            file: "a.vhd".to_string(),
+            line: 20,
+            ..Default::default()
+        });
+        let unreachable = unreachable_elsif_branch(&input);
+        assert_eq!(unreachable.len(), 1);
+        assert_eq!(unreachable[0].line, 5);
+        let parallel = parallel_if_chain(&input);
+        assert_eq!(parallel.len(), 1);
+        assert_eq!(parallel[0].line, 20);
+        assert!(parallel[0].message.contains("0, 1, 2"));
+    }
 }
@@ -1,7 +1,7 @@
 use crate::policy::helpers::{self, is_testbench_name};
 use crate::policy::input::{Component, Input};
 use crate::policy::result::Violation;
-use std::collections::HashMap;
+use std::collections::{HashMap, HashSet};
 
 pub fn violations(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
@@ -13,6 +13,7 @@ pub fn violations(input: &Input) -> Vec<Violation> {
     out.extend(entity_without_arch(input));
     out.extend(duplicate_entity_in_library(input));
     out.extend(duplicate_package_in_library(input));
+    out.extend(duplicate_symbol(input));
     out
 }
 
@@ -33,17 +34,16 @@ fn missing_ports(input: &Input) -> Vec<Violation> {
 
 fn orphan_architecture(input: &Input) -> Vec<Violation> {
     input
-        .architectures
+        .orphan_architectures
         .iter()
-        .filter(|arch| !entity_exists(input, &arch.entity_name))
         .map(|arch| Violation {
             rule: "architecture_has_entity".to_string(),
             severity: "error".to_string(),
             file: arch.file.clone(),
             line: arch.line,
             message: format!(
-                "Architecture '{}' references undefined entity '{}'",
-                arch.name, arch.entity_name
+                "Architecture '{}' references undefined entity '{}' (not found in library '{}')",
+                arch.architecture, arch.entity, arch.library
             ),
         })
         .collect()
@@ -87,7 +87,7 @@ fn potential_latch(input: &Input) -> Vec<Violation> {
     input
         .case_statements
         .iter()
-        .filter(|cs| !cs.has_others)
+        .filter(|cs| !cs.is_complete)
         .filter(|cs| case_in_combinational_process(input, cs))
         .filter(|cs| !helpers::file_in_testbench(input, &cs.file))
         .map(|cs| Violation {
@@ -108,7 +108,10 @@ fn case_in_combinational_process(input: &Input, cs: &crate::policy::input::CaseS
         return false;
     }
     input.processes.iter().any(|proc| {
-        proc.label == cs.in_process && proc.in_arch == cs.in_arch && proc.is_combinational
+        proc.label == cs.in_process
+            && proc.in_arch == cs.in_arch
+            && proc.is_combinational
+            && !proc.in_synth_off_region
     })
 }
 
@@ -191,6 +194,47 @@ fn duplicate_package_in_library(input: &Input) -> Vec<Violation> {
     out
 }
 
+/// Names the symbol table saw declared in more than one file. Entities and
+/// packages have their own duplicate rules, and members of a duplicated
+/// package are reported through the package.
+fn duplicate_symbol(input: &Input) -> Vec<Violation> {
+    let duplicated: HashSet<&str> = input
+        .duplicate_symbols
+        .iter()
+        .map(|dup| dup.name.as_str())
+        .collect();
+    let mut out = Vec::new();
+    for dup in &input.duplicate_symbols {
+        if dup.kind == "entity" || dup.kind == "package" {
+            continue;
+        }
+        if let Some((parent, _)) = dup.name.rsplit_once('.') {
+            if duplicated.contains(parent) {
+                continue;
+            }
+        }
+        let Some(first) = dup.locations.first() else {
+            continue;
+        };
+        for loc in dup.locations.iter().skip(1) {
+            if helpers::is_third_party_file(input, &loc.file) {
+                continue;
+            }
+            out.push(Violation {
+                rule: "duplicate_symbol".to_string(),
+                severity: "error".to_string(),
+                file: loc.file.clone(),
+                line: loc.line,
+                message: format!(
+                    "{} '{}' is also declared at {}:{} - references resolve to only one of the declarations",
+                    dup.kind, dup.name, first.file, first.line
+                ),
+            });
+        }
+    }
+    out
+}
+
 fn file_library_map(input: &Input) -> HashMap<String, String> {
     let mut map = HashMap::new();
     for file in &input.files {
@@ -208,13 +252,6 @@ fn library_for_file(map: &HashMap<String, String>, file: &str) -> String {
     map.get(file).cloned().unwrap_or_else(|| "work".to_string())
 }
 
-fn entity_exists(input: &Input, name: &str) -> bool {
-    input
-        .entities
-        .iter()
-        .any(|entity| entity.name.eq_ignore_ascii_case(name))
-}
-
 fn component_or_entity_exists(input: &Input, comp: &Component) -> bool {
     let target = base_entity_name(&comp.entity_ref);
     input
@@ -247,8 +284,8 @@ fn has_architecture(input: &Input, entity_name: &str) -> bool {
 mod tests {
     use super::*;
     use crate::policy::input::{
-        Architecture, CaseStatement, Component, Dependency, Entity, FileInfo, Input, Package, Port,
-        Process,
+        CaseStatement, Component, Dependency, DuplicateSymbol, Entity, FileInfo, Input,
+        OrphanArchitecture, Package, Port, Process, SymbolLocation,
     };
 
     fn base_input() -> Input {
@@ -287,9 +324,10 @@ mod tests {
     #[test]
     fn orphan_architecture_flags_missing_entity() {
         let mut input = base_input();
-        input.architectures.push(Architecture {
-            name: "rtl".to_string(),
-            entity_name: "missing".to_string(),
+        input.orphan_architectures.push(OrphanArchitecture {
+            architecture: "rtl".to_string(),
+            entity: "missing".to_string(),
+            library: "work".to_string(),
             file: "a.vhd".to_string(),
             line: 2,
         });
@@ -465,4 +503,35 @@ mod tests {
         assert_eq!(violations.len(), 1);
         assert_eq!(violations[0].rule, "duplicate_package_in_library");
     }
+
+    #[test]
+    fn duplicate_symbol_skips_units_with_own_rules() {
+        let mut input = base_input();
+        let locations = vec![
+            SymbolLocation {
+                file: "a.vhd".to_string(),
+                line: 3,
+            },
+            SymbolLocation {
+                file: "b.vhd".to_string(),
+                line: 5,
+            },
+        ];
+        for (name, kind) in [
+            ("work.proj_ctx", "context"),
+            ("work.pkg", "package"),
+            ("work.pkg.width", "constant"),
+        ] {
+            input.duplicate_symbols.push(DuplicateSymbol {
+                name: name.to_string(),
+                kind: kind.to_string(),
+                locations: locations.clone(),
+            });
+        }
+        let violations = duplicate_symbol(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "duplicate_symbol");
+        assert_eq!(violations[0].file, "b.vhd");
+        assert!(violations[0].message.contains("work.proj_ctx"));
+    }
 }
@@ -597,4 +597,34 @@ mod tests {
         assert_eq!(result.violations.len(), 1);
         assert_eq!(result.violations[0].severity, "error");
     }
+
+    #[test]
+    fn filter_applies_rules_allowlist_then_skip() {
+        let violation = |rule: &str| Violation {
+            rule: rule.to_string(),
+            severity: "warning".to_string(),
+            file: "a.vhd".to_string(),
+            line: 1,
+            message: String::new(),
+        };
+        let mut input = Input::default();
+        input.lint_config.only = vec![
+            "unused_signal".to_string(),
+            "multiple_drivers".to_string(),
+            "latch_inference".to_string(),
+        ];
+        input.lint_config.skip = vec!["latch_inference".to_string()];
+        let out = filter_violations(
+            &input,
+            vec![
+                violation("unused_signal"),
+                violation("undriven_signal"),
+                violation("multiple_drivers"),
+                violation("latch_inference"),
+            ],
+        );
+        let rules: Vec<&str> = out.iter().map(|v| v.rule.as_str()).collect();
+        // multiple_drivers is optional but enabled by naming it in --rules
+        assert_eq!(rules, vec!["unused_signal", "multiple_drivers"]);
+    }
 }
@@ -1,6 +1,9 @@
 use crate::policy::input::Input;
 use crate::policy::result::Violation;
 
+/// State count above which a binary-encoded FSM is worth a second look.
+const LARGE_BINARY_FSM_STATES: usize = 8;
+
 pub fn violations(_input: &Input) -> Vec<Violation> {
     Vec::new()
 }
@@ -12,6 +15,10 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     out.extend(fsm_unreachable_state(input));
     out.extend(fsm_missing_default_state(input));
     out.extend(fsm_unhandled_state(input));
+    out.extend(fsm_dead_state(input));
+    out.extend(fsm_unassigned_state(input));
+    out.extend(fsm_encoding_conflict(input));
+    out.extend(fsm_large_binary_encoding(input));
     out
 }
 
@@ -146,6 +153,91 @@ fn fsm_unreachable_state(input: &Input) -> Vec<Violation> {
     out
 }
 
+/// Enum states the typed state machine analysis found never decoded by a
+/// case choice on a state signal of that type.
+fn fsm_dead_state(input: &Input) -> Vec<Violation> {
+    input
+        .fsm_issues
+        .iter()
+        .filter(|issue| issue.kind == "dead")
+        .map(|issue| Violation {
+            rule: "fsm_dead_state".to_string(),
+            severity: "warning".to_string(),
+            file: issue.file.clone(),
+            line: issue.line,
+            message: format!(
+                "FSM state '{}' of type '{}' is never decoded by a case on '{}' - dead state",
+                issue.state, issue.r#type, issue.signal
+            ),
+        })
+        .collect()
+}
+
+/// Enum states the typed state machine analysis found never assigned to any
+/// state signal of that type.
+fn fsm_unassigned_state(input: &Input) -> Vec<Violation> {
+    input
+        .fsm_issues
+        .iter()
+        .filter(|issue| issue.kind == "unreachable")
+        .map(|issue| Violation {
+            rule: "fsm_unassigned_state".to_string(),
+            severity: "warning".to_string(),
+            file: issue.file.clone(),
+            line: issue.line,
+            message: format!(
+                "FSM state '{}' of type '{}' is never assigned to '{}' - unreachable state",
+                issue.state, issue.r#type, issue.signal
+            ),
+        })
+        .collect()
+}
+
+fn fsm_encoding_conflict(input: &Input) -> Vec<Violation> {
+    let mut out = Vec::new();
+    for (i, enc) in input.fsm_encodings.iter().enumerate() {
+        let earlier = input.fsm_encodings[..i].iter().find(|other| {
+            other.file == enc.file
+                && other.in_arch == enc.in_arch
+                && other.in_package == enc.in_package
+                && other.type_name.eq_ignore_ascii_case(&enc.type_name)
+                && other.encoding != enc.encoding
+        });
+        if let Some(other) = earlier {
+            out.push(Violation {
+                rule: "fsm_encoding_conflict".to_string(),
+                severity: "warning".to_string(),
+                file: enc.file.clone(),
+                line: enc.line,
+                message: format!(
+                    "Type '{}' gets {} encoding from '{}' but {} encoding from '{}' on line {} - the synthesized encoding depends on the tool",
+                    enc.type_name, enc.encoding, enc.attribute, other.encoding, other.attribute, other.line
+                ),
+            });
+        }
+    }
+    out
+}
+
+fn fsm_large_binary_encoding(input: &Input) -> Vec<Violation> {
+    input
+        .fsm_encodings
+        .iter()
+        .filter(|enc| enc.encoding == "binary")
+        .filter(|enc| enc.state_count > LARGE_BINARY_FSM_STATES)
+        .map(|enc| Violation {
+            rule: "fsm_large_binary_encoding".to_string(),
+            severity: "info".to_string(),
+            file: enc.file.clone(),
+            line: enc.line,
+            message: format!(
+                "FSM type '{}' has {} states with binary encoding - one-hot usually decodes faster on FPGAs",
+                enc.type_name, enc.state_count
+            ),
+        })
+        .collect()
+}
+
 fn is_state_signal_name(name: &str) -> bool {
     let lower = name.to_ascii_lowercase();
     lower == "state"
@@ -211,7 +303,9 @@ fn state_ever_assigned(input: &Input, sig_name: &str, state_literal: &str) -> bo
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{CaseStatement, Input, Process, Signal, TypeDeclaration};
+    use crate::policy::input::{
+        CaseStatement, FSMEncoding, FSMIssue, Input, Process, Signal, TypeDeclaration,
+    };
 
     #[test]
     fn state_signal_not_enum_flags_vector() {
@@ -323,4 +417,79 @@ mod tests {
         let violations = fsm_unreachable_state(&input);
         assert!(violations.is_empty());
     }
+
+    #[test]
+    fn fsm_typed_state_rules_split_by_kind() {
+        let mut input = Input::default();
+        for (state, kind) in [("DONE", "unreachable"), ("WAIT", "dead")] {
+            input.fsm_issues.push(FSMIssue {
+                signal: "state".to_string(),
+                r#type: "state_t".to_string(),
+                state: state.to_string(),
+                kind: kind.to_string(),
+                file: "a.vhd".to_string(),
+                line: 6,
+                ..Default::default()
+            });
+        }
+        let dead = fsm_dead_state(&input);
+        assert_eq!(dead.len(), 1);
+        assert_eq!(dead[0].rule, "fsm_dead_state");
+        assert!(dead[0].message.contains("WAIT"));
+        let unassigned = fsm_unassigned_state(&input);
+        assert_eq!(unassigned.len(), 1);
+        assert_eq!(unassigned[0].rule, "fsm_unassigned_state");
+        assert!(unassigned[0].message.contains("DONE"));
+    }
+
+    fn encoding(type_name: &str, attribute: &str, encoding: &str, states: usize) -> FSMEncoding {
+        FSMEncoding {
+            type_name: type_name.to_string(),
+            attribute: attribute.to_string(),
+            encoding: encoding.to_string(),
+            state_count: states,
+            file: "a.vhd".to_string(),
+            line: 7,
+            in_arch: "rtl".to_string(),
+            ..Default::default()
+        }
+    }
+
+    #[test]
+    fn fsm_encoding_conflict_flags_differing_attributes() {
+        let mut input = Input::default();
+        input
+            .fsm_encodings
+            .push(encoding("state_t", "fsm_encoding", "one-hot", 4));
+        input
+            .fsm_encodings
+            .push(encoding("STATE_T", "enum_encoding", "gray", 4));
+        input
+            .fsm_encodings
+            .push(encoding("mode_t", "fsm_encoding", "gray", 4));
+        input
+            .fsm_encodings
+            .push(encoding("mode_t", "syn_encoding", "gray", 4));
+        let violations = fsm_encoding_conflict(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "fsm_encoding_conflict");
+        assert!(violations[0].message.contains("enum_encoding"));
+    }
+
+    #[test]
+    fn fsm_large_binary_encoding_ignores_small_fsms() {
+        let mut input = Input::default();
+        input
+            .fsm_encodings
+            .push(encoding("big_t", "fsm_encoding", "binary", 12));
+        input
+            .fsm_encodings
+            .push(encoding("small_t", "fsm_encoding", "binary", 4));
+        input
+            .fsm_encodings
+            .push(encoding("hot_t", "fsm_encoding", "one-hot", 12));
+        let violations = fsm_large_binary_encoding(&input);
+        assert_eq!(violations.len(), 1);
+        assert!(violations[0].message.contains("big_t"));
+    }
 }
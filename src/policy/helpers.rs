@@ -85,12 +85,14 @@ pub fn is_shared_variable(input: &Input, name: &str) -> bool {
         .any(|v| v.eq_ignore_ascii_case(name))
 }
 
+/// Standard types that resolve multiple drivers: std_logic and the
+/// std_logic_1164/numeric_std arrays of it. The type mark must match exactly,
+/// so unresolved counterparts (std_ulogic_vector, u_unsigned) do not.
 pub fn is_resolved_type(type_str: &str) -> bool {
-    let lower = type_str.to_ascii_lowercase();
-    lower.contains("std_logic")
-        || lower.contains("std_logic_vector")
-        || lower.contains("signed")
-        || lower.contains("unsigned")
+    matches!(
+        base_type_name(type_str).as_str(),
+        "std_logic" | "std_logic_vector" | "signed" | "unsigned"
+    )
 }
 
 pub fn is_resolved_signal(input: &Input, name: &str) -> bool {
@@ -150,6 +152,47 @@ pub fn file_has_use_clause(input: &Input, file: &str) -> bool {
         .any(|dep| dep.kind == "use" && dep.source == file)
 }
 
+// Whether a "use lib.pkg.all" clause makes a package member named `name`
+// visible in `file`.
+pub fn is_imported_name(input: &Input, file: &str, name: &str) -> bool {
+    input
+        .imported_names
+        .iter()
+        .any(|imp| imp.file == file && imp.name.eq_ignore_ascii_case(name))
+}
+
+/// Attributes that tell synthesis to preserve a signal even without loads.
+const KEEP_ATTRIBUTES: &[&str] = &[
+    "keep",
+    "dont_touch",
+    "mark_debug",
+    "preserve",
+    "noprune",
+    "syn_keep",
+    "syn_preserve",
+    "syn_noprune",
+];
+
+/// Attribute values compare case-insensitively, without string quotes.
+pub fn attribute_value(value: &str) -> String {
+    value.trim().trim_matches('"').trim().to_ascii_lowercase()
+}
+
+/// True when a keep-style attribute (keep, dont_touch, ...) with a value
+/// other than false applies to signal `name` in `file`, by name or via an
+/// "others"/"all" signal specification.
+pub fn has_keep_attribute(input: &Input, file: &str, name: &str) -> bool {
+    input.attribute_specs.iter().any(|spec| {
+        spec.file == file
+            && spec.target_kind == "signal"
+            && KEEP_ATTRIBUTES.contains(&spec.attribute.to_ascii_lowercase().as_str())
+            && attribute_value(&spec.value) != "false"
+            && (spec.target.eq_ignore_ascii_case(name)
+                || spec.target.eq_ignore_ascii_case("others")
+                || spec.target.eq_ignore_ascii_case("all"))
+    })
+}
+
 pub fn base_type_name(t: &str) -> String {
     let trimmed = t.trim().to_ascii_lowercase();
     let head = trimmed.split_whitespace().next().unwrap_or("");
@@ -486,10 +529,21 @@ pub fn is_actual_signal(input: &Input, name: &str) -> bool {
 }
 
 pub fn rule_is_disabled(input: &Input, rule: &str) -> bool {
-    if matches!(input.lint_config.rules.get(rule), Some(val) if val == "off") {
+    let config = &input.lint_config;
+    // --rules allowlist, then --skip-rules denylist
+    if !config.only.is_empty() && !config.only.iter().any(|r| r == rule) {
+        return true;
+    }
+    if config.skip.iter().any(|r| r == rule) {
+        return true;
+    }
+    if matches!(config.rules.get(rule), Some(val) if val == "off") {
         return true;
     }
-    is_optional_rule(rule) && !input.lint_config.rules.contains_key(rule)
+    // Naming an optional rule in --rules enables it
+    is_optional_rule(rule)
+        && !config.rules.contains_key(rule)
+        && !config.only.iter().any(|r| r == rule)
 }
 
 pub fn get_rule_severity(input: &Input, rule: &str) -> Option<String> {
@@ -519,6 +573,9 @@ pub fn is_optional_rule(rule: &str) -> bool {
             | "active_low_naming"
             | "async_reset_active_high"
             | "missing_reset"
+            | "gated_clock"
+            | "uninitialized_read"
+            | "width_truncation"
             | "instance_naming_convention"
             | "positional_mapping"
             | "process_label_missing"
@@ -529,14 +586,25 @@ pub fn is_optional_rule(rule: &str) -> bool {
             | "large_entity"
             | "wide_signal"
             | "duplicate_signal_name"
+            | "multiple_drivers"
+            | "write_only_signal"
+            | "internal_tristate"
+            | "reset_domain_crossing"
             | "single_state_signal"
             | "fsm_unreachable_state"
+            | "fsm_unassigned_state"
+            | "fsm_dead_state"
+            | "fsm_encoding_conflict"
+            | "fsm_large_binary_encoding"
             | "state_signal_not_enum"
             | "fsm_missing_default_state"
             | "fsm_unhandled_state"
             | "large_combinational_process"
             | "vhdl2008_sensitivity_all"
             | "long_sensitivity_list"
+            | "parallel_if_chain"
+            | "port_feedthrough"
+            | "reset_default_mismatch"
             | "combinational_feedback"
             | "empty_sensitivity_combinational"
             | "direct_combinational_loop"
@@ -550,6 +618,7 @@ pub fn is_optional_rule(rule: &str) -> bool {
             | "missing_clock_sensitivity"
             | "very_wide_register"
             | "mixed_edge_clocking"
+            | "mixed_sensitivity"
             | "async_reset_naming"
             | "sparse_port_map"
             | "empty_port_map"
@@ -635,6 +704,10 @@ pub fn is_optional_rule(rule: &str) -> bool {
             | "gated_clock_detection"
             | "signal_crosses_clock_domain"
             | "port_width_mismatch"
+            | "component_port_mismatch"
+            | "standard_name_shadowing"
+            | "suspicious_clock"
+            | "sensitivity_list_smell"
             | "input_port_driven"
             | "procedure_param_invalid_mode"
             | "function_param_invalid_mode"
@@ -8,6 +8,8 @@ pub fn violations(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     out.extend(floating_instance_input(input));
     out.extend(port_width_mismatch(input));
+    out.extend(unused_component_declaration(input));
+    out.extend(unbound_generic(input));
     out
 }
 
@@ -20,9 +22,44 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     out.extend(many_instances(input));
     out.extend(hardcoded_port_value(input));
     out.extend(open_port_connection(input));
+    out.extend(component_port_mismatch(input));
     out
 }
 
+fn unused_component_declaration(input: &Input) -> Vec<Violation> {
+    input
+        .unused_component_declarations
+        .iter()
+        .map(|uc| Violation {
+            rule: "unused_component_declaration".to_string(),
+            severity: "info".to_string(),
+            file: uc.file.clone(),
+            line: uc.line,
+            message: format!(
+                "Component '{}' is declared in {} '{}' but never instantiated",
+                uc.component, uc.scope_kind, uc.scope
+            ),
+        })
+        .collect()
+}
+
+fn unbound_generic(input: &Input) -> Vec<Violation> {
+    input
+        .unbound_generics
+        .iter()
+        .map(|ug| Violation {
+            rule: "unbound_generic".to_string(),
+            severity: "error".to_string(),
+            file: ug.file.clone(),
+            line: ug.line,
+            message: format!(
+                "Instance '{}' leaves generic '{}' of '{}' unbound and it has no default",
+                ug.instance, ug.generic, ug.unit
+            ),
+        })
+        .collect()
+}
+
 fn sparse_port_map(input: &Input) -> Vec<Violation> {
     input
         .instances
@@ -200,6 +237,43 @@ fn open_port_connection(input: &Input) -> Vec<Violation> {
     out
 }
 
+fn component_port_mismatch(input: &Input) -> Vec<Violation> {
+    input
+        .component_mismatches
+        .iter()
+        .map(|cm| {
+            let detail = match cm.kind.as_str() {
+                "missing_in_entity" => format!(
+                    "port '{}' is not declared on entity '{}'",
+                    cm.port, cm.entity
+                ),
+                "missing_in_component" => format!(
+                    "entity '{}' port '{}' ({}) is missing from the component",
+                    cm.entity, cm.port, cm.entity_value
+                ),
+                "width" => format!(
+                    "port '{}' is {} bits on the component but {} bits on the entity",
+                    cm.port, cm.component_value, cm.entity_value
+                ),
+                _ => format!(
+                    "port '{}' is '{}' on the component but '{}' on the entity",
+                    cm.port, cm.component_value, cm.entity_value
+                ),
+            };
+            Violation {
+                rule: "component_port_mismatch".to_string(),
+                severity: "error".to_string(),
+                file: cm.file.clone(),
+                line: cm.line,
+                message: format!(
+                    "Component '{}' differs from its entity ({}:{}): {}",
+                    cm.component, cm.entity_file, cm.entity_line, detail
+                ),
+            }
+        })
+        .collect()
+}
+
 fn floating_instance_input(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     for inst in &input.instances {
@@ -463,7 +537,10 @@ fn indexed_width(actual: &str, base_width: usize) -> Option<usize> {
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{Association, Entity, Input, Instance, Port, Signal};
+    use crate::policy::input::{
+        Association, ComponentMismatch, Entity, Input, Instance, Port, Signal, UnboundGeneric,
+        UnusedComponentDeclaration,
+    };
 
     #[test]
     fn sparse_port_map_flags() {
@@ -478,6 +555,24 @@ mod tests {
         assert_eq!(v[0].rule, "sparse_port_map");
     }
 
+    #[test]
+    fn unbound_generic_flags() {
+        let mut input = Input::default();
+        input.unbound_generics.push(UnboundGeneric {
+            instance: "u_fifo".to_string(),
+            generic: "DEPTH".to_string(),
+            unit: "fifo".to_string(),
+            file: "a.vhd".to_string(),
+            line: 12,
+            ..Default::default()
+        });
+        let v = unbound_generic(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "unbound_generic");
+        assert_eq!(v[0].severity, "error");
+        assert_eq!(v[0].line, 12);
+    }
+
     #[test]
     fn floating_instance_input_flags() {
         let mut input = Input::default();
@@ -593,4 +688,45 @@ mod tests {
         let v = port_width_mismatch(&input);
         assert!(v.is_empty());
     }
+
+    #[test]
+    fn unused_component_declaration_flags() {
+        let mut input = Input::default();
+        input
+            .unused_component_declarations
+            .push(UnusedComponentDeclaration {
+                component: "old_fifo".to_string(),
+                scope: "rtl".to_string(),
+                scope_kind: "architecture".to_string(),
+                file: "top.vhd".to_string(),
+                line: 14,
+            });
+        let v = unused_component_declaration(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "unused_component_declaration");
+        assert_eq!(v[0].severity, "info");
+    }
+
+    #[test]
+    fn component_port_mismatch_cites_both_declarations() {
+        let mut input = Input::default();
+        input.component_mismatches.push(ComponentMismatch {
+            component: "fifo".to_string(),
+            entity: "fifo".to_string(),
+            port: "full_o".to_string(),
+            kind: "direction".to_string(),
+            component_value: "in".to_string(),
+            entity_value: "out".to_string(),
+            file: "top.vhd".to_string(),
+            line: 12,
+            entity_file: "fifo.vhd".to_string(),
+            entity_line: 4,
+        });
+        let v = component_port_mismatch(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "component_port_mismatch");
+        assert_eq!(v[0].line, 12);
+        assert!(v[0].message.contains("fifo.vhd:4"));
+        assert!(v[0].message.contains("'in' on the component but 'out'"));
+    }
 }
@@ -83,6 +83,98 @@ pub struct Input {
     #[serde(default)]
     pub signal_usages: Vec<SignalUsage>,
     #[serde(default)]
+    pub overlapping_choices: Vec<OverlappingChoice>,
+    #[serde(default)]
+    pub inconsistent_reset_polarities: Vec<InconsistentResetPolarity>,
+    #[serde(default)]
+    pub constants_in_sensitivity_list: Vec<ConstantInSensitivityList>,
+    #[serde(default)]
+    pub magic_numbers: Vec<MagicNumber>,
+    #[serde(default)]
+    pub ports_shadowed_by_signal: Vec<PortShadowedBySignal>,
+    #[serde(default)]
+    pub clock_level_conditions: Vec<ClockLevelCondition>,
+    #[serde(default)]
+    pub no_effect_processes: Vec<NoEffectProcess>,
+    #[serde(default)]
+    pub combinational_multi_drivers: Vec<CombinationalMultiDriver>,
+    #[serde(default)]
+    pub mixed_slice_directions: Vec<MixedSliceDirection>,
+    #[serde(default)]
+    pub unused_component_declarations: Vec<UnusedComponentDeclaration>,
+    #[serde(default)]
+    pub permanent_high_z: Vec<PermanentHighZ>,
+    #[serde(default)]
+    pub assertions: Vec<Assertion>,
+    #[serde(default)]
+    pub aliases: Vec<AliasDeclaration>,
+    #[serde(default)]
+    pub combinational_loops: Vec<CombinationalLoop>,
+    #[serde(default)]
+    pub multi_drivers: Vec<MultiDriver>,
+    #[serde(default)]
+    pub unbound_generics: Vec<UnboundGeneric>,
+    #[serde(default)]
+    pub unused_signals: Vec<UnusedSignal>,
+    #[serde(default)]
+    pub width_mismatches: Vec<WidthMismatch>,
+    #[serde(default)]
+    pub clock_misuses: Vec<ClockMisuse>,
+    #[serde(default)]
+    pub reset_crossings: Vec<ResetCrossing>,
+    #[serde(default)]
+    pub conditional_latches: Vec<LatchInference>,
+    #[serde(default)]
+    pub imported_names: Vec<ImportedName>,
+    #[serde(default)]
+    pub loops: Vec<LoopStatement>,
+    #[serde(default)]
+    pub reads_before_write: Vec<ReadBeforeWrite>,
+    #[serde(default)]
+    pub attribute_specs: Vec<AttributeSpec>,
+    #[serde(default)]
+    pub tristate_assignments: Vec<TristateAssignment>,
+    #[serde(default)]
+    pub fsm_issues: Vec<FSMIssue>,
+    #[serde(default)]
+    pub gated_clocks: Vec<GatedClock>,
+    #[serde(default)]
+    pub uninitialized_reads: Vec<UninitRead>,
+    #[serde(default)]
+    pub blocks: Vec<BlockStatement>,
+    #[serde(default)]
+    pub orphan_architectures: Vec<OrphanArchitecture>,
+    #[serde(default)]
+    pub duplicate_symbols: Vec<DuplicateSymbol>,
+    #[serde(default)]
+    pub width_truncations: Vec<WidthTruncation>,
+    #[serde(default)]
+    pub case_choice_conflicts: Vec<CaseChoiceConflict>,
+    #[serde(default)]
+    pub feedthroughs: Vec<Feedthrough>,
+    #[serde(default)]
+    pub reset_default_mismatches: Vec<ResetDefaultMismatch>,
+    #[serde(default)]
+    pub mixed_sensitivity_issues: Vec<MixedSensitivity>,
+    #[serde(default)]
+    pub fsm_encodings: Vec<FSMEncoding>,
+    #[serde(default)]
+    pub component_mismatches: Vec<ComponentMismatch>,
+    #[serde(default)]
+    pub standard_shadowings: Vec<StandardShadowing>,
+    #[serde(default)]
+    pub suspicious_clocks: Vec<SuspiciousClock>,
+    #[serde(default)]
+    pub unprotected_shared_variables: Vec<UnprotectedSharedVariable>,
+    #[serde(default)]
+    pub unsafe_arithmetic: Vec<UnsafeArithmetic>,
+    #[serde(default)]
+    pub instance_generates: Vec<InstanceGenerate>,
+    #[serde(default)]
+    pub sensitivity_list_smells: Vec<SensitivityListSmell>,
+    #[serde(default)]
+    pub incomplete_record_aggregates: Vec<IncompleteRecordAggregate>,
+    #[serde(default)]
     pub lint_config: LintConfig,
     #[serde(default)]
     pub third_party_files: Vec<String>,
@@ -92,6 +184,12 @@ pub struct Input {
 pub struct LintConfig {
     #[serde(default)]
     pub rules: HashMap<String, String>,
+    #[serde(default)]
+    pub only: Vec<String>,
+    #[serde(default)]
+    pub skip: Vec<String>,
+    #[serde(default)]
+    pub trojan_literal_bits: usize,
 }
 
 #[derive(Debug, Clone, Deserialize, Default)]
@@ -162,6 +260,12 @@ pub struct Signal {
     pub in_entity: String,
     #[serde(default)]
     pub width: usize,
+    #[serde(default)]
+    pub in_synth_off_region: bool,
+    #[serde(default)]
+    pub doc: String,
+    #[serde(default)]
+    pub default: String,
 }
 
 #[derive(Debug, Clone, Deserialize, Default)]
@@ -180,6 +284,8 @@ pub struct Port {
     pub in_entity: String,
     #[serde(default)]
     pub width: usize,
+    #[serde(default)]
+    pub doc: String,
 }
 
 #[derive(Debug, Clone, Deserialize, Default)]
@@ -266,6 +372,8 @@ pub struct FileInfo {
     pub library: String,
     #[serde(default)]
     pub is_third_party: bool,
+    #[serde(default)]
+    pub rule_overrides: HashMap<String, String>,
 }
 
 #[derive(Debug, Clone, Deserialize, Default)]
@@ -367,6 +475,8 @@ pub struct Instance {
     #[serde(default)]
     pub target: String,
     #[serde(default)]
+    pub raw_target: String,
+    #[serde(default)]
     pub port_map: HashMap<String, String>,
     #[serde(default)]
     pub generic_map: HashMap<String, String>,
@@ -440,6 +550,12 @@ pub struct ConcurrentAssignment {
     pub in_generate: bool,
     #[serde(default)]
     pub generate_label: String,
+    #[serde(default)]
+    pub in_synth_off_region: bool,
+    #[serde(default)]
+    pub choices: Vec<String>,
+    #[serde(default)]
+    pub has_others: bool,
 }
 
 #[derive(Debug, Clone, Deserialize, Default)]
@@ -535,57 +651,125 @@ pub struct CDCCrossing {
 }
 
 #[derive(Debug, Clone, Deserialize, Default)]
-pub struct SignalUsage {
+pub struct OverlappingChoice {
     #[serde(default)]
-    pub signal: String,
+    pub kind: String,
     #[serde(default)]
-    pub is_read: bool,
+    pub expression: String,
     #[serde(default)]
-    pub is_written: bool,
+    pub first: String,
+    #[serde(default)]
+    pub second: String,
+    #[serde(default)]
+    pub is_duplicate: bool,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
     #[serde(default)]
     pub in_process: String,
     #[serde(default)]
-    pub in_port_map: bool,
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct ConstantInSensitivityList {
     #[serde(default)]
-    pub instance_name: String,
+    pub name: String,
     #[serde(default)]
-    pub in_psl: bool,
+    pub kind: String,
+    #[serde(default)]
+    pub decl_line: usize,
+    #[serde(default)]
+    pub process: String,
+    #[serde(default)]
+    pub file: String,
     #[serde(default)]
     pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
 }
 
 #[derive(Debug, Clone, Deserialize, Default)]
-pub struct Process {
+pub struct MagicNumber {
     #[serde(default)]
-    pub label: String,
+    pub value: String,
     #[serde(default)]
-    pub sensitivity_list: Vec<String>,
+    pub numeric: i64,
     #[serde(default)]
-    pub is_sequential: bool,
+    pub context: String,
     #[serde(default)]
-    pub is_combinational: bool,
+    pub file: String,
     #[serde(default)]
-    pub clock_signal: String,
+    pub line: usize,
     #[serde(default)]
-    pub clock_edge: String,
+    pub in_process: String,
     #[serde(default)]
-    pub has_reset: bool,
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct PortShadowedBySignal {
     #[serde(default)]
-    pub reset_signal: String,
+    pub signal: String,
     #[serde(default)]
-    pub reset_async: bool,
+    pub port: String,
     #[serde(default)]
-    pub assigned_signals: Vec<String>,
+    pub entity: String,
+    #[serde(default)]
+    pub architecture: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub port_file: String,
+    #[serde(default)]
+    pub port_line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct ClockLevelCondition {
+    #[serde(default)]
+    pub target: String,
+    #[serde(default)]
+    pub clock: String,
+    #[serde(default)]
+    pub level: String,
+    #[serde(default)]
+    pub condition: String,
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct NoEffectProcess {
+    #[serde(default)]
+    pub process: String,
     #[serde(default)]
     pub read_signals: Vec<String>,
     #[serde(default)]
-    pub variables: Vec<VariableDecl>,
+    pub file: String,
     #[serde(default)]
-    pub procedure_calls: Vec<ProcedureCall>,
+    pub line: usize,
     #[serde(default)]
-    pub function_calls: Vec<FunctionCall>,
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct CombinationalMultiDriver {
     #[serde(default)]
-    pub wait_statements: Vec<WaitStatement>,
+    pub signal: String,
+    #[serde(default)]
+    pub processes: Vec<String>,
+    #[serde(default)]
+    pub lines: Vec<usize>,
     #[serde(default)]
     pub file: String,
     #[serde(default)]
@@ -595,13 +779,785 @@ pub struct Process {
 }
 
 #[derive(Debug, Clone, Deserialize, Default)]
-pub struct VariableDecl {
+pub struct MixedSliceDirection {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub architecture: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub to_expr: String,
+    #[serde(default)]
+    pub to_line: usize,
+    #[serde(default)]
+    pub downto_expr: String,
+    #[serde(default)]
+    pub downto_line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct UnusedComponentDeclaration {
+    #[serde(default)]
+    pub component: String,
+    #[serde(default)]
+    pub scope: String,
+    #[serde(default)]
+    pub scope_kind: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct PermanentHighZ {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub assignments: usize,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct Assertion {
+    #[serde(default)]
+    pub condition: String,
+    #[serde(default)]
+    pub report_message: String,
+    #[serde(default)]
+    pub severity: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct AliasDeclaration {
     #[serde(default)]
     pub name: String,
     #[serde(default)]
+    pub target: String,
+    #[serde(default)]
+    pub base_name: String,
+    #[serde(default)]
     pub r#type: String,
     #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+    #[serde(default)]
+    pub in_package: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct CombinationalLoop {
+    #[serde(default)]
+    pub signals: Vec<String>,
+    #[serde(default)]
+    pub lines: Vec<usize>,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct MultiDriver {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub drivers: Vec<DriverLocation>,
+    #[serde(default)]
+    pub in_generate: bool,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct DriverLocation {
+    #[serde(default)]
+    pub process: String,
+    #[serde(default)]
     pub line: usize,
+    #[serde(default)]
+    pub in_generate: bool,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct UnboundGeneric {
+    #[serde(default)]
+    pub instance: String,
+    #[serde(default)]
+    pub generic: String,
+    #[serde(default)]
+    pub unit: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct UnusedSignal {
+    #[serde(default)]
+    pub name: String,
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_entity: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct WidthMismatch {
+    #[serde(default)]
+    pub instance: String,
+    #[serde(default)]
+    pub formal: String,
+    #[serde(default)]
+    pub actual: String,
+    #[serde(default)]
+    pub formal_width: usize,
+    #[serde(default)]
+    pub actual_width: usize,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct ClockMisuse {
+    #[serde(default)]
+    pub clock: String,
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub process: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct ResetCrossing {
+    #[serde(default)]
+    pub reset: String,
+    #[serde(default)]
+    pub domains: Vec<String>,
+    #[serde(default)]
+    pub unsynchronized: Vec<String>,
+    #[serde(default)]
+    pub synchronized: bool,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct LatchInference {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub process: String,
+    #[serde(default)]
+    pub missing_branch: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct ImportedName {
+    #[serde(default)]
+    pub name: String,
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub from_package: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct LoopStatement {
+    #[serde(default)]
+    pub loop_var: String,
+    #[serde(default)]
+    pub range_low: String,
+    #[serde(default)]
+    pub range_high: String,
+    #[serde(default)]
+    pub range_dir: String,
+    #[serde(default)]
+    pub iteration_count: i64,
+    #[serde(default)]
+    pub can_elaborate: bool,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct ReadBeforeWrite {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub process: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub read_line: usize,
+    #[serde(default)]
+    pub write_line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct AttributeSpec {
+    #[serde(default)]
+    pub attribute: String,
+    #[serde(default)]
+    pub target: String,
+    #[serde(default)]
+    pub target_kind: String,
+    #[serde(default)]
+    pub value: String,
+    #[serde(default)]
+    pub r#type: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct TristateAssignment {
+    #[serde(default)]
+    pub target: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+    #[serde(default)]
+    pub conditional: bool,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct FSMIssue {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub r#type: String,
+    #[serde(default)]
+    pub state: String,
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct GatedClock {
+    #[serde(default)]
+    pub clock: String,
+    #[serde(default)]
+    pub expression: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct UninitRead {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub r#type: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct BlockStatement {
+    #[serde(default)]
+    pub label: String,
+    #[serde(default)]
+    pub guard_expr: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct OrphanArchitecture {
+    #[serde(default)]
+    pub architecture: String,
+    #[serde(default)]
+    pub entity: String,
+    #[serde(default)]
+    pub library: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct DuplicateSymbol {
+    #[serde(default)]
+    pub name: String,
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub locations: Vec<SymbolLocation>,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct SymbolLocation {
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct WidthTruncation {
+    #[serde(default)]
+    pub target: String,
+    #[serde(default)]
+    pub target_width: usize,
+    #[serde(default)]
+    pub source: String,
+    #[serde(default)]
+    pub source_width: usize,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct CaseChoiceConflict {
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub expression: String,
+    #[serde(default)]
+    pub choices: Vec<String>,
+    #[serde(default)]
+    pub first: String,
+    #[serde(default)]
+    pub second: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct Feedthrough {
+    #[serde(default)]
+    pub input: String,
+    #[serde(default)]
+    pub output: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct ResetDefaultMismatch {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub default: String,
+    #[serde(default)]
+    pub reset: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct MixedSensitivity {
+    #[serde(default)]
+    pub process: String,
+    #[serde(default)]
+    pub clock: String,
+    #[serde(default)]
+    pub extra: Vec<String>,
+    #[serde(default)]
+    pub missing_clock: bool,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct FSMEncoding {
+    #[serde(default)]
+    pub type_name: String,
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub attribute: String,
+    #[serde(default)]
+    pub encoding: String,
+    #[serde(default)]
+    pub value: String,
+    #[serde(default)]
+    pub state_count: usize,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+    #[serde(default)]
+    pub in_package: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct ComponentMismatch {
+    #[serde(default)]
+    pub component: String,
+    #[serde(default)]
+    pub entity: String,
+    #[serde(default)]
+    pub port: String,
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub component_value: String,
+    #[serde(default)]
+    pub entity_value: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub entity_file: String,
+    #[serde(default)]
+    pub entity_line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct StandardShadowing {
+    #[serde(default)]
+    pub name: String,
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub package: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct SuspiciousClock {
+    #[serde(default)]
+    pub clock: String,
+    #[serde(default)]
+    pub kind: String,
+    #[serde(default)]
+    pub process: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct UnprotectedSharedVariable {
+    #[serde(default)]
+    pub name: String,
+    #[serde(default)]
+    pub r#type: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_package: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct UnsafeArithmetic {
+    #[serde(default)]
+    pub operator: String,
+    #[serde(default)]
+    pub operand: String,
+    #[serde(default)]
+    pub operand_type: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct InstanceGenerate {
+    #[serde(default)]
+    pub instance: String,
+    #[serde(default)]
+    pub entity: String,
+    #[serde(default)]
+    pub architecture: String,
+    #[serde(default)]
+    pub generate: String,
+    #[serde(default)]
+    pub generate_file: String,
+    #[serde(default)]
+    pub generate_line: usize,
+    #[serde(default)]
+    pub iteration_count: i64,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct SensitivityListSmell {
+    #[serde(default)]
+    pub process: String,
+    #[serde(default)]
+    pub duplicates: Vec<String>,
+    #[serde(default)]
+    pub self_references: Vec<String>,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct IncompleteRecordAggregate {
+    #[serde(default)]
+    pub target: String,
+    #[serde(default)]
+    pub r#type: String,
+    #[serde(default)]
+    pub missing: Vec<String>,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_arch: String,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct ResetPolarityUsage {
+    #[serde(default)]
+    pub process: String,
+    #[serde(default)]
+    pub polarity: String,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct InconsistentResetPolarity {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub expected_polarity: String,
+    #[serde(default)]
+    pub polarities: Vec<String>,
+    #[serde(default)]
+    pub usages: Vec<ResetPolarityUsage>,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct SignalUsage {
+    #[serde(default)]
+    pub signal: String,
+    #[serde(default)]
+    pub field_path: String,
+    #[serde(default)]
+    pub is_read: bool,
+    #[serde(default)]
+    pub is_written: bool,
+    #[serde(default)]
+    pub in_process: String,
+    #[serde(default)]
+    pub in_port_map: bool,
+    #[serde(default)]
+    pub instance_name: String,
+    #[serde(default)]
+    pub in_psl: bool,
+    #[serde(default)]
+    pub line: usize,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct Process {
+    #[serde(default)]
+    pub label: String,
+    #[serde(default)]
+    pub sensitivity_list: Vec<String>,
+    #[serde(default)]
+    pub is_sequential: bool,
+    #[serde(default)]
+    pub is_combinational: bool,
+    #[serde(default)]
+    pub is_stimulus: bool,
+    #[serde(default)]
+    pub clock_signal: String,
+    #[serde(default)]
+    pub clock_edge: String,
+    #[serde(default)]
+    pub has_reset: bool,
+    #[serde(default)]
+    pub reset_signal: String,
+    #[serde(default)]
+    pub reset_async: bool,
+    #[serde(default)]
+    pub reset_polarity: String,
+    #[serde(default)]
+    pub assigned_signals: Vec<String>,
+    #[serde(default)]
+    pub read_signals: Vec<String>,
+    #[serde(default)]
+    pub variables: Vec<VariableDecl>,
+    #[serde(default)]
+    pub procedure_calls: Vec<ProcedureCall>,
+    #[serde(default)]
+    pub function_calls: Vec<FunctionCall>,
+    #[serde(default)]
+    pub wait_statements: Vec<WaitStatement>,
+    #[serde(default)]
+    pub file: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub in_arch: String,
+    #[serde(default)]
+    pub in_synth_off_region: bool,
+}
+
+#[derive(Debug, Clone, Deserialize, Default)]
+pub struct VariableDecl {
+    #[serde(default)]
+    pub name: String,
+    #[serde(default)]
+    pub r#type: String,
+    #[serde(default)]
+    pub line: usize,
+    #[serde(default)]
+    pub default: String,
 }
 
 #[derive(Debug, Clone, Deserialize, Default)]
@@ -5,7 +5,9 @@ use crate::policy::result::Violation;
 pub fn violations(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     out.extend(incomplete_case_latch(input));
+    out.extend(conditional_latch(input));
     out.extend(enum_case_incomplete(input));
+    out.extend(overlapping_choice(input));
     out
 }
 
@@ -19,10 +21,39 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     out
 }
 
+fn overlapping_choice(input: &Input) -> Vec<Violation> {
+    input
+        .overlapping_choices
+        .iter()
+        .map(|oc| {
+            let what = if oc.is_duplicate {
+                "duplicates"
+            } else {
+                "overlaps"
+            };
+            let statement = if oc.kind == "selected" {
+                "selected assignment"
+            } else {
+                "case"
+            };
+            Violation {
+                rule: "overlapping_choice".to_string(),
+                severity: "error".to_string(),
+                file: oc.file.clone(),
+                line: oc.line,
+                message: format!(
+                    "Choice '{}' {} choice '{}' in {} on '{}' - choices must be mutually exclusive",
+                    oc.second, what, oc.first, statement, oc.expression
+                ),
+            }
+        })
+        .collect()
+}
+
 fn incomplete_case_latch(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     for cs in &input.case_statements {
-        if cs.has_others {
+        if cs.is_complete {
             continue;
         }
         if cs.in_process.is_empty() {
@@ -38,11 +69,12 @@ fn incomplete_case_latch(input: &Input) -> Vec<Violation> {
             });
             continue;
         }
-        if let Some(proc) = input
-            .processes
-            .iter()
-            .find(|p| p.file == cs.file && p.label == cs.in_process && p.is_combinational)
-        {
+        if let Some(proc) = input.processes.iter().find(|p| {
+            p.file == cs.file
+                && p.label == cs.in_process
+                && p.is_combinational
+                && !p.in_synth_off_region
+        }) {
             out.push(Violation {
                 rule: "incomplete_case_latch".to_string(),
                 severity: "warning".to_string(),
@@ -90,11 +122,12 @@ fn enum_case_incomplete(input: &Input) -> Vec<Violation> {
         if missing.is_empty() {
             continue;
         }
-        if let Some(_proc) = input
-            .processes
-            .iter()
-            .find(|p| p.file == cs.file && p.label == cs.in_process && p.is_combinational)
-        {
+        if let Some(_proc) = input.processes.iter().find(|p| {
+            p.file == cs.file
+                && p.label == cs.in_process
+                && p.is_combinational
+                && !p.in_synth_off_region
+        }) {
             missing.sort();
             out.push(Violation {
                 rule: "enum_case_incomplete".to_string(),
@@ -111,10 +144,28 @@ fn enum_case_incomplete(input: &Input) -> Vec<Violation> {
     out
 }
 
+fn conditional_latch(input: &Input) -> Vec<Violation> {
+    input
+        .conditional_latches
+        .iter()
+        .filter(|li| !helpers::file_in_testbench(input, &li.file))
+        .map(|li| Violation {
+            rule: "conditional_latch".to_string(),
+            severity: "warning".to_string(),
+            file: li.file.clone(),
+            line: li.line,
+            message: format!(
+                "Signal '{}' in combinational process '{}' is not assigned on the '{}' branch - will infer latch; assign it on every path or give it a default",
+                li.signal, li.process, li.missing_branch
+            ),
+        })
+        .collect()
+}
+
 fn combinational_incomplete_assignment(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     for proc in &input.processes {
-        if !proc.is_combinational {
+        if !proc.is_combinational || proc.in_synth_off_region {
             continue;
         }
         for assigned in &proc.assigned_signals {
@@ -155,7 +206,7 @@ fn conditional_assignment_check(input: &Input) -> Vec<Violation> {
     input
         .concurrent_assignments
         .iter()
-        .filter(|ca| ca.kind == "conditional")
+        .filter(|ca| ca.kind == "conditional" && !ca.in_synth_off_region)
         .map(|ca| Violation {
             rule: "conditional_assignment_review".to_string(),
             severity: "info".to_string(),
@@ -173,15 +224,16 @@ fn selected_assignment_check(input: &Input) -> Vec<Violation> {
     input
         .concurrent_assignments
         .iter()
-        .filter(|ca| ca.kind == "selected")
+        .filter(|ca| ca.kind == "selected" && !ca.has_others && !ca.in_synth_off_region)
         .map(|ca| Violation {
             rule: "selected_assignment_review".to_string(),
             severity: "info".to_string(),
             file: ca.file.clone(),
             line: ca.line,
             message: format!(
-                "Selected assignment to '{}' - verify 'when others' is present to avoid latch inference",
-                ca.target
+                "Selected assignment to '{}' has no 'when others' (choices: {}) - verify the choices cover every value of the selector",
+                ca.target,
+                ca.choices.join(", ")
             ),
         })
         .collect()
@@ -190,13 +242,13 @@ fn selected_assignment_check(input: &Input) -> Vec<Violation> {
 fn many_signals_no_default(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     for proc in &input.processes {
-        if !proc.is_combinational || proc.assigned_signals.len() <= 3 {
+        if !proc.is_combinational || proc.in_synth_off_region || proc.assigned_signals.len() <= 3 {
             continue;
         }
         let has_incomplete_case = input
             .case_statements
             .iter()
-            .any(|cs| cs.file == proc.file && cs.in_process == proc.label && !cs.has_others);
+            .any(|cs| cs.file == proc.file && cs.in_process == proc.label && !cs.is_complete);
         if !has_incomplete_case {
             continue;
         }
@@ -242,7 +294,28 @@ fn fsm_no_reset(input: &Input) -> Vec<Violation> {
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{CaseStatement, Input, Process, Signal, TypeDeclaration};
+    use crate::policy::input::{
+        CaseStatement, ConcurrentAssignment, Input, LatchInference, OverlappingChoice, Process,
+        Signal, TypeDeclaration,
+    };
+
+    #[test]
+    fn conditional_latch_names_missing_branch() {
+        let mut input = Input::default();
+        input.conditional_latches.push(LatchInference {
+            signal: "q".to_string(),
+            process: "gate_proc".to_string(),
+            missing_branch: "else".to_string(),
+            file: "a.vhd".to_string(),
+            line: 12,
+            ..Default::default()
+        });
+        let v = conditional_latch(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "conditional_latch");
+        assert_eq!(v[0].line, 12);
+        assert!(v[0].message.contains("'else'"));
+    }
 
     #[test]
     fn incomplete_case_latch_flags() {
@@ -266,6 +339,49 @@ mod tests {
         assert_eq!(v[0].rule, "incomplete_case_latch");
     }
 
+    #[test]
+    fn incomplete_case_latch_skips_translate_off() {
+        let mut input = Input::default();
+        input.processes.push(Process {
+            label: "sim_only".to_string(),
+            is_combinational: true,
+            file: "a.vhd".to_string(),
+            in_synth_off_region: true,
+            ..Default::default()
+        });
+        input.case_statements.push(CaseStatement {
+            expression: "sel".to_string(),
+            has_others: false,
+            file: "a.vhd".to_string(),
+            line: 10,
+            in_process: "sim_only".to_string(),
+            ..Default::default()
+        });
+        assert!(incomplete_case_latch(&input).is_empty());
+    }
+
+    #[test]
+    fn incomplete_case_latch_skips_complete_enum_case() {
+        let mut input = Input::default();
+        input.processes.push(Process {
+            label: "p1".to_string(),
+            is_combinational: true,
+            file: "a.vhd".to_string(),
+            ..Default::default()
+        });
+        input.case_statements.push(CaseStatement {
+            expression: "state".to_string(),
+            choices: vec!["IDLE".to_string(), "RUN".to_string()],
+            has_others: false,
+            is_complete: true,
+            file: "a.vhd".to_string(),
+            line: 10,
+            in_process: "p1".to_string(),
+            ..Default::default()
+        });
+        assert!(incomplete_case_latch(&input).is_empty());
+    }
+
     #[test]
     fn enum_case_incomplete_flags() {
         let mut input = Input::default();
@@ -299,4 +415,43 @@ mod tests {
         assert_eq!(v.len(), 1);
         assert_eq!(v[0].rule, "enum_case_incomplete");
     }
+
+    #[test]
+    fn overlapping_choice_flags() {
+        let mut input = Input::default();
+        input.overlapping_choices.push(OverlappingChoice {
+            kind: "case".to_string(),
+            expression: "idx".to_string(),
+            first: "0 to 3".to_string(),
+            second: "2".to_string(),
+            file: "a.vhd".to_string(),
+            line: 12,
+            ..Default::default()
+        });
+        let v = overlapping_choice(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "overlapping_choice");
+        assert_eq!(v[0].severity, "error");
+    }
+
+    #[test]
+    fn selected_assignment_without_others_flags() {
+        let mut input = Input::default();
+        for (target, has_others) in [("y", false), ("z", true)] {
+            input.concurrent_assignments.push(ConcurrentAssignment {
+                target: target.to_string(),
+                kind: "selected".to_string(),
+                choices: vec!["\"00\"".to_string(), "\"01\"".to_string()],
+                has_others,
+                file: "a.vhd".to_string(),
+                line: 7,
+                ..Default::default()
+            });
+        }
+        let v = selected_assignment_check(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "selected_assignment_review");
+        assert!(v[0].message.contains("'y'"));
+        assert!(v[0].message.contains("\"00\", \"01\""));
+    }
 }
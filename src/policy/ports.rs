@@ -8,12 +8,14 @@ pub fn violations(input: &Input) -> Vec<Violation> {
     out.extend(output_port_read(input));
     out.extend(inout_as_output(input));
     out.extend(inout_as_input(input));
+    out.extend(port_shadowed_by_signal(input));
     out
 }
 
 pub fn optional_violations(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     out.extend(unused_input_port(input));
+    out.extend(port_feedthrough(input));
     out
 }
 
@@ -121,6 +123,46 @@ fn inout_as_input(input: &Input) -> Vec<Violation> {
         .collect()
 }
 
+fn port_shadowed_by_signal(input: &Input) -> Vec<Violation> {
+    input
+        .ports_shadowed_by_signal
+        .iter()
+        .map(|shadow| Violation {
+            rule: "port_shadowed_by_signal".to_string(),
+            severity: "warning".to_string(),
+            file: shadow.file.clone(),
+            line: shadow.line,
+            message: format!(
+                "Signal '{}' (line {}) in architecture '{}' shadows port '{}' of entity '{}' ({}:{}) - the port is unreachable inside the architecture",
+                shadow.signal,
+                shadow.line,
+                shadow.architecture,
+                shadow.port,
+                shadow.entity,
+                shadow.port_file,
+                shadow.port_line
+            ),
+        })
+        .collect()
+}
+
+fn port_feedthrough(input: &Input) -> Vec<Violation> {
+    input
+        .feedthroughs
+        .iter()
+        .map(|ft| Violation {
+            rule: "port_feedthrough".to_string(),
+            severity: "info".to_string(),
+            file: ft.file.clone(),
+            line: ft.line,
+            message: format!(
+                "Output port '{}' is wired straight to input port '{}' with no logic - check that a register or gate is not missing",
+                ft.output, ft.input
+            ),
+        })
+        .collect()
+}
+
 fn port_is_read(input: &Input, port_name: &str) -> bool {
     let port_lower = port_name.to_ascii_lowercase();
     input.processes.iter().any(|proc| {
@@ -185,7 +227,10 @@ fn is_legacy_standard(input: &Input) -> bool {
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{Architecture, ConcurrentAssignment, Entity, Port, Process};
+    use crate::policy::input::{
+        Architecture, ConcurrentAssignment, Entity, Feedthrough, Port, PortShadowedBySignal,
+        Process,
+    };
 
     fn base_input() -> Input {
         Input {
@@ -319,4 +364,40 @@ mod tests {
         let violations = unused_input_port(&input);
         assert!(violations.is_empty());
     }
+
+    #[test]
+    fn port_shadowed_by_signal_warns() {
+        let mut input = base_input();
+        input.ports_shadowed_by_signal.push(PortShadowedBySignal {
+            signal: "data_o".to_string(),
+            port: "DATA_O".to_string(),
+            entity: "core".to_string(),
+            architecture: "rtl".to_string(),
+            file: "a.vhd".to_string(),
+            line: 12,
+            port_file: "a.vhd".to_string(),
+            port_line: 4,
+        });
+        let violations = port_shadowed_by_signal(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "port_shadowed_by_signal");
+        assert_eq!(violations[0].severity, "warning");
+    }
+
+    #[test]
+    fn port_feedthrough_reports_info() {
+        let mut input = base_input();
+        input.feedthroughs.push(Feedthrough {
+            input: "a_i".to_string(),
+            output: "y_o".to_string(),
+            file: "a.vhd".to_string(),
+            line: 9,
+            in_arch: "rtl".to_string(),
+        });
+        let violations = port_feedthrough(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "port_feedthrough");
+        assert_eq!(violations[0].severity, "info");
+        assert!(violations[0].message.contains("'y_o'"));
+    }
 }
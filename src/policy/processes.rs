@@ -1,3 +1,4 @@
+use crate::policy::helpers;
 use crate::policy::input::Input;
 use crate::policy::result::Violation;
 
@@ -5,6 +6,8 @@ pub fn violations(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     out.extend(complex_process(input));
     out.extend(comb_process_no_default(input));
+    out.extend(no_effect_process(input));
+    out.extend(read_before_write(input));
     out
 }
 
@@ -30,7 +33,7 @@ fn complex_process(input: &Input) -> Vec<Violation> {
 fn comb_process_no_default(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     for proc in &input.processes {
-        if !proc.is_combinational {
+        if !proc.is_combinational || proc.in_synth_off_region {
             continue;
         }
         if proc.assigned_signals.is_empty() {
@@ -39,7 +42,7 @@ fn comb_process_no_default(input: &Input) -> Vec<Violation> {
         let has_incomplete_case = input
             .case_statements
             .iter()
-            .any(|cs| cs.in_process == proc.label && !cs.has_others);
+            .any(|cs| cs.in_process == proc.label && !cs.is_complete);
         if has_incomplete_case {
             out.push(Violation {
                 rule: "comb_process_no_default".to_string(),
@@ -56,10 +59,52 @@ fn comb_process_no_default(input: &Input) -> Vec<Violation> {
     out
 }
 
+fn no_effect_process(input: &Input) -> Vec<Violation> {
+    input
+        .no_effect_processes
+        .iter()
+        .map(|proc| {
+            let name = if proc.process.is_empty() {
+                "<unlabeled>"
+            } else {
+                proc.process.as_str()
+            };
+            Violation {
+                rule: "no_effect_process".to_string(),
+                severity: "info".to_string(),
+                file: proc.file.clone(),
+                line: proc.line,
+                message: format!(
+                    "Process '{}' has no effect - it assigns no signals, calls no procedures, and makes no assertions",
+                    name
+                ),
+            }
+        })
+        .collect()
+}
+
+fn read_before_write(input: &Input) -> Vec<Violation> {
+    input
+        .reads_before_write
+        .iter()
+        .filter(|rw| !helpers::file_in_testbench(input, &rw.file))
+        .map(|rw| Violation {
+            rule: "read_before_write".to_string(),
+            severity: "warning".to_string(),
+            file: rw.file.clone(),
+            line: rw.read_line,
+            message: format!(
+                "Signal '{}' is read in combinational process '{}' before its only assignment on line {} - the read sees the previous value; move the assignment above the read",
+                rw.signal, rw.process, rw.write_line
+            ),
+        })
+        .collect()
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{CaseStatement, Input, Process};
+    use crate::policy::input::{CaseStatement, Input, NoEffectProcess, Process, ReadBeforeWrite};
 
     #[test]
     fn complex_process_flags_many_assigns() {
@@ -96,4 +141,38 @@ mod tests {
         assert_eq!(violations.len(), 1);
         assert_eq!(violations[0].rule, "comb_process_no_default");
     }
+
+    #[test]
+    fn no_effect_process_flags() {
+        let mut input = Input::default();
+        input.no_effect_processes.push(NoEffectProcess {
+            process: "dbg_p".to_string(),
+            read_signals: vec!["a".to_string()],
+            file: "a.vhd".to_string(),
+            line: 20,
+            ..Default::default()
+        });
+        let violations = no_effect_process(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "no_effect_process");
+        assert_eq!(violations[0].severity, "info");
+    }
+
+    #[test]
+    fn read_before_write_reports_read_line() {
+        let mut input = Input::default();
+        input.reads_before_write.push(ReadBeforeWrite {
+            signal: "tmp".to_string(),
+            process: "comb_p".to_string(),
+            file: "a.vhd".to_string(),
+            read_line: 12,
+            write_line: 14,
+            ..Default::default()
+        });
+        let violations = read_before_write(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "read_before_write");
+        assert_eq!(violations[0].line, 12);
+        assert!(violations[0].message.contains("line 14"));
+    }
 }
@@ -25,10 +25,12 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     out.extend(many_signals(input));
     out.extend(deep_generate_nesting(input));
     out.extend(magic_width_number(input));
+    out.extend(magic_number(input));
     out.extend(hardcoded_generic(input));
     out.extend(file_entity_mismatch(input));
     out.extend(duplicate_port_in_entity(input));
     out.extend(duplicate_entity_in_file(input));
+    out.extend(standard_name_shadowing(input));
     out
 }
 
@@ -421,6 +423,30 @@ fn magic_width_number(input: &Input) -> Vec<Violation> {
         .collect()
 }
 
+fn magic_number(input: &Input) -> Vec<Violation> {
+    input
+        .magic_numbers
+        .iter()
+        .map(|mn| {
+            let location = if mn.in_process.is_empty() {
+                "concurrent statement".to_string()
+            } else {
+                format!("process '{}'", mn.in_process)
+            };
+            Violation {
+                rule: "magic_number".to_string(),
+                severity: "info".to_string(),
+                file: mn.file.clone(),
+                line: mn.line,
+                message: format!(
+                    "Magic number {} in {} ({}) - consider using a named constant",
+                    mn.value, mn.context, location
+                ),
+            }
+        })
+        .collect()
+}
+
 fn duplicate_signal_in_entity(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     let mut seen = std::collections::HashMap::new();
@@ -501,6 +527,23 @@ fn duplicate_entity_in_file(input: &Input) -> Vec<Violation> {
     out
 }
 
+fn standard_name_shadowing(input: &Input) -> Vec<Violation> {
+    input
+        .standard_shadowings
+        .iter()
+        .map(|ss| Violation {
+            rule: "standard_name_shadowing".to_string(),
+            severity: "warning".to_string(),
+            file: ss.file.clone(),
+            line: ss.line,
+            message: format!(
+                "Package '{}' declares {} '{}', which shadows the standard name - overload resolution becomes ambiguous wherever both are visible",
+                ss.package, ss.kind, ss.name
+            ),
+        })
+        .collect()
+}
+
 fn hardcoded_generic(input: &Input) -> Vec<Violation> {
     let re = Regex::new(r"^[0-9]+$").unwrap();
     let mut out = Vec::new();
@@ -576,7 +619,9 @@ fn entity_file(input: &Input, port: &Port) -> Option<String> {
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{Entity, GenerateStatement, Input, Port, Signal};
+    use crate::policy::input::{
+        Entity, GenerateStatement, Input, MagicNumber, Port, Signal, StandardShadowing,
+    };
 
     #[test]
     fn very_long_file_flags() {
@@ -690,4 +735,38 @@ mod tests {
         assert_eq!(violations.len(), 1);
         assert_eq!(violations[0].rule, "unlabeled_generate");
     }
+
+    #[test]
+    fn magic_number_flags() {
+        let mut input = Input::default();
+        input.magic_numbers.push(MagicNumber {
+            value: "100".to_string(),
+            numeric: 100,
+            context: "comparison".to_string(),
+            file: "a.vhd".to_string(),
+            line: 7,
+            in_process: "p1".to_string(),
+            ..Default::default()
+        });
+        let violations = magic_number(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "magic_number");
+        assert_eq!(violations[0].severity, "info");
+    }
+
+    #[test]
+    fn standard_name_shadowing_flags() {
+        let mut input = Input::default();
+        input.standard_shadowings.push(StandardShadowing {
+            name: "std_logic".to_string(),
+            kind: "subtype".to_string(),
+            package: "my_types_pkg".to_string(),
+            file: "pkg.vhd".to_string(),
+            line: 3,
+        });
+        let violations = standard_name_shadowing(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "standard_name_shadowing");
+        assert!(violations[0].message.contains("subtype 'std_logic'"));
+    }
 }
@@ -14,6 +14,7 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     out.extend(async_reset_unsynchronized(input));
     out.extend(partial_reset_domain(input));
     out.extend(short_reset_sync(input));
+    out.extend(reset_domain_crossing(input));
     out
 }
 
@@ -216,10 +217,34 @@ fn has_second_stage(signals: &[String], first_stage: &str) -> bool {
     false
 }
 
+fn reset_domain_crossing(input: &Input) -> Vec<Violation> {
+    input
+        .reset_crossings
+        .iter()
+        .filter(|crossing| !crossing.synchronized)
+        .map(|crossing| Violation {
+            rule: "reset_domain_crossing".to_string(),
+            severity: "warning".to_string(),
+            file: crossing.file.clone(),
+            line: crossing.line,
+            message: format!(
+                "Async reset '{}' is released into clock domain(s) {} without a reset synchronizer - deassertion can violate recovery/removal timing",
+                crossing.reset,
+                crossing
+                    .unsynchronized
+                    .iter()
+                    .map(|clock| format!("'{}'", clock))
+                    .collect::<Vec<_>>()
+                    .join(", ")
+            ),
+        })
+        .collect()
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::Process;
+    use crate::policy::input::{Process, ResetCrossing};
 
     #[test]
     fn reset_crosses_domains_flags() {
@@ -246,4 +271,29 @@ mod tests {
         assert_eq!(v.len(), 1);
         assert_eq!(v[0].rule, "reset_crosses_domains");
     }
+
+    #[test]
+    fn reset_domain_crossing_skips_synchronized() {
+        let mut input = Input::default();
+        input.reset_crossings.push(ResetCrossing {
+            reset: "rst_n".to_string(),
+            domains: vec!["clk_a".to_string(), "clk_b".to_string()],
+            unsynchronized: vec!["clk_b".to_string()],
+            file: "a.vhd".to_string(),
+            line: 3,
+            ..Default::default()
+        });
+        input.reset_crossings.push(ResetCrossing {
+            reset: "rst_sys".to_string(),
+            domains: vec!["clk_a".to_string()],
+            synchronized: true,
+            file: "a.vhd".to_string(),
+            line: 9,
+            ..Default::default()
+        });
+        let v = reset_domain_crossing(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "reset_domain_crossing");
+        assert!(v[0].message.contains("'clk_b'"));
+    }
 }
@@ -18,11 +18,25 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     out
 }
 
+/// Literal width the large-literal rules report from when
+/// lint_config.trojan_literal_bits is unset.
+const DEFAULT_TROJAN_LITERAL_BITS: usize = 32;
+
+/// Literal width, in bits, from which a comparison counts as a large-literal
+/// trojan trigger (lint.trojanLiteralBits).
+fn trojan_literal_bits(input: &Input) -> usize {
+    match input.lint_config.trojan_literal_bits {
+        0 => DEFAULT_TROJAN_LITERAL_BITS,
+        bits => bits,
+    }
+}
+
 fn large_literal_comparison(input: &Input) -> Vec<Violation> {
+    let min_bits = trojan_literal_bits(input);
     input
         .comparisons
         .iter()
-        .filter(|comp| comp.is_literal && comp.literal_bits > 16)
+        .filter(|comp| comp.is_literal && comp.literal_bits >= min_bits)
         .map(|comp| Violation {
             rule: "large_literal_comparison".to_string(),
             severity: "warning".to_string(),
@@ -106,10 +120,11 @@ fn counter_trigger(input: &Input) -> Vec<Violation> {
 }
 
 fn inverted_trigger(input: &Input) -> Vec<Violation> {
+    let min_bits = trojan_literal_bits(input);
     input
         .comparisons
         .iter()
-        .filter(|comp| comp.is_literal && comp.literal_bits > 16 && comp.operator == "/=")
+        .filter(|comp| comp.is_literal && comp.literal_bits >= min_bits && comp.operator == "/=")
         .map(|comp| Violation {
             rule: "inverted_trigger".to_string(),
             severity: "warning".to_string(),
@@ -197,6 +212,29 @@ mod tests {
         assert_eq!(v[0].rule, "trigger_drives_output");
     }
 
+    #[test]
+    fn large_literal_comparison_uses_configured_width() {
+        let mut input = Input::default();
+        for (bits, line) in [(16, 1), (32, 2)] {
+            input.comparisons.push(Comparison {
+                is_literal: true,
+                literal_bits: bits,
+                operator: "/=".to_string(),
+                file: "a.vhd".to_string(),
+                line,
+                ..Default::default()
+            });
+        }
+        let v = large_literal_comparison(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].line, 2);
+        assert_eq!(inverted_trigger(&input).len(), 1);
+
+        input.lint_config.trojan_literal_bits = 16;
+        assert_eq!(large_literal_comparison(&input).len(), 2);
+        assert_eq!(inverted_trigger(&input).len(), 2);
+    }
+
     #[test]
     fn multi_trigger_process_flags() {
         let mut input = Input::default();
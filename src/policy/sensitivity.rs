@@ -8,7 +8,57 @@ pub fn violations(input: &Input) -> Vec<Violation> {
 }
 
 pub fn optional_violations(input: &Input) -> Vec<Violation> {
-    sensitivity_list_superfluous(input)
+    let mut out = sensitivity_list_superfluous(input);
+    out.extend(constant_in_sensitivity_list(input));
+    out.extend(sensitivity_list_smell(input));
+    out
+}
+
+fn sensitivity_list_smell(input: &Input) -> Vec<Violation> {
+    input
+        .sensitivity_list_smells
+        .iter()
+        .map(|smell| {
+            let mut issues = Vec::new();
+            if !smell.duplicates.is_empty() {
+                issues.push(format!("repeats '{}'", smell.duplicates.join("', '")));
+            }
+            if !smell.self_references.is_empty() {
+                issues.push(format!(
+                    "lists '{}', which it also assigns",
+                    smell.self_references.join("', '")
+                ));
+            }
+            Violation {
+                rule: "sensitivity_list_smell".to_string(),
+                severity: "info".to_string(),
+                file: smell.file.clone(),
+                line: smell.line,
+                message: format!(
+                    "Sensitivity list of process '{}' {}",
+                    smell.process,
+                    issues.join(" and ")
+                ),
+            }
+        })
+        .collect()
+}
+
+fn constant_in_sensitivity_list(input: &Input) -> Vec<Violation> {
+    input
+        .constants_in_sensitivity_list
+        .iter()
+        .map(|entry| Violation {
+            rule: "constant_in_sensitivity_list".to_string(),
+            severity: "info".to_string(),
+            file: entry.file.clone(),
+            line: entry.line,
+            message: format!(
+                "Process '{}' lists {} '{}' (declared line {}) in its sensitivity list - it never changes",
+                entry.process, entry.kind, entry.name, entry.decl_line
+            ),
+        })
+        .collect()
 }
 
 fn skip_sensitivity(input: &Input, proc_index: usize) -> bool {
@@ -96,7 +146,7 @@ fn sensitivity_list_superfluous(input: &Input) -> Vec<Violation> {
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{Input, Process};
+    use crate::policy::input::{ConstantInSensitivityList, Input, Process, SensitivityListSmell};
 
     #[test]
     fn sensitivity_list_incomplete_flags() {
@@ -136,4 +186,42 @@ mod tests {
         assert_eq!(v.len(), 1);
         assert_eq!(v[0].rule, "sensitivity_list_superfluous");
     }
+
+    #[test]
+    fn constant_in_sensitivity_list_flags() {
+        let mut input = Input::default();
+        input
+            .constants_in_sensitivity_list
+            .push(ConstantInSensitivityList {
+                name: "WIDTH".to_string(),
+                kind: "generic".to_string(),
+                decl_line: 3,
+                process: "p1".to_string(),
+                file: "a.vhd".to_string(),
+                line: 12,
+                ..Default::default()
+            });
+        let v = constant_in_sensitivity_list(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "constant_in_sensitivity_list");
+        assert_eq!(v[0].severity, "info");
+    }
+
+    #[test]
+    fn sensitivity_list_smell_describes_both_issues() {
+        let mut input = Input::default();
+        input.sensitivity_list_smells.push(SensitivityListSmell {
+            process: "p_comb".to_string(),
+            duplicates: vec!["a".to_string()],
+            self_references: vec!["y".to_string()],
+            file: "a.vhd".to_string(),
+            line: 4,
+            ..Default::default()
+        });
+        let v = sensitivity_list_smell(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "sensitivity_list_smell");
+        assert!(v[0].message.contains("repeats 'a'"));
+        assert!(v[0].message.contains("'y', which it also assigns"));
+    }
 }
@@ -15,6 +15,7 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     out.extend(very_wide_register(input));
     out.extend(mixed_edge_clocking(input));
     out.extend(async_reset_naming(input));
+    out.extend(mixed_sensitivity(input));
     out
 }
 
@@ -183,6 +184,34 @@ fn async_reset_naming(input: &Input) -> Vec<Violation> {
         .collect()
 }
 
+// A missing clock alone is left to missing_clock_sensitivity
+fn mixed_sensitivity(input: &Input) -> Vec<Violation> {
+    input
+        .mixed_sensitivity_issues
+        .iter()
+        .filter(|ms| !ms.extra.is_empty())
+        .map(|ms| {
+            let clock_note = if ms.missing_clock {
+                format!(", and clock '{}' is missing", ms.clock)
+            } else {
+                String::new()
+            };
+            Violation {
+                rule: "mixed_sensitivity".to_string(),
+                severity: "warning".to_string(),
+                file: ms.file.clone(),
+                line: ms.line,
+                message: format!(
+                    "Clocked process '{}' is also sensitive to data signal(s) '{}'{} - a clocked process should list only its clock and asynchronous reset",
+                    ms.process,
+                    ms.extra.join("', '"),
+                    clock_note
+                ),
+            }
+        })
+        .collect()
+}
+
 fn is_active_low_reset_name(name: &str) -> bool {
     let lower = name.to_ascii_lowercase();
     lower.ends_with("_n") || (lower.ends_with('n') && helpers::is_reset_name(name))
@@ -191,7 +220,7 @@ fn is_active_low_reset_name(name: &str) -> bool {
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::Process;
+    use crate::policy::input::{MixedSensitivity, Process};
 
     #[test]
     fn missing_clock_sensitivity_flags() {
@@ -250,4 +279,25 @@ mod tests {
         assert_eq!(v.len(), 1);
         assert_eq!(v[0].rule, "async_reset_naming");
     }
+
+    #[test]
+    fn mixed_sensitivity_names_data_signals() {
+        let mut input = Input::default();
+        for (process, extra) in [("p_mixed", vec!["d".to_string()]), ("p_no_clk", vec![])] {
+            input.mixed_sensitivity_issues.push(MixedSensitivity {
+                process: process.to_string(),
+                clock: "clk".to_string(),
+                extra,
+                missing_clock: process == "p_no_clk",
+                file: "a.vhd".to_string(),
+                line: 6,
+                ..Default::default()
+            });
+        }
+        let v = mixed_sensitivity(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "mixed_sensitivity");
+        assert!(v[0].message.contains("'p_mixed'"));
+        assert!(v[0].message.contains("'d'"));
+    }
 }
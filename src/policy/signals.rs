@@ -2,7 +2,7 @@ use regex::Regex;
 use std::collections::HashSet;
 
 use crate::policy::helpers;
-use crate::policy::input::{Architecture, Input, Process, Signal};
+use crate::policy::input::{Architecture, CombinationalMultiDriver, Input, Process, Signal};
 use crate::policy::result::Violation;
 
 pub fn violations(input: &Input) -> Vec<Violation> {
@@ -11,8 +11,14 @@ pub fn violations(input: &Input) -> Vec<Violation> {
     out.extend(unused_signal(input, &usage));
     out.extend(undriven_signal(input, &usage));
     out.extend(multi_driven_signal(input));
+    out.extend(combinational_multi_driver(input));
+    out.extend(mixed_slice_direction(input));
+    out.extend(permanent_high_z(input));
     out.extend(undeclared_signal_usage(input, &usage));
     out.extend(input_port_driven(input));
+    out.extend(uninitialized_read(input));
+    out.extend(width_truncation(input));
+    out.extend(unprotected_shared_variable(input));
     out
 }
 
@@ -20,6 +26,9 @@ pub fn optional_violations(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     out.extend(wide_signal(input));
     out.extend(duplicate_signal_name(input));
+    out.extend(multiple_drivers(input));
+    out.extend(write_only_signal(input));
+    out.extend(internal_tristate(input));
     out
 }
 
@@ -99,6 +108,9 @@ fn skip_undeclared_read(
     in_arch: &str,
     name: &str,
 ) -> bool {
+    if helpers::is_imported_name(input, file, name) {
+        return true;
+    }
     if helpers::single_file_mode(input) && helpers::arch_missing_entity_for_context(input, in_arch)
     {
         return true;
@@ -118,6 +130,7 @@ fn unused_signal(input: &Input, usage: &SignalUsageIndex) -> Vec<Violation> {
         .iter()
         .filter(|sig| !helpers::file_in_testbench(input, &sig.file))
         .filter(|sig| !usage.has_used(&sig.name))
+        .filter(|sig| !helpers::has_keep_attribute(input, &sig.file, &sig.name))
         .map(|sig| Violation {
             rule: "unused_signal".to_string(),
             severity: "warning".to_string(),
@@ -230,6 +243,23 @@ impl SignalUsageIndex {
 }
 
 fn multi_driven_signal(input: &Input) -> Vec<Violation> {
+    multi_driven_signals(input)
+        .into_iter()
+        .map(|(sig, drivers)| Violation {
+            rule: "multi_driven_signal".to_string(),
+            severity: "warning".to_string(),
+            file: sig.file.clone(),
+            line: sig.line,
+            message: format!(
+                "Signal '{}' is assigned in {} places (review for multi-driver)",
+                sig.name, drivers
+            ),
+        })
+        .collect()
+}
+
+/// Unresolved scalar signals with more than one driver, with their driver count.
+fn multi_driven_signals(input: &Input) -> Vec<(&Signal, usize)> {
     input
         .signals
         .iter()
@@ -237,26 +267,190 @@ fn multi_driven_signal(input: &Input) -> Vec<Violation> {
         .filter(|sig| !helpers::is_composite_type(input, &sig.r#type))
         .filter(|sig| !helpers::is_resolved_type(&sig.r#type))
         .filter(|sig| helpers::is_unresolved_scalar_type(&sig.r#type))
-        .filter_map(|sig| {
+        .map(|sig| {
             let drivers = count_drivers_in_entity(input, &sig.name, &sig.in_entity, &sig.file);
-            if drivers > 1 {
-                Some(Violation {
-                    rule: "multi_driven_signal".to_string(),
-                    severity: "warning".to_string(),
-                    file: sig.file.clone(),
-                    line: sig.line,
-                    message: format!(
-                        "Signal '{}' is assigned in {} places (review for multi-driver)",
-                        sig.name, drivers
-                    ),
+            (sig, drivers)
+        })
+        .filter(|(_, drivers)| *drivers > 1)
+        .collect()
+}
+
+/// (file, lowercase name) of every signal multi_driven_signal reports when it
+/// is enabled, so the other driver rules do not report the same net again.
+fn multi_driven_signal_keys(input: &Input) -> HashSet<(String, String)> {
+    if helpers::rule_is_disabled(input, "multi_driven_signal") {
+        return HashSet::new();
+    }
+    multi_driven_signals(input)
+        .into_iter()
+        .map(|(sig, _)| (sig.file.clone(), sig.name.to_ascii_lowercase()))
+        .collect()
+}
+
+/// The combinational multi-drivers combinational_multi_driver reports.
+fn combinational_multi_drivers(input: &Input) -> Vec<&CombinationalMultiDriver> {
+    let reported = multi_driven_signal_keys(input);
+    input
+        .combinational_multi_drivers
+        .iter()
+        .filter(|md| !reported.contains(&(md.file.clone(), md.signal.to_ascii_lowercase())))
+        .collect()
+}
+
+fn combinational_multi_driver(input: &Input) -> Vec<Violation> {
+    combinational_multi_drivers(input)
+        .into_iter()
+        .map(|md| {
+            let procs: Vec<String> = md
+                .processes
+                .iter()
+                .zip(md.lines.iter())
+                .map(|(label, line)| {
+                    if label.is_empty() {
+                        format!("<unlabeled>@{}", line)
+                    } else {
+                        format!("{}@{}", label, line)
+                    }
                 })
-            } else {
-                None
+                .collect();
+            Violation {
+                rule: "combinational_multi_driver".to_string(),
+                severity: "error".to_string(),
+                file: md.file.clone(),
+                line: md.line,
+                message: format!(
+                    "Signal '{}' is driven by {} combinational processes ({})",
+                    md.signal,
+                    md.processes.len(),
+                    procs.join(", ")
+                ),
+            }
+        })
+        .collect()
+}
+
+fn mixed_slice_direction(input: &Input) -> Vec<Violation> {
+    input
+        .mixed_slice_directions
+        .iter()
+        .map(|ms| Violation {
+            rule: "mixed_slice_direction".to_string(),
+            severity: "info".to_string(),
+            file: ms.file.clone(),
+            line: ms.line,
+            message: format!(
+                "Signal '{}' is sliced both ascending ('{}' at line {}) and descending ('{}' at line {})",
+                ms.signal, ms.to_expr, ms.to_line, ms.downto_expr, ms.downto_line
+            ),
+        })
+        .collect()
+}
+
+fn permanent_high_z(input: &Input) -> Vec<Violation> {
+    input
+        .permanent_high_z
+        .iter()
+        .map(|hz| Violation {
+            rule: "permanent_high_z".to_string(),
+            severity: "warning".to_string(),
+            file: hz.file.clone(),
+            line: hz.line,
+            message: format!(
+                "Signal '{}' is only ever assigned 'Z' ({} assignment(s)); it never carries a real value",
+                hz.signal, hz.assignments
+            ),
+        })
+        .collect()
+}
+
+fn internal_tristate(input: &Input) -> Vec<Violation> {
+    input
+        .tristate_assignments
+        .iter()
+        .filter(|ts| !helpers::file_in_testbench(input, &ts.file))
+        // Tri-states on ports map to I/O buffers; only internal signals are flagged
+        .filter(|ts| {
+            input
+                .signals
+                .iter()
+                .any(|sig| sig.file == ts.file && sig.name.eq_ignore_ascii_case(&ts.target))
+        })
+        .map(|ts| Violation {
+            rule: "internal_tristate".to_string(),
+            severity: "warning".to_string(),
+            file: ts.file.clone(),
+            line: ts.line,
+            message: format!(
+                "Internal signal '{}' is driven with 'Z' - most FPGAs have no internal tri-state buses; use a multiplexer instead",
+                ts.target
+            ),
+        })
+        .collect()
+}
+
+fn multiple_drivers(input: &Input) -> Vec<Violation> {
+    // Nets the default-on driver rules already report are not reported again
+    let mut reported = multi_driven_signal_keys(input);
+    if !helpers::rule_is_disabled(input, "combinational_multi_driver") {
+        reported.extend(
+            combinational_multi_drivers(input)
+                .into_iter()
+                .map(|md| (md.file.clone(), md.signal.to_ascii_lowercase())),
+        );
+    }
+    input
+        .multi_drivers
+        .iter()
+        // Drivers in different generate branches are legal when mutually exclusive
+        .filter(|md| !md.in_generate)
+        .filter(|md| !reported.contains(&(md.file.clone(), md.signal.to_ascii_lowercase())))
+        .map(|md| {
+            let drivers: Vec<String> = md
+                .drivers
+                .iter()
+                .map(|d| {
+                    if d.process.is_empty() {
+                        format!("line {}", d.line)
+                    } else {
+                        format!("{}@{}", d.process, d.line)
+                    }
+                })
+                .collect();
+            Violation {
+                rule: "multiple_drivers".to_string(),
+                severity: "warning".to_string(),
+                file: md.file.clone(),
+                line: md.line,
+                message: format!(
+                    "Unresolved signal '{}' has {} drivers ({})",
+                    md.signal,
+                    md.drivers.len(),
+                    drivers.join(", ")
+                ),
             }
         })
         .collect()
 }
 
+fn write_only_signal(input: &Input) -> Vec<Violation> {
+    input
+        .unused_signals
+        .iter()
+        // Dead signals are already reported by unused_signal
+        .filter(|us| us.kind == "write-only")
+        .filter(|us| !helpers::file_in_testbench(input, &us.file))
+        // Debug probes marked keep/dont_touch are write-only on purpose
+        .filter(|us| !helpers::has_keep_attribute(input, &us.file, &us.name))
+        .map(|us| Violation {
+            rule: "write_only_signal".to_string(),
+            severity: "warning".to_string(),
+            file: us.file.clone(),
+            line: us.line,
+            message: format!("Signal '{}' is assigned but never read", us.name),
+        })
+        .collect()
+}
+
 fn undeclared_signal_usage(input: &Input, usage: &SignalUsageIndex) -> Vec<Violation> {
     let mut out = Vec::new();
     for proc in &input.processes {
@@ -557,10 +751,76 @@ fn duplicate_signal_name(input: &Input) -> Vec<Violation> {
     out
 }
 
+fn uninitialized_read(input: &Input) -> Vec<Violation> {
+    input
+        .uninitialized_reads
+        .iter()
+        .map(|ur| {
+            let reader = if ur.in_process.is_empty() {
+                "a concurrent assignment".to_string()
+            } else {
+                format!("process '{}'", ur.in_process)
+            };
+            Violation {
+                rule: "uninitialized_read".to_string(),
+                severity: "warning".to_string(),
+                file: ur.file.clone(),
+                line: ur.line,
+                message: format!(
+                    "Signal '{}' ({}) has no initial value and is read by {} before any assignment - 'U' propagates until it is driven; add a default or reset",
+                    ur.signal, ur.r#type, reader
+                ),
+            }
+        })
+        .collect()
+}
+
+fn width_truncation(input: &Input) -> Vec<Violation> {
+    input
+        .width_truncations
+        .iter()
+        .map(|wt| Violation {
+            rule: "width_truncation".to_string(),
+            severity: "warning".to_string(),
+            file: wt.file.clone(),
+            line: wt.line,
+            message: format!(
+                "Assignment to '{}' ({} bits) from '{}' ({} bits) silently drops the upper {} bits - resize or slice the value explicitly",
+                wt.target,
+                wt.target_width,
+                wt.source,
+                wt.source_width,
+                wt.source_width - wt.target_width
+            ),
+        })
+        .collect()
+}
+
+fn unprotected_shared_variable(input: &Input) -> Vec<Violation> {
+    input
+        .unprotected_shared_variables
+        .iter()
+        .map(|sv| Violation {
+            rule: "unprotected_shared_variable".to_string(),
+            severity: "error".to_string(),
+            file: sv.file.clone(),
+            line: sv.line,
+            message: format!(
+                "Shared variable '{}' has type '{}', which is not a protected type - VHDL-{} only allows shared variables of protected types",
+                sv.name, sv.r#type, input.standard
+            ),
+        })
+        .collect()
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{Architecture, Entity, Input, Port, Process};
+    use crate::policy::input::{
+        Architecture, AttributeSpec, CombinationalMultiDriver, DriverLocation, Entity,
+        ImportedName, Input, MixedSliceDirection, MultiDriver, PermanentHighZ, Port, Process,
+        TristateAssignment, UninitRead, UnprotectedSharedVariable, UnusedSignal, WidthTruncation,
+    };
 
     #[test]
     fn unused_signal_flags() {
@@ -578,6 +838,65 @@ mod tests {
         assert_eq!(v[0].rule, "unused_signal");
     }
 
+    #[test]
+    fn internal_tristate_skips_ports() {
+        let mut input = Input::default();
+        input.signals.push(Signal {
+            name: "shared_bus".to_string(),
+            file: "a.vhd".to_string(),
+            line: 3,
+            ..Default::default()
+        });
+        for (target, line) in [("shared_bus", 10), ("pad_io", 11)] {
+            input.tristate_assignments.push(TristateAssignment {
+                target: target.to_string(),
+                file: "a.vhd".to_string(),
+                line,
+                conditional: true,
+                ..Default::default()
+            });
+        }
+        let v = internal_tristate(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "internal_tristate");
+        assert_eq!(v[0].line, 10);
+    }
+
+    #[test]
+    fn unused_signal_skips_keep_attribute() {
+        let mut input = Input::default();
+        for name in ["dbg_probe", "spare"] {
+            input.signals.push(Signal {
+                name: name.to_string(),
+                file: "a.vhd".to_string(),
+                line: 1,
+                ..Default::default()
+            });
+        }
+        input.attribute_specs.push(AttributeSpec {
+            attribute: "keep".to_string(),
+            target: "DBG_PROBE".to_string(),
+            target_kind: "signal".to_string(),
+            value: "true".to_string(),
+            file: "a.vhd".to_string(),
+            line: 3,
+            ..Default::default()
+        });
+        input.attribute_specs.push(AttributeSpec {
+            attribute: "dont_touch".to_string(),
+            target: "spare".to_string(),
+            target_kind: "signal".to_string(),
+            value: "\"FALSE\"".to_string(),
+            file: "a.vhd".to_string(),
+            line: 4,
+            ..Default::default()
+        });
+        let usage = SignalUsageIndex::from_input(&input);
+        let v = unused_signal(&input, &usage);
+        assert_eq!(v.len(), 1);
+        assert!(v[0].message.contains("'spare'"));
+    }
+
     #[test]
     fn undriven_signal_flags() {
         let mut input = Input::default();
@@ -694,6 +1013,27 @@ mod tests {
         assert_eq!(v[0].rule, "undeclared_signal_usage");
     }
 
+    #[test]
+    fn undeclared_signal_usage_skips_imported_names() {
+        let mut input = Input::default();
+        input.processes.push(Process {
+            read_signals: vec!["C_WIDTH".to_string()],
+            file: "a.vhd".to_string(),
+            line: 10,
+            in_arch: "rtl".to_string(),
+            ..Default::default()
+        });
+        input.imported_names.push(ImportedName {
+            name: "c_width".to_string(),
+            kind: "constant".to_string(),
+            from_package: "work.cfg_pkg".to_string(),
+            file: "a.vhd".to_string(),
+            line: 2,
+        });
+        let usage = SignalUsageIndex::from_input(&input);
+        assert!(undeclared_signal_usage(&input, &usage).is_empty());
+    }
+
     #[test]
     fn input_port_driven_flags() {
         let mut input = Input::default();
@@ -759,4 +1099,255 @@ mod tests {
         assert_eq!(v.len(), 1);
         assert_eq!(v[0].rule, "duplicate_signal_name");
     }
+
+    #[test]
+    fn combinational_multi_driver_flags() {
+        let mut input = Input::default();
+        input
+            .combinational_multi_drivers
+            .push(CombinationalMultiDriver {
+                signal: "y".to_string(),
+                processes: vec!["comb_a".to_string(), String::new()],
+                lines: vec![10, 20],
+                file: "a.vhd".to_string(),
+                line: 10,
+                in_arch: "rtl".to_string(),
+            });
+        let v = combinational_multi_driver(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "combinational_multi_driver");
+        assert_eq!(v[0].severity, "error");
+        assert!(v[0].message.contains("<unlabeled>@20"));
+    }
+
+    #[test]
+    fn combinational_multi_driver_skips_multi_driven_signal() {
+        let mut input = Input::default();
+        input.entities.push(Entity {
+            name: "ent".to_string(),
+            file: "a.vhd".to_string(),
+            line: 1,
+            ..Default::default()
+        });
+        input.architectures.push(Architecture {
+            name: "rtl".to_string(),
+            entity_name: "ent".to_string(),
+            file: "a.vhd".to_string(),
+            line: 2,
+        });
+        for (name, typ) in [("flag", "bit"), ("bus", "std_logic")] {
+            input.signals.push(Signal {
+                name: name.to_string(),
+                r#type: typ.to_string(),
+                file: "a.vhd".to_string(),
+                line: 3,
+                in_entity: "ent".to_string(),
+                ..Default::default()
+            });
+            for label in ["comb_a", "comb_b"] {
+                input.processes.push(Process {
+                    label: label.to_string(),
+                    assigned_signals: vec![name.to_string()],
+                    in_arch: "rtl".to_string(),
+                    file: "a.vhd".to_string(),
+                    ..Default::default()
+                });
+            }
+            input
+                .combinational_multi_drivers
+                .push(CombinationalMultiDriver {
+                    signal: name.to_string(),
+                    processes: vec!["comb_a".to_string(), "comb_b".to_string()],
+                    lines: vec![10, 20],
+                    file: "a.vhd".to_string(),
+                    line: 10,
+                    in_arch: "rtl".to_string(),
+                });
+        }
+        // multi_driven_signal is optional: while it is off, both are reported
+        assert_eq!(combinational_multi_driver(&input).len(), 2);
+
+        input
+            .lint_config
+            .rules
+            .insert("multi_driven_signal".to_string(), "warning".to_string());
+        assert_eq!(multi_driven_signal(&input).len(), 1);
+        // The bit signal is already reported as multi-driven
+        let v = combinational_multi_driver(&input);
+        assert_eq!(v.len(), 1);
+        assert!(v[0].message.contains("'bus'"));
+    }
+
+    #[test]
+    fn multiple_drivers_skips_generate() {
+        let mut input = Input::default();
+        let driver = |process: &str, line: usize, in_generate: bool| DriverLocation {
+            process: process.to_string(),
+            line,
+            in_generate,
+        };
+        input.multi_drivers.push(MultiDriver {
+            signal: "count".to_string(),
+            drivers: vec![driver("p_inc", 10, false), driver("", 20, false)],
+            file: "a.vhd".to_string(),
+            line: 10,
+            in_arch: "rtl".to_string(),
+            ..Default::default()
+        });
+        input.multi_drivers.push(MultiDriver {
+            signal: "lane".to_string(),
+            drivers: vec![driver("", 30, true), driver("", 40, true)],
+            in_generate: true,
+            file: "a.vhd".to_string(),
+            line: 30,
+            in_arch: "rtl".to_string(),
+        });
+        let v = multiple_drivers(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "multiple_drivers");
+        assert!(v[0].message.contains("p_inc@10, line 20"));
+    }
+
+    #[test]
+    fn multiple_drivers_skips_nets_reported_by_other_driver_rules() {
+        let mut input = Input::default();
+        for signal in ["comb", "vec"] {
+            input.multi_drivers.push(MultiDriver {
+                signal: signal.to_string(),
+                file: "a.vhd".to_string(),
+                line: 10,
+                in_arch: "rtl".to_string(),
+                ..Default::default()
+            });
+        }
+        input
+            .combinational_multi_drivers
+            .push(CombinationalMultiDriver {
+                signal: "COMB".to_string(),
+                processes: vec!["p1".to_string(), "p2".to_string()],
+                lines: vec![10, 20],
+                file: "a.vhd".to_string(),
+                line: 10,
+                in_arch: "rtl".to_string(),
+            });
+        let v = multiple_drivers(&input);
+        assert_eq!(v.len(), 1);
+        assert!(v[0].message.contains("'vec'"));
+    }
+
+    #[test]
+    fn write_only_signal_ignores_dead() {
+        let mut input = Input::default();
+        input.unused_signals.push(UnusedSignal {
+            name: "dbg_count".to_string(),
+            kind: "write-only".to_string(),
+            file: "a.vhd".to_string(),
+            line: 8,
+            ..Default::default()
+        });
+        input.unused_signals.push(UnusedSignal {
+            name: "spare".to_string(),
+            kind: "dead".to_string(),
+            file: "a.vhd".to_string(),
+            line: 9,
+            ..Default::default()
+        });
+        let v = write_only_signal(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "write_only_signal");
+        assert_eq!(v[0].line, 8);
+    }
+
+    #[test]
+    fn mixed_slice_direction_flags() {
+        let mut input = Input::default();
+        input.mixed_slice_directions.push(MixedSliceDirection {
+            signal: "data".to_string(),
+            architecture: "rtl".to_string(),
+            file: "a.vhd".to_string(),
+            line: 12,
+            to_expr: "data(0 to 3)".to_string(),
+            to_line: 12,
+            downto_expr: "data(3 downto 0)".to_string(),
+            downto_line: 8,
+        });
+        let v = mixed_slice_direction(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "mixed_slice_direction");
+        assert_eq!(v[0].severity, "info");
+    }
+
+    #[test]
+    fn permanent_high_z_flags() {
+        let mut input = Input::default();
+        input.permanent_high_z.push(PermanentHighZ {
+            signal: "bus_t".to_string(),
+            assignments: 2,
+            file: "a.vhd".to_string(),
+            line: 14,
+            in_arch: "rtl".to_string(),
+        });
+        let v = permanent_high_z(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "permanent_high_z");
+        assert_eq!(v[0].severity, "warning");
+    }
+
+    #[test]
+    fn uninitialized_read_reports_first_read() {
+        let mut input = Input::default();
+        input.uninitialized_reads.push(UninitRead {
+            signal: "acc".to_string(),
+            r#type: "std_logic_vector(7 downto 0)".to_string(),
+            file: "a.vhd".to_string(),
+            line: 21,
+            in_process: "comb_p".to_string(),
+            in_arch: "rtl".to_string(),
+        });
+        let v = uninitialized_read(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "uninitialized_read");
+        assert_eq!(v[0].line, 21);
+        assert!(v[0].message.contains("process 'comb_p'"));
+    }
+
+    #[test]
+    fn width_truncation_reports_dropped_bits() {
+        let mut input = Input::default();
+        input.width_truncations.push(WidthTruncation {
+            target: "byte_q".to_string(),
+            target_width: 8,
+            source: "word_d".to_string(),
+            source_width: 16,
+            file: "a.vhd".to_string(),
+            line: 12,
+            ..Default::default()
+        });
+        let v = width_truncation(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "width_truncation");
+        assert!(v[0].message.contains("upper 8 bits"));
+    }
+
+    #[test]
+    fn unprotected_shared_variable_names_standard() {
+        let mut input = Input {
+            standard: "2008".to_string(),
+            ..Default::default()
+        };
+        input
+            .unprotected_shared_variables
+            .push(UnprotectedSharedVariable {
+                name: "hits".to_string(),
+                r#type: "integer".to_string(),
+                file: "a.vhd".to_string(),
+                line: 9,
+                ..Default::default()
+            });
+        let v = unprotected_shared_variable(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "unprotected_shared_variable");
+        assert_eq!(v[0].severity, "error");
+        assert!(v[0].message.contains("VHDL-2008"));
+    }
 }
@@ -1,13 +1,14 @@
 use regex::Regex;
 
 use crate::policy::helpers;
-use crate::policy::input::Input;
+use crate::policy::input::{AttributeSpec, Input};
 use crate::policy::result::Violation;
-use std::collections::HashSet;
+use std::collections::{HashMap, HashSet};
 
 pub fn violations(input: &Input) -> Vec<Violation> {
     let mut out = Vec::new();
     out.extend(signal_crosses_clock_domain(input));
+    out.extend(conflicting_attribute(input));
     out
 }
 
@@ -330,10 +331,79 @@ fn get_entity_file(input: &Input, entity_name: &str) -> String {
         .unwrap_or_else(|| "unknown".to_string())
 }
 
+fn conflicting_attribute(input: &Input) -> Vec<Violation> {
+    let mut out = Vec::new();
+    let mut first: HashMap<(String, String, String, String), &AttributeSpec> = HashMap::new();
+    for spec in input
+        .attribute_specs
+        .iter()
+        .filter(|s| !s.target.is_empty())
+    {
+        let key = (
+            spec.file.clone(),
+            spec.attribute.to_ascii_lowercase(),
+            spec.target.to_ascii_lowercase(),
+            spec.target_kind.clone(),
+        );
+        match first.get(&key) {
+            None => {
+                first.insert(key, spec);
+            }
+            Some(prev)
+                if helpers::attribute_value(&prev.value)
+                    != helpers::attribute_value(&spec.value) =>
+            {
+                out.push(Violation {
+                    rule: "conflicting_attribute".to_string(),
+                    severity: "warning".to_string(),
+                    file: spec.file.clone(),
+                    line: spec.line,
+                    message: format!(
+                        "Attribute '{}' of {} '{}' is {} here but {} on line {} - synthesis tools disagree on which wins",
+                        spec.attribute, spec.target_kind, spec.target, spec.value, prev.value, prev.line
+                    ),
+                });
+            }
+            Some(_) => {}
+        }
+    }
+    out
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{Input, Signal};
+    use crate::policy::input::{AttributeSpec, Input, Signal};
+
+    fn attribute(target: &str, value: &str, line: usize) -> AttributeSpec {
+        AttributeSpec {
+            attribute: "keep".to_string(),
+            target: target.to_string(),
+            target_kind: "signal".to_string(),
+            value: value.to_string(),
+            file: "a.vhd".to_string(),
+            line,
+            ..Default::default()
+        }
+    }
+
+    #[test]
+    fn conflicting_attribute_flags_different_values() {
+        let mut input = Input::default();
+        input.attribute_specs.push(attribute("data_r", "true", 10));
+        input
+            .attribute_specs
+            .push(attribute("DATA_R", "\"TRUE\"", 11));
+        input.attribute_specs.push(attribute("data_r", "false", 12));
+        input
+            .attribute_specs
+            .push(attribute("other_r", "false", 13));
+        let v = conflicting_attribute(&input);
+        assert_eq!(v.len(), 1);
+        assert_eq!(v[0].rule, "conflicting_attribute");
+        assert_eq!(v[0].line, 12);
+        assert!(v[0].message.contains("line 10"));
+    }
 
     #[test]
     fn very_wide_bus_flags() {
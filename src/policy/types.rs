@@ -2,8 +2,10 @@ use crate::policy::helpers::{is_signed_type, is_unsigned_type};
 use crate::policy::input::Input;
 use crate::policy::result::Violation;
 
-pub fn violations(_input: &Input) -> Vec<Violation> {
-    Vec::new()
+pub fn violations(input: &Input) -> Vec<Violation> {
+    let mut violations = unsafe_arithmetic(input);
+    violations.extend(incomplete_record_aggregate(input));
+    violations
 }
 
 pub fn optional_violations(input: &Input) -> Vec<Violation> {
@@ -37,10 +39,86 @@ fn mixed_signedness(input: &Input) -> Vec<Violation> {
     violations
 }
 
+fn unsafe_arithmetic(input: &Input) -> Vec<Violation> {
+    input
+        .unsafe_arithmetic
+        .iter()
+        .map(|ua| Violation {
+            rule: "unsafe_arithmetic".to_string(),
+            severity: "error".to_string(),
+            file: ua.file.clone(),
+            line: ua.line,
+            message: format!(
+                "'{}' applied to '{}' of type '{}' - numeric_std defines arithmetic only for unsigned and signed; convert with unsigned()/signed() instead of adding std_logic_unsigned",
+                ua.operator, ua.operand, ua.operand_type
+            ),
+        })
+        .collect()
+}
+
+fn incomplete_record_aggregate(input: &Input) -> Vec<Violation> {
+    input
+        .incomplete_record_aggregates
+        .iter()
+        .map(|ira| Violation {
+            rule: "incomplete_record_aggregate".to_string(),
+            severity: "error".to_string(),
+            file: ira.file.clone(),
+            line: ira.line,
+            message: format!(
+                "Aggregate assigned to '{}' of record type '{}' leaves out {} - a record aggregate must associate every field; name them or add others =>",
+                ira.target,
+                ira.r#type,
+                ira.missing
+                    .iter()
+                    .map(|f| format!("'{}'", f))
+                    .collect::<Vec<_>>()
+                    .join(", ")
+            ),
+        })
+        .collect()
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
-    use crate::policy::input::{Input, Signal};
+    use crate::policy::input::{IncompleteRecordAggregate, Input, Signal, UnsafeArithmetic};
+
+    #[test]
+    fn unsafe_arithmetic_names_operand() {
+        let mut input = Input::default();
+        input.unsafe_arithmetic.push(UnsafeArithmetic {
+            operator: "+".to_string(),
+            operand: "count".to_string(),
+            operand_type: "std_logic_vector(7 downto 0)".to_string(),
+            file: "a.vhd".to_string(),
+            line: 14,
+            ..Default::default()
+        });
+        let violations = violations(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "unsafe_arithmetic");
+        assert!(violations[0].message.contains("'count'"));
+    }
+
+    #[test]
+    fn incomplete_record_aggregate_lists_missing_fields() {
+        let mut input = Input::default();
+        input
+            .incomplete_record_aggregates
+            .push(IncompleteRecordAggregate {
+                target: "req".to_string(),
+                r#type: "bus_req_t".to_string(),
+                missing: vec!["addr".to_string(), "data".to_string()],
+                file: "a.vhd".to_string(),
+                line: 20,
+                ..Default::default()
+            });
+        let violations = violations(&input);
+        assert_eq!(violations.len(), 1);
+        assert_eq!(violations[0].rule, "incomplete_record_aggregate");
+        assert!(violations[0].message.contains("'addr', 'data'"));
+    }
 
     #[test]
     fn mixed_signedness_flags_pair() {
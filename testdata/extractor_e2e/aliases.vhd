@@ -0,0 +1,25 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity alias_user is
+  port (
+    clk : in  std_logic;
+    q   : out std_logic
+  );
+end entity;
+
+architecture rtl of alias_user is
+  signal status : std_logic_vector(7 downto 0);
+  alias ready : std_logic is status(0);
+  alias flags : std_logic_vector(3 downto 0) is status(7 downto 4);
+begin
+  ready <= '1';
+
+  reg_p : process (clk)
+  begin
+    if rising_edge(clk) then
+      flags <= "0000";
+      q <= flags(1);
+    end if;
+  end process;
+end architecture;
@@ -0,0 +1,21 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity assertions_tb is
+end entity;
+
+architecture sim of assertions_tb is
+  signal clk  : std_logic := '0';
+  signal done : boolean := false;
+begin
+  assert clk /= 'X' report "clock is X" severity failure;
+
+  check_p : process
+  begin
+    wait for 10 ns;
+    assert done report "not done";
+    assert clk = '0' severity warning;
+    report "finished";
+    wait;
+  end process;
+end architecture;
@@ -0,0 +1,21 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity attr_top is
+  port (
+    clk : in std_logic;
+    d   : in std_logic
+  );
+end entity;
+
+architecture rtl of attr_top is
+  signal dbg_a, dbg_b : std_logic;
+  signal sync_r       : std_logic;
+  attribute KEEP : boolean;
+  attribute KEEP of dbg_a, dbg_b : signal is true;
+  attribute ASYNC_REG : string;
+  attribute ASYNC_REG of sync_r : signal is "TRUE";
+  attribute my_note : string;
+  attribute my_note of others : signal is "spare";
+begin
+end architecture;
@@ -0,0 +1,64 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity reset_polarity_demo is
+  port (
+    clk    : in  std_logic;
+    rst    : in  std_logic;
+    rst_n  : in  std_logic;
+    srst   : in  boolean;
+    arst_n : in  boolean;
+    d      : in  std_logic;
+    q      : out std_logic_vector(4 downto 0)
+  );
+end entity;
+
+architecture rtl of reset_polarity_demo is
+begin
+  high_p : process(clk, rst)
+  begin
+    if rst = '1' then
+      q(0) <= '0';
+    elsif rising_edge(clk) then
+      q(0) <= d;
+    end if;
+  end process;
+
+  low_p : process(clk, rst_n)
+  begin
+    if rst_n = '0' then
+      q(1) <= '0';
+    elsif rising_edge(clk) then
+      q(1) <= d;
+    end if;
+  end process;
+
+  inverted_p : process(clk, rst_n)
+  begin
+    if rst_n /= '1' then
+      q(2) <= '0';
+    elsif rising_edge(clk) then
+      q(2) <= d;
+    end if;
+  end process;
+
+  bool_p : process(clk)
+  begin
+    if rising_edge(clk) then
+      if srst = true then
+        q(3) <= '0';
+      else
+        q(3) <= d;
+      end if;
+    end if;
+  end process;
+
+  not_p : process(clk, arst_n)
+  begin
+    if not arst_n then
+      q(4) <= '0';
+    elsif rising_edge(clk) then
+      q(4) <= d;
+    end if;
+  end process;
+end architecture;
@@ -0,0 +1,27 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity selected_assignment is
+  port (
+    sel : in std_logic_vector(1 downto 0);
+    a   : in std_logic;
+    b   : in std_logic;
+    c   : in std_logic;
+    y   : out std_logic;
+    z   : out std_logic;
+    w   : out std_logic
+  );
+end entity selected_assignment;
+
+architecture rtl of selected_assignment is
+begin
+  with sel select y <= a when "00", b when others;
+
+  mux_z : with sel select
+    z <= a when "00",
+         c when "01" | "10",
+         b when others;
+
+  -- No "others": the metavalues of sel are left unassigned
+  with sel select w <= a when "00", b when "01" | "10", c when "11";
+end architecture rtl;
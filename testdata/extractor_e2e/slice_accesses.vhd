@@ -0,0 +1,18 @@
+library ieee;
+use ieee.std_logic_1164.all;
+use ieee.numeric_std.all;
+
+entity slice_accesses is
+  port (
+    din  : in  std_logic_vector(7 downto 0);
+    dout : out std_logic_vector(7 downto 0)
+  );
+end entity slice_accesses;
+
+architecture rtl of slice_accesses is
+  signal lo : std_logic_vector(3 downto 0);
+begin
+  lo <= din(3 downto 0);
+  dout(0 to 3) <= std_logic_vector(unsigned(lo) + 1);
+  dout(7 downto 4) <= din(7 downto 4);
+end architecture rtl;
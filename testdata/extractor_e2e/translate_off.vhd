@@ -0,0 +1,40 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity translate_off_demo is
+  port (
+    clk : in  std_logic;
+    d   : in  std_logic;
+    q   : out std_logic
+  );
+end entity;
+
+architecture rtl of translate_off_demo is
+  signal q_r : std_logic;
+  -- synthesis translate_off
+  signal sim_count : integer := 0;
+  -- pragma translate_off
+  signal nested : std_logic;
+  -- pragma translate_on
+  signal still_off : std_logic;
+  -- synthesis translate_on
+begin
+  reg_p : process(clk)
+  begin
+    if rising_edge(clk) then
+      q_r <= d;
+    end if;
+  end process;
+
+  q <= q_r;
+
+  -- synthesis translate_off
+  monitor_p : process(clk)
+  begin
+    if rising_edge(clk) then
+      sim_count <= sim_count + 1;
+    end if;
+  end process;
+
+  nested <= q_r;
+end architecture;
@@ -0,0 +1,61 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity choices_rules is
+  port (
+    idx : in  integer range 0 to 15;
+    y   : out std_logic;
+    z   : out std_logic;
+    w   : out std_logic;
+    v   : out std_logic
+  );
+end choices_rules;
+
+architecture rtl of choices_rules is
+begin
+  decode_p: process(idx)
+  begin
+    case idx is
+      when 0 to 3 =>
+        y <= '0';
+      when 2 to 5 =>
+        y <= '1';
+      when others =>
+        y <= '0';
+    end case;
+  end process;
+
+  -- 16#1# repeats the first branch's value
+  unreachable_p: process(idx)
+  begin
+    if idx = 1 then
+      z <= '0';
+    elsif idx = 2 then
+      z <= '1';
+    elsif idx = 16#1# then
+      z <= '1';
+    else
+      z <= '0';
+    end if;
+  end process;
+
+  parallel_p: process(idx)
+  begin
+    if idx = 4 then
+      w <= '0';
+    elsif idx = 5 then
+      w <= '1';
+    elsif idx = 6 then
+      w <= '1';
+    else
+      w <= '0';
+    end if;
+  end process;
+
+  -- 7 falls inside the range split across two lines
+  with idx select
+    v <= '0' when 4 to
+                  8,
+         '1' when 7,
+         '0' when others;
+end rtl;
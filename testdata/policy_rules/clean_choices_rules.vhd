@@ -0,0 +1,24 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity clean_choices_rules is
+  port (
+    idx : in  integer range 0 to 15;
+    y   : out std_logic
+  );
+end clean_choices_rules;
+
+architecture rtl of clean_choices_rules is
+begin
+  decode_p: process(idx)
+  begin
+    case idx is
+      when 0 to 3 =>
+        y <= '0';
+      when 4 to 7 =>
+        y <= '1';
+      when others =>
+        y <= '0';
+    end case;
+  end process;
+end rtl;
@@ -6,12 +6,16 @@ entity clean_combinational_rules is
     sel_i : in std_logic;
     a_i   : in std_logic;
     b_i   : in std_logic;
-    y_o   : out std_logic
+    y_o   : out std_logic;
+    z_o   : out std_logic
   );
 end entity clean_combinational_rules;
 
 architecture rtl of clean_combinational_rules is
-  signal y_s : std_logic;
+  type mode_t is (MODE_A, MODE_B);
+  signal y_s    : std_logic;
+  signal z_s    : std_logic;
+  signal mode_s : mode_t;
 begin
   comb_p : process(sel_i, a_i, b_i)
   begin
@@ -22,5 +26,27 @@ begin
     end case;
   end process comb_p;
 
-  y_o <= y_s;
+  with sel_i select y_o <=
+    y_s when '1',
+    a_i when others;
+
+  mode_p : process(sel_i)
+  begin
+    if sel_i = '1' then
+      mode_s <= MODE_B;
+    else
+      mode_s <= MODE_A;
+    end if;
+  end process mode_p;
+
+  -- Every literal of mode_t is covered, so no "others" is needed
+  mode_comb_p : process(mode_s, a_i, b_i)
+  begin
+    case mode_s is
+      when MODE_A => z_s <= a_i;
+      when MODE_B => z_s <= b_i;
+    end case;
+  end process mode_comb_p;
+
+  z_o <= z_s;
 end architecture rtl;
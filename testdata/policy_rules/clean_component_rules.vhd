@@ -0,0 +1,45 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity reg_comp is
+  port (
+    clk_i : in  std_logic;
+    d_i   : in  std_logic_vector(7 downto 0);
+    q_o   : out std_logic_vector(7 downto 0)
+  );
+end entity reg_comp;
+
+architecture rtl of reg_comp is
+begin
+  reg_p : process(clk_i)
+  begin
+    if rising_edge(clk_i) then
+      q_o <= d_i;
+    end if;
+  end process reg_p;
+end architecture rtl;
+
+entity clean_component_rules is
+  port (
+    clk_i : in  std_logic;
+    d_i   : in  std_logic_vector(7 downto 0);
+    q_o   : out std_logic_vector(7 downto 0)
+  );
+end entity clean_component_rules;
+
+architecture rtl of clean_component_rules is
+  component reg_comp is
+    port (
+      clk_i : in  std_logic;
+      d_i   : in  std_logic_vector(7 downto 0);
+      q_o   : out std_logic_vector(7 downto 0)
+    );
+  end component reg_comp;
+begin
+  u_reg : reg_comp
+    port map (
+      clk_i => clk_i,
+      d_i   => d_i,
+      q_o   => q_o
+    );
+end architecture rtl;
@@ -12,6 +12,9 @@ architecture rtl of clean_fsm_rules is
   type state_t is (S_IDLE, S_RUN, S_DONE);
   signal state : state_t;
   signal next_state : state_t;
+  attribute fsm_encoding : string;
+  attribute fsm_encoding of state : signal is "one_hot";
+  attribute fsm_encoding of state_t : type is "one-hot";
 begin
   fsm_p : process(clk_i, rst_n)
   begin
@@ -0,0 +1,16 @@
+library ieee;
+use ieee.std_logic_1164.all;
+use ieee.numeric_std.all;
+
+package clean_package_rules is
+  subtype byte_t is std_logic_vector(7 downto 0);
+  constant BYTE_WIDTH : natural := 8;
+  function to_byte(value : natural) return byte_t;
+end package clean_package_rules;
+
+package body clean_package_rules is
+  function to_byte(value : natural) return byte_t is
+  begin
+    return std_logic_vector(to_unsigned(value, BYTE_WIDTH));
+  end function to_byte;
+end package body clean_package_rules;
@@ -11,7 +11,7 @@ entity clean_sequential_rules is
 end entity clean_sequential_rules;
 
 architecture rtl of clean_sequential_rules is
-  signal rst_n_sync_meta : std_logic;
+  signal rst_n_sync_meta : std_logic := '0';
   signal rst_n_sync2     : std_logic;
 begin
   rst_sync_p : process(clk_i, rst_n)
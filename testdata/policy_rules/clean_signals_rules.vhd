@@ -0,0 +1,49 @@
+package clean_signals_pkg is
+  type counter_t is protected
+    procedure increment;
+    impure function value return natural;
+  end protected counter_t;
+end package clean_signals_pkg;
+
+package body clean_signals_pkg is
+  type counter_t is protected body
+    variable count : natural := 0;
+
+    procedure increment is
+    begin
+      count := count + 1;
+    end procedure;
+
+    impure function value return natural is
+    begin
+      return count;
+    end function;
+  end protected body counter_t;
+end package body clean_signals_pkg;
+
+library ieee;
+use ieee.std_logic_1164.all;
+use work.clean_signals_pkg.all;
+
+entity clean_signals_rules is
+  port (
+    clk_i  : in std_logic;
+    data_i : in std_logic;
+    data_o : out std_logic
+  );
+end entity clean_signals_rules;
+
+architecture rtl of clean_signals_rules is
+  shared variable hits : counter_t;
+  signal data_q : std_logic := '0';
+begin
+  reg_p : process(clk_i)
+  begin
+    if rising_edge(clk_i) then
+      data_q <= data_i;
+      hits.increment;
+    end if;
+  end process reg_p;
+
+  data_o <= data_q;
+end architecture rtl;
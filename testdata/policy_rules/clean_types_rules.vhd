@@ -5,13 +5,21 @@ use ieee.numeric_std.all;
 entity clean_types_rules is
   port (
     data_i : in unsigned(3 downto 0);
-    data_o : out unsigned(3 downto 0)
+    data_o : out unsigned(3 downto 0);
+    pair_o : out unsigned(3 downto 0)
   );
 end entity clean_types_rules;
 
 architecture rtl of clean_types_rules is
+  type pair_t is record
+    hi : unsigned(1 downto 0);
+    lo : unsigned(1 downto 0);
+  end record;
   signal data_s : unsigned(3 downto 0);
+  signal pair_s : pair_t;
 begin
   data_s <= data_i;
   data_o <= data_s;
+  pair_s <= (hi => data_i(3 downto 2), lo => data_i(1 downto 0));
+  pair_o <= pair_s.hi & pair_s.lo;
 end architecture rtl;
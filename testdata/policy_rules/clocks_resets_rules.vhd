@@ -10,11 +10,19 @@ entity clocks_resets_rules is
     data_in : in std_logic;
     q1      : out std_logic;
     q2      : out std_logic;
-    q3      : out std_logic
+    q3      : out std_logic;
+    q4      : out std_logic;
+    q5      : out std_logic;
+    q6      : out std_logic;
+    q7      : out std_logic;
+    q8      : out std_logic
   );
 end clocks_resets_rules;
 
 architecture rtl of clocks_resets_rules is
+  signal gclk : std_logic;
+  signal q3_r : std_logic := '1';
+  signal data_r : std_logic;
 begin
   p_multi_clk: process(clk_vec, clk_aux)
   begin
@@ -33,9 +41,50 @@ begin
   p_async_reset: process(clk_aux, rst)
   begin
     if rst = '1' then
-      q3 <= '0';
+      q3_r <= '0';
     elsif rising_edge(clk_aux) then
-      q3 <= data_in;
+      q3_r <= data_in;
+    end if;
+  end process;
+
+  q3 <= q3_r;
+
+  p_async_reset_low: process(clk_aux, rst)
+  begin
+    if rst = '0' then
+      q4 <= '0';
+    elsif rising_edge(clk_aux) then
+      q4 <= data_in;
+    end if;
+  end process;
+
+  q5 <= data_in when clk_aux = '1' else '0';
+
+  p_clock_as_data: process(clk_aux, data_in)
+  begin
+    q6 <= clk_aux and data_in;
+  end process;
+
+  gclk <= clk_aux and data_in;
+
+  p_gated_clk: process(gclk)
+  begin
+    if rising_edge(gclk) then
+      q7 <= data_in;
+    end if;
+  end process;
+
+  p_data_reg: process(clk_aux)
+  begin
+    if rising_edge(clk_aux) then
+      data_r <= data_in;
+    end if;
+  end process;
+
+  p_data_clk: process(data_r)
+  begin
+    if rising_edge(data_r) then
+      q8 <= data_in;
     end if;
   end process;
 end rtl;
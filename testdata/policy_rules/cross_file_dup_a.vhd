@@ -5,6 +5,11 @@ package dup_pkg is
   constant WIDTH : integer := 8;
 end dup_pkg;
 
+context dup_ctx is
+  library ieee;
+  use ieee.std_logic_1164.all;
+end context dup_ctx;
+
 entity dup_ent is
   port (
     a : in std_logic;
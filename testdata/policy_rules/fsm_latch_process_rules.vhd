@@ -12,6 +12,12 @@ end fsm_latch_rules;
 architecture rtl of fsm_latch_rules is
   type state_t is (IDLE, RUN, DONE);
   signal state     : state_t;
+  type seq_state_t is (Q0, Q1, Q2, Q3, Q4, Q5, Q6, Q7, Q8);
+  attribute fsm_encoding : string;
+  attribute enum_encoding : string;
+  attribute fsm_encoding of state : signal is "one_hot";
+  attribute enum_encoding of state_t : type is "gray";
+  attribute fsm_encoding of seq_state_t : type is "sequential";
   signal state_vec : std_logic_vector(1 downto 0);
   signal s0 : std_logic;
   signal s1 : std_logic;
@@ -34,6 +40,9 @@ architecture rtl of fsm_latch_rules is
   signal s18 : std_logic;
   signal s19 : std_logic;
   signal s20 : std_logic;
+  signal s21 : std_logic;
+  signal s22 : std_logic;
+  signal s23 : std_logic;
 begin
   y <= a when a = '1' else '0';
 
@@ -75,4 +84,23 @@ begin
       state <= IDLE;
     end if;
   end process;
+
+  gate_proc: process(a, s1)
+  begin
+    if a = '1' then
+      s21 <= s1;
+    end if;
+  end process;
+
+  order_proc: process(a, s23)
+  begin
+    s22 <= s23;
+    s23 <= a;
+  end process;
+
+  dbg_leftover: process(a)
+    variable tmp : std_logic;
+  begin
+    tmp := a;
+  end process;
 end rtl;
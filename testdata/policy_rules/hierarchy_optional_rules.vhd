@@ -137,6 +137,12 @@ entity width_top is
 end width_top;
 
 architecture rtl of width_top is
+  component leaf_vec is
+    port (
+      d : in  std_logic_vector(3 downto 0);
+      q : in  std_logic_vector(7 downto 0)
+    );
+  end component;
   signal s4 : std_logic_vector(3 downto 0);
   signal s8 : std_logic_vector(7 downto 0);
 begin
@@ -2,6 +2,9 @@ library ieee;
 use ieee.std_logic_1164.all;
 
 entity child is
+  generic (
+    WIDTH : natural
+  );
   port (
     a : in std_logic;
     y : out std_logic
@@ -21,6 +24,12 @@ entity top is
 end top;
 
 architecture rtl of top is
+  component stale_child is
+    port (
+      a : in std_logic;
+      y : out std_logic
+    );
+  end component;
 begin
   u1: entity work.child;
 end rtl;
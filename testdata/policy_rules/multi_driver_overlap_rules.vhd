@@ -0,0 +1,28 @@
+library ieee;
+use ieee.std_logic_1164.all;
+
+-- sig_multi is an unresolved scalar driven by two combinational processes.
+-- multi_driven_signal reports it; combinational_multi_driver and
+-- multiple_drivers must not report the same net again.
+entity multi_driver_overlap_rules is
+  port (
+    in_p  : in bit;
+    out_p : out bit
+  );
+end multi_driver_overlap_rules;
+
+architecture rtl of multi_driver_overlap_rules is
+  signal sig_multi : bit;
+begin
+  p1: process(in_p)
+  begin
+    sig_multi <= in_p;
+  end process;
+
+  p2: process(in_p)
+  begin
+    sig_multi <= not in_p;
+  end process;
+
+  out_p <= sig_multi;
+end rtl;
@@ -27,3 +27,19 @@ begin
 
   out_read <= in_used;
 end rtl;
+
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity shadowed_port is
+  port (
+    d_i     : in std_logic;
+    valid_o : out std_logic
+  );
+end shadowed_port;
+
+architecture rtl of shadowed_port is
+  signal VALID_O : std_logic;
+begin
+  VALID_O <= d_i;
+end rtl;
@@ -3,6 +3,7 @@ use ieee.std_logic_1164.all;
 
 package big_pkg is
   signal p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, p10, p11, p12, p13, p14, p15, p16, p17, p18, p19, p20, p21, p22, p23, p24, p25, p26, p27, p28, p29, p30, p31, p32, p33, p34, p35, p36, p37, p38, p39, p40, p41, p42, p43, p44, p45, p46, p47, p48, p49, p50 : std_logic;
+  type unsigned is array (natural range <>) of std_logic;
 end big_pkg;
 
 entity block1 is
@@ -139,3 +140,27 @@ begin
     end generate;
   end generate;
 end deep_gen;
+
+entity magic_counter is
+  port (
+    clk  : in std_logic;
+    done : out std_logic
+  );
+end magic_counter;
+
+architecture magic_counter of magic_counter is
+  signal cnt : integer range 0 to 127;
+begin
+  count_p : process(clk)
+  begin
+    if rising_edge(clk) then
+      if cnt = 100 then
+        cnt <= 0;
+      else
+        cnt <= cnt + 1;
+      end if;
+    end if;
+  end process count_p;
+
+  done <= '1' when cnt = 0 else '0';
+end magic_counter;
@@ -12,6 +12,9 @@ end sensitivity_rules;
 architecture rtl of sensitivity_rules is
   signal s_out1 : std_logic;
   signal s_out2 : std_logic;
+  signal s_out3 : std_logic;
+  signal s_out4 : std_logic;
+  constant C_EN : std_logic := '1';
 begin
   p_incomplete: process(a)
   begin
@@ -22,4 +25,14 @@ begin
   begin
     s_out2 <= a or b;
   end process;
+
+  p_constant: process(a, C_EN)
+  begin
+    s_out3 <= a and C_EN;
+  end process;
+
+  p_smell: process(a, A, s_out4)
+  begin
+    s_out4 <= a;
+  end process;
 end rtl;
@@ -13,8 +13,22 @@ architecture rtl of signals_rules is
   signal sig_unused    : std_logic;
   signal sig_read_only : std_logic;
   signal sig_multi     : bit;
+  signal sig_contend   : std_logic;
+  signal vec_multi     : bit_vector(1 downto 0);
   signal wide_bus      : std_logic_vector(255 downto 0);
+  signal slice_bus     : std_logic_vector(7 downto 0);
+  signal float_bus     : std_logic_vector(3 downto 0);
+  signal narrow_bus    : std_logic_vector(3 downto 0);
+  shared variable hit_count : integer;
 begin
+  slice_bus(3 downto 0) <= (others => in_p);
+  slice_bus(4 to 7)     <= (others => '0');
+  float_bus             <= (others => 'Z');
+  narrow_bus            <= slice_bus;
+  -- Unresolved composite with two concurrent drivers
+  vec_multi             <= "00";
+  vec_multi             <= "11";
+
   p_read: process(sig_read_only, in_p)
   begin
     if sig_read_only = '1' then
@@ -34,6 +48,17 @@ begin
     sig_multi <= not in_p;
   end process;
 
+  -- Resolved, so not multi_driven_signal, but two combinational drivers
+  p3: process(in_p)
+  begin
+    sig_contend <= in_p;
+  end process;
+
+  p4: process(in_p)
+  begin
+    sig_contend <= '0';
+  end process;
+
   p_drive_input: process(in_p)
   begin
     in_p <= '0';
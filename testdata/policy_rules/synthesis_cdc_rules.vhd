@@ -24,6 +24,9 @@ architecture rtl of synthesis_cdc_rules is
   signal ready      : std_logic;
   signal sync_sink  : std_logic;
   signal gate_ff    : std_logic;
+  attribute keep : boolean;
+  attribute keep of sync1 : signal is true;
+  attribute keep of sync1 : signal is false;
 begin
   clk_gate <= clk_a and in_a;
 
@@ -0,0 +1,33 @@
+library ieee;
+use ieee.std_logic_1164.all;
+use ieee.numeric_std.all;
+
+entity types_rules is
+  port (
+    clk_i   : in std_logic;
+    step_i  : in std_logic_vector(7 downto 0);
+    count_o : out std_logic_vector(7 downto 0);
+    valid_o : out std_logic
+  );
+end entity types_rules;
+
+architecture rtl of types_rules is
+  type req_t is record
+    valid : std_logic;
+    addr  : std_logic_vector(7 downto 0);
+    data  : std_logic_vector(7 downto 0);
+  end record;
+  signal count_q : std_logic_vector(7 downto 0) := (others => '0');
+  signal req_s   : req_t;
+begin
+  count_p : process(clk_i)
+  begin
+    if rising_edge(clk_i) then
+      count_q <= count_q + step_i;
+    end if;
+  end process count_p;
+
+  count_o <= count_q;
+  req_s   <= (valid => clk_i, addr => step_i);
+  valid_o <= req_s.valid;
+end architecture rtl;